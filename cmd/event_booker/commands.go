@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/stpnv0/EventBooker/internal/app"
+	"github.com/stpnv0/EventBooker/internal/config"
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/handler/dto"
+)
+
+type command func(cfg *config.Config, args []string) error
+
+var commands = map[string]command{
+	"serve":          serve,
+	"migrate":        migrate,
+	"cancel-expired": cancelExpired,
+	"seed":           seed,
+	"user":           user,
+	"event":          event,
+}
+
+func serve(cfg *config.Config, args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	mode := fs.String("mode", cfg.App.Mode, "run mode: api, worker or all")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	switch *mode {
+	case config.ModeAPI, config.ModeWorker, config.ModeAll:
+		cfg.App.Mode = *mode
+	default:
+		return fmt.Errorf("unknown mode %q", *mode)
+	}
+
+	if err := app.Migrate(cfg, "up"); err != nil {
+		return fmt.Errorf("migrations: %w", err)
+	}
+
+	application, err := app.New(cfg)
+	if err != nil {
+		return fmt.Errorf("app init: %w", err)
+	}
+
+	return application.Run()
+}
+
+func migrate(cfg *config.Config, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("expected one of up, down, status")
+	}
+
+	switch args[0] {
+	case "up", "down", "status":
+		return app.Migrate(cfg, args[0])
+	default:
+		return fmt.Errorf("unknown migrate command %q", args[0])
+	}
+}
+
+func cancelExpired(cfg *config.Config, _ []string) error {
+	return withApp(cfg, func(ctx context.Context, a *app.App) error {
+		n, err := a.CancelExpired(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("cancelled %d expired bookings\n", n)
+		return nil
+	})
+}
+
+func seed(cfg *config.Config, _ []string) error {
+	return withApp(cfg, func(ctx context.Context, a *app.App) error {
+		return a.Seed(ctx)
+	})
+}
+
+func user(cfg *config.Config, args []string) error {
+	if len(args) == 0 || args[0] != "create" {
+		return fmt.Errorf("expected subcommand: create")
+	}
+
+	fs := flag.NewFlagSet("user create", flag.ContinueOnError)
+	username := fs.String("username", "", "username (required)")
+	chatID := fs.Int64("telegram-chat-id", 0, "telegram chat id")
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	input := domain.CreateUserInput{Username: *username}
+	if *chatID != 0 {
+		input.TelegramChatID = chatID
+	}
+
+	return withApp(cfg, func(ctx context.Context, a *app.App) error {
+		u, err := a.CreateUser(ctx, input)
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(os.Stdout).Encode(dto.ToUserResponse(u))
+	})
+}
+
+func event(cfg *config.Config, args []string) error {
+	if len(args) == 0 || args[0] != "export" {
+		return fmt.Errorf("expected subcommand: export")
+	}
+
+	fs := flag.NewFlagSet("event export", flag.ContinueOnError)
+	format := fs.String("format", app.ExportFormatJSON, "output format: json or csv")
+	out := fs.String("out", "", "output file (default stdout)")
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	// Формат проверяется до подключения к БД, чтобы не тратить время на заведомо неверный вызов.
+	switch *format {
+	case app.ExportFormatJSON, app.ExportFormatCSV:
+	default:
+		return fmt.Errorf("unknown export format %q", *format)
+	}
+
+	return withApp(cfg, func(ctx context.Context, a *app.App) error {
+		if *out == "" {
+			return a.ExportEvents(ctx, os.Stdout, *format)
+		}
+		return writeFileAtomic(*out, func(w io.Writer) error {
+			return a.ExportEvents(ctx, w, *format)
+		})
+	})
+}
+
+// writeFileAtomic пишет во временный файл рядом с path и переименовывает его
+// только после успешной записи: при ошибке прежний файл остаётся нетронутым.
+func writeFileAtomic(path string, write func(w io.Writer) error) error {
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("create output file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	if err = write(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return fmt.Errorf("write output file: %w", err)
+	}
+	if err = os.Rename(f.Name(), path); err != nil {
+		return fmt.Errorf("write output file: %w", err)
+	}
+
+	return nil
+}
+
+// withApp собирает сервисы без воркера и API, выполняет fn и закрывает соединение с БД.
+func withApp(cfg *config.Config, fn func(ctx context.Context, a *app.App) error) error {
+	a, err := app.NewServices(cfg)
+	if err != nil {
+		return fmt.Errorf("app init: %w", err)
+	}
+	defer a.Close()
+
+	return fn(context.Background(), a)
+}
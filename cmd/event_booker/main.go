@@ -1,21 +1,47 @@
 package main
 
 import (
+	"flag"
+	"fmt"
 	"log"
+	"os"
+	_ "time/tzdata" // часовые пояса пользователей не зависят от образа
 
-	"github.com/stpnv0/EventBooker/internal/app"
 	"github.com/stpnv0/EventBooker/internal/config"
 )
 
+const usage = `Usage: event_booker [--config path] <command> [args]
+
+Commands:
+  serve [--mode api|worker|all]  run HTTP API and/or background worker (default)
+  migrate up|down|status         manage database schema
+  cancel-expired                 cancel expired pending bookings once
+  seed                           create demo users and events
+  user create --username NAME [--telegram-chat-id ID]
+  event export [--format json|csv] [--out FILE]
+`
+
 func main() {
+	// Флаг регистрируется здесь, чтобы cleanenv-port не вызывал flag.Parse сам
+	// и подкоманды оставались в flag.Args().
+	flag.String("config", "", "path to config file")
+	flag.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	flag.Parse()
+
 	cfg := config.MustLoad()
 
-	application, err := app.New(cfg)
-	if err != nil {
-		log.Fatalf("app init: %v", err)
+	name, args := "serve", flag.Args()
+	if len(args) > 0 {
+		name, args = args[0], args[1:]
+	}
+
+	cmd, ok := commands[name]
+	if !ok {
+		flag.Usage()
+		os.Exit(2)
 	}
 
-	if err = application.Run(); err != nil {
-		log.Fatalf("app run: %v", err)
+	if err := cmd(cfg, args); err != nil {
+		log.Fatalf("%s: %v", name, err)
 	}
 }
@@ -4,12 +4,17 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"io/fs"
 	"net/http"
+	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/pressly/goose/v3"
 	"github.com/stpnv0/EventBooker/internal/config"
+	"github.com/stpnv0/EventBooker/internal/domain"
 	"github.com/stpnv0/EventBooker/internal/handler"
 	"github.com/stpnv0/EventBooker/internal/middleware"
 	"github.com/stpnv0/EventBooker/internal/notification"
@@ -17,49 +22,84 @@ import (
 	"github.com/stpnv0/EventBooker/internal/router"
 	"github.com/stpnv0/EventBooker/internal/scheduler"
 	"github.com/stpnv0/EventBooker/internal/service"
+	"github.com/stpnv0/EventBooker/internal/service/ports"
+	"github.com/stpnv0/EventBooker/internal/telegram"
+	"github.com/stpnv0/EventBooker/internal/webhook"
+	"github.com/stpnv0/EventBooker/migrations"
+	"github.com/stpnv0/EventBooker/web"
 	"github.com/wb-go/wbf/dbpg"
+	"github.com/wb-go/wbf/ginext"
 	"github.com/wb-go/wbf/logger"
 )
 
-const migrationsDir = "migrations"
-
 type App struct {
 	cfg        *config.Config
 	log        logger.Logger
 	db         *dbpg.DB
 	httpServer *http.Server
 	scheduler  *scheduler.Scheduler
+
+	// healthServer поднимается только в режиме worker.
+	healthServer  *http.Server
+	schedulerDone chan struct{}
+
+	// bot принимает команды в Telegram, поднимается только воркером.
+	bot     *telegram.Bot
+	botDone chan struct{}
+
+	eventService   *service.EventService
+	bookingService *service.BookingService
+	userService    *service.UserService
+
+	telegramLinkService *service.TelegramLinkService
+	webhookService      *service.WebhookService
+	seriesService       *service.SeriesService
+	venueService        *service.VenueService
+	calendarService     *service.CalendarService
+	statsService        *service.StatsService
+	categoryService     *service.CategoryService
+	bookingLimitService *service.BookingLimitService
 }
 
+// New собирает зависимости приложения. Миграции не применяются —
+// для этого нужно вызвать Migrate.
 func New(cfg *config.Config) (*App, error) {
-	app := &App{cfg: cfg}
+	return newApp(cfg, true)
+}
 
-	log, err := logger.InitLogger(
-		cfg.Logger.LogEngine(),
-		"EventBooker",
-		cfg.Gin.Mode,
-		logger.WithLevel(cfg.Logger.LogLevel()),
-	)
+// NewServices собирает только БД и сервисы, без воркера и HTTP API, — для разовых
+// команд CLI. Им не нужны ни шаблоны уведомлений, ни связь с Telegram.
+func NewServices(cfg *config.Config) (*App, error) {
+	return newApp(cfg, false)
+}
+
+func newApp(cfg *config.Config, runtime bool) (*App, error) {
+	log, err := initLogger(cfg)
 	if err != nil {
 		return nil, fmt.Errorf("init logger: %w", err)
 	}
-	app.log = log
-
-	if err = app.runMigrations(); err != nil {
-		return nil, fmt.Errorf("migrations: %w", err)
-	}
+	app := &App{cfg: cfg, log: log}
 
 	if err = app.initDB(); err != nil {
 		return nil, fmt.Errorf("init db: %w", err)
 	}
 
-	if err = app.initServices(); err != nil {
+	if err = app.initServices(runtime); err != nil {
 		return nil, fmt.Errorf("init services: %w", err)
 	}
 
 	return app, nil
 }
 
+func initLogger(cfg *config.Config) (logger.Logger, error) {
+	return logger.InitLogger(
+		cfg.Logger.LogEngine(),
+		"EventBooker",
+		cfg.Gin.Mode,
+		logger.WithLevel(cfg.Logger.LogLevel()),
+	)
+}
+
 func (a *App) initDB() error {
 	db, err := dbpg.New(
 		a.cfg.Postgres.DSN(),
@@ -88,34 +128,218 @@ func (a *App) initDB() error {
 	return nil
 }
 
-func (a *App) initServices() error {
+// initServices собирает сервисы; с runtime — ещё воркер и API согласно режиму запуска.
+func (a *App) initServices(runtime bool) error {
 	eventRepo := repository.NewEventRepo(a.db)
 	bookingRepo := repository.NewBookingRepo(a.db)
 	userRepo := repository.NewUserRepo(a.db)
+	notificationRepo := repository.NewNotificationRepo(a.db)
+	prefsRepo := repository.NewNotificationPreferenceRepo(a.db)
+
+	// Сервисы только пишут уведомления в outbox, доставляет их воркер.
+	outbox := notification.NewOutboxNotifier(notificationRepo, a.log)
+
+	// Вебхуки тоже идут через очередь: сервисы ставят доставки, воркер их отправляет.
+	a.webhookService = service.NewWebhookService(
+		repository.NewWebhookRepo(a.db),
+		webhook.NewClient(a.cfg.Webhook.Timeout),
+		service.WebhookConfig{
+			BatchSize:   a.cfg.Webhook.BatchSize,
+			MaxAttempts: a.cfg.Webhook.MaxAttempts,
+			Backoff:     a.cfg.Webhook.Backoff,
+			MaxBackoff:  a.cfg.Webhook.MaxBackoff,
+		},
+		a.log,
+	)
 
-	n, err := notification.NewTelegramNotifier(a.cfg.Telegram.BotToken, a.log)
+	venueRepo := repository.NewVenueRepo(a.db)
+	a.venueService = service.NewVenueService(venueRepo)
+	categoryRepo := repository.NewCategoryRepo(a.db)
+	a.categoryService = service.NewCategoryService(categoryRepo)
+	a.eventService = service.NewEventService(
+		eventRepo, bookingRepo, venueRepo, categoryRepo, a.webhookService, outbox, a.log,
+	)
+	a.calendarService = service.NewCalendarService(
+		eventRepo, venueRepo, userRepo, repository.NewCalendarTokenRepo(a.db),
+	)
+	a.statsService = service.NewStatsService(repository.NewReportingRepo(a.db))
+	a.seriesService = service.NewSeriesService(
+		repository.NewSeriesRepo(a.db), a.webhookService, a.eventService, a.cfg.Series.Horizon, a.log,
+	)
+	a.userService = service.NewUserService(userRepo, prefsRepo)
+	a.bookingLimitService = service.NewBookingLimitService(
+		repository.NewBookingLimitRepo(a.db),
+		userRepo,
+		domain.BookingLimits{
+			MaxPending:    a.cfg.BookingLimits.MaxPending,
+			MaxPerDay:     a.cfg.BookingLimits.MaxPerDay,
+			MaxExpired:    a.cfg.BookingLimits.MaxExpired,
+			ExpiredWindow: a.cfg.BookingLimits.ExpiredWindow,
+			Cooldown:      a.cfg.BookingLimits.Cooldown,
+		},
+	)
+	a.bookingService = service.NewBookingService(
+		bookingRepo, eventRepo, userRepo, a.webhookService, a.bookingLimitService, a.log,
+	)
+	a.telegramLinkService = service.NewTelegramLinkService(
+		repository.NewTelegramLinkRepo(a.db), userRepo,
+		a.cfg.Telegram.BotUsername, a.cfg.Telegram.LinkTTL,
+	)
+
+	if !runtime {
+		return nil
+	}
+
+	if a.cfg.App.RunsWorker() {
+		if err := a.initWorker(notificationRepo, prefsRepo, userRepo, eventRepo); err != nil {
+			return err
+		}
+	}
+
+	if a.cfg.App.RunsAPI() {
+		if err := a.initAPI(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *App) initWorker(
+	notificationRepo *repository.NotificationRepository,
+	prefsRepo *repository.NotificationPreferenceRepository,
+	userRepo *repository.UserRepository,
+	eventRepo *repository.EventRepository,
+) error {
+	templates, err := notification.NewTemplates(a.cfg.Notification.TemplatesDir)
+	if err != nil {
+		return fmt.Errorf("load notification templates: %w", err)
+	}
+
+	tg, err := notification.NewTelegramNotifier(a.cfg.Telegram.BotToken, templates, a.log)
 	if err != nil {
 		return fmt.Errorf("init notifier: %w", err)
 	}
 
-	eventService := service.NewEventService(eventRepo, bookingRepo)
-	userService := service.NewUserService(userRepo)
-	bookingService := service.NewBookingService(bookingRepo, eventRepo, userRepo, n, a.log)
+	email, err := notification.NewEmailNotifier(a.cfg.Email, templates, a.log)
+	if err != nil {
+		return fmt.Errorf("init email notifier: %w", err)
+	}
+
+	channels := notification.NewRouter(map[domain.NotificationChannel]ports.ChannelNotifier{
+		domain.ChannelTelegram: tg,
+		domain.ChannelEmail:    email,
+		domain.ChannelWebhook:  notification.NewWebhookNotifier(a.log),
+	}, prefsRepo, a.log)
+
+	notificationService := service.NewNotificationService(
+		notificationRepo, userRepo, eventRepo, channels,
+		a.cfg.Worker.DispatchBatch, a.log,
+	)
 
 	a.scheduler = scheduler.New(
-		bookingService,
+		a.bookingService,
 		a.cfg.Scheduler.Interval,
 		a.log,
 	)
+	a.scheduler.Register(scheduler.Job{
+		Name:     "notifications",
+		Interval: a.cfg.Worker.DispatchInterval,
+		Run: func(ctx context.Context) error {
+			_, err := notificationService.Dispatch(ctx)
+			return err
+		},
+	})
+	a.scheduler.Register(scheduler.Job{
+		Name:     "series",
+		Interval: a.cfg.Series.MaterializeInterval,
+		Run: func(ctx context.Context) error {
+			_, err := a.seriesService.Materialize(ctx)
+			return err
+		},
+	})
+	a.scheduler.Register(scheduler.Job{
+		Name:     "publications",
+		Interval: a.cfg.Scheduler.Interval,
+		Run: func(ctx context.Context) error {
+			_, err := a.eventService.PublishDue(ctx)
+			return err
+		},
+	})
+	a.scheduler.Register(scheduler.Job{
+		Name:     "webhooks",
+		Interval: a.cfg.Webhook.DispatchInterval,
+		Run: func(ctx context.Context) error {
+			_, err := a.webhookService.Dispatch(ctx)
+			return err
+		},
+	})
+
+	if a.cfg.RateLimit.Enabled && a.cfg.RateLimit.Store == "postgres" {
+		rateLimitRepo := repository.NewRateLimitRepo(a.db)
+		a.scheduler.Register(scheduler.Job{
+			Name:     "rate-limit-cleanup",
+			Interval: a.cfg.Scheduler.Interval,
+			Run: func(ctx context.Context) error {
+				_, err := rateLimitRepo.DeleteExpired(ctx, time.Now())
+				return err
+			},
+		})
+	}
+
+	if a.cfg.Telegram.BotToken != "" && a.cfg.Telegram.Polling {
+		a.bot, err = telegram.NewBot(
+			a.cfg.Telegram.BotToken,
+			a.cfg.Telegram.PollTimeout,
+			a.eventService, a.bookingService, a.userService, a.telegramLinkService,
+			a.log,
+		)
+		if err != nil {
+			return fmt.Errorf("init telegram bot: %w", err)
+		}
+	}
+
+	// В режиме all воркер обслуживается общим /health API-сервера.
+	if a.cfg.App.Mode == config.ModeWorker {
+		a.healthServer = &http.Server{
+			Addr:         a.cfg.Worker.HealthAddr,
+			Handler:      router.InitHealthRouter(a.cfg.Gin.Mode, a.workerHealth, middleware.Recovery(a.log)),
+			ReadTimeout:  a.cfg.Server.ReadTimeout,
+			WriteTimeout: a.cfg.Server.WriteTimeout,
+			IdleTimeout:  a.cfg.Server.IdleTimeout,
+		}
+	}
 
-	h := handler.NewHandler(eventService, bookingService, userService)
-	r := router.InitRouter(
-		a.cfg.Gin.Mode,
-		h,
+	return nil
+}
+
+func (a *App) initAPI() error {
+	h := handler.NewHandler(handler.Services{
+		Events:        a.eventService,
+		Bookings:      a.bookingService,
+		Users:         a.userService,
+		TelegramLinks: a.telegramLinkService,
+		Webhooks:      a.webhookService,
+		Series:        a.seriesService,
+		Venues:        a.venueService,
+		Calendar:      a.calendarService,
+		Stats:         a.statsService,
+		Categories:    a.categoryService,
+		BookingLimits: a.bookingLimitService,
+	})
+	mw := []ginext.HandlerFunc{
 		middleware.RequestID(),
 		middleware.RequestLogger(a.log),
 		middleware.Recovery(a.log),
-	)
+	}
+	if a.cfg.RateLimit.Enabled {
+		mw = append(mw, middleware.RateLimit(a.rateLimitStore(), rateLimitPolicies(a.cfg.RateLimit.Routes), a.log))
+	}
+
+	r, err := router.InitRouter(a.cfg.Gin.Mode, a.cfg.Server.TrustedProxies, h, a.webAssets(), mw...)
+	if err != nil {
+		return fmt.Errorf("init router: %w", err)
+	}
 
 	a.httpServer = &http.Server{
 		Addr:         a.cfg.Server.Addr,
@@ -128,21 +352,67 @@ func (a *App) initServices() error {
 	return nil
 }
 
+// rateLimitStore выбирает хранилище лимитов: общее в Postgres или своё в памяти реплики.
+func (a *App) rateLimitStore() middleware.RateLimitStore {
+	if a.cfg.RateLimit.Store == "postgres" {
+		return repository.NewRateLimitRepo(a.db)
+	}
+	return middleware.NewMemoryRateLimitStore()
+}
+
+func rateLimitPolicies(routes []config.RateLimitRoute) []middleware.RateLimitPolicy {
+	policies := make([]middleware.RateLimitPolicy, 0, len(routes))
+	for _, r := range routes {
+		policies = append(policies, middleware.RateLimitPolicy{
+			Method: strings.ToUpper(r.Method),
+			Path:   r.Path,
+			Key:    middleware.RateLimitKey(r.Key),
+			Limit: domain.RateLimit{
+				Requests: r.Requests,
+				Period:   r.Period,
+				Burst:    r.Burst,
+			},
+		})
+	}
+	return policies
+}
+
+// workerHealth проверяет доступность БД и то, что планировщик не завис.
+func (a *App) workerHealth(ctx context.Context) error {
+	if err := a.db.Master.PingContext(ctx); err != nil {
+		return fmt.Errorf("database: %w", err)
+	}
+
+	return a.scheduler.Healthy()
+}
+
 func (a *App) Run() error {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
-	go a.scheduler.Start(ctx)
+	a.log.LogAttrs(ctx, logger.InfoLevel, "starting",
+		logger.String("mode", a.cfg.App.Mode),
+	)
 
-	errCh := make(chan error, 1)
-	go func() {
-		a.log.LogAttrs(ctx, logger.InfoLevel, "HTTP server starting",
-			logger.String("addr", a.httpServer.Addr),
-		)
-		if err := a.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			errCh <- fmt.Errorf("http server: %w", err)
-		}
-	}()
+	if a.scheduler != nil {
+		a.schedulerDone = make(chan struct{})
+		go func() {
+			defer close(a.schedulerDone)
+			a.scheduler.Start(ctx)
+		}()
+	}
+
+	if a.bot != nil {
+		a.botDone = make(chan struct{})
+		go func() {
+			defer close(a.botDone)
+			a.bot.Run(ctx)
+		}()
+	}
+
+	errCh := make(chan error, 2)
+	a.listen(ctx, "HTTP server", a.httpServer, errCh)
+	a.listen(ctx, "health server", a.healthServer, errCh)
 
 	select {
 	case <-ctx.Done():
@@ -154,6 +424,21 @@ func (a *App) Run() error {
 	return a.shutdown()
 }
 
+func (a *App) listen(ctx context.Context, name string, srv *http.Server, errCh chan<- error) {
+	if srv == nil {
+		return
+	}
+
+	go func() {
+		a.log.LogAttrs(ctx, logger.InfoLevel, name+" starting",
+			logger.String("addr", srv.Addr),
+		)
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			errCh <- fmt.Errorf("%s: %w", name, err)
+		}
+	}()
+}
+
 func (a *App) shutdown() error {
 	a.log.LogAttrs(context.Background(), logger.InfoLevel, "shutting down...")
 
@@ -163,32 +448,75 @@ func (a *App) shutdown() error {
 	)
 	defer cancel()
 
-	if err := a.httpServer.Shutdown(shutdownCtx); err != nil {
-		return fmt.Errorf("http server shutdown: %w", err)
+	for _, srv := range []*http.Server{a.httpServer, a.healthServer} {
+		if srv == nil {
+			continue
+		}
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			return fmt.Errorf("http server shutdown: %w", err)
+		}
 	}
 	a.log.LogAttrs(context.Background(), logger.InfoLevel, "HTTP server stopped")
 
+	if a.schedulerDone != nil {
+		<-a.schedulerDone
+	}
+	if a.botDone != nil {
+		<-a.botDone
+	}
+
+	if err := a.Close(); err != nil {
+		return err
+	}
+
+	a.log.LogAttrs(context.Background(), logger.InfoLevel, "app stopped")
+
+	return nil
+}
+
+// Close закрывает соединение с базой данных.
+func (a *App) Close() error {
 	if err := a.db.Master.Close(); err != nil {
 		return fmt.Errorf("close db: %w", err)
 	}
 	a.log.LogAttrs(context.Background(), logger.InfoLevel, "database connection closed")
 
-	a.log.LogAttrs(context.Background(), logger.InfoLevel, "app stopped")
-
 	return nil
 }
 
-func (a *App) runMigrations() error {
-	db, err := sql.Open("postgres", a.cfg.Postgres.DSN())
+// Migrate выполняет команду goose (up, down, status) над встроенными миграциями,
+// не собирая остальные зависимости приложения.
+func Migrate(cfg *config.Config, command string) error {
+	log, err := initLogger(cfg)
+	if err != nil {
+		return fmt.Errorf("init logger: %w", err)
+	}
+
+	db, err := sql.Open("postgres", cfg.Postgres.DSN())
 	if err != nil {
 		return fmt.Errorf("open db for migrations: %w", err)
 	}
 	defer db.Close()
 
-	if err := goose.Up(db, migrationsDir); err != nil {
-		return fmt.Errorf("goose up: %w", err)
+	goose.SetBaseFS(migrations.FS)
+	if err = goose.RunContext(context.Background(), command, db, "."); err != nil {
+		return fmt.Errorf("goose %s: %w", command, err)
 	}
 
-	a.log.Info("migrations applied successfully")
+	log.LogAttrs(context.Background(), logger.InfoLevel, "migrations command completed",
+		logger.String("command", command),
+	)
 	return nil
 }
+
+// webAssets возвращает встроенные ассеты или, если задан web.assets_dir, каталог на диске.
+func (a *App) webAssets() fs.FS {
+	if a.cfg.Web.AssetsDir == "" {
+		return web.FS
+	}
+
+	a.log.LogAttrs(context.Background(), logger.InfoLevel, "serving web assets from disk",
+		logger.String("dir", a.cfg.Web.AssetsDir),
+	)
+	return os.DirFS(a.cfg.Web.AssetsDir)
+}
@@ -0,0 +1,129 @@
+package app
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/handler/dto"
+	"github.com/wb-go/wbf/logger"
+)
+
+// Export formats supported by ExportEvents.
+const (
+	ExportFormatJSON = "json"
+	ExportFormatCSV  = "csv"
+)
+
+// CancelExpired однократно отменяет просроченные брони. Уведомления об отмене
+// записываются в outbox и уйдут с ближайшим проходом воркера.
+func (a *App) CancelExpired(ctx context.Context) (int, error) {
+	cancelled, err := a.bookingService.CancelExpired(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(cancelled), nil
+}
+
+// CreateUser регистрирует пользователя через UserService.
+func (a *App) CreateUser(ctx context.Context, input domain.CreateUserInput) (*domain.User, error) {
+	return a.userService.Create(ctx, input)
+}
+
+// Seed заполняет базу демонстрационными пользователями и мероприятиями.
+// Уже существующие пользователи пропускаются.
+func (a *App) Seed(ctx context.Context) error {
+	for _, username := range []string{"alice", "bob", "charlie"} {
+		_, err := a.userService.Create(ctx, domain.CreateUserInput{Username: username})
+		if errors.Is(err, domain.ErrUsernameTaken) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("seed user %s: %w", username, err)
+		}
+	}
+
+	free := false
+	events := []domain.CreateEventInput{
+		{
+			Title:       "Go Meetup",
+			Description: "Доклады о конкурентности и профилировании",
+			EventDate:   time.Now().UTC().Add(7 * 24 * time.Hour).Truncate(time.Hour),
+			TotalSpots:  50,
+			BookingTTL:  30 * time.Minute,
+		},
+		{
+			Title:           "Open Lecture",
+			Description:     "Бесплатная лекция, бронь подтверждается сразу",
+			EventDate:       time.Now().UTC().Add(3 * 24 * time.Hour).Truncate(time.Hour),
+			TotalSpots:      100,
+			RequiresPayment: &free,
+		},
+		{
+			Title:       "Workshop",
+			Description: "Практический воркшоп в малой группе",
+			EventDate:   time.Now().UTC().Add(14 * 24 * time.Hour).Truncate(time.Hour),
+			TotalSpots:  5,
+			BookingTTL:  5 * time.Minute,
+		},
+	}
+	for _, input := range events {
+		if _, err := a.eventService.CreateEvent(ctx, input); err != nil {
+			return fmt.Errorf("seed event %s: %w", input.Title, err)
+		}
+	}
+
+	a.log.LogAttrs(ctx, logger.InfoLevel, "demo data seeded",
+		logger.Int("events", len(events)),
+	)
+	return nil
+}
+
+// ExportEvents выгружает все мероприятия в w в формате json или csv.
+func (a *App) ExportEvents(ctx context.Context, w io.Writer, format string) error {
+	events, err := a.eventService.List(ctx)
+	if err != nil {
+		return fmt.Errorf("list events: %w", err)
+	}
+
+	switch format {
+	case ExportFormatJSON:
+		resp := make([]dto.EventResponse, 0, len(events))
+		for _, e := range events {
+			resp = append(resp, dto.ToEventResponse(e))
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(resp)
+
+	case ExportFormatCSV:
+		cw := csv.NewWriter(w)
+		if err = cw.Write([]string{
+			"id", "title", "description", "event_date",
+			"total_spots", "requires_payment", "booking_ttl", "created_at",
+		}); err != nil {
+			return fmt.Errorf("write csv header: %w", err)
+		}
+		for _, e := range events {
+			if err = cw.Write([]string{
+				e.ID, e.Title, e.Description, e.EventDate.Format(time.RFC3339),
+				strconv.Itoa(e.TotalSpots), strconv.FormatBool(e.RequiresPayment),
+				e.BookingTTL.String(), e.CreatedAt.Format(time.RFC3339),
+			}); err != nil {
+				return fmt.Errorf("write csv row: %w", err)
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+
+	default:
+		return fmt.Errorf("unknown export format %q", format)
+	}
+}
@@ -0,0 +1,89 @@
+// Package calendar собирает iCalendar (RFC 5545) для мероприятий и календарных подписок.
+package calendar
+
+import (
+	"strings"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+	"github.com/stpnv0/EventBooker/internal/domain"
+)
+
+const (
+	// ContentType — MIME-тип ответа и вложения.
+	ContentType = "text/calendar; charset=utf-8"
+	// uidDomain делает UID глобально уникальным: клиенты сопоставляют по нему обновления.
+	uidDomain = "eventbooker"
+	// feedRefresh — как часто клиенту советуют обновлять подписку.
+	feedRefresh = "PT1H"
+)
+
+// Entry — мероприятие с площадкой (может быть nil) для LOCATION и GEO.
+type Entry struct {
+	Event *domain.Event
+	Venue *domain.Venue
+}
+
+// Event возвращает календарь с одним мероприятием — для скачивания и вложений в письма.
+func Event(entry Entry) []byte {
+	cal := newCalendar()
+	cal.SetMethod(ics.MethodPublish)
+	addEvent(cal, entry)
+	return []byte(cal.Serialize())
+}
+
+// Feed возвращает календарь-подписку с несколькими мероприятиями.
+func Feed(name string, entries []Entry) []byte {
+	cal := newCalendar()
+	cal.SetMethod(ics.MethodPublish)
+	cal.SetName(name)
+	cal.SetXWRCalName(name)
+	cal.SetRefreshInterval(feedRefresh)
+	for _, entry := range entries {
+		addEvent(cal, entry)
+	}
+	return []byte(cal.Serialize())
+}
+
+func newCalendar() *ics.Calendar {
+	return ics.NewCalendarFor("EventBooker")
+}
+
+// addEvent добавляет VEVENT. Время пишется в UTC: так не нужен VTIMEZONE,
+// а клиент сам покажет его в поясе пользователя.
+func addEvent(cal *ics.Calendar, entry Entry) {
+	e := entry.Event
+
+	ve := cal.AddEvent(e.ID + "@" + uidDomain)
+	ve.SetDtStampTime(time.Now())
+	ve.SetStartAt(e.EventDate)
+	ve.SetEndAt(e.EndDate())
+	ve.SetSummary(e.Title)
+	if e.Description != "" {
+		ve.SetDescription(e.Description)
+	}
+	if !e.CreatedAt.IsZero() {
+		ve.SetCreatedTime(e.CreatedAt)
+	}
+	if !e.UpdatedAt.IsZero() {
+		ve.SetLastModifiedAt(e.UpdatedAt)
+	}
+
+	if e.CancelledAt != nil {
+		ve.SetStatus(ics.ObjectStatusCancelled)
+	} else {
+		ve.SetStatus(ics.ObjectStatusConfirmed)
+	}
+
+	if v := entry.Venue; v != nil {
+		ve.SetLocation(strings.Join([]string{v.Name, v.Address}, ", "))
+		if v.Latitude != nil && v.Longitude != nil {
+			ve.SetGeo(*v.Latitude, *v.Longitude)
+		}
+	}
+}
+
+// Filename возвращает имя файла для Content-Disposition.
+func Filename(e *domain.Event) string {
+	return "event-" + e.ID + ".ics"
+}
@@ -0,0 +1,60 @@
+package calendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvent_RendersVEvent(t *testing.T) {
+	lat, lon := 55.75, 37.61
+	e := &domain.Event{
+		ID:          "e1",
+		Title:       "Concert",
+		Description: "Live music",
+		EventDate:   time.Date(2030, 5, 1, 19, 0, 0, 0, time.UTC),
+		Duration:    2 * time.Hour,
+	}
+
+	out := string(Event(Entry{
+		Event: e,
+		Venue: &domain.Venue{Name: "Hall", Address: "Tverskaya 1", Latitude: &lat, Longitude: &lon},
+	}))
+
+	assert.Contains(t, out, "BEGIN:VCALENDAR")
+	assert.Contains(t, out, "METHOD:PUBLISH")
+	assert.Contains(t, out, "UID:e1@eventbooker")
+	assert.Contains(t, out, "DTSTART:20300501T190000Z")
+	assert.Contains(t, out, "DTEND:20300501T210000Z")
+	assert.Contains(t, out, "SUMMARY:Concert")
+	assert.Contains(t, out, "STATUS:CONFIRMED")
+	assert.Contains(t, out, "LOCATION:Hall\\, Tverskaya 1")
+	assert.Contains(t, out, "GEO:55.75;37.61")
+}
+
+func TestEvent_CancelledWithoutVenue(t *testing.T) {
+	now := time.Now()
+	e := &domain.Event{ID: "e1", Title: "Concert", EventDate: now, Duration: time.Hour, CancelledAt: &now}
+
+	out := string(Event(Entry{Event: e}))
+
+	assert.Contains(t, out, "STATUS:CANCELLED")
+	assert.NotContains(t, out, "LOCATION")
+}
+
+func TestFeed_ContainsAllEvents(t *testing.T) {
+	date := time.Date(2030, 5, 1, 19, 0, 0, 0, time.UTC)
+	entries := []Entry{
+		{Event: &domain.Event{ID: "e1", Title: "A", EventDate: date, Duration: time.Hour}},
+		{Event: &domain.Event{ID: "e2", Title: "B", EventDate: date.Add(24 * time.Hour), Duration: time.Hour}},
+	}
+
+	out := string(Feed("EventBooker: alice", entries))
+
+	assert.Contains(t, out, "X-WR-CALNAME:EventBooker: alice")
+	assert.Contains(t, out, "REFRESH-INTERVAL")
+	assert.Equal(t, 2, strings.Count(out, "BEGIN:VEVENT"))
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	cleanenvport "github.com/wb-go/wbf/config/cleanenv-port"
@@ -9,19 +10,53 @@ import (
 )
 
 type Config struct {
-	Server    ServerConfig    `yaml:"server"    validate:"required"`
-	Logger    LoggerConfig    `yaml:"logger"    validate:"required"`
-	Gin       GinConfig       `yaml:"gin"       validate:"required"`
-	Postgres  PostgresConfig  `yaml:"postgres"  validate:"required"`
-	Scheduler SchedulerConfig `yaml:"scheduler" validate:"required"`
-	Telegram  TelegramConfig  `yaml:"telegram"`
+	App           AppConfig           `yaml:"app"`
+	Server        ServerConfig        `yaml:"server"    validate:"required"`
+	Logger        LoggerConfig        `yaml:"logger"    validate:"required"`
+	Gin           GinConfig           `yaml:"gin"       validate:"required"`
+	Postgres      PostgresConfig      `yaml:"postgres"  validate:"required"`
+	Scheduler     SchedulerConfig     `yaml:"scheduler" validate:"required"`
+	Telegram      TelegramConfig      `yaml:"telegram"`
+	Email         EmailConfig         `yaml:"email"`
+	Web           WebConfig           `yaml:"web"`
+	Worker        WorkerConfig        `yaml:"worker"`
+	Notification  NotificationConfig  `yaml:"notification"`
+	Webhook       WebhookConfig       `yaml:"webhook"`
+	Series        SeriesConfig        `yaml:"series"`
+	BookingLimits BookingLimitsConfig `yaml:"booking_limits"`
+	RateLimit     RateLimitConfig     `yaml:"rate_limit"`
 }
 
+// Режимы запуска: api — только HTTP, worker — фоновые задачи и доставка уведомлений, all — всё сразу.
+const (
+	ModeAPI    = "api"
+	ModeWorker = "worker"
+	ModeAll    = "all"
+)
+
+type AppConfig struct {
+	Mode string `yaml:"mode" env:"APP_MODE" env-default:"all" validate:"oneof=api worker all"`
+}
+
+// RunsAPI сообщает, должен ли процесс обслуживать HTTP API.
+func (c AppConfig) RunsAPI() bool {
+	return c.Mode == ModeAPI || c.Mode == ModeAll
+}
+
+// RunsWorker сообщает, должен ли процесс выполнять фоновые задачи.
+func (c AppConfig) RunsWorker() bool {
+	return c.Mode == ModeWorker || c.Mode == ModeAll
+}
+
+// ServerConfig задаёт HTTP-сервер API. TrustedProxies — адреса и подсети прокси,
+// которым можно верить в X-Forwarded-For и X-Real-IP; пустой список — адрес клиента
+// берётся из соединения, а заголовки игнорируются.
 type ServerConfig struct {
-	Addr         string        `yaml:"addr"          env:"SERVER_ADDR"          env-default:":8080" validate:"required"`
-	ReadTimeout  time.Duration `yaml:"read_timeout"  env:"SERVER_READ_TIMEOUT"  env-default:"10s"   validate:"gt=0"`
-	WriteTimeout time.Duration `yaml:"write_timeout" env:"SERVER_WRITE_TIMEOUT" env-default:"10s"   validate:"gt=0"`
-	IdleTimeout  time.Duration `yaml:"idle_timeout"  env:"SERVER_IDLE_TIMEOUT"  env-default:"60s"   validate:"gt=0"`
+	Addr           string        `yaml:"addr"            env:"SERVER_ADDR"            env-default:":8080" validate:"required"`
+	ReadTimeout    time.Duration `yaml:"read_timeout"    env:"SERVER_READ_TIMEOUT"    env-default:"10s"   validate:"gt=0"`
+	WriteTimeout   time.Duration `yaml:"write_timeout"   env:"SERVER_WRITE_TIMEOUT"   env-default:"10s"   validate:"gt=0"`
+	IdleTimeout    time.Duration `yaml:"idle_timeout"    env:"SERVER_IDLE_TIMEOUT"    env-default:"60s"   validate:"gt=0"`
+	TrustedProxies []string      `yaml:"trusted_proxies" env:"SERVER_TRUSTED_PROXIES"`
 }
 
 // LogLevel преобразует строковый уровень в logger.Level из wbf.
@@ -75,8 +110,113 @@ type SchedulerConfig struct {
 	Interval time.Duration `yaml:"interval" env:"SCHEDULER_INTERVAL" env-default:"30s" validate:"required,gt=0"`
 }
 
+type WorkerConfig struct {
+	HealthAddr       string        `yaml:"health_addr"       env:"WORKER_HEALTH_ADDR"       env-default:":8081" validate:"required"`
+	DispatchInterval time.Duration `yaml:"dispatch_interval" env:"WORKER_DISPATCH_INTERVAL" env-default:"2s"    validate:"gt=0"`
+	DispatchBatch    int           `yaml:"dispatch_batch"    env:"WORKER_DISPATCH_BATCH"    env-default:"100"   validate:"min=1"`
+}
+
+// TelegramConfig задаёт бота для уведомлений. Если Polling включён, воркер также
+// принимает команды через long polling — такой воркер должен быть единственным.
+// BotUsername нужен API для ссылок привязки t.me/<bot>?start=<token>.
 type TelegramConfig struct {
-	BotToken string `yaml:"bot_token" env:"TELEGRAM_BOT_TOKEN" env-default:""`
+	BotToken    string        `yaml:"bot_token"    env:"TELEGRAM_BOT_TOKEN"    env-default:""`
+	BotUsername string        `yaml:"bot_username" env:"TELEGRAM_BOT_USERNAME" env-default:""`
+	Polling     bool          `yaml:"polling"      env:"TELEGRAM_POLLING"      env-default:"true"`
+	PollTimeout time.Duration `yaml:"poll_timeout" env:"TELEGRAM_POLL_TIMEOUT" env-default:"30s" validate:"gt=0"`
+	LinkTTL     time.Duration `yaml:"link_ttl"     env:"TELEGRAM_LINK_TTL"     env-default:"15m" validate:"gt=0"`
+}
+
+// EmailConfig задаёт SMTP-сервер для email-уведомлений. Пустой SMTPHost отключает канал.
+type EmailConfig struct {
+	SMTPHost string        `yaml:"smtp_host" env:"SMTP_HOST"     env-default:""`
+	SMTPPort int           `yaml:"smtp_port" env:"SMTP_PORT"     env-default:"587"                                     validate:"min=1,max=65535"`
+	Username string        `yaml:"username"  env:"SMTP_USERNAME" env-default:""`
+	Password string        `yaml:"password"  env:"SMTP_PASSWORD" env-default:""`
+	From     string        `yaml:"from"      env:"SMTP_FROM"     env-default:"EventBooker <noreply@eventbooker.local>"`
+	Timeout  time.Duration `yaml:"timeout"   env:"SMTP_TIMEOUT"  env-default:"10s"                                     validate:"gt=0"`
+}
+
+func (c EmailConfig) Addr() string {
+	return fmt.Sprintf("%s:%d", c.SMTPHost, c.SMTPPort)
+}
+
+// WebConfig задаёт источник шаблонов и статики веб-интерфейса.
+// Если AssetsDir пуст, используются файлы, встроенные в бинарник;
+// иначе они читаются с диска (удобно при разработке фронтенда).
+type WebConfig struct {
+	AssetsDir string `yaml:"assets_dir" env:"WEB_ASSETS_DIR" env-default:""`
+}
+
+// NotificationConfig — настройки шаблонов уведомлений.
+// TemplatesDir — каталог с шаблонами <channel>/<locale>/<kind>.<ext>, переопределяющими встроенные.
+type NotificationConfig struct {
+	TemplatesDir string `yaml:"templates_dir" env:"NOTIFICATION_TEMPLATES_DIR" env-default:""`
+}
+
+// WebhookConfig — доставка вебхуков партнёрам. Неудачная попытка повторяется
+// через Backoff, удваивая задержку до MaxBackoff; после MaxAttempts доставка считается проваленной.
+type WebhookConfig struct {
+	DispatchInterval time.Duration `yaml:"dispatch_interval" env:"WEBHOOK_DISPATCH_INTERVAL" env-default:"5s"  validate:"gt=0"`
+	BatchSize        int           `yaml:"batch_size"        env:"WEBHOOK_BATCH_SIZE"        env-default:"50"  validate:"min=1"`
+	MaxAttempts      int           `yaml:"max_attempts"      env:"WEBHOOK_MAX_ATTEMPTS"      env-default:"8"   validate:"min=1"`
+	Backoff          time.Duration `yaml:"backoff"           env:"WEBHOOK_BACKOFF"           env-default:"30s" validate:"gt=0"`
+	MaxBackoff       time.Duration `yaml:"max_backoff"       env:"WEBHOOK_MAX_BACKOFF"       env-default:"1h"  validate:"gt=0"`
+	Timeout          time.Duration `yaml:"timeout"           env:"WEBHOOK_TIMEOUT"           env-default:"10s" validate:"gt=0"`
+}
+
+// SeriesConfig — материализация повторяющихся мероприятий: вхождения создаются
+// на Horizon вперёд, задача запускается раз в MaterializeInterval.
+type SeriesConfig struct {
+	Horizon             time.Duration `yaml:"horizon"              env:"SERIES_HORIZON"              env-default:"1440h" validate:"gt=0"`
+	MaterializeInterval time.Duration `yaml:"materialize_interval" env:"SERIES_MATERIALIZE_INTERVAL" env-default:"1h"    validate:"gt=0"`
+}
+
+// BookingLimitsConfig — общие ограничения броней одного пользователя; 0 отключает правило.
+// После MaxExpired истёкших за ExpiredWindow броней бронирование приостанавливается на Cooldown.
+type BookingLimitsConfig struct {
+	MaxPending    int           `yaml:"max_pending"    env:"BOOKING_MAX_PENDING"    env-default:"5"   validate:"min=0"`
+	MaxPerDay     int           `yaml:"max_per_day"    env:"BOOKING_MAX_PER_DAY"    env-default:"20"  validate:"min=0"`
+	MaxExpired    int           `yaml:"max_expired"    env:"BOOKING_MAX_EXPIRED"    env-default:"3"   validate:"min=0"`
+	ExpiredWindow time.Duration `yaml:"expired_window" env:"BOOKING_EXPIRED_WINDOW" env-default:"24h" validate:"gt=0"`
+	Cooldown      time.Duration `yaml:"cooldown"       env:"BOOKING_COOLDOWN"       env-default:"1h"  validate:"gt=0"`
+}
+
+// RateLimitConfig — ограничение частоты запросов к API. Store memory считает запросы
+// в каждой реплике отдельно, postgres — общим счётом для всех реплик.
+type RateLimitConfig struct {
+	Enabled bool             `yaml:"enabled" env:"RATE_LIMIT_ENABLED" env-default:"true"`
+	Store   string           `yaml:"store"   env:"RATE_LIMIT_STORE"   env-default:"memory" validate:"oneof=memory postgres"`
+	Routes  []RateLimitRoute `yaml:"routes"                                                validate:"dive"`
+}
+
+// RateLimitRoute — лимит маршрута: до Burst запросов подряд (по умолчанию Requests)
+// и в среднем Requests за Period. Path — шаблон маршрута, как в роутере: /api/events/:id/book.
+type RateLimitRoute struct {
+	Method   string        `yaml:"method"   validate:"required"`
+	Path     string        `yaml:"path"     validate:"required"`
+	Key      string        `yaml:"key"      validate:"oneof=ip user"`
+	Requests int           `yaml:"requests" validate:"min=1"`
+	Period   time.Duration `yaml:"period"   validate:"gt=0"`
+	Burst    int           `yaml:"burst"    validate:"min=0"`
+}
+
+// Validate проверяет, что у каждого лимита по пользователю есть лимит по IP на тот же
+// маршрут: user_id указывает сам клиент, и без лимита по IP его подмена снимает ограничение.
+func (c RateLimitConfig) Validate() error {
+	byIP := make(map[string]bool, len(c.Routes))
+	for _, r := range c.Routes {
+		if r.Key == "ip" {
+			byIP[strings.ToUpper(r.Method)+" "+r.Path] = true
+		}
+	}
+	for _, r := range c.Routes {
+		route := strings.ToUpper(r.Method) + " " + r.Path
+		if r.Key == "user" && !byIP[route] {
+			return fmt.Errorf("rate_limit: route %s has a user limit without an ip limit", route)
+		}
+	}
+	return nil
 }
 
 func MustLoad() *Config {
@@ -84,5 +224,8 @@ func MustLoad() *Config {
 	if err := cleanenvport.Load(&cfg); err != nil {
 		panic(fmt.Sprintf("failed to load config: %v", err))
 	}
+	if err := cfg.RateLimit.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to load config: %v", err))
+	}
 	return &cfg
 }
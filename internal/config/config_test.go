@@ -0,0 +1,36 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitConfig_Validate(t *testing.T) {
+	route := func(method, key string) RateLimitRoute {
+		return RateLimitRoute{Method: method, Path: "/api/events/:id/book", Key: key, Requests: 10, Period: time.Minute}
+	}
+
+	tests := []struct {
+		name    string
+		routes  []RateLimitRoute
+		wantErr bool
+	}{
+		{"ip only", []RateLimitRoute{route("POST", "ip")}, false},
+		{"user with ip", []RateLimitRoute{route("POST", "user"), route("POST", "ip")}, false},
+		{"method case ignored", []RateLimitRoute{route("post", "user"), route("POST", "ip")}, false},
+		{"user without ip", []RateLimitRoute{route("POST", "user")}, true},
+		{"ip on other method", []RateLimitRoute{route("POST", "user"), route("GET", "ip")}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := RateLimitConfig{Routes: tt.routes}.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
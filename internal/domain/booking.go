@@ -20,3 +20,57 @@ type Booking struct {
 	CreatedAt time.Time     `json:"created_at"`
 	UpdatedAt time.Time     `json:"updated_at"`
 }
+
+// Attendee — бронь с именем пользователя, строка списка участников.
+// ConfirmedAt пуст, пока бронь не подтверждена.
+type Attendee struct {
+	BookingID   string
+	UserID      string
+	Username    string
+	Status      BookingStatus
+	BookedAt    time.Time
+	ConfirmedAt *time.Time
+}
+
+// BookingActor — кто сменил статус брони.
+type BookingActor string
+
+const (
+	BookingActorUser   BookingActor = "user"
+	BookingActorAdmin  BookingActor = "admin"
+	BookingActorSystem BookingActor = "system"
+)
+
+// BookingReason — почему сменился статус брони.
+type BookingReason string
+
+const (
+	BookingReasonCreated          BookingReason = "created"
+	BookingReasonPaymentConfirmed BookingReason = "payment_confirmed"
+	BookingReasonExpired          BookingReason = "expired"
+	BookingReasonUserCancelled    BookingReason = "user_cancelled"
+	BookingReasonEventCancelled   BookingReason = "event_cancelled"
+	BookingReasonSeriesCancelled  BookingReason = "series_cancelled"
+)
+
+// BookingChange — кто, почему и в каком запросе меняет статус. Репозиторий пишет его
+// в историю в той же транзакции, что и сам статус.
+type BookingChange struct {
+	Actor     BookingActor
+	ActorID   string // пусто, если действует не пользователь
+	Reason    BookingReason
+	RequestID string
+}
+
+// BookingHistoryEntry — запись истории статусов брони. FromStatus пуст у записи о создании.
+type BookingHistoryEntry struct {
+	ID         int64
+	BookingID  string
+	FromStatus *BookingStatus
+	ToStatus   BookingStatus
+	Actor      BookingActor
+	ActorID    *string
+	Reason     BookingReason
+	RequestID  *string
+	CreatedAt  time.Time
+}
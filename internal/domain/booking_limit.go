@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// BookingLimits — правила против скупки мест одним пользователем. Нулевой лимит отключает правило.
+type BookingLimits struct {
+	MaxPending    int           // неоплаченных броней одновременно
+	MaxPerDay     int           // броней за последние 24 часа
+	MaxExpired    int           // истёкших за ExpiredWindow броней, после которых включается пауза
+	ExpiredWindow time.Duration // окно подсчёта истёкших броней
+	Cooldown      time.Duration // пауза после последней истёкшей брони
+}
+
+// BookingLimitOverride — индивидуальные правила пользователя, заданные администратором.
+// Пустой лимит означает общее правило, Exempt снимает все ограничения.
+// Истечения до CooldownClearedAt не учитываются — так администратор снимает паузу.
+type BookingLimitOverride struct {
+	UserID            string     `json:"user_id"`
+	MaxPending        *int       `json:"max_pending,omitempty"`
+	MaxPerDay         *int       `json:"max_per_day,omitempty"`
+	MaxExpired        *int       `json:"max_expired,omitempty"`
+	Exempt            bool       `json:"exempt"`
+	CooldownClearedAt *time.Time `json:"cooldown_cleared_at,omitempty"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+// BookingUsage — сколько броней пользователь уже сделал в пределах правил.
+type BookingUsage struct {
+	Pending       int
+	Today         int
+	Expired       int
+	LastExpiredAt *time.Time
+}
+
+// UserBookingLimits — действующие для пользователя правила и их текущее потребление.
+type UserBookingLimits struct {
+	UserID        string
+	Limits        BookingLimits
+	Override      *BookingLimitOverride
+	Usage         BookingUsage
+	CooldownUntil *time.Time
+}
+
+// BookingQuota — правила, по которым проверяется новая бронь. Потребление считается
+// в транзакции создания брони, чтобы параллельные запросы не обошли лимиты.
+type BookingQuota struct {
+	Limits       BookingLimits
+	DayStart     time.Time // начало окна MaxPerDay
+	ExpiredSince time.Time // начало окна подсчёта истёкших броней
+}
+
+// Apply возвращает правила с учётом переопределения.
+func (l BookingLimits) Apply(o *BookingLimitOverride) BookingLimits {
+	if o == nil {
+		return l
+	}
+	if o.MaxPending != nil {
+		l.MaxPending = *o.MaxPending
+	}
+	if o.MaxPerDay != nil {
+		l.MaxPerDay = *o.MaxPerDay
+	}
+	if o.MaxExpired != nil {
+		l.MaxExpired = *o.MaxExpired
+	}
+	return l
+}
+
+// ExpiredSince — начало окна подсчёта истёкших броней.
+func (l BookingLimits) ExpiredSince(o *BookingLimitOverride, now time.Time) time.Time {
+	since := now.Add(-l.ExpiredWindow)
+	if o != nil && o.CooldownClearedAt != nil && o.CooldownClearedAt.After(since) {
+		since = *o.CooldownClearedAt
+	}
+	return since
+}
+
+// CooldownUntil возвращает конец паузы или nil, если паузы нет.
+func (l BookingLimits) CooldownUntil(u BookingUsage, now time.Time) *time.Time {
+	if l.MaxExpired == 0 || u.Expired < l.MaxExpired || u.LastExpiredAt == nil {
+		return nil
+	}
+	until := u.LastExpiredAt.Add(l.Cooldown)
+	if !now.Before(until) {
+		return nil
+	}
+	return &until
+}
+
+// Check проверяет, можно ли пользователю сделать ещё одну бронь.
+func (l BookingLimits) Check(u BookingUsage, now time.Time) error {
+	if until := l.CooldownUntil(u, now); until != nil {
+		return fmt.Errorf("%w: try again after %s", ErrBookingCooldown, until.UTC().Format(time.RFC3339))
+	}
+	if l.MaxPending > 0 && u.Pending >= l.MaxPending {
+		return fmt.Errorf("%w: at most %d", ErrTooManyPendingBookings, l.MaxPending)
+	}
+	if l.MaxPerDay > 0 && u.Today >= l.MaxPerDay {
+		return fmt.Errorf("%w: at most %d per 24 hours", ErrDailyBookingLimit, l.MaxPerDay)
+	}
+	return nil
+}
+
+// Validate проверяет лимиты переопределения.
+func (o *BookingLimitOverride) Validate() error {
+	if (o.MaxPending != nil && *o.MaxPending < 0) ||
+		(o.MaxPerDay != nil && *o.MaxPerDay < 0) ||
+		(o.MaxExpired != nil && *o.MaxExpired < 0) {
+		return fmt.Errorf("%w: limits must not be negative", ErrValidation)
+	}
+	return nil
+}
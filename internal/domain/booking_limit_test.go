@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookingLimits_CooldownUntil(t *testing.T) {
+	now := time.Now()
+	limits := BookingLimits{MaxExpired: 2, Cooldown: time.Hour}
+	last := now.Add(-20 * time.Minute)
+
+	until := limits.CooldownUntil(BookingUsage{Expired: 2, LastExpiredAt: &last}, now)
+	if assert.NotNil(t, until) {
+		assert.True(t, last.Add(time.Hour).Equal(*until))
+	}
+
+	// Пауза закончилась — истечения в окне больше не мешают бронировать.
+	long := now.Add(-2 * time.Hour)
+	assert.Nil(t, limits.CooldownUntil(BookingUsage{Expired: 5, LastExpiredAt: &long}, now))
+
+	assert.Nil(t, limits.CooldownUntil(BookingUsage{Expired: 1, LastExpiredAt: &last}, now))
+
+	limits.MaxExpired = 0
+	assert.Nil(t, limits.CooldownUntil(BookingUsage{Expired: 5, LastExpiredAt: &last}, now))
+}
+
+func TestBookingLimits_ExpiredSince(t *testing.T) {
+	now := time.Now()
+	limits := BookingLimits{ExpiredWindow: 24 * time.Hour}
+
+	assert.True(t, now.Add(-24*time.Hour).Equal(limits.ExpiredSince(nil, now)))
+
+	old := now.Add(-48 * time.Hour)
+	assert.True(t, now.Add(-24*time.Hour).Equal(limits.ExpiredSince(&BookingLimitOverride{CooldownClearedAt: &old}, now)))
+
+	cleared := now.Add(-time.Hour)
+	assert.True(t, cleared.Equal(limits.ExpiredSince(&BookingLimitOverride{CooldownClearedAt: &cleared}, now)))
+}
+
+func TestBookingLimits_Check(t *testing.T) {
+	now := time.Now()
+	recently := now.Add(-10 * time.Minute)
+	limits := BookingLimits{MaxPending: 2, MaxPerDay: 5, MaxExpired: 3, ExpiredWindow: 24 * time.Hour, Cooldown: time.Hour}
+
+	tests := []struct {
+		name  string
+		usage BookingUsage
+		err   error
+	}{
+		{"within limits", BookingUsage{Pending: 1, Today: 4}, nil},
+		{"pending limit", BookingUsage{Pending: 2}, ErrTooManyPendingBookings},
+		{"daily limit", BookingUsage{Today: 5}, ErrDailyBookingLimit},
+		{"cooldown", BookingUsage{Expired: 3, LastExpiredAt: &recently}, ErrBookingCooldown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := limits.Check(tt.usage, now)
+			if tt.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+var ErrInvalidTransition = errors.New("booking status transition is not allowed")
+
+// bookingRule — разрешённый переход статуса. Пустой from означает создание брони.
+type bookingRule struct {
+	from []BookingStatus
+	to   BookingStatus
+}
+
+// bookingRules — машина состояний брони: у каждой причины свой набор переходов.
+// Одна и та же пара статусов может быть разрешена одной причине и запрещена другой:
+// истечь по TTL может только неоплаченная бронь, а отменить пользователь — любую активную.
+// Новый статус появляется здесь, иначе ни сервисы, ни репозитории не смогут в него перевести.
+var bookingRules = map[BookingReason][]bookingRule{
+	BookingReasonCreated: {
+		{to: BookingStatusPending},
+		{to: BookingStatusConfirmed},
+	},
+	BookingReasonPaymentConfirmed: {
+		{from: []BookingStatus{BookingStatusPending}, to: BookingStatusConfirmed},
+	},
+	BookingReasonExpired: {
+		{from: []BookingStatus{BookingStatusPending}, to: BookingStatusCancelled},
+	},
+	BookingReasonUserCancelled: {
+		{from: ActiveStatuses, to: BookingStatusCancelled},
+	},
+	BookingReasonEventCancelled: {
+		{from: ActiveStatuses, to: BookingStatusCancelled},
+	},
+	BookingReasonSeriesCancelled: {
+		{from: ActiveStatuses, to: BookingStatusCancelled},
+	},
+}
+
+// CheckBookingTransition проверяет переход from → to по причине reason. from пуст при создании брони.
+func CheckBookingTransition(from, to BookingStatus, reason BookingReason) error {
+	for _, rule := range bookingRules[reason] {
+		if rule.to != to {
+			continue
+		}
+		if from == "" && len(rule.from) == 0 || slices.Contains(rule.from, from) {
+			return nil
+		}
+	}
+	if from == "" {
+		from = "new"
+	}
+	return fmt.Errorf("%w: %s -> %s (%s)", ErrInvalidTransition, from, to, reason)
+}
+
+// BookingTransitionSources возвращает статусы, из которых reason переводит бронь в to.
+// Репозитории подставляют их в условие UPDATE, чтобы недопустимый переход не прошёл и при гонке.
+func BookingTransitionSources(to BookingStatus, reason BookingReason) ([]BookingStatus, error) {
+	var sources []BookingStatus
+	for _, rule := range bookingRules[reason] {
+		if rule.to == to {
+			sources = append(sources, rule.from...)
+		}
+	}
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("%w: -> %s (%s)", ErrInvalidTransition, to, reason)
+	}
+	return sources, nil
+}
+
+// BookingEventType — доменное событие, которое порождает переход статуса.
+type BookingEventType string
+
+const (
+	BookingEventCreated   BookingEventType = "created"
+	BookingEventConfirmed BookingEventType = "confirmed"
+	BookingEventCancelled BookingEventType = "cancelled"
+	BookingEventExpired   BookingEventType = "expired"
+)
+
+// BookingEvent — событие о брони после перехода. Booking — уже в новом статусе.
+type BookingEvent struct {
+	Type    BookingEventType
+	Booking *Booking
+	Change  BookingChange
+}
+
+// BookingEvents возвращает события перехода, после которого бронь b оказалась в текущем статусе.
+// Бронь без оплаты подтверждается при создании и порождает сразу created и confirmed.
+func BookingEvents(b *Booking, change BookingChange) []BookingEvent {
+	var types []BookingEventType
+	if change.Reason == BookingReasonCreated {
+		types = append(types, BookingEventCreated)
+	}
+	switch {
+	case b.Status == BookingStatusConfirmed:
+		types = append(types, BookingEventConfirmed)
+	case b.Status == BookingStatusCancelled && change.Reason == BookingReasonExpired:
+		types = append(types, BookingEventExpired)
+	case b.Status == BookingStatusCancelled:
+		types = append(types, BookingEventCancelled)
+	}
+
+	events := make([]BookingEvent, 0, len(types))
+	for _, t := range types {
+		events = append(events, BookingEvent{Type: t, Booking: b, Change: change})
+	}
+	return events
+}
+
+// Transition переводит бронь в статус to в памяти и возвращает порождённые события.
+// Для создания брони Status должен быть пустым.
+func (b *Booking) Transition(to BookingStatus, change BookingChange, now time.Time) ([]BookingEvent, error) {
+	if err := CheckBookingTransition(b.Status, to, change.Reason); err != nil {
+		return nil, err
+	}
+	b.Status = to
+	b.UpdatedAt = now
+	return BookingEvents(b, change), nil
+}
+
+// InitialBookingStatus — статус новой брони: без обязательной оплаты она сразу подтверждена.
+func (e *Event) InitialBookingStatus() BookingStatus {
+	if e.RequiresPayment {
+		return BookingStatusPending
+	}
+	return BookingStatusConfirmed
+}
+
+// CheckConfirm — условие перехода pending → confirmed по оплате: переход разрешён
+// и TTL, отсчитываемый от создания брони, ещё не истёк.
+func (b *Booking) CheckConfirm(now time.Time, ttl time.Duration) error {
+	if err := CheckBookingTransition(b.Status, BookingStatusConfirmed, BookingReasonPaymentConfirmed); err != nil {
+		return ErrBookingNotPending
+	}
+	if now.After(b.CreatedAt.Add(ttl)) {
+		return ErrBookingExpired
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckBookingTransition(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    BookingStatus
+		to      BookingStatus
+		reason  BookingReason
+		allowed bool
+	}{
+		{"create pending", "", BookingStatusPending, BookingReasonCreated, true},
+		{"create confirmed", "", BookingStatusConfirmed, BookingReasonCreated, true},
+		{"create cancelled", "", BookingStatusCancelled, BookingReasonCreated, false},
+		{"pay pending", BookingStatusPending, BookingStatusConfirmed, BookingReasonPaymentConfirmed, true},
+		{"pay confirmed", BookingStatusConfirmed, BookingStatusConfirmed, BookingReasonPaymentConfirmed, false},
+		{"pay cancelled", BookingStatusCancelled, BookingStatusConfirmed, BookingReasonPaymentConfirmed, false},
+		{"expire pending", BookingStatusPending, BookingStatusCancelled, BookingReasonExpired, true},
+		{"expire confirmed", BookingStatusConfirmed, BookingStatusCancelled, BookingReasonExpired, false},
+		{"user cancels confirmed", BookingStatusConfirmed, BookingStatusCancelled, BookingReasonUserCancelled, true},
+		{"event cancels pending", BookingStatusPending, BookingStatusCancelled, BookingReasonEventCancelled, true},
+		{"cancel twice", BookingStatusCancelled, BookingStatusCancelled, BookingReasonUserCancelled, false},
+		{"revive cancelled", BookingStatusCancelled, BookingStatusPending, BookingReasonCreated, false},
+		{"unknown reason", BookingStatusPending, BookingStatusCancelled, "refund", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckBookingTransition(tt.from, tt.to, tt.reason)
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidTransition)
+			}
+		})
+	}
+}
+
+func TestBookingTransitionSources(t *testing.T) {
+	sources, err := BookingTransitionSources(BookingStatusCancelled, BookingReasonExpired)
+	require.NoError(t, err)
+	assert.Equal(t, []BookingStatus{BookingStatusPending}, sources)
+
+	sources, err = BookingTransitionSources(BookingStatusCancelled, BookingReasonUserCancelled)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, ActiveStatuses, sources)
+
+	_, err = BookingTransitionSources(BookingStatusConfirmed, BookingReasonExpired)
+	assert.ErrorIs(t, err, ErrInvalidTransition)
+}
+
+func TestBooking_Transition_Events(t *testing.T) {
+	now := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	created := BookingChange{Actor: BookingActorUser, ActorID: "u1", Reason: BookingReasonCreated}
+
+	b := &Booking{ID: "b1"}
+	events, err := b.Transition(BookingStatusConfirmed, created, now)
+	require.NoError(t, err)
+	assert.Equal(t, BookingStatusConfirmed, b.Status)
+	assert.Equal(t, now, b.UpdatedAt)
+	require.Len(t, events, 2)
+	assert.Equal(t, BookingEventCreated, events[0].Type)
+	assert.Equal(t, BookingEventConfirmed, events[1].Type)
+	assert.Same(t, b, events[0].Booking)
+
+	_, err = b.Transition(BookingStatusConfirmed, BookingChange{Reason: BookingReasonPaymentConfirmed}, now)
+	assert.ErrorIs(t, err, ErrInvalidTransition)
+	assert.Equal(t, BookingStatusConfirmed, b.Status)
+
+	expired := &Booking{Status: BookingStatusCancelled}
+	events = BookingEvents(expired, BookingChange{Reason: BookingReasonExpired})
+	require.Len(t, events, 1)
+	assert.Equal(t, BookingEventExpired, events[0].Type)
+}
+
+func TestBooking_CheckConfirm(t *testing.T) {
+	now := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	pending := &Booking{Status: BookingStatusPending, CreatedAt: now.Add(-10 * time.Minute)}
+	assert.NoError(t, pending.CheckConfirm(now, 15*time.Minute))
+	assert.ErrorIs(t, pending.CheckConfirm(now, 5*time.Minute), ErrBookingExpired)
+
+	confirmed := &Booking{Status: BookingStatusConfirmed, CreatedAt: now}
+	assert.ErrorIs(t, confirmed.CheckConfirm(now, time.Hour), ErrBookingNotPending)
+}
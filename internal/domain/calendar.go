@@ -0,0 +1,8 @@
+package domain
+
+// CalendarFeed — ссылка на подписку с подтверждёнными бронями пользователя.
+// Token показывается один раз: в БД хранится только его хеш.
+type CalendarFeed struct {
+	Token string
+	Path  string
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Category — рубрика мероприятий. Пользователи подписываются на рубрики
+// и получают уведомление о каждом новом мероприятии в них.
+type Category struct {
+	ID          string
+	Slug        string
+	Name        string
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type CreateCategoryInput struct {
+	Slug        string
+	Name        string
+	Description string
+}
+
+// UpdateCategoryInput — частичное обновление рубрики: nil-поля не меняются.
+type UpdateCategoryInput struct {
+	Slug        *string
+	Name        *string
+	Description *string
+}
+
+// EventFilter — отбор мероприятий в списке. Пустые поля не ограничивают выборку.
+// Category — id или slug рубрики. Закрытые мероприятия попадают в список, только если
+// ViewerID приглашён на них или задан IncludePrivate (для администратора).
+type EventFilter struct {
+	HappeningAt    *time.Time
+	Category       string
+	Tag            string
+	Statuses       []EventStatus
+	ViewerID       string
+	IncludePrivate bool
+}
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+// ValidateSlug проверяет slug рубрики: латиница в нижнем регистре, цифры и дефисы.
+func ValidateSlug(slug string) error {
+	if len(slug) > 64 || !slugPattern.MatchString(slug) {
+		return fmt.Errorf("%w: slug must contain only lowercase latin letters, digits and dashes", ErrValidation)
+	}
+	return nil
+}
+
+const (
+	MaxEventTags  = 20
+	MaxTagLength  = 50
+	MaxCategories = 10
+)
+
+// NormalizeTags приводит теги к нижнему регистру, убирает пробелы по краям, пустые и повторы.
+func NormalizeTags(tags []string) ([]string, error) {
+	res := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" || seen[t] {
+			continue
+		}
+		if utf8.RuneCountInString(t) > MaxTagLength {
+			return nil, fmt.Errorf("%w: tag %q is longer than %d characters", ErrValidation, t, MaxTagLength)
+		}
+		seen[t] = true
+		res = append(res, t)
+	}
+	if len(res) > MaxEventTags {
+		return nil, fmt.Errorf("%w: an event can have at most %d tags", ErrValidation, MaxEventTags)
+	}
+	return res, nil
+}
@@ -3,9 +3,19 @@ package domain
 import "errors"
 
 var (
-	ErrEventNotFound   = errors.New("event not found")
-	ErrUserNotFound    = errors.New("user not found")
-	ErrBookingNotFound = errors.New("booking not found")
+	ErrEventNotFound    = errors.New("event not found")
+	ErrUserNotFound     = errors.New("user not found")
+	ErrBookingNotFound  = errors.New("booking not found")
+	ErrSeriesNotFound   = errors.New("event series not found")
+	ErrVenueNotFound    = errors.New("venue not found")
+	ErrCategoryNotFound = errors.New("category not found")
+	// ErrEventNotPublished — черновик для пользователей не существует, поэтому это тоже «не найдено».
+	ErrEventNotPublished     = errors.New("event is not published")
+	ErrInvitationNotFound    = errors.New("invitation not found")
+	ErrLimitOverrideNotFound = errors.New("booking limit override not found")
+
+	ErrWebhookNotFound         = errors.New("webhook subscription not found")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
 )
 
 var (
@@ -13,12 +23,36 @@ var (
 	ErrAlreadyBooked     = errors.New("user already has a booking for this event")
 	ErrBookingNotPending = errors.New("booking is not in pending status")
 	ErrBookingExpired    = errors.New("booking has expired")
+	ErrEventCancelled    = errors.New("event is cancelled")
+	ErrSeriesCancelled   = errors.New("event series is cancelled")
+	ErrSpotsBelowBooked  = errors.New("total spots cannot be less than active bookings")
+	ErrVenueBusy         = errors.New("venue is already taken for an overlapping time")
+	ErrVenueInUse        = errors.New("venue has events and cannot be deleted")
+	ErrEventStarted      = errors.New("event has already started")
+	ErrSalesNotOpen      = errors.New("ticket sales are not open yet")
+	ErrSalesClosed       = errors.New("ticket sales are closed")
+	ErrCategoryExists    = errors.New("category with this slug already exists")
+	ErrEventNotDraft     = errors.New("event is not a draft")
+	ErrEventArchived     = errors.New("event is archived")
+
+	ErrTooManyPendingBookings = errors.New("too many unpaid bookings")
+	ErrDailyBookingLimit      = errors.New("daily booking limit reached")
+	// ErrBookingCooldown — после нескольких истёкших броней бронирование временно приостановлено.
+	ErrBookingCooldown = errors.New("booking is paused after expired bookings")
 )
 
 var (
 	ErrUsernameTaken = errors.New("username is already taken")
 )
 
+var (
+	ErrTelegramLinkInvalid  = errors.New("telegram link token is invalid, used or expired")
+	ErrTelegramDisabled     = errors.New("telegram bot is not configured")
+	ErrCalendarTokenInvalid = errors.New("calendar token is invalid")
+	// ErrEventAccessDenied — закрытое мероприятие, а код доступа неверен и приглашения нет.
+	ErrEventAccessDenied = errors.New("event is private: access code or invitation required")
+)
+
 var (
 	ErrValidation = errors.New("validation error")
 )
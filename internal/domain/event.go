@@ -1,6 +1,30 @@
 package domain
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
+
+// EventStatus — видимость мероприятия. Черновик видит только администратор,
+// опубликованное доступно всем, архивное снято с публикации и закрыто для брони.
+type EventStatus string
+
+const (
+	EventStatusDraft     EventStatus = "draft"
+	EventStatusPublished EventStatus = "published"
+	EventStatusArchived  EventStatus = "archived"
+)
+
+var EventStatuses = []EventStatus{EventStatusDraft, EventStatusPublished, EventStatusArchived}
+
+// EventVisibility — кому доступно мероприятие. Закрытое не попадает в общие списки
+// и бронируется только по коду доступа или приглашению.
+type EventVisibility string
+
+const (
+	EventVisibilityPublic  EventVisibility = "public"
+	EventVisibilityPrivate EventVisibility = "private"
+)
 
 type Event struct {
 	ID              string        `json:"id"`
@@ -10,8 +34,37 @@ type Event struct {
 	TotalSpots      int           `json:"total_spots"`
 	RequiresPayment bool          `json:"requires_payment"`
 	BookingTTL      time.Duration `json:"booking_ttl"`
-	CreatedAt       time.Time     `json:"created_at"`
-	UpdatedAt       time.Time     `json:"updated_at"`
+	// Duration — сколько мероприятие занимает площадку начиная с EventDate.
+	Duration time.Duration `json:"duration"`
+	VenueID  *string       `json:"venue_id,omitempty"`
+	// Timezone — IANA-пояс, в котором мероприятие показывается пользователям.
+	Timezone string `json:"timezone"`
+	// Окно продаж: бронировать можно с SalesOpenAt до SalesCloseAt, nil — без ограничения.
+	// В любом случае продажи закрываются в момент начала мероприятия.
+	SalesOpenAt  *time.Time `json:"sales_open_at,omitempty"`
+	SalesCloseAt *time.Time `json:"sales_close_at,omitempty"`
+
+	// SeriesID задан у вхождений повторяющейся серии. Detached — вхождение
+	// отредактировано отдельно и больше не меняется вместе с серией.
+	SeriesID    *string    `json:"series_id,omitempty"`
+	Detached    bool       `json:"detached"`
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+
+	// CategoryIDs — рубрики мероприятия, Tags — свободные метки в нижнем регистре.
+	CategoryIDs []string `json:"category_ids"`
+	Tags        []string `json:"tags"`
+
+	Status EventStatus `json:"status"`
+	// PublishAt — когда планировщик опубликует черновик; nil — только вручную.
+	PublishAt   *time.Time `json:"publish_at,omitempty"`
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+
+	Visibility EventVisibility `json:"visibility"`
+	// AccessCode — код для брони закрытого мероприятия; пустой — только по приглашениям.
+	AccessCode string `json:"-"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 type EventDetails struct {
@@ -27,4 +80,128 @@ type CreateEventInput struct {
 	TotalSpots      int
 	BookingTTL      time.Duration
 	RequiresPayment *bool
+	// Длительность задаётся либо Duration, либо EndDate.
+	Duration time.Duration
+	EndDate  *time.Time
+	// VenueID — площадка; если TotalSpots не задан, берётся её вместимость.
+	VenueID *string
+	// Timezone по умолчанию — пояс площадки, без площадки — UTC.
+	Timezone     string
+	SalesOpenAt  *time.Time
+	SalesCloseAt *time.Time
+	CategoryIDs  []string
+	Tags         []string
+	// Без Publish мероприятие создаётся черновиком; PublishAt планирует публикацию.
+	Publish   bool
+	PublishAt *time.Time
+	// Visibility по умолчанию public; AccessCode допустим только у закрытого.
+	Visibility EventVisibility
+	AccessCode string
+}
+
+// UpdateEventInput — частичное изменение мероприятия: nil-поля не меняются.
+type UpdateEventInput struct {
+	Title           *string
+	Description     *string
+	EventDate       *time.Time
+	TotalSpots      *int
+	RequiresPayment *bool
+	BookingTTL      *time.Duration
+	Duration        *time.Duration
+	EndDate         *time.Time
+	VenueID         *string
+	Timezone        *string
+	// Нулевое время снимает соответствующую границу окна продаж.
+	SalesOpenAt  *time.Time
+	SalesCloseAt *time.Time
+	// Рубрики и теги заменяются целиком; пустой список их снимает.
+	CategoryIDs *[]string
+	Tags        *[]string
+	// PublishAt меняется только у черновика; нулевое время отменяет публикацию по расписанию.
+	PublishAt *time.Time
+	// Пустой AccessCode оставляет закрытое мероприятие только для приглашённых.
+	Visibility *EventVisibility
+	AccessCode *string
+}
+
+// EndDate — момент, когда мероприятие заканчивается и освобождает площадку.
+func (e *Event) EndDate() time.Time {
+	return e.EventDate.Add(e.Duration)
+}
+
+// Location возвращает часовой пояс мероприятия; неизвестный пояс считается UTC.
+func (e *Event) Location() *time.Location {
+	loc, err := time.LoadLocation(e.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// CheckBookable проверяет, можно ли забронировать место в момент now:
+// мероприятие не отменено, ещё не началось и продажи открыты.
+func (e *Event) CheckBookable(now time.Time) error {
+	switch {
+	case e.Status == EventStatusDraft:
+		return ErrEventNotPublished
+	case e.Status == EventStatusArchived:
+		return ErrEventArchived
+	case e.CancelledAt != nil:
+		return ErrEventCancelled
+	case !now.Before(e.EventDate):
+		return ErrEventStarted
+	case e.SalesOpenAt != nil && now.Before(*e.SalesOpenAt):
+		return fmt.Errorf("%w: sales open at %s", ErrSalesNotOpen, e.SalesOpenAt.In(e.Location()).Format(time.RFC3339))
+	case e.SalesCloseAt != nil && !now.Before(*e.SalesCloseAt):
+		return ErrSalesClosed
+	}
+	return nil
+}
+
+// CheckPublishable проверяет, что черновик готов к публикации в момент now:
+// заполнен, не отменён и ещё не начался.
+func (e *Event) CheckPublishable(now time.Time) error {
+	switch {
+	case e.Status != EventStatusDraft:
+		return ErrEventNotDraft
+	case e.CancelledAt != nil:
+		return ErrEventCancelled
+	case !now.Before(e.EventDate):
+		return ErrEventStarted
+	case e.Title == "":
+		return fmt.Errorf("%w: title is required", ErrValidation)
+	case e.Description == "":
+		return fmt.Errorf("%w: description is required", ErrValidation)
+	case e.TotalSpots <= 0:
+		return fmt.Errorf("%w: total_spots must be positive", ErrValidation)
+	}
+	return e.ValidateSalesWindow()
+}
+
+// ValidatePublishAt проверяет время отложенной публикации: в будущем и до начала мероприятия.
+func (e *Event) ValidatePublishAt(now time.Time) error {
+	if e.PublishAt == nil {
+		return nil
+	}
+	if !e.PublishAt.After(now) {
+		return fmt.Errorf("%w: publish_at must be in the future", ErrValidation)
+	}
+	if !e.PublishAt.Before(e.EventDate) {
+		return fmt.Errorf("%w: publish_at must be before event_date", ErrValidation)
+	}
+	return nil
+}
+
+// ValidateSalesWindow проверяет, что окно продаж непустое и закрывается не позже начала.
+func (e *Event) ValidateSalesWindow() error {
+	if e.SalesOpenAt != nil && !e.SalesOpenAt.Before(e.EventDate) {
+		return fmt.Errorf("%w: sales_open_at must be before event_date", ErrValidation)
+	}
+	if e.SalesCloseAt != nil && e.SalesCloseAt.After(e.EventDate) {
+		return fmt.Errorf("%w: sales_close_at must not be after event_date", ErrValidation)
+	}
+	if e.SalesOpenAt != nil && e.SalesCloseAt != nil && !e.SalesCloseAt.After(*e.SalesOpenAt) {
+		return fmt.Errorf("%w: sales_close_at must be after sales_open_at", ErrValidation)
+	}
+	return nil
 }
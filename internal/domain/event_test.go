@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvent_CheckPublishable(t *testing.T) {
+	now := time.Now()
+	ready := func() Event {
+		return Event{
+			Title:       "Concert",
+			Description: "Live music",
+			TotalSpots:  10,
+			EventDate:   now.Add(time.Hour),
+			Status:      EventStatusDraft,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(e *Event)
+		err    error
+	}{
+		{"ready draft", func(*Event) {}, nil},
+		{"already published", func(e *Event) { e.Status = EventStatusPublished }, ErrEventNotDraft},
+		{"archived", func(e *Event) { e.Status = EventStatusArchived }, ErrEventNotDraft},
+		{"cancelled", func(e *Event) { e.CancelledAt = &now }, ErrEventCancelled},
+		{"started", func(e *Event) { e.EventDate = now.Add(-time.Minute) }, ErrEventStarted},
+		{"no description", func(e *Event) { e.Description = "" }, ErrValidation},
+		{"no spots", func(e *Event) { e.TotalSpots = 0 }, ErrValidation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := ready()
+			tt.modify(&e)
+			err := e.CheckPublishable(now)
+			if tt.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestEvent_CheckBookable_Status(t *testing.T) {
+	now := time.Now()
+	e := Event{EventDate: now.Add(time.Hour)}
+
+	e.Status = EventStatusDraft
+	assert.ErrorIs(t, e.CheckBookable(now), ErrEventNotPublished)
+
+	e.Status = EventStatusArchived
+	assert.ErrorIs(t, e.CheckBookable(now), ErrEventArchived)
+
+	e.Status = EventStatusPublished
+	assert.NoError(t, e.CheckBookable(now))
+}
+
+func TestEvent_CheckAccess(t *testing.T) {
+	public := Event{Visibility: EventVisibilityPublic}
+	assert.NoError(t, public.CheckAccess("", false))
+
+	withCode := Event{Visibility: EventVisibilityPrivate, AccessCode: "secret"}
+	assert.NoError(t, withCode.CheckAccess("secret", false))
+	assert.NoError(t, withCode.CheckAccess("", true))
+	assert.ErrorIs(t, withCode.CheckAccess("Secret", false), ErrEventAccessDenied)
+
+	// Без кода закрытое мероприятие доступно только приглашённым.
+	inviteOnly := Event{Visibility: EventVisibilityPrivate}
+	assert.ErrorIs(t, inviteOnly.CheckAccess("", false), ErrEventAccessDenied)
+	assert.NoError(t, inviteOnly.CheckAccess("", true))
+}
+
+func TestEvent_ValidateVisibility(t *testing.T) {
+	tests := []struct {
+		name  string
+		event Event
+		valid bool
+	}{
+		{"public", Event{Visibility: EventVisibilityPublic}, true},
+		{"private invite only", Event{Visibility: EventVisibilityPrivate}, true},
+		{"private with code", Event{Visibility: EventVisibilityPrivate, AccessCode: "1234"}, true},
+		{"public with code", Event{Visibility: EventVisibilityPublic, AccessCode: "1234"}, false},
+		{"short code", Event{Visibility: EventVisibilityPrivate, AccessCode: "123"}, false},
+		{"unknown", Event{Visibility: "hidden"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.event.ValidateVisibility()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrValidation)
+			}
+		})
+	}
+}
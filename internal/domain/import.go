@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"fmt"
+	"io"
+)
+
+type ImportFormat string
+
+const (
+	ImportCSV ImportFormat = "csv"
+	ImportICS ImportFormat = "ics"
+)
+
+// ImportInput — файл с мероприятиями. VenueID и TotalSpots подставляются в строки,
+// где они не заданы (в ICS мест нет вовсе).
+type ImportInput struct {
+	Format     ImportFormat
+	Data       io.Reader
+	DryRun     bool
+	VenueID    *string
+	TotalSpots int
+	// Publish публикует импортированные мероприятия сразу, иначе они остаются черновиками.
+	Publish bool
+}
+
+// ImportRow — разобранная строка файла. Row — номер строки CSV или порядковый номер VEVENT;
+// Err — ошибка разбора, тогда Input не заполнен.
+type ImportRow struct {
+	Row   int
+	Input CreateEventInput
+	Err   error
+}
+
+// ImportError — ошибка одной строки в отчёте об импорте.
+type ImportError struct {
+	Row     int
+	Title   string
+	Message string
+}
+
+// ImportResult — отчёт об импорте. При любой ошибке не создаётся ни одно мероприятие,
+// а Events пуст; при DryRun Events — то, что было бы создано.
+type ImportResult struct {
+	DryRun bool
+	Total  int
+	Events []*Event
+	Errors []ImportError
+}
+
+// EventBatchError — ошибка вставки Index-го мероприятия пакета; транзакция при этом откатывается.
+type EventBatchError struct {
+	Index int
+	Err   error
+}
+
+func (e *EventBatchError) Error() string {
+	return fmt.Sprintf("event %d: %v", e.Index, e.Err)
+}
+
+func (e *EventBatchError) Unwrap() error {
+	return e.Err
+}
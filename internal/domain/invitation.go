@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"time"
+)
+
+const (
+	MinAccessCodeLength = 4
+	MaxAccessCodeLength = 64
+	// MaxInvitationsPerRequest ограничивает число пользователей в одном приглашении.
+	MaxInvitationsPerRequest = 500
+)
+
+// EventInvitation — приглашение пользователя на закрытое мероприятие.
+type EventInvitation struct {
+	EventID   string    `json:"event_id"`
+	UserID    string    `json:"user_id"`
+	Username  string    `json:"username"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// IsPrivate сообщает, закрыто ли мероприятие.
+func (e *Event) IsPrivate() bool {
+	return e.Visibility == EventVisibilityPrivate
+}
+
+// CheckAccess проверяет доступ к закрытому мероприятию: по приглашению или по коду.
+// Код сравнивается за постоянное время.
+func (e *Event) CheckAccess(code string, invited bool) error {
+	if !e.IsPrivate() || invited {
+		return nil
+	}
+	if e.AccessCode != "" && subtle.ConstantTimeCompare([]byte(code), []byte(e.AccessCode)) == 1 {
+		return nil
+	}
+	return ErrEventAccessDenied
+}
+
+// ValidateVisibility проверяет видимость и код доступа.
+func (e *Event) ValidateVisibility() error {
+	switch e.Visibility {
+	case EventVisibilityPublic:
+		if e.AccessCode != "" {
+			return fmt.Errorf("%w: access_code is only allowed for private events", ErrValidation)
+		}
+	case EventVisibilityPrivate:
+		if e.AccessCode != "" && (len(e.AccessCode) < MinAccessCodeLength || len(e.AccessCode) > MaxAccessCodeLength) {
+			return fmt.Errorf("%w: access_code must be %d to %d characters",
+				ErrValidation, MinAccessCodeLength, MaxAccessCodeLength)
+		}
+	default:
+		return fmt.Errorf("%w: unknown visibility %q", ErrValidation, e.Visibility)
+	}
+	return nil
+}
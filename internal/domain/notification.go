@@ -0,0 +1,96 @@
+package domain
+
+import "time"
+
+type NotificationKind string
+
+const (
+	NotificationBookingCreated   NotificationKind = "booking_created"
+	NotificationBookingConfirmed NotificationKind = "booking_confirmed"
+	NotificationBookingCancelled NotificationKind = "booking_cancelled"
+	// NotificationNewEvent — новое мероприятие в рубрике, на которую подписан пользователь.
+	NotificationNewEvent NotificationKind = "new_event"
+	// NotificationEventInvitation — приглашение на закрытое мероприятие.
+	NotificationEventInvitation NotificationKind = "event_invitation"
+)
+
+// NotificationKinds — все типы уведомлений, на которые пользователь может подписаться.
+var NotificationKinds = []NotificationKind{
+	NotificationBookingCreated,
+	NotificationBookingConfirmed,
+	NotificationBookingCancelled,
+	NotificationNewEvent,
+	NotificationEventInvitation,
+}
+
+type NotificationChannel string
+
+const (
+	ChannelTelegram NotificationChannel = "telegram"
+	ChannelEmail    NotificationChannel = "email"
+	ChannelWebhook  NotificationChannel = "webhook"
+)
+
+var NotificationChannels = []NotificationChannel{ChannelTelegram, ChannelEmail, ChannelWebhook}
+
+// Notification — запись исходящего уведомления (outbox), которую доставляет воркер.
+// Delivered — каналы, уже доставившие уведомление; при повторе они пропускаются.
+type Notification struct {
+	ID        string                `json:"id"`
+	Kind      NotificationKind      `json:"kind"`
+	UserID    string                `json:"user_id"`
+	EventID   string                `json:"event_id"`
+	Attempts  int                   `json:"attempts"`
+	Delivered []NotificationChannel `json:"delivered,omitempty"`
+	CreatedAt time.Time             `json:"created_at"`
+}
+
+// NotificationPreferences — подписки пользователя по каналам и типам уведомлений.
+// Telegram и email включены по умолчанию, webhook нужно включить явно.
+type NotificationPreferences struct {
+	UserID   string
+	Settings map[NotificationChannel]map[NotificationKind]bool
+}
+
+// NewNotificationPreferences возвращает настройки по умолчанию.
+func NewNotificationPreferences(userID string) *NotificationPreferences {
+	p := &NotificationPreferences{
+		UserID:   userID,
+		Settings: make(map[NotificationChannel]map[NotificationKind]bool, len(NotificationChannels)),
+	}
+	for _, ch := range NotificationChannels {
+		for _, kind := range NotificationKinds {
+			p.Set(ch, kind, ch != ChannelWebhook)
+		}
+	}
+	return p
+}
+
+func (p *NotificationPreferences) Set(ch NotificationChannel, kind NotificationKind, enabled bool) {
+	if p.Settings[ch] == nil {
+		p.Settings[ch] = make(map[NotificationKind]bool, len(NotificationKinds))
+	}
+	p.Settings[ch][kind] = enabled
+}
+
+func (p *NotificationPreferences) Enabled(ch NotificationChannel, kind NotificationKind) bool {
+	return p.Settings[ch][kind]
+}
+
+func IsValidNotificationChannel(ch NotificationChannel) bool {
+	for _, c := range NotificationChannels {
+		if c == ch {
+			return true
+		}
+	}
+	return false
+}
+
+func IsValidNotificationKind(kind NotificationKind) bool {
+	for _, k := range NotificationKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,19 @@
+package domain
+
+// Outbox — сообщения, которые записываются в одной транзакции с изменением, о котором
+// сообщают, и отправляются воркером после коммита. Сообщение не теряется, если процесс
+// упадёт сразу после коммита, и не уходит, если транзакция откатилась.
+type Outbox struct {
+	Notifications []*Notification
+	Webhooks      []*WebhookMessage
+}
+
+// BookingOutbox возвращает сообщения о смене статуса брони b на мероприятие event;
+// репозиторий читает event и записывает сообщения в транзакции этой смены.
+type BookingOutbox func(b *Booking, event *Event) (*Outbox, error)
+
+// Add дописывает в o сообщения other.
+func (o *Outbox) Add(other *Outbox) {
+	o.Notifications = append(o.Notifications, other.Notifications...)
+	o.Webhooks = append(o.Webhooks, other.Webhooks...)
+}
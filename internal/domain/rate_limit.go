@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// RateLimit — маркерное ведро: до Burst запросов подряд, пополнение Requests за Period.
+// Нулевой Burst означает Burst = Requests.
+type RateLimit struct {
+	Requests int
+	Period   time.Duration
+	Burst    int
+}
+
+// RateBucket — состояние ведра. Ведро с нулевым UpdatedAt считается полным.
+type RateBucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+// RateKey — ведро Key, ограниченное Limit.
+type RateKey struct {
+	Key   string
+	Limit RateLimit
+}
+
+// RateDecision — результат попытки взять маркер.
+type RateDecision struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	RetryAfter time.Duration // через сколько появится маркер; только при отказе
+	ResetAfter time.Duration // через сколько ведро снова будет полным
+}
+
+func (l RateLimit) Capacity() int {
+	if l.Burst > 0 {
+		return l.Burst
+	}
+	return l.Requests
+}
+
+// FillTime — за сколько пустое ведро наполняется полностью.
+func (l RateLimit) FillTime() time.Duration {
+	return l.duration(float64(l.Capacity()))
+}
+
+// Refill возвращает число маркеров в ведре к моменту now.
+func (l RateLimit) Refill(b RateBucket, now time.Time) float64 {
+	capacity := float64(l.Capacity())
+	if b.UpdatedAt.IsZero() {
+		return capacity
+	}
+	elapsed := max(now.Sub(b.UpdatedAt), 0)
+	return min(capacity, b.Tokens+elapsed.Seconds()*l.rate())
+}
+
+// Take пытается взять маркер и обновляет ведро.
+func (l RateLimit) Take(b *RateBucket, now time.Time) RateDecision {
+	tokens := l.Refill(*b, now)
+	allowed := tokens >= 1
+	if allowed {
+		tokens--
+	}
+	b.Tokens, b.UpdatedAt = tokens, now
+	return l.Decision(allowed, tokens)
+}
+
+// TakeAll берёт по маркеру из каждого ведра, только если маркер есть во всех: запрос,
+// отклонённый одним лимитом, не расходует остальные. limits[i] относится к buckets[i].
+func TakeAll(limits []RateLimit, buckets []*RateBucket, now time.Time) []RateDecision {
+	tokens := make([]float64, len(buckets))
+	allowed := true
+	for i, b := range buckets {
+		tokens[i] = limits[i].Refill(*b, now)
+		allowed = allowed && tokens[i] >= 1
+	}
+
+	decisions := make([]RateDecision, len(buckets))
+	for i, b := range buckets {
+		if allowed {
+			tokens[i]--
+		}
+		b.Tokens, b.UpdatedAt = tokens[i], now
+		decisions[i] = limits[i].Decision(allowed || tokens[i] >= 1, tokens[i])
+	}
+	return decisions
+}
+
+// Decision описывает ведро, в котором после попытки осталось tokens маркеров.
+func (l RateLimit) Decision(allowed bool, tokens float64) RateDecision {
+	d := RateDecision{
+		Allowed:    allowed,
+		Limit:      l.Capacity(),
+		Remaining:  int(math.Floor(tokens)),
+		ResetAfter: l.duration(float64(l.Capacity()) - tokens),
+	}
+	if !allowed {
+		d.RetryAfter = l.duration(1 - tokens)
+	}
+	return d
+}
+
+// rate — маркеров в секунду.
+func (l RateLimit) rate() float64 {
+	return float64(l.Requests) / l.Period.Seconds()
+}
+
+func (l RateLimit) duration(tokens float64) time.Duration {
+	if tokens <= 0 {
+		return 0
+	}
+	return time.Duration(math.Ceil(tokens / l.rate() * float64(time.Second)))
+}
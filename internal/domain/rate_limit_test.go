@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimit_Take(t *testing.T) {
+	limit := RateLimit{Requests: 60, Period: time.Minute, Burst: 2}
+	now := time.Now()
+	var b RateBucket
+
+	d := limit.Take(&b, now)
+	assert.True(t, d.Allowed)
+	assert.Equal(t, 2, d.Limit)
+	assert.Equal(t, 1, d.Remaining)
+
+	assert.True(t, limit.Take(&b, now).Allowed)
+
+	d = limit.Take(&b, now)
+	assert.False(t, d.Allowed)
+	assert.Equal(t, 0, d.Remaining)
+	assert.Equal(t, time.Second, d.RetryAfter)
+	assert.Equal(t, 2*time.Second, d.ResetAfter)
+
+	// Маркер в секунду: через полсекунды ещё рано, через секунду — можно.
+	assert.False(t, limit.Take(&b, now.Add(500*time.Millisecond)).Allowed)
+	assert.True(t, limit.Take(&b, now.Add(time.Second)).Allowed)
+}
+
+func TestRateLimit_RefillCapsAtCapacity(t *testing.T) {
+	limit := RateLimit{Requests: 10, Period: time.Second}
+	now := time.Now()
+
+	assert.Equal(t, 10, limit.Capacity())
+	assert.InDelta(t, 10, limit.Refill(RateBucket{}, now), 1e-9)
+	assert.InDelta(t, 10, limit.Refill(RateBucket{Tokens: 0, UpdatedAt: now.Add(-time.Hour)}, now), 1e-9)
+	assert.InDelta(t, 5, limit.Refill(RateBucket{Tokens: 0, UpdatedAt: now.Add(-500 * time.Millisecond)}, now), 1e-9)
+	assert.Equal(t, time.Second, limit.FillTime())
+}
+
+func TestTakeAll_DeniedRequestKeepsOtherBuckets(t *testing.T) {
+	limits := []RateLimit{
+		{Requests: 1, Period: time.Minute, Burst: 2},
+		{Requests: 1, Period: time.Minute, Burst: 1},
+	}
+	now := time.Now()
+	full, empty := RateBucket{}, RateBucket{Tokens: 0, UpdatedAt: now}
+
+	d := TakeAll(limits, []*RateBucket{&full, &empty}, now)
+
+	assert.True(t, d[0].Allowed)
+	assert.Equal(t, 2, d[0].Remaining)
+	assert.False(t, d[1].Allowed)
+	assert.InDelta(t, 2, full.Tokens, 1e-9, "маркер не должен списываться, если отказал другой лимит")
+
+	empty = RateBucket{}
+	d = TakeAll(limits, []*RateBucket{&full, &empty}, now)
+
+	assert.True(t, d[0].Allowed)
+	assert.True(t, d[1].Allowed)
+	assert.InDelta(t, 1, full.Tokens, 1e-9)
+	assert.InDelta(t, 0, empty.Tokens, 1e-9)
+}
@@ -0,0 +1,32 @@
+package domain
+
+import "time"
+
+// EventSearchInput — запрос поиска мероприятий. Query — слова через пробел, каждое ищется
+// и как начало слова. From и To ограничивают дату начала, Available оставляет
+// только мероприятия со свободными местами.
+type EventSearchInput struct {
+	Query     string
+	From      *time.Time
+	To        *time.Time
+	Available bool
+	Limit     int
+	Offset    int
+}
+
+// EventSearch — разобранный запрос поиска для репозитория.
+type EventSearch struct {
+	Terms     []string
+	From      *time.Time
+	To        *time.Time
+	Available bool
+	Limit     int
+	Offset    int
+}
+
+// EventSearchResult — найденное мероприятие с релевантностью и числом свободных мест.
+type EventSearchResult struct {
+	Event          Event
+	Rank           float64
+	AvailableSpots int
+}
@@ -0,0 +1,73 @@
+package domain
+
+import "time"
+
+type RecurrenceFreq string
+
+const (
+	FreqDaily   RecurrenceFreq = "daily"
+	FreqWeekly  RecurrenceFreq = "weekly"
+	FreqMonthly RecurrenceFreq = "monthly"
+)
+
+// Recurrence — правило повторения в духе RRULE (RFC 5545): FREQ, INTERVAL, BYDAY,
+// BYMONTHDAY и одно из COUNT/UNTIL. Без COUNT и UNTIL серия бесконечна.
+type Recurrence struct {
+	Freq       RecurrenceFreq
+	Interval   int
+	ByWeekday  []time.Weekday
+	ByMonthDay []int
+	Count      int
+	Until      *time.Time
+}
+
+// EventSeries — шаблон повторяющегося мероприятия. Вхождения материализуются
+// планировщиком в обычные события заранее, на горизонт из конфигурации.
+type EventSeries struct {
+	ID              string
+	Title           string
+	Description     string
+	TotalSpots      int
+	RequiresPayment bool
+	BookingTTL      time.Duration
+
+	// Start — первое вхождение (DTSTART); время суток сохраняется в Timezone при переходе на летнее время.
+	Start      time.Time
+	Timezone   string
+	Recurrence Recurrence
+	// Exceptions — отменённые вхождения (EXDATE).
+	Exceptions []time.Time
+
+	// MaterializedUntil — до какого момента вхождения уже созданы.
+	MaterializedUntil *time.Time
+	CancelledAt       *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+type CreateSeriesInput struct {
+	Title           string
+	Description     string
+	Start           time.Time
+	Timezone        string
+	TotalSpots      int
+	BookingTTL      time.Duration
+	RequiresPayment *bool
+	Recurrence      Recurrence
+	Exceptions      []time.Time
+}
+
+// UpdateSeriesInput — изменение шаблона серии: nil-поля не меняются. Изменения применяются
+// к будущим вхождениям, кроме отменённых и отредактированных по отдельности.
+type UpdateSeriesInput struct {
+	Title           *string
+	Description     *string
+	TotalSpots      *int
+	RequiresPayment *bool
+	BookingTTL      *time.Duration
+}
+
+type SeriesDetails struct {
+	Series      EventSeries
+	Occurrences []*Event
+}
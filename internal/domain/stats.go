@@ -0,0 +1,59 @@
+package domain
+
+import "time"
+
+// StatsRange — период отчёта [From, To); дни считаются в часовом поясе Location.
+type StatsRange struct {
+	From     time.Time
+	To       time.Time
+	Location *time.Location
+}
+
+// DailyBookingStats — брони, созданные, подтверждённые и истёкшие за день Day (полночь в поясе отчёта).
+type DailyBookingStats struct {
+	Day       time.Time
+	Created   int
+	Confirmed int
+	Expired   int
+}
+
+// ConversionStats — путь pending → confirmed для броней, созданных за период.
+// Брони без оплаты подтверждаются сразу и сюда не входят; ещё ожидающие оплаты — тоже.
+type ConversionStats struct {
+	Resolved  int
+	Confirmed int
+	// Rate — доля подтверждённых среди завершённых, 0 при отсутствии данных.
+	Rate float64
+	// AvgTimeToConfirm — среднее время от создания до подтверждения для подтверждённых за период.
+	AvgTimeToConfirm time.Duration
+}
+
+// EventOccupancy — заполненность мероприятия: FillRate = (Confirmed + Pending) / TotalSpots.
+type EventOccupancy struct {
+	EventID    string
+	Title      string
+	EventDate  time.Time
+	TotalSpots int
+	Confirmed  int
+	Pending    int
+	FillRate   float64
+}
+
+// BookingStats — сводка для панели администратора. Occupancy — мероприятия,
+// проходящие в периоде; TopEvents — самые заполненные из них.
+type BookingStats struct {
+	Range      StatsRange
+	Daily      []DailyBookingStats
+	Conversion ConversionStats
+	Occupancy  []EventOccupancy
+	TopEvents  []EventOccupancy
+}
+
+// StatsInput — параметры отчёта. From и To — календарные дни включительно,
+// нулевые значения — последние 30 дней; Top — размер рейтинга мероприятий.
+type StatsInput struct {
+	From     time.Time
+	To       time.Time
+	Timezone string
+	Top      int
+}
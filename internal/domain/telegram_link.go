@@ -0,0 +1,10 @@
+package domain
+
+import "time"
+
+// TelegramLink — одноразовая deep-link ссылка для привязки чата Telegram к пользователю.
+type TelegramLink struct {
+	Token     string    `json:"token"`
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
@@ -1,15 +1,62 @@
 package domain
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	DefaultLocale   = "ru"
+	DefaultTimezone = "UTC"
+)
+
+// SupportedLocales — языки, для которых есть шаблоны уведомлений.
+var SupportedLocales = []string{"ru", "en"}
+
+func IsSupportedLocale(locale string) bool {
+	for _, l := range SupportedLocales {
+		if l == locale {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadTimezone возвращает часовой пояс по имени из базы IANA. Пустое имя и "Local"
+// отклоняются: time.LoadLocation превращает их в UTC и пояс сервера, а не в пояс клиента.
+func LoadTimezone(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("%w: unknown timezone %q", ErrValidation, name)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unknown timezone %q", ErrValidation, name)
+	}
+	return loc, nil
+}
+
+// ValidateTimezone проверяет имя часового пояса, см. LoadTimezone.
+func ValidateTimezone(name string) error {
+	_, err := LoadTimezone(name)
+	return err
+}
 
 type User struct {
 	ID             string    `json:"id"`
 	Username       string    `json:"username"`
 	TelegramChatID *int64    `json:"telegram_chat_id"`
+	Email          *string   `json:"email"`
+	WebhookURL     *string   `json:"webhook_url"`
+	Locale         string    `json:"locale"`
+	Timezone       string    `json:"timezone"`
 	CreatedAt      time.Time `json:"created_at"`
 }
 
 type CreateUserInput struct {
 	Username       string
 	TelegramChatID *int64
+	Email          *string
+	WebhookURL     *string
+	Locale         string
+	Timezone       string
 }
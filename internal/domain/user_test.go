@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTimezone(t *testing.T) {
+	loc, err := LoadTimezone("Europe/Moscow")
+	require.NoError(t, err)
+	assert.Equal(t, "Europe/Moscow", loc.String())
+
+	for _, name := range []string{"", "Local", "Mars/Olympus"} {
+		_, err = LoadTimezone(name)
+		assert.ErrorIs(t, err, ErrValidation, name)
+	}
+}
@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+// Venue — площадка, на которой проходят мероприятия. Capacity подставляется
+// в TotalSpots мероприятия, если количество мест не указано явно.
+type Venue struct {
+	ID        string
+	Name      string
+	Address   string
+	Latitude  *float64
+	Longitude *float64
+	Capacity  int
+	Timezone  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type CreateVenueInput struct {
+	Name      string
+	Address   string
+	Latitude  *float64
+	Longitude *float64
+	Capacity  int
+	Timezone  string
+}
+
+// UpdateVenueInput — частичное обновление площадки: nil-поля не меняются.
+type UpdateVenueInput struct {
+	Name      *string
+	Address   *string
+	Latitude  *float64
+	Longitude *float64
+	Capacity  *int
+	Timezone  *string
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+)
+
+// WebhookEventType — тип события, о котором уведомляются внешние системы.
+type WebhookEventType string
+
+const (
+	WebhookBookingCreated   WebhookEventType = "booking.created"
+	WebhookBookingConfirmed WebhookEventType = "booking.confirmed"
+	WebhookBookingCancelled WebhookEventType = "booking.cancelled"
+	WebhookEventCreated     WebhookEventType = "event.created"
+	WebhookEventPublished   WebhookEventType = "event.published"
+)
+
+var WebhookEventTypes = []WebhookEventType{
+	WebhookBookingCreated,
+	WebhookBookingConfirmed,
+	WebhookBookingCancelled,
+	WebhookEventCreated,
+	WebhookEventPublished,
+}
+
+func IsValidWebhookEventType(t WebhookEventType) bool {
+	return slices.Contains(WebhookEventTypes, t)
+}
+
+// WebhookMessage — событие для партнёров с готовым телом запроса. В outbox оно
+// превращается в доставку на каждую активную подписку на Type.
+type WebhookMessage struct {
+	Type    WebhookEventType
+	Payload json.RawMessage
+}
+
+// WebhookSubscription — подписка партнёрской системы на события.
+// Secret используется для HMAC-подписи каждого запроса.
+type WebhookSubscription struct {
+	ID         string
+	URL        string
+	Secret     string
+	EventTypes []WebhookEventType
+	Active     bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type CreateWebhookInput struct {
+	URL        string
+	Secret     string
+	EventTypes []WebhookEventType
+}
+
+// UpdateWebhookInput — частичное обновление подписки: nil-поля не меняются.
+type UpdateWebhookInput struct {
+	URL        *string
+	EventTypes []WebhookEventType
+	Active     *bool
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery — одна отправка события подписчику со всеми попытками.
+type WebhookDelivery struct {
+	ID             string
+	SubscriptionID string
+	EventType      WebhookEventType
+	Payload        json.RawMessage
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	ResponseStatus *int
+	LastError      *string
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+
+	// AttemptLog заполняется только при запросе одной доставки.
+	AttemptLog []WebhookDeliveryAttempt
+}
+
+// WebhookDeliveryAttempt — запись журнала о попытке доставки.
+type WebhookDeliveryAttempt struct {
+	Attempt        int
+	ResponseStatus *int
+	Error          *string
+	Duration       time.Duration
+	CreatedAt      time.Time
+}
@@ -0,0 +1,317 @@
+// Package eventimport разбирает файлы с мероприятиями (CSV и iCalendar) в строки для EventService.
+// Пакет только читает формат: бизнес-правила проверяет сервис.
+package eventimport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+	"github.com/google/uuid"
+	"github.com/stpnv0/EventBooker/internal/domain"
+)
+
+// MaxRows ограничивает размер одного импорта: все строки вставляются одной транзакцией.
+const MaxRows = 1000
+
+// CSV-колонки совпадают с полями JSON в POST /api/events.
+const (
+	colTitle           = "title"
+	colDescription     = "description"
+	colEventDate       = "event_date"
+	colEndDate         = "end_date"
+	colDurationMinutes = "duration_minutes"
+	colTimezone        = "timezone"
+	colTotalSpots      = "total_spots"
+	colBookingTTL      = "booking_ttl_minutes"
+	colRequiresPayment = "requires_payment"
+	colVenueID         = "venue_id"
+	colSalesOpenAt     = "sales_open_at"
+	colSalesCloseAt    = "sales_close_at"
+)
+
+var knownColumns = map[string]bool{
+	colTitle: true, colDescription: true, colEventDate: true, colEndDate: true,
+	colDurationMinutes: true, colTimezone: true, colTotalSpots: true, colBookingTTL: true,
+	colRequiresPayment: true, colVenueID: true, colSalesOpenAt: true, colSalesCloseAt: true,
+}
+
+var requiredColumns = []string{colTitle, colEventDate}
+
+// Parse разбирает файл. Ошибка возвращается, только если файл нельзя прочитать целиком
+// (битый формат, неизвестные колонки, слишком много строк); ошибки отдельных строк — в ImportRow.Err.
+func Parse(format domain.ImportFormat, r io.Reader) ([]domain.ImportRow, error) {
+	switch format {
+	case domain.ImportCSV:
+		return ParseCSV(r)
+	case domain.ImportICS:
+		return ParseICS(r)
+	default:
+		return nil, fmt.Errorf("%w: unsupported import format %q", domain.ErrValidation, format)
+	}
+}
+
+// ParseCSV читает CSV с заголовком. Разделитель — запятая или точка с запятой
+// (так сохраняет Excel в русской локали); BOM в начале файла пропускается.
+func ParseCSV(r io.Reader) ([]domain.ImportRow, error) {
+	br := bufio.NewReader(r)
+	if bom, _ := br.Peek(3); bytes.Equal(bom, []byte("\xef\xbb\xbf")) {
+		_, _ = br.Discard(3)
+	}
+
+	cr := csv.NewReader(br)
+	cr.Comma = detectDelimiter(br)
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: file is empty", domain.ErrValidation)
+		}
+		return nil, fmt.Errorf("%w: read header: %v", domain.ErrValidation, err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !knownColumns[name] {
+			return nil, fmt.Errorf("%w: unknown column %q", domain.ErrValidation, name)
+		}
+		if _, dup := columns[name]; dup {
+			return nil, fmt.Errorf("%w: duplicate column %q", domain.ErrValidation, name)
+		}
+		columns[name] = i
+	}
+	for _, name := range requiredColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("%w: missing column %q", domain.ErrValidation, name)
+		}
+	}
+
+	var rows []domain.ImportRow
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			// Сломанные кавычки сбивают разбор всего остатка файла, поэтому это ошибка файла.
+			return nil, fmt.Errorf("%w: %v", domain.ErrValidation, err)
+		}
+		if len(rows) == MaxRows {
+			return nil, fmt.Errorf("%w: too many rows, limit is %d", domain.ErrValidation, MaxRows)
+		}
+
+		line, _ := cr.FieldPos(0)
+		get := func(name string) string {
+			if i, ok := columns[name]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		input, err := csvInput(get)
+		rows = append(rows, domain.ImportRow{Row: line, Input: input, Err: err})
+	}
+
+	return rows, nil
+}
+
+// detectDelimiter смотрит на заголовок: точка с запятой без запятых — разделитель Excel.
+func detectDelimiter(br *bufio.Reader) rune {
+	peek, _ := br.Peek(br.Size())
+	if i := bytes.IndexByte(peek, '\n'); i >= 0 {
+		peek = peek[:i]
+	}
+	if bytes.IndexByte(peek, ';') >= 0 && bytes.IndexByte(peek, ',') < 0 {
+		return ';'
+	}
+	return ','
+}
+
+func csvInput(get func(string) string) (domain.CreateEventInput, error) {
+	input := domain.CreateEventInput{
+		Title:       get(colTitle),
+		Description: get(colDescription),
+		Timezone:    get(colTimezone),
+	}
+
+	var err error
+	if input.EventDate, err = parseTime(colEventDate, get(colEventDate)); err != nil {
+		return input, err
+	}
+	if input.EndDate, err = parseOptionalTime(colEndDate, get(colEndDate)); err != nil {
+		return input, err
+	}
+	if input.SalesOpenAt, err = parseOptionalTime(colSalesOpenAt, get(colSalesOpenAt)); err != nil {
+		return input, err
+	}
+	if input.SalesCloseAt, err = parseOptionalTime(colSalesCloseAt, get(colSalesCloseAt)); err != nil {
+		return input, err
+	}
+
+	if input.TotalSpots, err = parseInt(colTotalSpots, get(colTotalSpots)); err != nil {
+		return input, err
+	}
+	minutes, err := parseInt(colDurationMinutes, get(colDurationMinutes))
+	if err != nil {
+		return input, err
+	}
+	input.Duration = time.Duration(minutes) * time.Minute
+	if minutes, err = parseInt(colBookingTTL, get(colBookingTTL)); err != nil {
+		return input, err
+	}
+	input.BookingTTL = time.Duration(minutes) * time.Minute
+
+	if v := get(colRequiresPayment); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return input, fmt.Errorf("%w: %s must be true or false", domain.ErrValidation, colRequiresPayment)
+		}
+		input.RequiresPayment = &b
+	}
+	if v := get(colVenueID); v != "" {
+		if _, err := uuid.Parse(v); err != nil {
+			return input, fmt.Errorf("%w: invalid %s", domain.ErrValidation, colVenueID)
+		}
+		input.VenueID = &v
+	}
+
+	return input, nil
+}
+
+func parseTime(name, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, fmt.Errorf("%w: %s is required", domain.ErrValidation, name)
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid %s format, expected RFC3339", domain.ErrValidation, name)
+	}
+	return t, nil
+}
+
+func parseOptionalTime(name, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := parseTime(name, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// parseInt: пустое значение — ноль, то есть значение по умолчанию.
+func parseInt(name, value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("%w: %s must be a non-negative integer", domain.ErrValidation, name)
+	}
+	return n, nil
+}
+
+// ParseICS читает VEVENT календаря: SUMMARY, DESCRIPTION, DTSTART и DTEND или DURATION.
+// TZID из DTSTART становится часовым поясом мероприятия. Отменённые события пропускаются.
+func ParseICS(r io.Reader) ([]domain.ImportRow, error) {
+	cal, err := ics.ParseCalendar(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse calendar: %v", domain.ErrValidation, err)
+	}
+
+	var rows []domain.ImportRow
+	for i, ve := range cal.Events() {
+		if status := ve.GetProperty(ics.ComponentPropertyStatus); status != nil &&
+			strings.EqualFold(status.Value, string(ics.ObjectStatusCancelled)) {
+			continue
+		}
+		if len(rows) == MaxRows {
+			return nil, fmt.Errorf("%w: too many events, limit is %d", domain.ErrValidation, MaxRows)
+		}
+
+		input, err := icsInput(ve)
+		rows = append(rows, domain.ImportRow{Row: i + 1, Input: input, Err: err})
+	}
+
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w: calendar has no events", domain.ErrValidation)
+	}
+
+	return rows, nil
+}
+
+func icsInput(ve *ics.VEvent) (domain.CreateEventInput, error) {
+	var input domain.CreateEventInput
+	if p := ve.GetProperty(ics.ComponentPropertySummary); p != nil {
+		input.Title = strings.TrimSpace(p.Value)
+	}
+	if p := ve.GetProperty(ics.ComponentPropertyDescription); p != nil {
+		input.Description = strings.TrimSpace(p.Value)
+	}
+
+	start := ve.GetProperty(ics.ComponentPropertyDtStart)
+	if start == nil {
+		return input, fmt.Errorf("%w: DTSTART is required", domain.ErrValidation)
+	}
+	if tzid, ok := start.ICalParameters[string(ics.ParameterTzid)]; ok && len(tzid) == 1 {
+		input.Timezone = tzid[0]
+	}
+
+	var err error
+	if input.EventDate, err = ve.GetStartAt(); err != nil {
+		return input, fmt.Errorf("%w: invalid DTSTART: %v", domain.ErrValidation, err)
+	}
+
+	if ve.GetProperty(ics.ComponentPropertyDtEnd) != nil {
+		end, err := ve.GetEndAt()
+		if err != nil {
+			return input, fmt.Errorf("%w: invalid DTEND: %v", domain.ErrValidation, err)
+		}
+		input.EndDate = &end
+	} else if p := ve.GetProperty(ics.ComponentPropertyDuration); p != nil {
+		if input.Duration, err = parseDuration(p.Value); err != nil {
+			return input, err
+		}
+	}
+
+	return input, nil
+}
+
+// icsDuration — DURATION из RFC 5545: P1W, P1DT2H, PT90M и т.п. Отрицательные длительности не принимаются.
+var icsDuration = regexp.MustCompile(`^\+?P(?:(\d+)W|(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?)$`)
+
+func parseDuration(value string) (time.Duration, error) {
+	m := icsDuration.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(value)))
+	if m == nil {
+		return 0, fmt.Errorf("%w: invalid DURATION %q", domain.ErrValidation, value)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var d time.Duration
+	for i, unit := range units {
+		if m[i+1] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m[i+1])
+		if err != nil {
+			return 0, fmt.Errorf("%w: invalid DURATION %q", domain.ErrValidation, value)
+		}
+		d += time.Duration(n) * unit
+	}
+	if d == 0 {
+		return 0, fmt.Errorf("%w: DURATION must be positive", domain.ErrValidation)
+	}
+
+	return d, nil
+}
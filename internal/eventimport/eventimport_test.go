@@ -0,0 +1,157 @@
+package eventimport
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCSV_AllColumns(t *testing.T) {
+	data := "title,description,event_date,end_date,timezone,total_spots,booking_ttl_minutes," +
+		"requires_payment,venue_id,sales_open_at,sales_close_at\n" +
+		`"Go meetup","Talks, pizza",2030-03-19T19:00:00+03:00,2030-03-19T21:30:00+03:00,Europe/Moscow,50,15,` +
+		"false,2b1f6c8e-2d8c-4b8f-9a57-0c3f7a5d1e11,2030-03-01T10:00:00+03:00,\n"
+
+	rows, err := ParseCSV(strings.NewReader(data))
+
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	row := rows[0]
+	require.NoError(t, row.Err)
+	assert.Equal(t, 2, row.Row)
+	assert.Equal(t, "Go meetup", row.Input.Title)
+	assert.Equal(t, "Talks, pizza", row.Input.Description)
+	assert.Equal(t, "Europe/Moscow", row.Input.Timezone)
+	assert.Equal(t, 50, row.Input.TotalSpots)
+	assert.Equal(t, 15*time.Minute, row.Input.BookingTTL)
+	require.NotNil(t, row.Input.RequiresPayment)
+	assert.False(t, *row.Input.RequiresPayment)
+	require.NotNil(t, row.Input.EndDate)
+	assert.Equal(t, 150*time.Minute, row.Input.EndDate.Sub(row.Input.EventDate))
+	assert.NotNil(t, row.Input.VenueID)
+	assert.NotNil(t, row.Input.SalesOpenAt)
+	assert.Nil(t, row.Input.SalesCloseAt)
+}
+
+func TestParseCSV_ExcelSemicolonWithBOM(t *testing.T) {
+	data := "\xef\xbb\xbfTitle;Event_Date;Duration_Minutes\r\nКонцерт;2030-05-01T19:00:00Z;90\r\n"
+
+	rows, err := ParseCSV(strings.NewReader(data))
+
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.NoError(t, rows[0].Err)
+	assert.Equal(t, "Концерт", rows[0].Input.Title)
+	assert.Equal(t, 90*time.Minute, rows[0].Input.Duration)
+}
+
+func TestParseCSV_RowErrors(t *testing.T) {
+	data := "title,event_date,total_spots,venue_id\n" +
+		"A,2030-05-01T19:00:00Z,ten,\n" +
+		"B,01.05.2030,,\n" +
+		"C,2030-05-01T19:00:00Z,10,hall-1\n" +
+		"D,2030-05-01T19:00:00Z,10,\n"
+
+	rows, err := ParseCSV(strings.NewReader(data))
+
+	require.NoError(t, err)
+	require.Len(t, rows, 4)
+	for _, row := range rows[:3] {
+		assert.ErrorIs(t, row.Err, domain.ErrValidation, "row %d", row.Row)
+	}
+	assert.NoError(t, rows[3].Err)
+	assert.Equal(t, 5, rows[3].Row)
+}
+
+func TestParseCSV_FileErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"empty", ""},
+		{"unknown column", "title,event_date,price\n"},
+		{"missing event_date", "title\n"},
+		{"duplicate column", "title,title,event_date\n"},
+		{"wrong field count", "title,event_date\nA\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCSV(strings.NewReader(tt.data))
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+func TestParseCSV_TooManyRows(t *testing.T) {
+	data := "title,event_date\n" + strings.Repeat("A,2030-05-01T19:00:00Z\n", MaxRows+1)
+
+	_, err := ParseCSV(strings.NewReader(data))
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestParseICS(t *testing.T) {
+	data := "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:test\r\n" +
+		"BEGIN:VEVENT\r\nUID:1\r\nSUMMARY:Go meetup\r\nDESCRIPTION:Talks\\, pizza\r\n" +
+		"DTSTART;TZID=Europe/Moscow:20300319T190000\r\nDTEND;TZID=Europe/Moscow:20300319T210000\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nUID:2\r\nSUMMARY:Workshop\r\nDTSTART:20300320T100000Z\r\nDURATION:P1DT2H\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nUID:3\r\nSUMMARY:Cancelled\r\nSTATUS:CANCELLED\r\nDTSTART:20300321T100000Z\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nUID:4\r\nSUMMARY:Broken\r\nEND:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	rows, err := ParseICS(strings.NewReader(data))
+
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+
+	meetup := rows[0]
+	require.NoError(t, meetup.Err)
+	assert.Equal(t, "Go meetup", meetup.Input.Title)
+	assert.Equal(t, "Talks, pizza", meetup.Input.Description)
+	assert.Equal(t, "Europe/Moscow", meetup.Input.Timezone)
+	assert.Equal(t, time.Date(2030, 3, 19, 16, 0, 0, 0, time.UTC), meetup.Input.EventDate.UTC())
+	require.NotNil(t, meetup.Input.EndDate)
+	assert.Equal(t, 2*time.Hour, meetup.Input.EndDate.Sub(meetup.Input.EventDate))
+
+	workshop := rows[1]
+	require.NoError(t, workshop.Err)
+	assert.Equal(t, 26*time.Hour, workshop.Input.Duration)
+
+	broken := rows[2]
+	assert.Equal(t, 4, broken.Row)
+	assert.ErrorIs(t, broken.Err, domain.ErrValidation)
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{"PT90M", 90 * time.Minute, true},
+		{"PT1H30M", 90 * time.Minute, true},
+		{"P1W", 7 * 24 * time.Hour, true},
+		{"P1DT12H", 36 * time.Hour, true},
+		{"PT0M", 0, false},
+		{"PT", 0, false},
+		{"-PT1H", 0, false},
+		{"1H", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseDuration(tt.value)
+			if !tt.ok {
+				assert.ErrorIs(t, err, domain.ErrValidation)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
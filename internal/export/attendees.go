@@ -0,0 +1,181 @@
+// Package export пишет списки участников в CSV и XLSX построчно, по мере чтения из БД.
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	ContentTypeCSV  = "text/csv; charset=utf-8"
+	ContentTypeXLSX = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+	// csvFlushEvery — раз в сколько строк CSV уходит клиенту, чтобы загрузка шла сразу.
+	csvFlushEvery = 100
+	sheetName     = "Attendees"
+	xlsxTimeFmt   = "dd.mm.yyyy hh:mm"
+	// xlsxTextFmt — встроенный формат «Текст»: значение ячейки не разбирается как формула.
+	xlsxTextFmt = 49
+)
+
+var attendeeColumns = []string{"booking_id", "user_id", "username", "status", "booked_at", "confirmed_at"}
+
+// AttendeeWriter пишет участников по одному. Close дописывает файл; без него выгрузка неполная.
+type AttendeeWriter interface {
+	Write(a *domain.Attendee) error
+	Close() error
+}
+
+// CSVWriter — CSV с заголовком; время в RFC3339 в часовом поясе мероприятия.
+type CSVWriter struct {
+	w    *csv.Writer
+	loc  *time.Location
+	rows int
+}
+
+func NewCSVWriter(w io.Writer, loc *time.Location) (*CSVWriter, error) {
+	cw := &CSVWriter{w: csv.NewWriter(w), loc: loc}
+	if err := cw.w.Write(attendeeColumns); err != nil {
+		return nil, fmt.Errorf("write header: %w", err)
+	}
+	return cw, nil
+}
+
+// csvFormulaPrefixes — символы, с которых табличные редакторы начинают формулу.
+const csvFormulaPrefixes = "=+-@\t\r"
+
+// escapeCSVFormula экранирует значение, которое редактор при открытии CSV выполнил бы
+// как формулу: апостроф в начале заставляет прочитать его как текст.
+func escapeCSVFormula(v string) string {
+	if v != "" && strings.ContainsRune(csvFormulaPrefixes, rune(v[0])) {
+		return "'" + v
+	}
+	return v
+}
+
+func (cw *CSVWriter) Write(a *domain.Attendee) error {
+	confirmedAt := ""
+	if a.ConfirmedAt != nil {
+		confirmedAt = a.ConfirmedAt.In(cw.loc).Format(time.RFC3339)
+	}
+
+	err := cw.w.Write([]string{
+		a.BookingID, a.UserID, escapeCSVFormula(a.Username), string(a.Status),
+		a.BookedAt.In(cw.loc).Format(time.RFC3339), confirmedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	cw.rows++
+	if cw.rows%csvFlushEvery == 0 {
+		cw.w.Flush()
+		return cw.w.Error()
+	}
+	return nil
+}
+
+func (cw *CSVWriter) Close() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+// XLSXWriter пишет лист через потоковый writer excelize: строки уходят во временный файл,
+// а не копятся в памяти. Книга целиком отдаётся в Close — формат zip не позволяет раньше.
+type XLSXWriter struct {
+	w         io.Writer
+	file      *excelize.File
+	sheet     *excelize.StreamWriter
+	loc       *time.Location
+	timeStyle int
+	textStyle int
+	row       int
+}
+
+func NewXLSXWriter(w io.Writer, loc *time.Location) (*XLSXWriter, error) {
+	f := excelize.NewFile()
+	if err := f.SetSheetName("Sheet1", sheetName); err != nil {
+		return nil, fmt.Errorf("rename sheet: %w", err)
+	}
+
+	timeFmt := xlsxTimeFmt
+	timeStyle, err := f.NewStyle(&excelize.Style{CustomNumFmt: &timeFmt})
+	if err != nil {
+		return nil, fmt.Errorf("create time style: %w", err)
+	}
+	textStyle, err := f.NewStyle(&excelize.Style{NumFmt: xlsxTextFmt})
+	if err != nil {
+		return nil, fmt.Errorf("create text style: %w", err)
+	}
+	headerStyle, err := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	if err != nil {
+		return nil, fmt.Errorf("create header style: %w", err)
+	}
+
+	sheet, err := f.NewStreamWriter(sheetName)
+	if err != nil {
+		return nil, fmt.Errorf("create stream writer: %w", err)
+	}
+	if err = sheet.SetColWidth(1, 2, 38); err != nil {
+		return nil, err
+	}
+	if err = sheet.SetColWidth(3, len(attendeeColumns), 18); err != nil {
+		return nil, err
+	}
+	// Заголовок закреплён, чтобы не терялся при прокрутке длинного списка.
+	if err = sheet.SetPanes(&excelize.Panes{
+		Freeze: true, YSplit: 1, TopLeftCell: "A2", ActivePane: "bottomLeft",
+	}); err != nil {
+		return nil, err
+	}
+
+	header := make([]any, len(attendeeColumns))
+	for i, name := range attendeeColumns {
+		header[i] = excelize.Cell{StyleID: headerStyle, Value: name}
+	}
+	if err = sheet.SetRow("A1", header); err != nil {
+		return nil, fmt.Errorf("write header: %w", err)
+	}
+
+	return &XLSXWriter{w: w, file: f, sheet: sheet, loc: loc, timeStyle: timeStyle, textStyle: textStyle, row: 1}, nil
+}
+
+func (xw *XLSXWriter) Write(a *domain.Attendee) error {
+	xw.row++
+
+	// Время пишется как дата Excel в поясе мероприятия: так по столбцу работают сортировка и фильтры.
+	var confirmedAt any
+	if a.ConfirmedAt != nil {
+		confirmedAt = excelize.Cell{StyleID: xw.timeStyle, Value: a.ConfirmedAt.In(xw.loc)}
+	}
+
+	cell, err := excelize.CoordinatesToCellName(1, xw.row)
+	if err != nil {
+		return err
+	}
+	// Имя вводит пользователь: строковая ячейка в формате «Текст» не станет формулой
+	// ни при открытии, ни при правке.
+	return xw.sheet.SetRow(cell, []any{
+		a.BookingID, a.UserID, excelize.Cell{StyleID: xw.textStyle, Value: a.Username}, string(a.Status),
+		excelize.Cell{StyleID: xw.timeStyle, Value: a.BookedAt.In(xw.loc)},
+		confirmedAt,
+	})
+}
+
+func (xw *XLSXWriter) Close() error {
+	defer xw.file.Close()
+
+	if err := xw.sheet.Flush(); err != nil {
+		return fmt.Errorf("flush sheet: %w", err)
+	}
+	if err := xw.file.Write(xw.w); err != nil {
+		return fmt.Errorf("write workbook: %w", err)
+	}
+	return nil
+}
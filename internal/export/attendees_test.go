@@ -0,0 +1,115 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func testAttendees() []*domain.Attendee {
+	booked := time.Date(2030, 5, 1, 9, 0, 0, 0, time.UTC)
+	confirmed := booked.Add(10 * time.Minute)
+	return []*domain.Attendee{
+		{BookingID: "b1", UserID: "u1", Username: "alice", Status: domain.BookingStatusConfirmed,
+			BookedAt: booked, ConfirmedAt: &confirmed},
+		{BookingID: "b2", UserID: "u2", Username: "bob, jr", Status: domain.BookingStatusPending, BookedAt: booked},
+	}
+}
+
+func TestCSVWriter(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	w, err := NewCSVWriter(&buf, loc)
+	require.NoError(t, err)
+	for _, a := range testAttendees() {
+		require.NoError(t, w.Write(a))
+	}
+	require.NoError(t, w.Close())
+
+	assert.Equal(t,
+		"booking_id,user_id,username,status,booked_at,confirmed_at\n"+
+			"b1,u1,alice,confirmed,2030-05-01T12:00:00+03:00,2030-05-01T12:10:00+03:00\n"+
+			"b2,u2,\"bob, jr\",pending,2030-05-01T12:00:00+03:00,\n",
+		buf.String())
+}
+
+func TestXLSXWriter(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	w, err := NewXLSXWriter(&buf, loc)
+	require.NoError(t, err)
+	for _, a := range testAttendees() {
+		require.NoError(t, w.Write(a))
+	}
+	require.NoError(t, w.Close())
+
+	f, err := excelize.OpenReader(&buf)
+	require.NoError(t, err)
+	defer f.Close()
+
+	rows, err := f.GetRows(sheetName)
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	assert.Equal(t, attendeeColumns, rows[0])
+	assert.Equal(t, []string{"b1", "u1", "alice", "confirmed", "01.05.2030 12:00", "01.05.2030 12:10"}, rows[1])
+	assert.Equal(t, []string{"b2", "u2", "bob, jr", "pending", "01.05.2030 12:00"}, rows[2])
+}
+
+func formulaAttendee() *domain.Attendee {
+	return &domain.Attendee{
+		BookingID: "b1", UserID: "u1", Username: `=HYPERLINK("http://evil.example","click")`,
+		Status: domain.BookingStatusConfirmed, BookedAt: time.Date(2030, 5, 1, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestCSVWriter_EscapesFormulas(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewCSVWriter(&buf, time.UTC)
+	require.NoError(t, err)
+	require.NoError(t, w.Write(formulaAttendee()))
+	for _, name := range []string{"+1", "-1", "@SUM(A1)", "a=b"} {
+		require.NoError(t, w.Write(&domain.Attendee{Username: name}))
+	}
+	require.NoError(t, w.Close())
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 6)
+	assert.Equal(t, `'=HYPERLINK("http://evil.example","click")`, rows[1][2])
+	assert.Equal(t, "'+1", rows[2][2])
+	assert.Equal(t, "'-1", rows[3][2])
+	assert.Equal(t, "'@SUM(A1)", rows[4][2])
+	assert.Equal(t, "a=b", rows[5][2])
+}
+
+func TestXLSXWriter_UsernameIsText(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewXLSXWriter(&buf, time.UTC)
+	require.NoError(t, err)
+	require.NoError(t, w.Write(formulaAttendee()))
+	require.NoError(t, w.Close())
+
+	f, err := excelize.OpenReader(&buf)
+	require.NoError(t, err)
+	defer f.Close()
+
+	formula, err := f.GetCellFormula(sheetName, "C2")
+	require.NoError(t, err)
+	assert.Empty(t, formula)
+	value, err := f.GetCellValue(sheetName, "C2")
+	require.NoError(t, err)
+	assert.Equal(t, formulaAttendee().Username, value)
+	cellType, err := f.GetCellType(sheetName, "C2")
+	require.NoError(t, err)
+	assert.Equal(t, excelize.CellTypeInlineString, cellType)
+}
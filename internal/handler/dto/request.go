@@ -1,16 +1,120 @@
 package dto
 
+// CreateEventRequest: total_spots можно не указывать, если задан venue_id, — тогда берётся вместимость площадки.
+// Окончание задаётся либо end_date (RFC3339), либо duration_minutes.
 type CreateEventRequest struct {
-	Title           string `json:"title" binding:"required"`
-	Description     string `json:"description" binding:"required"`
-	EventDate       string `json:"event_date" binding:"required"`
-	TotalSpots      int    `json:"total_spots" binding:"required,gt=0"`
-	BookingTTL      int    `json:"booking_ttl_minutes"`
-	RequiresPayment *bool  `json:"requires_payment"`
+	Title           string   `json:"title" binding:"required"`
+	Description     string   `json:"description" binding:"required"`
+	EventDate       string   `json:"event_date" binding:"required"`
+	EndDate         *string  `json:"end_date"`
+	Timezone        string   `json:"timezone"`
+	SalesOpenAt     *string  `json:"sales_open_at"`
+	SalesCloseAt    *string  `json:"sales_close_at"`
+	TotalSpots      int      `json:"total_spots" binding:"omitempty,gt=0"`
+	BookingTTL      int      `json:"booking_ttl_minutes"`
+	DurationMinutes int      `json:"duration_minutes" binding:"gte=0"`
+	VenueID         *string  `json:"venue_id" binding:"omitempty,uuid"`
+	RequiresPayment *bool    `json:"requires_payment"`
+	CategoryIDs     []string `json:"category_ids"`
+	Tags            []string `json:"tags"`
+	// Без publish мероприятие создаётся черновиком; publish_at (RFC3339) планирует публикацию.
+	Publish   bool    `json:"publish"`
+	PublishAt *string `json:"publish_at"`
+	// Закрытое (private) мероприятие бронируется по access_code или по приглашению.
+	Visibility string `json:"visibility" binding:"omitempty,oneof=public private"`
+	AccessCode string `json:"access_code"`
+}
+
+// ImportEventsQuery — параметры импорта. Формат без format определяется по имени файла
+// или Content-Type; venue_id и total_spots подставляются в строки, где они пусты.
+type ImportEventsQuery struct {
+	Format     string  `form:"format" binding:"omitempty,oneof=csv ics"`
+	DryRun     bool    `form:"dry_run"`
+	VenueID    *string `form:"venue_id" binding:"omitempty,uuid"`
+	TotalSpots int     `form:"total_spots" binding:"gte=0"`
+	Publish    bool    `form:"publish"`
+}
+
+// SearchEventsQuery — поиск мероприятий: q — слова запроса, from и to (RFC3339) ограничивают
+// дату начала, available=true оставляет мероприятия со свободными местами.
+type SearchEventsQuery struct {
+	Query     string `form:"q" binding:"required,max=200"`
+	From      string `form:"from"`
+	To        string `form:"to"`
+	Available bool   `form:"available"`
+	Limit     int    `form:"limit" binding:"gte=0,lte=100"`
+	Offset    int    `form:"offset" binding:"gte=0"`
+}
+
+// AdminStatsQuery — период отчёта: from и to — дни YYYY-MM-DD включительно, по умолчанию последние 30 дней;
+// tz — часовой пояс, в котором режутся дни; top — сколько мероприятий в рейтинге.
+type AdminStatsQuery struct {
+	From     string `form:"from"`
+	To       string `form:"to"`
+	Timezone string `form:"tz"`
+	Top      int    `form:"top" binding:"gte=0,lte=100"`
+}
+
+// UpdateEventRequest — частичное изменение мероприятия, отсутствующие поля не меняются.
+// Пустая строка в sales_open_at/sales_close_at снимает границу окна продаж.
+type UpdateEventRequest struct {
+	Title           *string `json:"title"`
+	Description     *string `json:"description"`
+	EventDate       *string `json:"event_date"`
+	EndDate         *string `json:"end_date"`
+	Timezone        *string `json:"timezone"`
+	SalesOpenAt     *string `json:"sales_open_at"`
+	SalesCloseAt    *string `json:"sales_close_at"`
+	TotalSpots      *int    `json:"total_spots" binding:"omitempty,gt=0"`
+	BookingTTL      *int    `json:"booking_ttl_minutes" binding:"omitempty,gt=0"`
+	DurationMinutes *int    `json:"duration_minutes" binding:"omitempty,gt=0"`
+	// VenueID: пустая строка отвязывает мероприятие от площадки.
+	VenueID         *string `json:"venue_id" binding:"omitempty,uuid|eq="`
+	RequiresPayment *bool   `json:"requires_payment"`
+	// CategoryIDs и Tags заменяют весь набор; пустой массив снимает все рубрики или теги.
+	CategoryIDs *[]string `json:"category_ids"`
+	Tags        *[]string `json:"tags"`
+	// PublishAt — только для черновика; пустая строка отменяет публикацию по расписанию.
+	PublishAt *string `json:"publish_at"`
+	// Пустой access_code оставляет закрытое мероприятие только для приглашённых.
+	Visibility *string `json:"visibility" binding:"omitempty,oneof=public private"`
+	AccessCode *string `json:"access_code"`
+}
+
+// RecurrenceRequest — правило повторения: by_weekday — дни RRULE (MO, TU, ...), until — RFC3339.
+type RecurrenceRequest struct {
+	Freq       string   `json:"freq" binding:"required,oneof=daily weekly monthly"`
+	Interval   int      `json:"interval" binding:"gte=0"`
+	ByWeekday  []string `json:"by_weekday"`
+	ByMonthDay []int    `json:"by_month_day"`
+	Count      int      `json:"count" binding:"gte=0"`
+	Until      *string  `json:"until"`
+}
+
+type CreateSeriesRequest struct {
+	Title           string            `json:"title" binding:"required"`
+	Description     string            `json:"description"`
+	Start           string            `json:"start" binding:"required"`
+	Timezone        string            `json:"timezone"`
+	TotalSpots      int               `json:"total_spots" binding:"required,gt=0"`
+	BookingTTL      int               `json:"booking_ttl_minutes"`
+	RequiresPayment *bool             `json:"requires_payment"`
+	Recurrence      RecurrenceRequest `json:"recurrence" binding:"required"`
+	Exceptions      []string          `json:"exceptions"`
+}
+
+type UpdateSeriesRequest struct {
+	Title           *string `json:"title"`
+	Description     *string `json:"description"`
+	TotalSpots      *int    `json:"total_spots" binding:"omitempty,gt=0"`
+	BookingTTL      *int    `json:"booking_ttl_minutes" binding:"omitempty,gt=0"`
+	RequiresPayment *bool   `json:"requires_payment"`
 }
 
 type BookRequest struct {
 	UserID string `json:"user_id" binding:"required,uuid"`
+	// AccessCode нужен для закрытого мероприятия, если пользователя на него не пригласили.
+	AccessCode string `json:"access_code"`
 }
 
 type ConfirmRequest struct {
@@ -18,6 +122,73 @@ type ConfirmRequest struct {
 }
 
 type CreateUserRequest struct {
-	Username       string `json:"username" binding:"required"`
-	TelegramChatID *int64 `json:"telegram_chat_id"`
+	Username       string  `json:"username" binding:"required"`
+	TelegramChatID *int64  `json:"telegram_chat_id"`
+	Email          *string `json:"email" binding:"omitempty,email"`
+	WebhookURL     *string `json:"webhook_url" binding:"omitempty,url"`
+	Locale         string  `json:"locale"`
+	Timezone       string  `json:"timezone"`
+}
+
+// NotificationPreferencesRequest — частичное обновление подписок: channel -> type -> enabled.
+type NotificationPreferencesRequest struct {
+	Preferences map[string]map[string]bool `json:"preferences" binding:"required"`
+}
+
+type CreateWebhookRequest struct {
+	URL        string   `json:"url" binding:"required,url"`
+	Secret     string   `json:"secret"`
+	EventTypes []string `json:"event_types" binding:"required,min=1"`
+}
+
+// UpdateWebhookRequest — частичное обновление подписки, отсутствующие поля не меняются.
+type UpdateWebhookRequest struct {
+	URL        *string  `json:"url" binding:"omitempty,url"`
+	EventTypes []string `json:"event_types" binding:"omitempty,min=1"`
+	Active     *bool    `json:"active"`
+}
+
+type CreateVenueRequest struct {
+	Name      string   `json:"name" binding:"required"`
+	Address   string   `json:"address" binding:"required"`
+	Latitude  *float64 `json:"latitude" binding:"omitempty,gte=-90,lte=90"`
+	Longitude *float64 `json:"longitude" binding:"omitempty,gte=-180,lte=180"`
+	Capacity  int      `json:"capacity" binding:"required,gt=0"`
+	Timezone  string   `json:"timezone"`
+}
+
+// UpdateVenueRequest — частичное изменение площадки, отсутствующие поля не меняются.
+type UpdateVenueRequest struct {
+	Name      *string  `json:"name"`
+	Address   *string  `json:"address"`
+	Latitude  *float64 `json:"latitude" binding:"omitempty,gte=-90,lte=90"`
+	Longitude *float64 `json:"longitude" binding:"omitempty,gte=-180,lte=180"`
+	Capacity  *int     `json:"capacity" binding:"omitempty,gt=0"`
+	Timezone  *string  `json:"timezone"`
+}
+
+type CreateCategoryRequest struct {
+	Slug        string `json:"slug" binding:"required"`
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+}
+
+// UpdateCategoryRequest — частичное изменение рубрики, отсутствующие поля не меняются.
+type UpdateCategoryRequest struct {
+	Slug        *string `json:"slug"`
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+}
+
+type InviteRequest struct {
+	UserIDs []string `json:"user_ids" binding:"required,min=1,dive,uuid"`
+}
+
+// BookingLimitOverrideRequest — индивидуальные лимиты пользователя. Отсутствующий лимит
+// означает общее правило, 0 — без ограничения; exempt снимает все ограничения.
+type BookingLimitOverrideRequest struct {
+	MaxPending *int `json:"max_pending" binding:"omitempty,gte=0"`
+	MaxPerDay  *int `json:"max_per_day" binding:"omitempty,gte=0"`
+	MaxExpired *int `json:"max_expired" binding:"omitempty,gte=0"`
+	Exempt     bool `json:"exempt"`
 }
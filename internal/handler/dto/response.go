@@ -1,20 +1,43 @@
 package dto
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/stpnv0/EventBooker/internal/domain"
 )
 
 type EventResponse struct {
-	ID              string `json:"id"`
-	Title           string `json:"title"`
-	Description     string `json:"description"`
-	EventDate       string `json:"event_date"`
-	TotalSpots      int    `json:"total_spots"`
-	BookingTTL      string `json:"booking_ttl"`
-	RequiresPayment bool   `json:"requires_payment"`
-	CreatedAt       string `json:"created_at"`
+	ID              string   `json:"id"`
+	Title           string   `json:"title"`
+	Description     string   `json:"description"`
+	EventDate       string   `json:"event_date"`
+	EndDate         string   `json:"end_date"`
+	Timezone        string   `json:"timezone"`
+	SalesOpenAt     string   `json:"sales_open_at,omitempty"`
+	SalesCloseAt    string   `json:"sales_close_at,omitempty"`
+	TotalSpots      int      `json:"total_spots"`
+	BookingTTL      string   `json:"booking_ttl"`
+	RequiresPayment bool     `json:"requires_payment"`
+	Duration        string   `json:"duration"`
+	VenueID         string   `json:"venue_id,omitempty"`
+	SeriesID        string   `json:"series_id,omitempty"`
+	Detached        bool     `json:"detached,omitempty"`
+	CategoryIDs     []string `json:"category_ids"`
+	Tags            []string `json:"tags"`
+	Status          string   `json:"status"`
+	PublishAt       string   `json:"publish_at,omitempty"`
+	PublishedAt     string   `json:"published_at,omitempty"`
+	Visibility      string   `json:"visibility"`
+	HasAccessCode   bool     `json:"has_access_code,omitempty"`
+	CancelledAt     string   `json:"cancelled_at,omitempty"`
+	CreatedAt       string   `json:"created_at"`
+}
+
+type EventSearchResponse struct {
+	Event          EventResponse `json:"event"`
+	AvailableSpots int           `json:"available_spots"`
+	Rank           float64       `json:"rank"`
 }
 
 type EventDetailsResponse struct {
@@ -31,28 +54,238 @@ type BookingResponse struct {
 	CreatedAt string `json:"created_at"`
 }
 
+type BookingHistoryResponse struct {
+	FromStatus *string `json:"from_status"`
+	ToStatus   string  `json:"to_status"`
+	Actor      string  `json:"actor"`
+	ActorID    *string `json:"actor_id,omitempty"`
+	Reason     string  `json:"reason"`
+	RequestID  *string `json:"request_id,omitempty"`
+	CreatedAt  string  `json:"created_at"`
+}
+
 type UserResponse struct {
-	ID             string `json:"id"`
-	Username       string `json:"username"`
-	TelegramChatID *int64 `json:"telegram_chat_id,omitempty"`
-	CreatedAt      string `json:"created_at"`
+	ID             string  `json:"id"`
+	Username       string  `json:"username"`
+	TelegramChatID *int64  `json:"telegram_chat_id,omitempty"`
+	Email          *string `json:"email,omitempty"`
+	WebhookURL     *string `json:"webhook_url,omitempty"`
+	Locale         string  `json:"locale"`
+	Timezone       string  `json:"timezone"`
+	CreatedAt      string  `json:"created_at"`
+}
+
+type NotificationPreferencesResponse struct {
+	UserID      string                     `json:"user_id"`
+	Preferences map[string]map[string]bool `json:"preferences"`
+}
+
+type TelegramLinkResponse struct {
+	Token     string `json:"token"`
+	URL       string `json:"url"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+// CalendarFeedResponse — ссылка на подписку; url — путь относительно адреса API.
+type CalendarFeedResponse struct {
+	Token string `json:"token"`
+	URL   string `json:"url"`
+}
+
+// WebhookResponse — подписка на вебхуки. Secret возвращается только при создании.
+type WebhookResponse struct {
+	ID         string   `json:"id"`
+	URL        string   `json:"url"`
+	Secret     string   `json:"secret,omitempty"`
+	EventTypes []string `json:"event_types"`
+	Active     bool     `json:"active"`
+	CreatedAt  string   `json:"created_at"`
+	UpdatedAt  string   `json:"updated_at"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID             string                           `json:"id"`
+	SubscriptionID string                           `json:"subscription_id"`
+	EventType      string                           `json:"event_type"`
+	Payload        json.RawMessage                  `json:"payload"`
+	Status         string                           `json:"status"`
+	Attempts       int                              `json:"attempts"`
+	NextAttemptAt  string                           `json:"next_attempt_at"`
+	ResponseStatus *int                             `json:"response_status,omitempty"`
+	LastError      *string                          `json:"last_error,omitempty"`
+	CreatedAt      string                           `json:"created_at"`
+	DeliveredAt    *string                          `json:"delivered_at,omitempty"`
+	AttemptLog     []WebhookDeliveryAttemptResponse `json:"attempt_log,omitempty"`
+}
+
+type WebhookDeliveryAttemptResponse struct {
+	Attempt        int     `json:"attempt"`
+	ResponseStatus *int    `json:"response_status,omitempty"`
+	Error          *string `json:"error,omitempty"`
+	DurationMs     int64   `json:"duration_ms"`
+	CreatedAt      string  `json:"created_at"`
+}
+
+type RecurrenceResponse struct {
+	Freq       string   `json:"freq"`
+	Interval   int      `json:"interval"`
+	ByWeekday  []string `json:"by_weekday,omitempty"`
+	ByMonthDay []int    `json:"by_month_day,omitempty"`
+	Count      int      `json:"count,omitempty"`
+	Until      string   `json:"until,omitempty"`
+}
+
+type SeriesResponse struct {
+	ID                string             `json:"id"`
+	Title             string             `json:"title"`
+	Description       string             `json:"description"`
+	Start             string             `json:"start"`
+	Timezone          string             `json:"timezone"`
+	TotalSpots        int                `json:"total_spots"`
+	BookingTTL        string             `json:"booking_ttl"`
+	RequiresPayment   bool               `json:"requires_payment"`
+	Recurrence        RecurrenceResponse `json:"recurrence"`
+	Exceptions        []string           `json:"exceptions"`
+	MaterializedUntil string             `json:"materialized_until,omitempty"`
+	CancelledAt       string             `json:"cancelled_at,omitempty"`
+	CreatedAt         string             `json:"created_at"`
+}
+
+type SeriesDetailsResponse struct {
+	SeriesResponse
+	Occurrences []EventResponse `json:"occurrences"`
+}
+
+type VenueResponse struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Address   string   `json:"address"`
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+	Capacity  int      `json:"capacity"`
+	Timezone  string   `json:"timezone"`
+	CreatedAt string   `json:"created_at"`
+	UpdatedAt string   `json:"updated_at"`
+}
+
+type CategoryResponse struct {
+	ID          string `json:"id"`
+	Slug        string `json:"slug"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// ImportResponse — отчёт об импорте. Events — созданные мероприятия
+// (при dry_run — те, что были бы созданы); при ошибках он пуст.
+type ImportResponse struct {
+	DryRun bool                  `json:"dry_run"`
+	Total  int                   `json:"total"`
+	Events []EventResponse       `json:"events"`
+	Errors []ImportErrorResponse `json:"errors"`
+}
+
+type ImportErrorResponse struct {
+	Row   int    `json:"row"`
+	Title string `json:"title,omitempty"`
+	Error string `json:"error"`
+}
+
+// AdminStatsResponse — сводка за период; to включительно, даты — в поясе timezone.
+type AdminStatsResponse struct {
+	From       string                   `json:"from"`
+	To         string                   `json:"to"`
+	Timezone   string                   `json:"timezone"`
+	Daily      []DailyBookingsResponse  `json:"daily"`
+	Conversion ConversionResponse       `json:"conversion"`
+	Occupancy  []EventOccupancyResponse `json:"occupancy"`
+	TopEvents  []EventOccupancyResponse `json:"top_events"`
+}
+
+type DailyBookingsResponse struct {
+	Date      string `json:"date"`
+	Created   int    `json:"created"`
+	Confirmed int    `json:"confirmed"`
+	Expired   int    `json:"expired"`
+}
+
+type ConversionResponse struct {
+	Resolved                int     `json:"resolved"`
+	Confirmed               int     `json:"confirmed"`
+	Rate                    float64 `json:"rate"`
+	AvgTimeToConfirmSeconds float64 `json:"avg_time_to_confirm_seconds"`
+}
+
+type EventOccupancyResponse struct {
+	EventID    string  `json:"event_id"`
+	Title      string  `json:"title"`
+	EventDate  string  `json:"event_date"`
+	TotalSpots int     `json:"total_spots"`
+	Confirmed  int     `json:"confirmed"`
+	Pending    int     `json:"pending"`
+	FillRate   float64 `json:"fill_rate"`
 }
 
 type ErrorResponse struct {
 	Error string `json:"error"`
 }
 
+// ToEventResponse отдаёт начало и окончание в местном времени мероприятия (RFC3339 со смещением пояса).
+func ToEventSearchResponse(r *domain.EventSearchResult) EventSearchResponse {
+	return EventSearchResponse{
+		Event:          ToEventResponse(&r.Event),
+		AvailableSpots: r.AvailableSpots,
+		Rank:           r.Rank,
+	}
+}
+
 func ToEventResponse(e *domain.Event) EventResponse {
-	return EventResponse{
+	loc := e.Location()
+	resp := EventResponse{
 		ID:              e.ID,
 		Title:           e.Title,
 		Description:     e.Description,
-		EventDate:       e.EventDate.Format(time.RFC3339),
+		EventDate:       e.EventDate.In(loc).Format(time.RFC3339),
+		EndDate:         e.EndDate().In(loc).Format(time.RFC3339),
+		Timezone:        loc.String(),
 		TotalSpots:      e.TotalSpots,
 		RequiresPayment: e.RequiresPayment,
 		BookingTTL:      e.BookingTTL.String(),
-		CreatedAt:       e.CreatedAt.Format(time.RFC3339),
+		Duration:        e.Duration.String(),
+		Detached:        e.Detached,
+		// Пустые списки отдаются как [], а не null.
+		CategoryIDs: append([]string{}, e.CategoryIDs...),
+		Tags:        append([]string{}, e.Tags...),
+		Status:      string(e.Status),
+		// Сам код не отдаётся: детали мероприятия может запросить любой, кто знает id.
+		Visibility:    string(e.Visibility),
+		HasAccessCode: e.AccessCode != "",
+		CreatedAt:     e.CreatedAt.Format(time.RFC3339),
 	}
+	if e.VenueID != nil {
+		resp.VenueID = *e.VenueID
+	}
+	if e.SalesOpenAt != nil {
+		resp.SalesOpenAt = e.SalesOpenAt.In(loc).Format(time.RFC3339)
+	}
+	if e.SalesCloseAt != nil {
+		resp.SalesCloseAt = e.SalesCloseAt.In(loc).Format(time.RFC3339)
+	}
+	if e.SeriesID != nil {
+		resp.SeriesID = *e.SeriesID
+	}
+	if e.PublishAt != nil {
+		resp.PublishAt = e.PublishAt.In(loc).Format(time.RFC3339)
+	}
+	if e.PublishedAt != nil {
+		resp.PublishedAt = e.PublishedAt.Format(time.RFC3339)
+	}
+	if e.CancelledAt != nil {
+		resp.CancelledAt = e.CancelledAt.Format(time.RFC3339)
+	}
+
+	return resp
 }
 
 func ToEventDetailsResponse(d *domain.EventDetails) EventDetailsResponse {
@@ -78,11 +311,323 @@ func ToBookingResponse(b *domain.Booking) BookingResponse {
 	}
 }
 
+func ToBookingHistoryResponse(e *domain.BookingHistoryEntry) BookingHistoryResponse {
+	resp := BookingHistoryResponse{
+		ToStatus:  string(e.ToStatus),
+		Actor:     string(e.Actor),
+		ActorID:   e.ActorID,
+		Reason:    string(e.Reason),
+		RequestID: e.RequestID,
+		CreatedAt: e.CreatedAt.Format(time.RFC3339),
+	}
+	if e.FromStatus != nil {
+		from := string(*e.FromStatus)
+		resp.FromStatus = &from
+	}
+	return resp
+}
+
 func ToUserResponse(u *domain.User) UserResponse {
 	return UserResponse{
 		ID:             u.ID,
 		Username:       u.Username,
 		TelegramChatID: u.TelegramChatID,
+		Email:          u.Email,
+		WebhookURL:     u.WebhookURL,
+		Locale:         u.Locale,
+		Timezone:       u.Timezone,
 		CreatedAt:      u.CreatedAt.Format(time.RFC3339),
 	}
 }
+
+func ToNotificationPreferencesResponse(p *domain.NotificationPreferences) NotificationPreferencesResponse {
+	prefs := make(map[string]map[string]bool, len(p.Settings))
+	for ch, kinds := range p.Settings {
+		m := make(map[string]bool, len(kinds))
+		for kind, enabled := range kinds {
+			m[string(kind)] = enabled
+		}
+		prefs[string(ch)] = m
+	}
+
+	return NotificationPreferencesResponse{
+		UserID:      p.UserID,
+		Preferences: prefs,
+	}
+}
+
+func ToTelegramLinkResponse(l *domain.TelegramLink) TelegramLinkResponse {
+	return TelegramLinkResponse{
+		Token:     l.Token,
+		URL:       l.URL,
+		ExpiresAt: l.ExpiresAt.Format(time.RFC3339),
+	}
+}
+
+func ToCalendarFeedResponse(f *domain.CalendarFeed) CalendarFeedResponse {
+	return CalendarFeedResponse{Token: f.Token, URL: f.Path}
+}
+
+func ToWebhookResponse(s *domain.WebhookSubscription) WebhookResponse {
+	types := make([]string, len(s.EventTypes))
+	for i, t := range s.EventTypes {
+		types[i] = string(t)
+	}
+
+	return WebhookResponse{
+		ID:         s.ID,
+		URL:        s.URL,
+		EventTypes: types,
+		Active:     s.Active,
+		CreatedAt:  s.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  s.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToWebhookDeliveryResponse(d *domain.WebhookDelivery) WebhookDeliveryResponse {
+	resp := WebhookDeliveryResponse{
+		ID:             d.ID,
+		SubscriptionID: d.SubscriptionID,
+		EventType:      string(d.EventType),
+		Payload:        d.Payload,
+		Status:         string(d.Status),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt.Format(time.RFC3339),
+		ResponseStatus: d.ResponseStatus,
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt.Format(time.RFC3339),
+	}
+	if d.DeliveredAt != nil {
+		deliveredAt := d.DeliveredAt.Format(time.RFC3339)
+		resp.DeliveredAt = &deliveredAt
+	}
+	for _, a := range d.AttemptLog {
+		resp.AttemptLog = append(resp.AttemptLog, WebhookDeliveryAttemptResponse{
+			Attempt:        a.Attempt,
+			ResponseStatus: a.ResponseStatus,
+			Error:          a.Error,
+			DurationMs:     a.Duration.Milliseconds(),
+			CreatedAt:      a.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return resp
+}
+
+// RRuleWeekdays — коды дней недели RRULE, индексируются time.Weekday.
+var RRuleWeekdays = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+func ToSeriesResponse(s *domain.EventSeries) SeriesResponse {
+	rec := RecurrenceResponse{
+		Freq:       string(s.Recurrence.Freq),
+		Interval:   s.Recurrence.Interval,
+		ByMonthDay: s.Recurrence.ByMonthDay,
+		Count:      s.Recurrence.Count,
+	}
+	for _, d := range s.Recurrence.ByWeekday {
+		rec.ByWeekday = append(rec.ByWeekday, RRuleWeekdays[d])
+	}
+	if s.Recurrence.Until != nil {
+		rec.Until = s.Recurrence.Until.Format(time.RFC3339)
+	}
+
+	resp := SeriesResponse{
+		ID:              s.ID,
+		Title:           s.Title,
+		Description:     s.Description,
+		Start:           s.Start.Format(time.RFC3339),
+		Timezone:        s.Timezone,
+		TotalSpots:      s.TotalSpots,
+		BookingTTL:      s.BookingTTL.String(),
+		RequiresPayment: s.RequiresPayment,
+		Recurrence:      rec,
+		Exceptions:      make([]string, 0, len(s.Exceptions)),
+		CreatedAt:       s.CreatedAt.Format(time.RFC3339),
+	}
+	for _, t := range s.Exceptions {
+		resp.Exceptions = append(resp.Exceptions, t.Format(time.RFC3339))
+	}
+	if s.MaterializedUntil != nil {
+		resp.MaterializedUntil = s.MaterializedUntil.Format(time.RFC3339)
+	}
+	if s.CancelledAt != nil {
+		resp.CancelledAt = s.CancelledAt.Format(time.RFC3339)
+	}
+
+	return resp
+}
+
+func ToSeriesDetailsResponse(d *domain.SeriesDetails) SeriesDetailsResponse {
+	occurrences := make([]EventResponse, 0, len(d.Occurrences))
+	for _, e := range d.Occurrences {
+		occurrences = append(occurrences, ToEventResponse(e))
+	}
+
+	return SeriesDetailsResponse{
+		SeriesResponse: ToSeriesResponse(&d.Series),
+		Occurrences:    occurrences,
+	}
+}
+
+func ToVenueResponse(v *domain.Venue) VenueResponse {
+	return VenueResponse{
+		ID:        v.ID,
+		Name:      v.Name,
+		Address:   v.Address,
+		Latitude:  v.Latitude,
+		Longitude: v.Longitude,
+		Capacity:  v.Capacity,
+		Timezone:  v.Timezone,
+		CreatedAt: v.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: v.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToCategoryResponse(c *domain.Category) CategoryResponse {
+	return CategoryResponse{
+		ID:          c.ID,
+		Slug:        c.Slug,
+		Name:        c.Name,
+		Description: c.Description,
+		CreatedAt:   c.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   c.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToImportResponse(r *domain.ImportResult) ImportResponse {
+	resp := ImportResponse{
+		DryRun: r.DryRun,
+		Total:  r.Total,
+		Events: make([]EventResponse, 0, len(r.Events)),
+		Errors: make([]ImportErrorResponse, 0, len(r.Errors)),
+	}
+	for _, e := range r.Events {
+		resp.Events = append(resp.Events, ToEventResponse(e))
+	}
+	for _, e := range r.Errors {
+		resp.Errors = append(resp.Errors, ImportErrorResponse{Row: e.Row, Title: e.Title, Error: e.Message})
+	}
+
+	return resp
+}
+
+func ToAdminStatsResponse(s *domain.BookingStats) AdminStatsResponse {
+	loc := s.Range.Location
+	resp := AdminStatsResponse{
+		From:     s.Range.From.In(loc).Format(time.DateOnly),
+		To:       s.Range.To.In(loc).AddDate(0, 0, -1).Format(time.DateOnly),
+		Timezone: loc.String(),
+		Daily:    make([]DailyBookingsResponse, 0, len(s.Daily)),
+		Conversion: ConversionResponse{
+			Resolved:                s.Conversion.Resolved,
+			Confirmed:               s.Conversion.Confirmed,
+			Rate:                    s.Conversion.Rate,
+			AvgTimeToConfirmSeconds: s.Conversion.AvgTimeToConfirm.Seconds(),
+		},
+		Occupancy: toOccupancyResponses(s.Occupancy, loc),
+		TopEvents: toOccupancyResponses(s.TopEvents, loc),
+	}
+	for _, d := range s.Daily {
+		resp.Daily = append(resp.Daily, DailyBookingsResponse{
+			Date:      d.Day.Format(time.DateOnly),
+			Created:   d.Created,
+			Confirmed: d.Confirmed,
+			Expired:   d.Expired,
+		})
+	}
+
+	return resp
+}
+
+func toOccupancyResponses(list []domain.EventOccupancy, loc *time.Location) []EventOccupancyResponse {
+	res := make([]EventOccupancyResponse, 0, len(list))
+	for _, o := range list {
+		res = append(res, EventOccupancyResponse{
+			EventID:    o.EventID,
+			Title:      o.Title,
+			EventDate:  o.EventDate.In(loc).Format(time.RFC3339),
+			TotalSpots: o.TotalSpots,
+			Confirmed:  o.Confirmed,
+			Pending:    o.Pending,
+			FillRate:   o.FillRate,
+		})
+	}
+	return res
+}
+
+type InvitationResponse struct {
+	EventID   string `json:"event_id"`
+	UserID    string `json:"user_id"`
+	Username  string `json:"username"`
+	CreatedAt string `json:"created_at"`
+}
+
+func ToInvitationResponse(inv *domain.EventInvitation) InvitationResponse {
+	return InvitationResponse{
+		EventID:   inv.EventID,
+		UserID:    inv.UserID,
+		Username:  inv.Username,
+		CreatedAt: inv.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+type BookingLimitsResponse struct {
+	UserID        string                        `json:"user_id"`
+	MaxPending    int                           `json:"max_pending"`
+	MaxPerDay     int                           `json:"max_per_day"`
+	MaxExpired    int                           `json:"max_expired"`
+	ExpiredWindow string                        `json:"expired_window"`
+	Cooldown      string                        `json:"cooldown"`
+	Exempt        bool                          `json:"exempt"`
+	Override      *BookingLimitOverrideResponse `json:"override,omitempty"`
+	Usage         BookingUsageResponse          `json:"usage"`
+	CooldownUntil string                        `json:"cooldown_until,omitempty"`
+}
+
+type BookingLimitOverrideResponse struct {
+	MaxPending        *int   `json:"max_pending,omitempty"`
+	MaxPerDay         *int   `json:"max_per_day,omitempty"`
+	MaxExpired        *int   `json:"max_expired,omitempty"`
+	Exempt            bool   `json:"exempt"`
+	CooldownClearedAt string `json:"cooldown_cleared_at,omitempty"`
+	UpdatedAt         string `json:"updated_at"`
+}
+
+type BookingUsageResponse struct {
+	Pending int `json:"pending"`
+	Today   int `json:"today"`
+	Expired int `json:"expired"`
+}
+
+func ToBookingLimitsResponse(l *domain.UserBookingLimits) BookingLimitsResponse {
+	resp := BookingLimitsResponse{
+		UserID:        l.UserID,
+		MaxPending:    l.Limits.MaxPending,
+		MaxPerDay:     l.Limits.MaxPerDay,
+		MaxExpired:    l.Limits.MaxExpired,
+		ExpiredWindow: l.Limits.ExpiredWindow.String(),
+		Cooldown:      l.Limits.Cooldown.String(),
+		Usage: BookingUsageResponse{
+			Pending: l.Usage.Pending,
+			Today:   l.Usage.Today,
+			Expired: l.Usage.Expired,
+		},
+	}
+	if l.CooldownUntil != nil {
+		resp.CooldownUntil = l.CooldownUntil.Format(time.RFC3339)
+	}
+	if o := l.Override; o != nil {
+		resp.Exempt = o.Exempt
+		resp.Override = &BookingLimitOverrideResponse{
+			MaxPending: o.MaxPending,
+			MaxPerDay:  o.MaxPerDay,
+			MaxExpired: o.MaxExpired,
+			Exempt:     o.Exempt,
+			UpdatedAt:  o.UpdatedAt.Format(time.RFC3339),
+		}
+		if o.CooldownClearedAt != nil {
+			resp.Override.CooldownClearedAt = o.CooldownClearedAt.Format(time.RFC3339)
+		}
+	}
+	return resp
+}
@@ -1,13 +1,22 @@
 package handler
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"mime"
 	"net/http"
+	"path"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/stpnv0/EventBooker/internal/calendar"
 	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/export"
 	"github.com/stpnv0/EventBooker/internal/handler/dto"
 	"github.com/wb-go/wbf/ginext"
 )
@@ -15,31 +24,139 @@ import (
 type EventSvc interface {
 	CreateEvent(ctx context.Context, input domain.CreateEventInput) (*domain.Event, error)
 	GetDetails(ctx context.Context, id string) (*domain.EventDetails, error)
-	List(ctx context.Context) ([]*domain.Event, error)
+	ListFiltered(ctx context.Context, filter domain.EventFilter) ([]*domain.Event, error)
+	Search(ctx context.Context, input domain.EventSearchInput) ([]*domain.EventSearchResult, error)
+	UpdateEvent(ctx context.Context, id string, input domain.UpdateEventInput) (*domain.Event, error)
+	CancelEvent(ctx context.Context, id string) error
+	Publish(ctx context.Context, id string) (*domain.Event, error)
+	Archive(ctx context.Context, id string) (*domain.Event, error)
+	Invite(ctx context.Context, eventID string, userIDs []string) ([]*domain.EventInvitation, error)
+	ListInvitations(ctx context.Context, eventID string) ([]*domain.EventInvitation, error)
+	RevokeInvitation(ctx context.Context, eventID, userID string) error
+	Import(ctx context.Context, input domain.ImportInput) (*domain.ImportResult, error)
+	GetByID(ctx context.Context, id string) (*domain.Event, error)
+	StreamAttendees(ctx context.Context, eventID string, statuses []domain.BookingStatus, fn func(*domain.Attendee) error) error
 }
 
 type BookingSvc interface {
-	Book(ctx context.Context, eventID, userID string) (*domain.Booking, error)
+	Book(ctx context.Context, eventID, userID, accessCode string) (*domain.Booking, error)
 	Confirm(ctx context.Context, eventID, userID string) error
 	ListByUser(ctx context.Context, userID string) ([]*domain.Booking, error)
+	History(ctx context.Context, bookingID string) ([]*domain.BookingHistoryEntry, error)
 }
 
 type UserSvc interface {
 	Create(ctx context.Context, input domain.CreateUserInput) (*domain.User, error)
 	List(ctx context.Context) ([]*domain.User, error)
+	GetNotificationPreferences(ctx context.Context, userID string) (*domain.NotificationPreferences, error)
+	UpdateNotificationPreferences(
+		ctx context.Context,
+		userID string,
+		changes map[domain.NotificationChannel]map[domain.NotificationKind]bool,
+	) (*domain.NotificationPreferences, error)
+}
+
+type TelegramLinkSvc interface {
+	CreateLink(ctx context.Context, userID string) (*domain.TelegramLink, error)
+}
+
+type WebhookSvc interface {
+	CreateSubscription(ctx context.Context, input domain.CreateWebhookInput) (*domain.WebhookSubscription, error)
+	GetSubscription(ctx context.Context, id string) (*domain.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, id string, input domain.UpdateWebhookInput) (*domain.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id string) error
+	ListDeliveries(ctx context.Context, subscriptionID string) ([]*domain.WebhookDelivery, error)
+	GetDelivery(ctx context.Context, subscriptionID, deliveryID string) (*domain.WebhookDelivery, error)
+	Replay(ctx context.Context, subscriptionID, deliveryID string) (*domain.WebhookDelivery, error)
+}
+
+type SeriesSvc interface {
+	Create(ctx context.Context, input domain.CreateSeriesInput) (*domain.SeriesDetails, error)
+	Get(ctx context.Context, id string) (*domain.SeriesDetails, error)
+	List(ctx context.Context) ([]*domain.EventSeries, error)
+	Update(ctx context.Context, id string, input domain.UpdateSeriesInput) (*domain.EventSeries, error)
+	Cancel(ctx context.Context, id string) error
+}
+
+type VenueSvc interface {
+	Create(ctx context.Context, input domain.CreateVenueInput) (*domain.Venue, error)
+	Get(ctx context.Context, id string) (*domain.Venue, error)
+	List(ctx context.Context) ([]*domain.Venue, error)
+	Update(ctx context.Context, id string, input domain.UpdateVenueInput) (*domain.Venue, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type CalendarSvc interface {
+	EventICS(ctx context.Context, eventID string) (*domain.Event, []byte, error)
+	CreateFeedToken(ctx context.Context, userID string) (*domain.CalendarFeed, error)
+	UserFeed(ctx context.Context, userID, token string) ([]byte, error)
+}
+
+type StatsSvc interface {
+	BookingStats(ctx context.Context, input domain.StatsInput) (*domain.BookingStats, error)
+}
+
+type CategorySvc interface {
+	Create(ctx context.Context, input domain.CreateCategoryInput) (*domain.Category, error)
+	Get(ctx context.Context, id string) (*domain.Category, error)
+	List(ctx context.Context) ([]*domain.Category, error)
+	Update(ctx context.Context, id string, input domain.UpdateCategoryInput) (*domain.Category, error)
+	Delete(ctx context.Context, id string) error
+	Follow(ctx context.Context, userID, categoryID string) error
+	Unfollow(ctx context.Context, userID, categoryID string) error
+	ListFollowed(ctx context.Context, userID string) ([]*domain.Category, error)
+}
+
+type BookingLimitSvc interface {
+	Get(ctx context.Context, userID string) (*domain.UserBookingLimits, error)
+	SetOverride(ctx context.Context, o *domain.BookingLimitOverride) (*domain.UserBookingLimits, error)
+	DeleteOverride(ctx context.Context, userID string) error
+	ClearCooldown(ctx context.Context, userID string) (*domain.UserBookingLimits, error)
 }
 
 type Handler struct {
-	eventService   EventSvc
-	bookingService BookingSvc
-	userService    UserSvc
+	eventService        EventSvc
+	bookingService      BookingSvc
+	userService         UserSvc
+	telegramLinkService TelegramLinkSvc
+	webhookService      WebhookSvc
+	seriesService       SeriesSvc
+	venueService        VenueSvc
+	calendarService     CalendarSvc
+	statsService        StatsSvc
+	categoryService     CategorySvc
+	bookingLimitService BookingLimitSvc
 }
 
-func NewHandler(eventService EventSvc, bookingService BookingSvc, userService UserSvc) *Handler {
+// Services — сервисы, которые использует Handler.
+type Services struct {
+	Events        EventSvc
+	Bookings      BookingSvc
+	Users         UserSvc
+	TelegramLinks TelegramLinkSvc
+	Webhooks      WebhookSvc
+	Series        SeriesSvc
+	Venues        VenueSvc
+	Calendar      CalendarSvc
+	Stats         StatsSvc
+	Categories    CategorySvc
+	BookingLimits BookingLimitSvc
+}
+
+func NewHandler(s Services) *Handler {
 	return &Handler{
-		eventService:   eventService,
-		bookingService: bookingService,
-		userService:    userService,
+		eventService:        s.Events,
+		bookingService:      s.Bookings,
+		userService:         s.Users,
+		telegramLinkService: s.TelegramLinks,
+		webhookService:      s.Webhooks,
+		seriesService:       s.Series,
+		venueService:        s.Venues,
+		calendarService:     s.Calendar,
+		statsService:        s.Stats,
+		categoryService:     s.Categories,
+		bookingLimitService: s.BookingLimits,
 	}
 }
 
@@ -63,9 +180,30 @@ func (h *Handler) CreateEvent(c *ginext.Context) {
 		Title:           req.Title,
 		Description:     req.Description,
 		EventDate:       eventDate,
+		Timezone:        req.Timezone,
 		TotalSpots:      req.TotalSpots,
 		RequiresPayment: req.RequiresPayment,
 		BookingTTL:      time.Duration(req.BookingTTL) * time.Minute,
+		Duration:        time.Duration(req.DurationMinutes) * time.Minute,
+		VenueID:         req.VenueID,
+		CategoryIDs:     req.CategoryIDs,
+		Tags:            req.Tags,
+		Publish:         req.Publish,
+		Visibility:      domain.EventVisibility(req.Visibility),
+		AccessCode:      req.AccessCode,
+	}
+	var ok bool
+	if input.EndDate, ok = parseTimeField(c, "end_date", req.EndDate); !ok {
+		return
+	}
+	if input.SalesOpenAt, ok = parseTimeField(c, "sales_open_at", req.SalesOpenAt); !ok {
+		return
+	}
+	if input.SalesCloseAt, ok = parseTimeField(c, "sales_close_at", req.SalesCloseAt); !ok {
+		return
+	}
+	if input.PublishAt, ok = parseTimeField(c, "publish_at", req.PublishAt); !ok {
+		return
 	}
 
 	event, err := h.eventService.CreateEvent(c.Request.Context(), input)
@@ -77,6 +215,119 @@ func (h *Handler) CreateEvent(c *ginext.Context) {
 	c.JSON(http.StatusCreated, dto.ToEventResponse(event))
 }
 
+// maxImportSize ограничивает размер файла импорта.
+const maxImportSize = 5 << 20
+
+// ImportEvents создаёт мероприятия из CSV или ICS — файлом в поле file формы multipart
+// или телом запроса. Если в строках есть ошибки, ничего не создаётся и возвращается 422 с отчётом;
+// с dry_run отчёт возвращается с 200 без сохранения.
+func (h *Handler) ImportEvents(c *ginext.Context) {
+	var q dto.ImportEventsQuery
+	if err := c.ShouldBindQuery(&q); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	data, filename, contentType, ok := readImportFile(c)
+	if !ok {
+		return
+	}
+
+	format := domain.ImportFormat(q.Format)
+	if format == "" {
+		format = detectImportFormat(filename, contentType)
+	}
+	if format == "" {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "cannot detect import format, pass ?format=csv or ?format=ics",
+		})
+		return
+	}
+
+	result, err := h.eventService.Import(c.Request.Context(), domain.ImportInput{
+		Format:     format,
+		Data:       bytes.NewReader(data),
+		DryRun:     q.DryRun,
+		VenueID:    q.VenueID,
+		TotalSpots: q.TotalSpots,
+		Publish:    q.Publish,
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	status := http.StatusCreated
+	switch {
+	case result.DryRun:
+		status = http.StatusOK
+	case len(result.Errors) > 0:
+		status = http.StatusUnprocessableEntity
+	}
+	c.JSON(status, dto.ToImportResponse(result))
+}
+
+// readImportFile читает файл импорта из формы или тела запроса; при ошибке отвечает сам.
+func readImportFile(c *ginext.Context) (data []byte, filename, contentType string, ok bool) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportSize)
+
+	var r io.Reader = c.Request.Body
+	contentType = c.ContentType()
+	if strings.HasPrefix(contentType, "multipart/") {
+		fh, err := c.FormFile("file")
+		if err != nil {
+			writeImportReadError(c, err, "file is required")
+			return nil, "", "", false
+		}
+		f, err := fh.Open()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "cannot open file"})
+			return nil, "", "", false
+		}
+		defer f.Close()
+		r, filename, contentType = f, fh.Filename, fh.Header.Get("Content-Type")
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		writeImportReadError(c, err, "cannot read file")
+		return nil, "", "", false
+	}
+
+	return data, filename, contentType, true
+}
+
+func writeImportReadError(c *ginext.Context, err error, msg string) {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		c.JSON(http.StatusRequestEntityTooLarge, dto.ErrorResponse{
+			Error: fmt.Sprintf("file is too large, limit is %d MB", maxImportSize>>20),
+		})
+		return
+	}
+	c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: msg})
+}
+
+// detectImportFormat определяет формат по расширению файла, затем по Content-Type.
+func detectImportFormat(filename, contentType string) domain.ImportFormat {
+	switch strings.ToLower(path.Ext(filename)) {
+	case ".csv":
+		return domain.ImportCSV
+	case ".ics", ".ical":
+		return domain.ImportICS
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "text/csv":
+		return domain.ImportCSV
+	case "text/calendar":
+		return domain.ImportICS
+	}
+
+	return ""
+}
+
 func (h *Handler) GetEvent(c *ginext.Context) {
 	id := c.Param("id")
 	if _, err := uuid.Parse(id); err != nil {
@@ -93,8 +344,45 @@ func (h *Handler) GetEvent(c *ginext.Context) {
 	c.JSON(http.StatusOK, dto.ToEventDetailsResponse(details))
 }
 
+// ListEvents возвращает мероприятия; с ?happening=now (или моментом в RFC3339) — только идущие в этот момент.
+// ?category= (id или slug) и ?tag= оставляют мероприятия из рубрики и с тегом. По умолчанию
+// отдаются опубликованные; ?status=draft,archived или ?status=all — для администратора.
+// Закрытые мероприятия видны приглашённому ?user_id= или всем при ?visibility=all.
 func (h *Handler) ListEvents(c *ginext.Context) {
-	events, err := h.eventService.List(c.Request.Context())
+	statuses, ok := parseEventStatuses(c)
+	if !ok {
+		return
+	}
+	filter := domain.EventFilter{
+		Category:       c.Query("category"),
+		Tag:            c.Query("tag"),
+		Statuses:       statuses,
+		ViewerID:       c.Query("user_id"),
+		IncludePrivate: c.Query("visibility") == "all",
+	}
+	if filter.ViewerID != "" {
+		if _, err := uuid.Parse(filter.ViewerID); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user id"})
+			return
+		}
+	}
+	switch happening := c.Query("happening"); happening {
+	case "":
+	case "now":
+		now := time.Now().UTC()
+		filter.HappeningAt = &now
+	default:
+		at, err := time.Parse(time.RFC3339, happening)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "invalid happening, expected \"now\" or RFC3339",
+			})
+			return
+		}
+		filter.HappeningAt = &at
+	}
+
+	events, err := h.eventService.ListFiltered(c.Request.Context(), filter)
 	if err != nil {
 		h.handleError(c, err)
 		return
@@ -108,128 +396,1390 @@ func (h *Handler) ListEvents(c *ginext.Context) {
 	c.JSON(http.StatusOK, resp)
 }
 
-// Bookings
+// SearchEvents — полнотекстовый поиск по названию и описанию с фильтрами по дате и свободным местам.
+func (h *Handler) SearchEvents(c *ginext.Context) {
+	var q dto.SearchEventsQuery
+	if err := c.ShouldBindQuery(&q); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
 
-func (h *Handler) BookEvent(c *ginext.Context) {
-	eventID := c.Param("id")
-	if _, err := uuid.Parse(eventID); err != nil {
+	input := domain.EventSearchInput{
+		Query:     q.Query,
+		Available: q.Available,
+		Limit:     q.Limit,
+		Offset:    q.Offset,
+	}
+	var ok bool
+	if input.From, ok = parseTimeParam(c, "from", q.From); !ok {
+		return
+	}
+	if input.To, ok = parseTimeParam(c, "to", q.To); !ok {
+		return
+	}
+
+	results, err := h.eventService.Search(c.Request.Context(), input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	resp := make([]dto.EventSearchResponse, 0, len(results))
+	for _, r := range results {
+		resp = append(resp, dto.ToEventSearchResponse(r))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// parseTimeParam разбирает необязательный параметр в RFC3339; при ошибке отвечает 400.
+func parseTimeParam(c *ginext.Context, name, value string) (*time.Time, bool) {
+	if value == "" {
+		return nil, true
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: fmt.Sprintf("invalid %s, expected RFC3339", name)})
+		return nil, false
+	}
+	return &t, true
+}
+
+// UpdateEvent частично меняет мероприятие; для вхождения серии это отвязывает его от шаблона.
+func (h *Handler) UpdateEvent(c *ginext.Context) {
+	id := c.Param("id")
+	if _, err := uuid.Parse(id); err != nil {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid event id"})
 		return
 	}
 
-	var req dto.BookRequest
+	var req dto.UpdateEventRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
 		return
 	}
 
-	booking, err := h.bookingService.Book(c.Request.Context(), eventID, req.UserID)
+	input := domain.UpdateEventInput{
+		Title:           req.Title,
+		Description:     req.Description,
+		TotalSpots:      req.TotalSpots,
+		RequiresPayment: req.RequiresPayment,
+		VenueID:         req.VenueID,
+		Timezone:        req.Timezone,
+		CategoryIDs:     req.CategoryIDs,
+		Tags:            req.Tags,
+		AccessCode:      req.AccessCode,
+	}
+	if req.Visibility != nil {
+		visibility := domain.EventVisibility(*req.Visibility)
+		input.Visibility = &visibility
+	}
+	if req.EventDate != nil {
+		eventDate, err := time.Parse(time.RFC3339, *req.EventDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "invalid event_date format, expected RFC3339",
+			})
+			return
+		}
+		input.EventDate = &eventDate
+	}
+	var ok bool
+	if input.EndDate, ok = parseTimeField(c, "end_date", req.EndDate); !ok {
+		return
+	}
+	// Пустая строка снимает границу окна продаж.
+	if input.SalesOpenAt, ok = parseTimeField(c, "sales_open_at", req.SalesOpenAt); !ok {
+		return
+	}
+	if input.SalesCloseAt, ok = parseTimeField(c, "sales_close_at", req.SalesCloseAt); !ok {
+		return
+	}
+	if input.PublishAt, ok = parseTimeField(c, "publish_at", req.PublishAt); !ok {
+		return
+	}
+	if req.BookingTTL != nil {
+		ttl := time.Duration(*req.BookingTTL) * time.Minute
+		input.BookingTTL = &ttl
+	}
+	if req.DurationMinutes != nil {
+		duration := time.Duration(*req.DurationMinutes) * time.Minute
+		input.Duration = &duration
+	}
+
+	event, err := h.eventService.UpdateEvent(c.Request.Context(), id, input)
 	if err != nil {
 		h.handleError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, dto.ToBookingResponse(booking))
+	c.JSON(http.StatusOK, dto.ToEventResponse(event))
 }
 
-func (h *Handler) ConfirmBooking(c *ginext.Context) {
+// CancelEvent отменяет мероприятие или одно вхождение серии вместе с бронями.
+func (h *Handler) CancelEvent(c *ginext.Context) {
+	id := c.Param("id")
+	if _, err := uuid.Parse(id); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid event id"})
+		return
+	}
+
+	if err := h.eventService.CancelEvent(c.Request.Context(), id); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ginext.H{"status": "cancelled"})
+}
+
+// PublishEvent публикует черновик: мероприятие появляется в списках и открывается для брони.
+func (h *Handler) PublishEvent(c *ginext.Context) {
+	id := c.Param("id")
+	if _, err := uuid.Parse(id); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid event id"})
+		return
+	}
+
+	event, err := h.eventService.Publish(c.Request.Context(), id)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToEventResponse(event))
+}
+
+// ArchiveEvent снимает мероприятие с публикации.
+func (h *Handler) ArchiveEvent(c *ginext.Context) {
+	id := c.Param("id")
+	if _, err := uuid.Parse(id); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid event id"})
+		return
+	}
+
+	event, err := h.eventService.Archive(c.Request.Context(), id)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToEventResponse(event))
+}
+
+// InviteToEvent приглашает пользователей на закрытое мероприятие. В ответе — только новые
+// приглашения: уже приглашённые пропускаются.
+func (h *Handler) InviteToEvent(c *ginext.Context) {
 	eventID := c.Param("id")
 	if _, err := uuid.Parse(eventID); err != nil {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid event id"})
 		return
 	}
 
-	var req dto.ConfirmRequest
+	var req dto.InviteRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
 		return
 	}
 
-	if err := h.bookingService.Confirm(c.Request.Context(), eventID, req.UserID); err != nil {
+	invitations, err := h.eventService.Invite(c.Request.Context(), eventID, req.UserIDs)
+	if err != nil {
 		h.handleError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, ginext.H{"status": "confirmed"})
+	c.JSON(http.StatusCreated, toInvitationResponses(invitations))
 }
 
-func (h *Handler) GetUserBookings(c *ginext.Context) {
-	userID := c.Param("id")
-	if _, err := uuid.Parse(userID); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user id"})
+func (h *Handler) ListEventInvitations(c *ginext.Context) {
+	eventID := c.Param("id")
+	if _, err := uuid.Parse(eventID); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid event id"})
 		return
 	}
-	bookings, err := h.bookingService.ListByUser(c.Request.Context(), userID)
+
+	invitations, err := h.eventService.ListInvitations(c.Request.Context(), eventID)
 	if err != nil {
 		h.handleError(c, err)
 		return
 	}
 
-	resp := make([]dto.BookingResponse, 0, len(bookings))
-	for _, b := range bookings {
-		resp = append(resp, dto.ToBookingResponse(b))
-	}
-
-	c.JSON(http.StatusOK, resp)
+	c.JSON(http.StatusOK, toInvitationResponses(invitations))
 }
 
-// Users
+func (h *Handler) RevokeEventInvitation(c *ginext.Context) {
+	eventID := c.Param("id")
+	if _, err := uuid.Parse(eventID); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid event id"})
+		return
+	}
+	userID := c.Param("user_id")
+	if _, err := uuid.Parse(userID); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user id"})
+		return
+	}
 
-func (h *Handler) CreateUser(c *ginext.Context) {
-	var req dto.CreateUserRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+	if err := h.eventService.RevokeInvitation(c.Request.Context(), eventID, userID); err != nil {
+		h.handleError(c, err)
 		return
 	}
 
-	input := domain.CreateUserInput{
-		Username:       req.Username,
-		TelegramChatID: req.TelegramChatID,
+	c.Status(http.StatusNoContent)
+}
+
+func toInvitationResponses(invitations []*domain.EventInvitation) []dto.InvitationResponse {
+	resp := make([]dto.InvitationResponse, 0, len(invitations))
+	for _, inv := range invitations {
+		resp = append(resp, dto.ToInvitationResponse(inv))
 	}
+	return resp
+}
 
-	user, err := h.userService.Create(c.Request.Context(), input)
+// GetEventICS отдаёт мероприятие файлом .ics для импорта в календарь.
+func (h *Handler) GetEventICS(c *ginext.Context) {
+	id := c.Param("id")
+	if _, err := uuid.Parse(id); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid event id"})
+		return
+	}
+
+	event, body, err := h.calendarService.EventICS(c.Request.Context(), id)
 	if err != nil {
 		h.handleError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, dto.ToUserResponse(user))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", calendar.Filename(event)))
+	c.Data(http.StatusOK, calendar.ContentType, body)
 }
 
-func (h *Handler) ListUsers(c *ginext.Context) {
-	users, err := h.userService.List(c.Request.Context())
+func (h *Handler) ExportAttendeesCSV(c *ginext.Context) {
+	h.exportAttendees(c, "csv")
+}
+
+func (h *Handler) ExportAttendeesXLSX(c *ginext.Context) {
+	h.exportAttendees(c, "xlsx")
+}
+
+// exportAttendees отдаёт список участников файлом, записывая строки по мере чтения из БД.
+// ?status= (через запятую или несколько раз) фильтрует по статусу брони, по умолчанию — активные.
+func (h *Handler) exportAttendees(c *ginext.Context, format string) {
+	id := c.Param("id")
+	if _, err := uuid.Parse(id); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid event id"})
+		return
+	}
+	statuses, ok := parseStatuses(c)
+	if !ok {
+		return
+	}
+
+	event, err := h.eventService.GetByID(c.Request.Context(), id)
 	if err != nil {
 		h.handleError(c, err)
 		return
 	}
 
-	resp := make([]dto.UserResponse, 0, len(users))
-	for _, u := range users {
-		resp = append(resp, dto.ToUserResponse(u))
+	var (
+		w           export.AttendeeWriter
+		contentType string
+	)
+	switch format {
+	case "csv":
+		w, err = export.NewCSVWriter(c.Writer, event.Location())
+		contentType = export.ContentTypeCSV
+	default:
+		w, err = export.NewXLSXWriter(c.Writer, event.Location())
+		contentType = export.ContentTypeXLSX
+	}
+	if err != nil {
+		h.handleError(c, err)
+		return
 	}
 
-	c.JSON(http.StatusOK, resp)
+	c.Header("Content-Type", contentType)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "attendees-"+event.ID+"."+format))
+
+	err = h.eventService.StreamAttendees(c.Request.Context(), id, statuses, w.Write)
+	if err == nil {
+		err = w.Close()
+	}
+	if err != nil {
+		// Пока в ответ ничего не ушло, можно вернуть обычную ошибку; иначе остаётся только оборвать выгрузку.
+		if !c.Writer.Written() {
+			c.Writer.Header().Del("Content-Type")
+			c.Writer.Header().Del("Content-Disposition")
+			h.handleError(c, err)
+			return
+		}
+		c.Set("error", err.Error())
+		c.Abort()
+	}
 }
 
-func (h *Handler) handleError(c *ginext.Context, err error) {
-	c.Set("error", err.Error())
+// parseStatuses читает ?status=; при неизвестном статусе отвечает 400.
+func parseStatuses(c *ginext.Context) ([]domain.BookingStatus, bool) {
+	var statuses []domain.BookingStatus
+	for _, param := range c.QueryArray("status") {
+		for _, v := range strings.Split(param, ",") {
+			status := domain.BookingStatus(strings.TrimSpace(v))
+			switch status {
+			case domain.BookingStatusPending, domain.BookingStatusConfirmed, domain.BookingStatusCancelled:
+				if !slices.Contains(statuses, status) {
+					statuses = append(statuses, status)
+				}
+			default:
+				c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: fmt.Sprintf("unknown booking status %q", v)})
+				return nil, false
+			}
+		}
+	}
+	return statuses, true
+}
 
-	switch {
-	case errors.Is(err, domain.ErrEventNotFound),
-		errors.Is(err, domain.ErrUserNotFound),
-		errors.Is(err, domain.ErrBookingNotFound):
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: err.Error()})
+// parseEventStatuses читает ?status= для списка мероприятий; all — все статусы.
+// При неизвестном статусе отвечает 400.
+func parseEventStatuses(c *ginext.Context) ([]domain.EventStatus, bool) {
+	var statuses []domain.EventStatus
+	for _, param := range c.QueryArray("status") {
+		for _, v := range strings.Split(param, ",") {
+			v = strings.TrimSpace(v)
+			if v == "all" {
+				return domain.EventStatuses, true
+			}
+			status := domain.EventStatus(v)
+			if !slices.Contains(domain.EventStatuses, status) {
+				c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: fmt.Sprintf("unknown event status %q", v)})
+				return nil, false
+			}
+			if !slices.Contains(statuses, status) {
+				statuses = append(statuses, status)
+			}
+		}
+	}
+	return statuses, true
+}
 
-	case errors.Is(err, domain.ErrNoAvailableSpots),
-		errors.Is(err, domain.ErrAlreadyBooked),
-		errors.Is(err, domain.ErrBookingNotPending),
-		errors.Is(err, domain.ErrBookingExpired):
-		c.JSON(http.StatusConflict, dto.ErrorResponse{Error: err.Error()})
+// parseTimeField разбирает необязательное поле в RFC3339; при ошибке отвечает 400.
+// Пустая строка даёт нулевое время — так PUT снимает необязательные границы.
+func parseTimeField(c *ginext.Context, name string, value *string) (*time.Time, bool) {
+	if value == nil {
+		return nil, true
+	}
+	if *value == "" {
+		return &time.Time{}, true
+	}
 
-	case errors.Is(err, domain.ErrValidation),
+	t, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "invalid " + name + " format, expected RFC3339",
+		})
+		return nil, false
+	}
+	return &t, true
+}
+
+// Series
+
+func (h *Handler) CreateSeries(c *ginext.Context) {
+	var req dto.CreateSeriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	input, err := toCreateSeriesInput(req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	details, err := h.seriesService.Create(c.Request.Context(), input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.ToSeriesDetailsResponse(details))
+}
+
+func (h *Handler) ListSeries(c *ginext.Context) {
+	series, err := h.seriesService.List(c.Request.Context())
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	resp := make([]dto.SeriesResponse, 0, len(series))
+	for _, s := range series {
+		resp = append(resp, dto.ToSeriesResponse(s))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) GetSeries(c *ginext.Context) {
+	id := c.Param("id")
+	if _, err := uuid.Parse(id); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid series id"})
+		return
+	}
+
+	details, err := h.seriesService.Get(c.Request.Context(), id)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToSeriesDetailsResponse(details))
+}
+
+// UpdateSeries меняет шаблон серии и все её будущие вхождения, кроме отредактированных отдельно.
+func (h *Handler) UpdateSeries(c *ginext.Context) {
+	id := c.Param("id")
+	if _, err := uuid.Parse(id); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid series id"})
+		return
+	}
+
+	var req dto.UpdateSeriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	input := domain.UpdateSeriesInput{
+		Title:           req.Title,
+		Description:     req.Description,
+		TotalSpots:      req.TotalSpots,
+		RequiresPayment: req.RequiresPayment,
+	}
+	if req.BookingTTL != nil {
+		ttl := time.Duration(*req.BookingTTL) * time.Minute
+		input.BookingTTL = &ttl
+	}
+
+	series, err := h.seriesService.Update(c.Request.Context(), id, input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToSeriesResponse(series))
+}
+
+func (h *Handler) CancelSeries(c *ginext.Context) {
+	id := c.Param("id")
+	if _, err := uuid.Parse(id); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid series id"})
+		return
+	}
+
+	if err := h.seriesService.Cancel(c.Request.Context(), id); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ginext.H{"status": "cancelled"})
+}
+
+func toCreateSeriesInput(req dto.CreateSeriesRequest) (domain.CreateSeriesInput, error) {
+	start, err := time.Parse(time.RFC3339, req.Start)
+	if err != nil {
+		return domain.CreateSeriesInput{}, errors.New("invalid start format, expected RFC3339")
+	}
+
+	rec := domain.Recurrence{
+		Freq:       domain.RecurrenceFreq(req.Recurrence.Freq),
+		Interval:   req.Recurrence.Interval,
+		ByMonthDay: req.Recurrence.ByMonthDay,
+		Count:      req.Recurrence.Count,
+	}
+	for _, day := range req.Recurrence.ByWeekday {
+		idx := slices.Index(dto.RRuleWeekdays, strings.ToUpper(day))
+		if idx < 0 {
+			return domain.CreateSeriesInput{}, fmt.Errorf("invalid weekday %q, expected one of MO..SU", day)
+		}
+		rec.ByWeekday = append(rec.ByWeekday, time.Weekday(idx))
+	}
+	if req.Recurrence.Until != nil {
+		until, err := time.Parse(time.RFC3339, *req.Recurrence.Until)
+		if err != nil {
+			return domain.CreateSeriesInput{}, errors.New("invalid until format, expected RFC3339")
+		}
+		rec.Until = &until
+	}
+
+	exceptions := make([]time.Time, 0, len(req.Exceptions))
+	for _, raw := range req.Exceptions {
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return domain.CreateSeriesInput{}, errors.New("invalid exception format, expected RFC3339")
+		}
+		exceptions = append(exceptions, t)
+	}
+
+	return domain.CreateSeriesInput{
+		Title:           req.Title,
+		Description:     req.Description,
+		Start:           start,
+		Timezone:        req.Timezone,
+		TotalSpots:      req.TotalSpots,
+		BookingTTL:      time.Duration(req.BookingTTL) * time.Minute,
+		RequiresPayment: req.RequiresPayment,
+		Recurrence:      rec,
+		Exceptions:      exceptions,
+	}, nil
+}
+
+// Bookings
+
+func (h *Handler) BookEvent(c *ginext.Context) {
+	eventID := c.Param("id")
+	if _, err := uuid.Parse(eventID); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid event id"})
+		return
+	}
+
+	var req dto.BookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	booking, err := h.bookingService.Book(c.Request.Context(), eventID, req.UserID, req.AccessCode)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.ToBookingResponse(booking))
+}
+
+func (h *Handler) ConfirmBooking(c *ginext.Context) {
+	eventID := c.Param("id")
+	if _, err := uuid.Parse(eventID); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid event id"})
+		return
+	}
+
+	var req dto.ConfirmRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if err := h.bookingService.Confirm(c.Request.Context(), eventID, req.UserID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ginext.H{"status": "confirmed"})
+}
+
+func (h *Handler) GetUserBookings(c *ginext.Context) {
+	userID := c.Param("id")
+	if _, err := uuid.Parse(userID); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user id"})
+		return
+	}
+	bookings, err := h.bookingService.ListByUser(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	resp := make([]dto.BookingResponse, 0, len(bookings))
+	for _, b := range bookings {
+		resp = append(resp, dto.ToBookingResponse(b))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) GetBookingHistory(c *ginext.Context) {
+	bookingID := c.Param("id")
+	if _, err := uuid.Parse(bookingID); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid booking id"})
+		return
+	}
+
+	history, err := h.bookingService.History(c.Request.Context(), bookingID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	resp := make([]dto.BookingHistoryResponse, 0, len(history))
+	for _, e := range history {
+		resp = append(resp, dto.ToBookingHistoryResponse(e))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Users
+
+func (h *Handler) CreateUser(c *ginext.Context) {
+	var req dto.CreateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	input := domain.CreateUserInput{
+		Username:       req.Username,
+		TelegramChatID: req.TelegramChatID,
+		Email:          req.Email,
+		WebhookURL:     req.WebhookURL,
+		Locale:         req.Locale,
+		Timezone:       req.Timezone,
+	}
+
+	user, err := h.userService.Create(c.Request.Context(), input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.ToUserResponse(user))
+}
+
+func (h *Handler) ListUsers(c *ginext.Context) {
+	users, err := h.userService.List(c.Request.Context())
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	resp := make([]dto.UserResponse, 0, len(users))
+	for _, u := range users {
+		resp = append(resp, dto.ToUserResponse(u))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) GetNotificationPreferences(c *ginext.Context) {
+	userID := c.Param("id")
+	if _, err := uuid.Parse(userID); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user id"})
+		return
+	}
+
+	prefs, err := h.userService.GetNotificationPreferences(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToNotificationPreferencesResponse(prefs))
+}
+
+func (h *Handler) UpdateNotificationPreferences(c *ginext.Context) {
+	userID := c.Param("id")
+	if _, err := uuid.Parse(userID); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user id"})
+		return
+	}
+
+	var req dto.NotificationPreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	changes := make(map[domain.NotificationChannel]map[domain.NotificationKind]bool, len(req.Preferences))
+	for ch, kinds := range req.Preferences {
+		m := make(map[domain.NotificationKind]bool, len(kinds))
+		for kind, enabled := range kinds {
+			m[domain.NotificationKind(kind)] = enabled
+		}
+		changes[domain.NotificationChannel(ch)] = m
+	}
+
+	prefs, err := h.userService.UpdateNotificationPreferences(c.Request.Context(), userID, changes)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToNotificationPreferencesResponse(prefs))
+}
+
+// CreateTelegramLink выдаёт одноразовую ссылку на бота для привязки чата к пользователю.
+func (h *Handler) CreateTelegramLink(c *ginext.Context) {
+	userID := c.Param("id")
+	if _, err := uuid.Parse(userID); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user id"})
+		return
+	}
+
+	link, err := h.telegramLinkService.CreateLink(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.ToTelegramLinkResponse(link))
+}
+
+// CreateCalendarToken выпускает новую секретную ссылку на календарную подписку пользователя.
+func (h *Handler) CreateCalendarToken(c *ginext.Context) {
+	userID := c.Param("id")
+	if _, err := uuid.Parse(userID); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user id"})
+		return
+	}
+
+	feed, err := h.calendarService.CreateFeedToken(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.ToCalendarFeedResponse(feed))
+}
+
+// GetUserCalendar — подписка с подтверждёнными бронями; доступ по токену из ссылки,
+// потому что календарные клиенты не умеют передавать заголовки авторизации.
+func (h *Handler) GetUserCalendar(c *ginext.Context) {
+	userID := c.Param("id")
+	if _, err := uuid.Parse(userID); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user id"})
+		return
+	}
+
+	body, err := h.calendarService.UserFeed(c.Request.Context(), userID, c.Query("token"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Data(http.StatusOK, calendar.ContentType, body)
+}
+
+// Venues
+
+func (h *Handler) CreateVenue(c *ginext.Context) {
+	var req dto.CreateVenueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	venue, err := h.venueService.Create(c.Request.Context(), domain.CreateVenueInput{
+		Name:      req.Name,
+		Address:   req.Address,
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+		Capacity:  req.Capacity,
+		Timezone:  req.Timezone,
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.ToVenueResponse(venue))
+}
+
+func (h *Handler) ListVenues(c *ginext.Context) {
+	venues, err := h.venueService.List(c.Request.Context())
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	resp := make([]dto.VenueResponse, 0, len(venues))
+	for _, v := range venues {
+		resp = append(resp, dto.ToVenueResponse(v))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) GetVenue(c *ginext.Context) {
+	id, ok := venueID(c)
+	if !ok {
+		return
+	}
+
+	venue, err := h.venueService.Get(c.Request.Context(), id)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToVenueResponse(venue))
+}
+
+func (h *Handler) UpdateVenue(c *ginext.Context) {
+	id, ok := venueID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateVenueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	venue, err := h.venueService.Update(c.Request.Context(), id, domain.UpdateVenueInput{
+		Name:      req.Name,
+		Address:   req.Address,
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+		Capacity:  req.Capacity,
+		Timezone:  req.Timezone,
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToVenueResponse(venue))
+}
+
+func (h *Handler) DeleteVenue(c *ginext.Context) {
+	id, ok := venueID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.venueService.Delete(c.Request.Context(), id); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func venueID(c *ginext.Context) (string, bool) {
+	id := c.Param("id")
+	if _, err := uuid.Parse(id); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid venue id"})
+		return "", false
+	}
+	return id, true
+}
+
+// Categories
+
+func (h *Handler) CreateCategory(c *ginext.Context) {
+	var req dto.CreateCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	category, err := h.categoryService.Create(c.Request.Context(), domain.CreateCategoryInput{
+		Slug:        req.Slug,
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.ToCategoryResponse(category))
+}
+
+func (h *Handler) ListCategories(c *ginext.Context) {
+	categories, err := h.categoryService.List(c.Request.Context())
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toCategoryResponses(categories))
+}
+
+func (h *Handler) GetCategory(c *ginext.Context) {
+	id, ok := categoryID(c, "id")
+	if !ok {
+		return
+	}
+
+	category, err := h.categoryService.Get(c.Request.Context(), id)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToCategoryResponse(category))
+}
+
+func (h *Handler) UpdateCategory(c *ginext.Context) {
+	id, ok := categoryID(c, "id")
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	category, err := h.categoryService.Update(c.Request.Context(), id, domain.UpdateCategoryInput{
+		Slug:        req.Slug,
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToCategoryResponse(category))
+}
+
+func (h *Handler) DeleteCategory(c *ginext.Context) {
+	id, ok := categoryID(c, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.categoryService.Delete(c.Request.Context(), id); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListFollowedCategories возвращает рубрики, на которые подписан пользователь.
+func (h *Handler) ListFollowedCategories(c *ginext.Context) {
+	userID := c.Param("id")
+	if _, err := uuid.Parse(userID); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user id"})
+		return
+	}
+
+	categories, err := h.categoryService.ListFollowed(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toCategoryResponses(categories))
+}
+
+// FollowCategory подписывает пользователя на новые мероприятия рубрики. Запрос идемпотентен.
+func (h *Handler) FollowCategory(c *ginext.Context) {
+	userID, catID, ok := followParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.categoryService.Follow(c.Request.Context(), userID, catID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *Handler) UnfollowCategory(c *ginext.Context) {
+	userID, catID, ok := followParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.categoryService.Unfollow(c.Request.Context(), userID, catID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func followParams(c *ginext.Context) (userID, catID string, ok bool) {
+	userID = c.Param("id")
+	if _, err := uuid.Parse(userID); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user id"})
+		return "", "", false
+	}
+	catID, ok = categoryID(c, "category_id")
+	return userID, catID, ok
+}
+
+func categoryID(c *ginext.Context, param string) (string, bool) {
+	id := c.Param(param)
+	if _, err := uuid.Parse(id); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid category id"})
+		return "", false
+	}
+	return id, true
+}
+
+func toCategoryResponses(categories []*domain.Category) []dto.CategoryResponse {
+	resp := make([]dto.CategoryResponse, 0, len(categories))
+	for _, c := range categories {
+		resp = append(resp, dto.ToCategoryResponse(c))
+	}
+	return resp
+}
+
+// Webhooks
+
+func (h *Handler) CreateWebhook(c *ginext.Context) {
+	var req dto.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	sub, err := h.webhookService.CreateSubscription(c.Request.Context(), domain.CreateWebhookInput{
+		URL:        req.URL,
+		Secret:     req.Secret,
+		EventTypes: toWebhookEventTypes(req.EventTypes),
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	// Секрет показывается один раз — партнёру нужно сохранить его для проверки подписи.
+	resp := dto.ToWebhookResponse(sub)
+	resp.Secret = sub.Secret
+	c.JSON(http.StatusCreated, resp)
+}
+
+func (h *Handler) ListWebhooks(c *ginext.Context) {
+	subs, err := h.webhookService.ListSubscriptions(c.Request.Context())
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	resp := make([]dto.WebhookResponse, 0, len(subs))
+	for _, s := range subs {
+		resp = append(resp, dto.ToWebhookResponse(s))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) GetWebhook(c *ginext.Context) {
+	id, ok := webhookID(c)
+	if !ok {
+		return
+	}
+
+	sub, err := h.webhookService.GetSubscription(c.Request.Context(), id)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToWebhookResponse(sub))
+}
+
+func (h *Handler) UpdateWebhook(c *ginext.Context) {
+	id, ok := webhookID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	sub, err := h.webhookService.UpdateSubscription(c.Request.Context(), id, domain.UpdateWebhookInput{
+		URL:        req.URL,
+		EventTypes: toWebhookEventTypes(req.EventTypes),
+		Active:     req.Active,
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToWebhookResponse(sub))
+}
+
+func (h *Handler) DeleteWebhook(c *ginext.Context) {
+	id, ok := webhookID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.webhookService.DeleteSubscription(c.Request.Context(), id); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *Handler) ListWebhookDeliveries(c *ginext.Context) {
+	id, ok := webhookID(c)
+	if !ok {
+		return
+	}
+
+	deliveries, err := h.webhookService.ListDeliveries(c.Request.Context(), id)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	resp := make([]dto.WebhookDeliveryResponse, 0, len(deliveries))
+	for _, d := range deliveries {
+		resp = append(resp, dto.ToWebhookDeliveryResponse(d))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) GetWebhookDelivery(c *ginext.Context) {
+	id, deliveryID, ok := webhookDeliveryID(c)
+	if !ok {
+		return
+	}
+
+	d, err := h.webhookService.GetDelivery(c.Request.Context(), id, deliveryID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToWebhookDeliveryResponse(d))
+}
+
+// ReplayWebhookDelivery повторно ставит доставку в очередь, например после исправления на стороне партнёра.
+func (h *Handler) ReplayWebhookDelivery(c *ginext.Context) {
+	id, deliveryID, ok := webhookDeliveryID(c)
+	if !ok {
+		return
+	}
+
+	d, err := h.webhookService.Replay(c.Request.Context(), id, deliveryID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.ToWebhookDeliveryResponse(d))
+}
+
+func webhookID(c *ginext.Context) (string, bool) {
+	id := c.Param("id")
+	if _, err := uuid.Parse(id); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid webhook id"})
+		return "", false
+	}
+	return id, true
+}
+
+func webhookDeliveryID(c *ginext.Context) (string, string, bool) {
+	id, ok := webhookID(c)
+	if !ok {
+		return "", "", false
+	}
+
+	deliveryID := c.Param("delivery_id")
+	if _, err := uuid.Parse(deliveryID); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid delivery id"})
+		return "", "", false
+	}
+	return id, deliveryID, true
+}
+
+func toWebhookEventTypes(types []string) []domain.WebhookEventType {
+	if types == nil {
+		return nil
+	}
+
+	res := make([]domain.WebhookEventType, len(types))
+	for i, t := range types {
+		res[i] = domain.WebhookEventType(t)
+	}
+	return res
+}
+
+// Admin
+
+// GetAdminStats — сводка по броням и заполненности за период для панели администратора.
+func (h *Handler) GetAdminStats(c *ginext.Context) {
+	var q dto.AdminStatsQuery
+	if err := c.ShouldBindQuery(&q); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	input := domain.StatsInput{Timezone: q.Timezone, Top: q.Top}
+	var ok bool
+	if input.From, ok = parseDateParam(c, "from", q.From); !ok {
+		return
+	}
+	if input.To, ok = parseDateParam(c, "to", q.To); !ok {
+		return
+	}
+
+	stats, err := h.statsService.BookingStats(c.Request.Context(), input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToAdminStatsResponse(stats))
+}
+
+// GetBookingLimits показывает действующие для пользователя лимиты броней и их потребление.
+func (h *Handler) GetBookingLimits(c *ginext.Context) {
+	userID, ok := userIDParam(c)
+	if !ok {
+		return
+	}
+
+	limits, err := h.bookingLimitService.Get(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToBookingLimitsResponse(limits))
+}
+
+// SetBookingLimits заменяет индивидуальные лимиты пользователя.
+func (h *Handler) SetBookingLimits(c *ginext.Context) {
+	userID, ok := userIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req dto.BookingLimitOverrideRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	limits, err := h.bookingLimitService.SetOverride(c.Request.Context(), &domain.BookingLimitOverride{
+		UserID:     userID,
+		MaxPending: req.MaxPending,
+		MaxPerDay:  req.MaxPerDay,
+		MaxExpired: req.MaxExpired,
+		Exempt:     req.Exempt,
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToBookingLimitsResponse(limits))
+}
+
+// ResetBookingLimits возвращает пользователя к общим лимитам.
+func (h *Handler) ResetBookingLimits(c *ginext.Context) {
+	userID, ok := userIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.bookingLimitService.DeleteOverride(c.Request.Context(), userID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ClearBookingCooldown снимает паузу после истёкших броней.
+func (h *Handler) ClearBookingCooldown(c *ginext.Context) {
+	userID, ok := userIDParam(c)
+	if !ok {
+		return
+	}
+
+	limits, err := h.bookingLimitService.ClearCooldown(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToBookingLimitsResponse(limits))
+}
+
+func userIDParam(c *ginext.Context) (string, bool) {
+	id := c.Param("id")
+	if _, err := uuid.Parse(id); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user id"})
+		return "", false
+	}
+	return id, true
+}
+
+// parseDateParam разбирает день в формате YYYY-MM-DD; пустое значение — нулевое время.
+func parseDateParam(c *ginext.Context, name, value string) (time.Time, bool) {
+	if value == "" {
+		return time.Time{}, true
+	}
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: fmt.Sprintf("invalid %s format, expected YYYY-MM-DD", name),
+		})
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+func (h *Handler) handleError(c *ginext.Context, err error) {
+	c.Set("error", err.Error())
+
+	switch {
+	case errors.Is(err, domain.ErrEventNotFound),
+		errors.Is(err, domain.ErrUserNotFound),
+		errors.Is(err, domain.ErrBookingNotFound),
+		errors.Is(err, domain.ErrWebhookNotFound),
+		errors.Is(err, domain.ErrWebhookDeliveryNotFound),
+		errors.Is(err, domain.ErrSeriesNotFound),
+		errors.Is(err, domain.ErrVenueNotFound),
+		errors.Is(err, domain.ErrCategoryNotFound),
+		errors.Is(err, domain.ErrEventNotPublished),
+		errors.Is(err, domain.ErrInvitationNotFound),
+		errors.Is(err, domain.ErrLimitOverrideNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: err.Error()})
+
+	case errors.Is(err, domain.ErrNoAvailableSpots),
+		errors.Is(err, domain.ErrAlreadyBooked),
+		errors.Is(err, domain.ErrBookingNotPending),
+		errors.Is(err, domain.ErrBookingExpired),
+		errors.Is(err, domain.ErrInvalidTransition),
+		errors.Is(err, domain.ErrEventCancelled),
+		errors.Is(err, domain.ErrSeriesCancelled),
+		errors.Is(err, domain.ErrSpotsBelowBooked),
+		errors.Is(err, domain.ErrVenueBusy),
+		errors.Is(err, domain.ErrVenueInUse),
+		errors.Is(err, domain.ErrCategoryExists),
+		errors.Is(err, domain.ErrEventNotDraft),
+		errors.Is(err, domain.ErrEventArchived),
+		errors.Is(err, domain.ErrEventStarted),
+		errors.Is(err, domain.ErrSalesNotOpen),
+		errors.Is(err, domain.ErrSalesClosed),
+		errors.Is(err, domain.ErrTooManyPendingBookings),
+		errors.Is(err, domain.ErrDailyBookingLimit),
+		errors.Is(err, domain.ErrBookingCooldown):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{Error: err.Error()})
+
+	case errors.Is(err, domain.ErrValidation),
 		errors.Is(err, domain.ErrUsernameTaken):
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
 
+	case errors.Is(err, domain.ErrCalendarTokenInvalid),
+		errors.Is(err, domain.ErrEventAccessDenied):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: err.Error()})
+
+	case errors.Is(err, domain.ErrTelegramDisabled):
+		c.JSON(http.StatusServiceUnavailable, dto.ErrorResponse{Error: err.Error()})
+
 	default:
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "internal server error"})
 	}
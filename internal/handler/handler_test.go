@@ -2,7 +2,12 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -12,40 +17,70 @@ import (
 	"github.com/stpnv0/EventBooker/internal/domain"
 	"github.com/stpnv0/EventBooker/internal/handler/dto"
 	hmocks "github.com/stpnv0/EventBooker/internal/handler/mocks"
+	"github.com/stpnv0/EventBooker/internal/router"
+	"github.com/stpnv0/EventBooker/web"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
-	"github.com/wb-go/wbf/ginext"
 )
 
-func setupRouter(t *testing.T) (*hmocks.MockEventSvc, *hmocks.MockBookingSvc, *hmocks.MockUserSvc, http.Handler) {
+// testServices — моки всех сервисов обработчика. Ожидания задаются только для тех,
+// которые нужны тесту.
+type testServices struct {
+	events        *hmocks.MockEventSvc
+	bookings      *hmocks.MockBookingSvc
+	users         *hmocks.MockUserSvc
+	telegramLinks *hmocks.MockTelegramLinkSvc
+	webhooks      *hmocks.MockWebhookSvc
+	series        *hmocks.MockSeriesSvc
+	venues        *hmocks.MockVenueSvc
+	calendar      *hmocks.MockCalendarSvc
+	stats         *hmocks.MockStatsSvc
+	categories    *hmocks.MockCategorySvc
+	bookingLimits *hmocks.MockBookingLimitSvc
+}
+
+// newTestRouter собирает роутер так же, как приложение, но с моками сервисов.
+func newTestRouter(t *testing.T) (*testServices, http.Handler) {
 	t.Helper()
-	eventSvc := hmocks.NewMockEventSvc(t)
-	bookingSvc := hmocks.NewMockBookingSvc(t)
-	userSvc := hmocks.NewMockUserSvc(t)
-
-	h := NewHandler(eventSvc, bookingSvc, userSvc)
-
-	r := ginext.New("test")
-	api := r.Group("/api")
-	{
-		api.POST("/events", h.CreateEvent)
-		api.GET("/events", h.ListEvents)
-		api.GET("/events/:id", h.GetEvent)
-		api.POST("/events/:id/book", h.BookEvent)
-		api.POST("/events/:id/confirm", h.ConfirmBooking)
-		api.POST("/users", h.CreateUser)
-		api.GET("/users", h.ListUsers)
-		api.GET("/users/:id/bookings", h.GetUserBookings)
+	svc := &testServices{
+		events:        hmocks.NewMockEventSvc(t),
+		bookings:      hmocks.NewMockBookingSvc(t),
+		users:         hmocks.NewMockUserSvc(t),
+		telegramLinks: hmocks.NewMockTelegramLinkSvc(t),
+		webhooks:      hmocks.NewMockWebhookSvc(t),
+		series:        hmocks.NewMockSeriesSvc(t),
+		venues:        hmocks.NewMockVenueSvc(t),
+		calendar:      hmocks.NewMockCalendarSvc(t),
+		stats:         hmocks.NewMockStatsSvc(t),
+		categories:    hmocks.NewMockCategorySvc(t),
+		bookingLimits: hmocks.NewMockBookingLimitSvc(t),
 	}
 
-	return eventSvc, bookingSvc, userSvc, r
+	h := NewHandler(Services{
+		Events:        svc.events,
+		Bookings:      svc.bookings,
+		Users:         svc.users,
+		TelegramLinks: svc.telegramLinks,
+		Webhooks:      svc.webhooks,
+		Series:        svc.series,
+		Venues:        svc.venues,
+		Calendar:      svc.calendar,
+		Stats:         svc.stats,
+		Categories:    svc.categories,
+		BookingLimits: svc.bookingLimits,
+	})
+
+	r, err := router.InitRouter("test", nil, h, web.FS)
+	require.NoError(t, err)
+
+	return svc, r
 }
 
 // --- Events ---
 
 func TestHandler_CreateEvent_Success(t *testing.T) {
-	eventSvc, _, _, r := setupRouter(t)
+	svc, r := newTestRouter(t)
 
 	now := time.Now().Add(24 * time.Hour)
 	event := &domain.Event{
@@ -59,7 +94,7 @@ func TestHandler_CreateEvent_Success(t *testing.T) {
 		CreatedAt:       time.Now(),
 	}
 
-	eventSvc.EXPECT().CreateEvent(mock.Anything, mock.Anything).Return(event, nil)
+	svc.events.EXPECT().CreateEvent(mock.Anything, mock.Anything).Return(event, nil)
 
 	body, _ := json.Marshal(dto.CreateEventRequest{
 		Title:       "Concert",
@@ -81,7 +116,7 @@ func TestHandler_CreateEvent_Success(t *testing.T) {
 }
 
 func TestHandler_CreateEvent_BadRequest(t *testing.T) {
-	_, _, _, r := setupRouter(t)
+	_, r := newTestRouter(t)
 
 	body := []byte(`{"title":""}`)
 
@@ -94,7 +129,7 @@ func TestHandler_CreateEvent_BadRequest(t *testing.T) {
 }
 
 func TestHandler_CreateEvent_InvalidDate(t *testing.T) {
-	_, _, _, r := setupRouter(t)
+	_, r := newTestRouter(t)
 
 	body := []byte(`{"title":"X","description":"Y","event_date":"not-a-date","total_spots":10}`)
 
@@ -107,7 +142,7 @@ func TestHandler_CreateEvent_InvalidDate(t *testing.T) {
 }
 
 func TestHandler_GetEvent_Success(t *testing.T) {
-	eventSvc, _, _, r := setupRouter(t)
+	svc, r := newTestRouter(t)
 
 	eventID := uuid.New().String()
 	details := &domain.EventDetails{
@@ -116,7 +151,7 @@ func TestHandler_GetEvent_Success(t *testing.T) {
 		Bookings:       []domain.Booking{},
 	}
 
-	eventSvc.EXPECT().GetDetails(mock.Anything, eventID).Return(details, nil)
+	svc.events.EXPECT().GetDetails(mock.Anything, eventID).Return(details, nil)
 
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodGet, "/api/events/"+eventID, nil)
@@ -130,7 +165,7 @@ func TestHandler_GetEvent_Success(t *testing.T) {
 }
 
 func TestHandler_GetEvent_InvalidID(t *testing.T) {
-	_, _, _, r := setupRouter(t)
+	_, r := newTestRouter(t)
 
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodGet, "/api/events/not-a-uuid", nil)
@@ -140,10 +175,10 @@ func TestHandler_GetEvent_InvalidID(t *testing.T) {
 }
 
 func TestHandler_GetEvent_NotFound(t *testing.T) {
-	eventSvc, _, _, r := setupRouter(t)
+	svc, r := newTestRouter(t)
 
 	eventID := uuid.New().String()
-	eventSvc.EXPECT().GetDetails(mock.Anything, eventID).Return(nil, domain.ErrEventNotFound)
+	svc.events.EXPECT().GetDetails(mock.Anything, eventID).Return(nil, domain.ErrEventNotFound)
 
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodGet, "/api/events/"+eventID, nil)
@@ -153,13 +188,13 @@ func TestHandler_GetEvent_NotFound(t *testing.T) {
 }
 
 func TestHandler_ListEvents_Success(t *testing.T) {
-	eventSvc, _, _, r := setupRouter(t)
+	svc, r := newTestRouter(t)
 
 	events := []*domain.Event{
 		{ID: "e1", Title: "Event 1", EventDate: time.Now(), CreatedAt: time.Now()},
 		{ID: "e2", Title: "Event 2", EventDate: time.Now(), CreatedAt: time.Now()},
 	}
-	eventSvc.EXPECT().List(mock.Anything).Return(events, nil)
+	svc.events.EXPECT().ListFiltered(mock.Anything, domain.EventFilter{}).Return(events, nil)
 
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodGet, "/api/events", nil)
@@ -172,10 +207,183 @@ func TestHandler_ListEvents_Success(t *testing.T) {
 	assert.Len(t, resp, 2)
 }
 
+func TestHandler_ListEvents_HappeningNow(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	start := time.Date(2030, 5, 1, 16, 0, 0, 0, time.UTC)
+	svc.events.EXPECT().ListFiltered(mock.Anything, mock.MatchedBy(func(f domain.EventFilter) bool {
+		return f.HappeningAt != nil
+	})).Return([]*domain.Event{
+		{ID: "e1", Title: "Live", EventDate: start, Duration: 2 * time.Hour, Timezone: "Europe/Moscow"},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/events?happening=now", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp []dto.EventResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, "2030-05-01T19:00:00+03:00", resp[0].EventDate)
+	assert.Equal(t, "2030-05-01T21:00:00+03:00", resp[0].EndDate)
+	assert.Equal(t, "Europe/Moscow", resp[0].Timezone)
+}
+
+func TestHandler_ListEvents_HappeningAt(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	at := time.Date(2030, 5, 1, 17, 0, 0, 0, time.UTC)
+	svc.events.EXPECT().ListFiltered(mock.Anything, mock.MatchedBy(func(f domain.EventFilter) bool {
+		return f.HappeningAt != nil && f.HappeningAt.Equal(at)
+	})).Return(nil, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/events?happening=2030-05-01T20:00:00%2B03:00", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestHandler_ListEvents_ByCategoryAndTag(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	svc.events.EXPECT().ListFiltered(mock.Anything, domain.EventFilter{Category: "live-music", Tag: "jazz"}).
+		Return([]*domain.Event{{ID: "e1", Title: "Jazz", CategoryIDs: []string{"c1"}, Tags: []string{"jazz"}}}, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/events?category=live-music&tag=jazz", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp []dto.EventResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, []string{"c1"}, resp[0].CategoryIDs)
+	assert.Equal(t, []string{"jazz"}, resp[0].Tags)
+}
+
+func TestHandler_ListEvents_AllStatuses(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	svc.events.EXPECT().ListFiltered(mock.Anything, domain.EventFilter{Statuses: domain.EventStatuses}).
+		Return([]*domain.Event{{ID: "e1", Status: domain.EventStatusDraft}}, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/events?status=all", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp []dto.EventResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, "draft", resp[0].Status)
+}
+
+func TestHandler_ListEvents_PrivateForViewer(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	userID := uuid.New().String()
+	svc.events.EXPECT().ListFiltered(mock.Anything, domain.EventFilter{ViewerID: userID}).
+		Return([]*domain.Event{{ID: "e1", Visibility: domain.EventVisibilityPrivate, AccessCode: "secret"}}, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/events?user_id="+userID, nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "secret")
+
+	var resp []dto.EventResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, "private", resp[0].Visibility)
+	assert.True(t, resp[0].HasAccessCode)
+}
+
+func TestHandler_ListEvents_InvalidStatus(t *testing.T) {
+	_, r := newTestRouter(t)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/events?status=draft,hidden", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_ListEvents_InvalidHappening(t *testing.T) {
+	_, r := newTestRouter(t)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/events?happening=tomorrow", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_SearchEvents_Success(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	from := time.Date(2030, 5, 1, 0, 0, 0, 0, time.UTC)
+	svc.events.EXPECT().Search(mock.Anything, mock.MatchedBy(func(in domain.EventSearchInput) bool {
+		return in.Query == "джаз вечер" && in.From != nil && in.From.Equal(from) && in.To == nil &&
+			in.Available && in.Limit == 5
+	})).Return([]*domain.EventSearchResult{{
+		Event:          domain.Event{ID: "e1", Title: "Джазовый вечер", EventDate: from.Add(48 * time.Hour)},
+		Rank:           0.42,
+		AvailableSpots: 7,
+	}}, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet,
+		"/api/events/search?q=%D0%B4%D0%B6%D0%B0%D0%B7+%D0%B2%D0%B5%D1%87%D0%B5%D1%80&from=2030-05-01T00:00:00Z&available=true&limit=5", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp []dto.EventSearchResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, "e1", resp[0].Event.ID)
+	assert.Equal(t, 7, resp[0].AvailableSpots)
+	assert.InDelta(t, 0.42, resp[0].Rank, 1e-9)
+}
+
+func TestHandler_SearchEvents_BadRequest(t *testing.T) {
+	_, r := newTestRouter(t)
+
+	for _, url := range []string{
+		"/api/events/search",
+		"/api/events/search?q=jazz&from=tomorrow",
+		"/api/events/search?q=jazz&limit=500",
+	} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, url, nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, url)
+	}
+}
+
+func TestHandler_CreateEvent_InvalidEndDate(t *testing.T) {
+	_, r := newTestRouter(t)
+
+	body := []byte(`{"title":"X","description":"Y","event_date":"2030-01-01T19:00:00Z","end_date":"later","total_spots":10}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/events", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
 // --- Bookings ---
 
 func TestHandler_BookEvent_Success(t *testing.T) {
-	_, bookingSvc, _, r := setupRouter(t)
+	svc, r := newTestRouter(t)
 
 	eventID := uuid.New().String()
 	userID := uuid.New().String()
@@ -187,7 +395,7 @@ func TestHandler_BookEvent_Success(t *testing.T) {
 		CreatedAt: time.Now(),
 	}
 
-	bookingSvc.EXPECT().Book(mock.Anything, eventID, userID).Return(booking, nil)
+	svc.bookings.EXPECT().Book(mock.Anything, eventID, userID, "").Return(booking, nil)
 
 	body, _ := json.Marshal(dto.BookRequest{UserID: userID})
 
@@ -204,7 +412,7 @@ func TestHandler_BookEvent_Success(t *testing.T) {
 }
 
 func TestHandler_BookEvent_InvalidEventID(t *testing.T) {
-	_, _, _, r := setupRouter(t)
+	_, r := newTestRouter(t)
 
 	body := []byte(`{"user_id":"` + uuid.New().String() + `"}`)
 
@@ -216,13 +424,68 @@ func TestHandler_BookEvent_InvalidEventID(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 }
 
+func TestHandler_BookEvent_SalesWindow(t *testing.T) {
+	for _, want := range []error{domain.ErrSalesNotOpen, domain.ErrSalesClosed, domain.ErrEventStarted} {
+		t.Run(want.Error(), func(t *testing.T) {
+			svc, r := newTestRouter(t)
+
+			eventID := uuid.New().String()
+			userID := uuid.New().String()
+			svc.bookings.EXPECT().Book(mock.Anything, eventID, userID, "").Return(nil, want)
+
+			body, _ := json.Marshal(dto.BookRequest{UserID: userID})
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/api/events/"+eventID+"/book", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusConflict, w.Code)
+		})
+	}
+}
+
+func TestHandler_BookEvent_Draft(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	eventID := uuid.New().String()
+	userID := uuid.New().String()
+	svc.bookings.EXPECT().Book(mock.Anything, eventID, userID, "").Return(nil, domain.ErrEventNotPublished)
+
+	body, _ := json.Marshal(dto.BookRequest{UserID: userID})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/events/"+eventID+"/book", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandler_BookEvent_PrivateAccessDenied(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	eventID := uuid.New().String()
+	userID := uuid.New().String()
+	svc.bookings.EXPECT().Book(mock.Anything, eventID, userID, "guess").Return(nil, domain.ErrEventAccessDenied)
+
+	body, _ := json.Marshal(dto.BookRequest{UserID: userID, AccessCode: "guess"})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/events/"+eventID+"/book", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
 func TestHandler_BookEvent_NoSpots(t *testing.T) {
-	_, bookingSvc, _, r := setupRouter(t)
+	svc, r := newTestRouter(t)
 
 	eventID := uuid.New().String()
 	userID := uuid.New().String()
 
-	bookingSvc.EXPECT().Book(mock.Anything, eventID, userID).Return(nil, domain.ErrNoAvailableSpots)
+	svc.bookings.EXPECT().Book(mock.Anything, eventID, userID, "").Return(nil, domain.ErrNoAvailableSpots)
 
 	body, _ := json.Marshal(dto.BookRequest{UserID: userID})
 
@@ -235,12 +498,12 @@ func TestHandler_BookEvent_NoSpots(t *testing.T) {
 }
 
 func TestHandler_ConfirmBooking_Success(t *testing.T) {
-	_, bookingSvc, _, r := setupRouter(t)
+	svc, r := newTestRouter(t)
 
 	eventID := uuid.New().String()
 	userID := uuid.New().String()
 
-	bookingSvc.EXPECT().Confirm(mock.Anything, eventID, userID).Return(nil)
+	svc.bookings.EXPECT().Confirm(mock.Anything, eventID, userID).Return(nil)
 
 	body, _ := json.Marshal(dto.ConfirmRequest{UserID: userID})
 
@@ -253,7 +516,7 @@ func TestHandler_ConfirmBooking_Success(t *testing.T) {
 }
 
 func TestHandler_ConfirmBooking_InvalidEventID(t *testing.T) {
-	_, _, _, r := setupRouter(t)
+	_, r := newTestRouter(t)
 
 	body := []byte(`{"user_id":"` + uuid.New().String() + `"}`)
 
@@ -266,12 +529,12 @@ func TestHandler_ConfirmBooking_InvalidEventID(t *testing.T) {
 }
 
 func TestHandler_ConfirmBooking_Expired(t *testing.T) {
-	_, bookingSvc, _, r := setupRouter(t)
+	svc, r := newTestRouter(t)
 
 	eventID := uuid.New().String()
 	userID := uuid.New().String()
 
-	bookingSvc.EXPECT().Confirm(mock.Anything, eventID, userID).Return(domain.ErrBookingExpired)
+	svc.bookings.EXPECT().Confirm(mock.Anything, eventID, userID).Return(domain.ErrBookingExpired)
 
 	body, _ := json.Marshal(dto.ConfirmRequest{UserID: userID})
 
@@ -283,17 +546,70 @@ func TestHandler_ConfirmBooking_Expired(t *testing.T) {
 	assert.Equal(t, http.StatusConflict, w.Code)
 }
 
+func TestHandler_GetBookingHistory_Success(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	bookingID := uuid.New().String()
+	userID := uuid.New().String()
+	requestID := "req-1"
+	pending := domain.BookingStatusPending
+	at := time.Date(2030, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	svc.bookings.EXPECT().History(mock.Anything, bookingID).Return([]*domain.BookingHistoryEntry{
+		{
+			BookingID: bookingID, ToStatus: domain.BookingStatusPending,
+			Actor: domain.BookingActorUser, ActorID: &userID, Reason: domain.BookingReasonCreated,
+			RequestID: &requestID, CreatedAt: at,
+		},
+		{
+			BookingID: bookingID, FromStatus: &pending, ToStatus: domain.BookingStatusCancelled,
+			Actor: domain.BookingActorSystem, Reason: domain.BookingReasonExpired, CreatedAt: at.Add(time.Hour),
+		},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/bookings/"+bookingID+"/history", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp []dto.BookingHistoryResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp, 2)
+	assert.Nil(t, resp[0].FromStatus)
+	assert.Equal(t, "user", resp[0].Actor)
+	assert.Equal(t, &userID, resp[0].ActorID)
+	assert.Equal(t, &requestID, resp[0].RequestID)
+	assert.Equal(t, "pending", *resp[1].FromStatus)
+	assert.Equal(t, "cancelled", resp[1].ToStatus)
+	assert.Equal(t, "expired", resp[1].Reason)
+	assert.Nil(t, resp[1].ActorID)
+}
+
+func TestHandler_GetBookingHistory_NotFound(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	bookingID := uuid.New().String()
+	svc.bookings.EXPECT().History(mock.Anything, bookingID).Return(nil, domain.ErrBookingNotFound)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/bookings/"+bookingID+"/history", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
 // --- Users ---
 
 func TestHandler_CreateUser_Success(t *testing.T) {
-	_, _, userSvc, r := setupRouter(t)
+	svc, r := newTestRouter(t)
 
 	user := &domain.User{
 		ID:        uuid.New().String(),
 		Username:  "alice",
 		CreatedAt: time.Now(),
 	}
-	userSvc.EXPECT().Create(mock.Anything, mock.Anything).Return(user, nil)
+	svc.users.EXPECT().Create(mock.Anything, mock.Anything).Return(user, nil)
 
 	body, _ := json.Marshal(dto.CreateUserRequest{Username: "alice"})
 
@@ -310,7 +626,7 @@ func TestHandler_CreateUser_Success(t *testing.T) {
 }
 
 func TestHandler_CreateUser_BadRequest(t *testing.T) {
-	_, _, _, r := setupRouter(t)
+	_, r := newTestRouter(t)
 
 	body := []byte(`{}`)
 
@@ -323,9 +639,9 @@ func TestHandler_CreateUser_BadRequest(t *testing.T) {
 }
 
 func TestHandler_CreateUser_UsernameTaken(t *testing.T) {
-	_, _, userSvc, r := setupRouter(t)
+	svc, r := newTestRouter(t)
 
-	userSvc.EXPECT().Create(mock.Anything, mock.Anything).Return(nil, domain.ErrUsernameTaken)
+	svc.users.EXPECT().Create(mock.Anything, mock.Anything).Return(nil, domain.ErrUsernameTaken)
 
 	body, _ := json.Marshal(dto.CreateUserRequest{Username: "taken"})
 
@@ -338,12 +654,12 @@ func TestHandler_CreateUser_UsernameTaken(t *testing.T) {
 }
 
 func TestHandler_ListUsers_Success(t *testing.T) {
-	_, _, userSvc, r := setupRouter(t)
+	svc, r := newTestRouter(t)
 
 	users := []*domain.User{
 		{ID: "u1", Username: "alice", CreatedAt: time.Now()},
 	}
-	userSvc.EXPECT().List(mock.Anything).Return(users, nil)
+	svc.users.EXPECT().List(mock.Anything).Return(users, nil)
 
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodGet, "/api/users", nil)
@@ -357,14 +673,14 @@ func TestHandler_ListUsers_Success(t *testing.T) {
 }
 
 func TestHandler_GetUserBookings_Success(t *testing.T) {
-	_, bookingSvc, _, r := setupRouter(t)
+	svc, r := newTestRouter(t)
 
 	userID := uuid.New().String()
 	bookings := []*domain.Booking{
 		{ID: "b1", EventID: "e1", UserID: userID, Status: domain.BookingStatusPending, CreatedAt: time.Now()},
 	}
 
-	bookingSvc.EXPECT().ListByUser(mock.Anything, userID).Return(bookings, nil)
+	svc.bookings.EXPECT().ListByUser(mock.Anything, userID).Return(bookings, nil)
 
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodGet, "/api/users/"+userID+"/bookings", nil)
@@ -378,7 +694,7 @@ func TestHandler_GetUserBookings_Success(t *testing.T) {
 }
 
 func TestHandler_GetUserBookings_InvalidID(t *testing.T) {
-	_, _, _, r := setupRouter(t)
+	_, r := newTestRouter(t)
 
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodGet, "/api/users/bad-id/bookings", nil)
@@ -387,11 +703,81 @@ func TestHandler_GetUserBookings_InvalidID(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 }
 
+func TestHandler_GetNotificationPreferences_Success(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	userID := uuid.New().String()
+	svc.users.EXPECT().GetNotificationPreferences(mock.Anything, userID).
+		Return(domain.NewNotificationPreferences(userID), nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/users/"+userID+"/notification-preferences", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp dto.NotificationPreferencesResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.True(t, resp.Preferences["telegram"]["booking_created"])
+	assert.False(t, resp.Preferences["webhook"]["booking_created"])
+}
+
+func TestHandler_GetNotificationPreferences_UserNotFound(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	userID := uuid.New().String()
+	svc.users.EXPECT().GetNotificationPreferences(mock.Anything, userID).Return(nil, domain.ErrUserNotFound)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/users/"+userID+"/notification-preferences", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandler_UpdateNotificationPreferences_Success(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	userID := uuid.New().String()
+	updated := domain.NewNotificationPreferences(userID)
+	updated.Set(domain.ChannelEmail, domain.NotificationBookingCreated, false)
+
+	changes := map[domain.NotificationChannel]map[domain.NotificationKind]bool{
+		domain.ChannelEmail: {domain.NotificationBookingCreated: false},
+	}
+	svc.users.EXPECT().UpdateNotificationPreferences(mock.Anything, userID, changes).Return(updated, nil)
+
+	body := []byte(`{"preferences":{"email":{"booking_created":false}}}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPut, "/api/users/"+userID+"/notification-preferences", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp dto.NotificationPreferencesResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.False(t, resp.Preferences["email"]["booking_created"])
+}
+
+func TestHandler_UpdateNotificationPreferences_BadRequest(t *testing.T) {
+	_, r := newTestRouter(t)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPut, "/api/users/"+uuid.New().String()+"/notification-preferences",
+		bytes.NewReader([]byte(`{}`)))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
 func TestHandler_HandleError_InternalError(t *testing.T) {
-	eventSvc, _, _, r := setupRouter(t)
+	svc, r := newTestRouter(t)
 
 	eventID := uuid.New().String()
-	eventSvc.EXPECT().GetDetails(mock.Anything, eventID).Return(nil, assert.AnError)
+	svc.events.EXPECT().GetDetails(mock.Anything, eventID).Return(nil, assert.AnError)
 
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodGet, "/api/events/"+eventID, nil)
@@ -399,3 +785,944 @@ func TestHandler_HandleError_InternalError(t *testing.T) {
 
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
 }
+
+// --- Telegram link ---
+
+func TestHandler_CreateTelegramLink_Success(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	userID := uuid.New().String()
+	expiresAt := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc.telegramLinks.EXPECT().CreateLink(mock.Anything, userID).Return(&domain.TelegramLink{
+		Token:     "abc",
+		URL:       "https://t.me/event_booker_bot?start=abc",
+		ExpiresAt: expiresAt,
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/users/"+userID+"/telegram-link", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var resp dto.TelegramLinkResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "https://t.me/event_booker_bot?start=abc", resp.URL)
+	assert.Equal(t, "2030-01-01T12:00:00Z", resp.ExpiresAt)
+}
+
+func TestHandler_CreateTelegramLink_InvalidUserID(t *testing.T) {
+	_, r := newTestRouter(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/users/not-a-uuid/telegram-link", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_CreateTelegramLink_BotNotConfigured(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	userID := uuid.New().String()
+	svc.telegramLinks.EXPECT().CreateLink(mock.Anything, userID).Return(nil, domain.ErrTelegramDisabled)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/users/"+userID+"/telegram-link", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
+
+// --- Webhooks ---
+
+func TestHandler_CreateWebhook_ReturnsSecretOnce(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	sub := &domain.WebhookSubscription{
+		ID:         uuid.New().String(),
+		URL:        "https://partner.example/hook",
+		Secret:     "s3cret",
+		EventTypes: []domain.WebhookEventType{domain.WebhookBookingCreated},
+		Active:     true,
+	}
+	svc.webhooks.EXPECT().CreateSubscription(mock.Anything, domain.CreateWebhookInput{
+		URL:        "https://partner.example/hook",
+		EventTypes: []domain.WebhookEventType{domain.WebhookBookingCreated},
+	}).Return(sub, nil)
+	svc.webhooks.EXPECT().GetSubscription(mock.Anything, sub.ID).Return(sub, nil)
+
+	body := `{"url":"https://partner.example/hook","event_types":["booking.created"]}`
+	req := httptest.NewRequest(http.MethodPost, "/api/webhooks", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	var resp dto.WebhookResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "s3cret", resp.Secret)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/webhooks/"+sub.ID, nil)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "s3cret")
+}
+
+func TestHandler_CreateWebhook_InvalidEventType(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	svc.webhooks.EXPECT().CreateSubscription(mock.Anything, mock.Anything).
+		Return(nil, domain.ErrValidation)
+
+	body := `{"url":"https://partner.example/hook","event_types":["booking.exploded"]}`
+	req := httptest.NewRequest(http.MethodPost, "/api/webhooks", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_UpdateWebhook_NotFound(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	id := uuid.New().String()
+	active := false
+	svc.webhooks.EXPECT().UpdateSubscription(mock.Anything, id, domain.UpdateWebhookInput{Active: &active}).
+		Return(nil, domain.ErrWebhookNotFound)
+
+	req := httptest.NewRequest(http.MethodPut, "/api/webhooks/"+id, bytes.NewBufferString(`{"active":false}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandler_GetWebhookDelivery_WithAttempts(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	id, deliveryID := uuid.New().String(), uuid.New().String()
+	status := 500
+	errMsg := "unexpected status 500"
+	svc.webhooks.EXPECT().GetDelivery(mock.Anything, id, deliveryID).Return(&domain.WebhookDelivery{
+		ID:             deliveryID,
+		SubscriptionID: id,
+		EventType:      domain.WebhookBookingConfirmed,
+		Payload:        []byte(`{"type":"booking.confirmed"}`),
+		Status:         domain.WebhookDeliveryPending,
+		Attempts:       1,
+		AttemptLog: []domain.WebhookDeliveryAttempt{
+			{Attempt: 1, ResponseStatus: &status, Error: &errMsg, Duration: 120 * time.Millisecond},
+		},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/webhooks/"+id+"/deliveries/"+deliveryID, nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp dto.WebhookDeliveryResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.JSONEq(t, `{"type":"booking.confirmed"}`, string(resp.Payload))
+	require.Len(t, resp.AttemptLog, 1)
+	assert.Equal(t, int64(120), resp.AttemptLog[0].DurationMs)
+}
+
+func TestHandler_ReplayWebhookDelivery_InvalidDeliveryID(t *testing.T) {
+	_, r := newTestRouter(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/webhooks/"+uuid.New().String()+"/deliveries/bad/replay", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// --- Series ---
+
+func TestHandler_CreateSeries_Success(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	seriesID := uuid.New().String()
+	svc.series.EXPECT().Create(mock.Anything, mock.MatchedBy(func(in domain.CreateSeriesInput) bool {
+		return in.Recurrence.Freq == domain.FreqWeekly &&
+			assert.ObjectsAreEqual([]time.Weekday{time.Tuesday, time.Thursday}, in.Recurrence.ByWeekday) &&
+			in.Recurrence.Count == 8 && len(in.Exceptions) == 1 && in.Timezone == "Europe/Moscow"
+	})).Return(&domain.SeriesDetails{
+		Series: domain.EventSeries{
+			ID:         seriesID,
+			Title:      "Go workshop",
+			Timezone:   "Europe/Moscow",
+			Recurrence: domain.Recurrence{Freq: domain.FreqWeekly, Interval: 1, ByWeekday: []time.Weekday{time.Tuesday}},
+		},
+		Occurrences: []*domain.Event{{ID: uuid.New().String(), SeriesID: &seriesID}},
+	}, nil)
+
+	body := `{"title":"Go workshop","start":"2030-03-19T19:00:00+03:00","timezone":"Europe/Moscow","total_spots":20,
+		"recurrence":{"freq":"weekly","by_weekday":["TU","th"],"count":8},
+		"exceptions":["2030-03-26T19:00:00+03:00"]}`
+	req := httptest.NewRequest(http.MethodPost, "/api/series", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	var resp dto.SeriesDetailsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, []string{"TU"}, resp.Recurrence.ByWeekday)
+	require.Len(t, resp.Occurrences, 1)
+	assert.Equal(t, seriesID, resp.Occurrences[0].SeriesID)
+}
+
+func TestHandler_CreateSeries_InvalidWeekday(t *testing.T) {
+	_, r := newTestRouter(t)
+
+	body := `{"title":"Go workshop","start":"2030-03-19T19:00:00Z","total_spots":20,
+		"recurrence":{"freq":"weekly","by_weekday":["XX"]}}`
+	req := httptest.NewRequest(http.MethodPost, "/api/series", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_CancelSeries_AlreadyCancelled(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	id := uuid.New().String()
+	svc.series.EXPECT().Cancel(mock.Anything, id).Return(domain.ErrSeriesCancelled)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/series/"+id+"/cancel", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestHandler_UpdateEvent_SpotsBelowBooked(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	id := uuid.New().String()
+	spots := 2
+	svc.events.EXPECT().UpdateEvent(mock.Anything, id, domain.UpdateEventInput{TotalSpots: &spots}).
+		Return(nil, domain.ErrSpotsBelowBooked)
+
+	req := httptest.NewRequest(http.MethodPut, "/api/events/"+id, bytes.NewBufferString(`{"total_spots":2}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestHandler_CancelEvent_Success(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	id := uuid.New().String()
+	svc.events.EXPECT().CancelEvent(mock.Anything, id).Return(nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/events/"+id+"/cancel", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestHandler_PublishEvent_Success(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	id := uuid.New().String()
+	now := time.Now().UTC()
+	svc.events.EXPECT().Publish(mock.Anything, id).Return(&domain.Event{
+		ID: id, EventDate: now.Add(time.Hour), Status: domain.EventStatusPublished, PublishedAt: &now,
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/events/"+id+"/publish", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp dto.EventResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "published", resp.Status)
+	assert.NotEmpty(t, resp.PublishedAt)
+}
+
+func TestHandler_PublishEvent_Errors(t *testing.T) {
+	tests := map[error]int{
+		domain.ErrEventNotDraft: http.StatusConflict,
+		domain.ErrValidation:    http.StatusBadRequest,
+		domain.ErrEventNotFound: http.StatusNotFound,
+	}
+	for want, code := range tests {
+		t.Run(want.Error(), func(t *testing.T) {
+			svc, r := newTestRouter(t)
+
+			id := uuid.New().String()
+			svc.events.EXPECT().Publish(mock.Anything, id).Return(nil, want)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/events/"+id+"/publish", nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, code, w.Code)
+		})
+	}
+}
+
+func TestHandler_ArchiveEvent_AlreadyArchived(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	id := uuid.New().String()
+	svc.events.EXPECT().Archive(mock.Anything, id).Return(nil, domain.ErrEventArchived)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/events/"+id+"/archive", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestHandler_InviteToEvent_Success(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	eventID := uuid.New().String()
+	userID := uuid.New().String()
+	svc.events.EXPECT().Invite(mock.Anything, eventID, []string{userID}).Return([]*domain.EventInvitation{
+		{EventID: eventID, UserID: userID, Username: "alice", CreatedAt: time.Now()},
+	}, nil)
+
+	body := `{"user_ids":["` + userID + `"]}`
+	req := httptest.NewRequest(http.MethodPost, "/api/events/"+eventID+"/invitations", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var resp []dto.InvitationResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, "alice", resp[0].Username)
+}
+
+func TestHandler_InviteToEvent_InvalidUserID(t *testing.T) {
+	_, r := newTestRouter(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/events/"+uuid.New().String()+"/invitations",
+		bytes.NewBufferString(`{"user_ids":["alice"]}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_RevokeEventInvitation_NotFound(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	eventID := uuid.New().String()
+	userID := uuid.New().String()
+	svc.events.EXPECT().RevokeInvitation(mock.Anything, eventID, userID).Return(domain.ErrInvitationNotFound)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/events/"+eventID+"/invitations/"+userID, nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+// --- Venues ---
+
+func TestHandler_CreateVenue_Success(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	lat, lon := 55.7558, 37.6173
+	svc.venues.EXPECT().Create(mock.Anything, domain.CreateVenueInput{
+		Name: "Hall A", Address: "Tverskaya 1", Latitude: &lat, Longitude: &lon,
+		Capacity: 120, Timezone: "Europe/Moscow",
+	}).Return(&domain.Venue{
+		ID: uuid.New().String(), Name: "Hall A", Address: "Tverskaya 1",
+		Latitude: &lat, Longitude: &lon, Capacity: 120, Timezone: "Europe/Moscow",
+	}, nil)
+
+	body := `{"name":"Hall A","address":"Tverskaya 1","latitude":55.7558,"longitude":37.6173,
+		"capacity":120,"timezone":"Europe/Moscow"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/venues", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var resp dto.VenueResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, 120, resp.Capacity)
+	require.NotNil(t, resp.Latitude)
+	assert.InDelta(t, lat, *resp.Latitude, 1e-9)
+}
+
+func TestHandler_CreateVenue_InvalidCoordinates(t *testing.T) {
+	_, r := newTestRouter(t)
+
+	body := `{"name":"Hall A","address":"Tverskaya 1","latitude":91,"longitude":0,"capacity":10}`
+	req := httptest.NewRequest(http.MethodPost, "/api/venues", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_GetVenue_NotFound(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	id := uuid.New().String()
+	svc.venues.EXPECT().Get(mock.Anything, id).Return(nil, domain.ErrVenueNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/venues/"+id, nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandler_DeleteVenue_InUse(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	id := uuid.New().String()
+	svc.venues.EXPECT().Delete(mock.Anything, id).Return(domain.ErrVenueInUse)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/venues/"+id, nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestHandler_CreateEvent_AtVenueWithoutSpots(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	venueID := uuid.New().String()
+	svc.events.EXPECT().CreateEvent(mock.Anything, mock.MatchedBy(func(in domain.CreateEventInput) bool {
+		return in.TotalSpots == 0 && in.VenueID != nil && *in.VenueID == venueID && in.Duration == 90*time.Minute
+	})).Return(&domain.Event{ID: uuid.New().String(), TotalSpots: 120, VenueID: &venueID, Duration: 90 * time.Minute}, nil)
+
+	body := `{"title":"Talk","description":"D","event_date":"2030-01-01T19:00:00Z","venue_id":"` + venueID + `","duration_minutes":90}`
+	req := httptest.NewRequest(http.MethodPost, "/api/events", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var resp dto.EventResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, venueID, resp.VenueID)
+	assert.Equal(t, "1h30m0s", resp.Duration)
+}
+
+func TestHandler_CreateEvent_VenueBusy(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	svc.events.EXPECT().CreateEvent(mock.Anything, mock.Anything).Return(nil, domain.ErrVenueBusy)
+
+	body := `{"title":"Talk","description":"D","event_date":"2030-01-01T19:00:00Z","total_spots":10,"venue_id":"` +
+		uuid.New().String() + `"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/events", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestHandler_UpdateEvent_DetachVenue(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	id := uuid.New().String()
+	svc.events.EXPECT().UpdateEvent(mock.Anything, id, mock.MatchedBy(func(in domain.UpdateEventInput) bool {
+		return in.VenueID != nil && *in.VenueID == ""
+	})).Return(&domain.Event{ID: id}, nil)
+
+	req := httptest.NewRequest(http.MethodPut, "/api/events/"+id, bytes.NewBufferString(`{"venue_id":""}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestHandler_UpdateEvent_InvalidVenueID(t *testing.T) {
+	_, r := newTestRouter(t)
+
+	req := httptest.NewRequest(http.MethodPut, "/api/events/"+uuid.New().String(), bytes.NewBufferString(`{"venue_id":"hall"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_UpdateEvent_SalesWindow(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	id := uuid.New().String()
+	opens := time.Date(2030, 1, 1, 10, 0, 0, 0, time.UTC)
+	svc.events.EXPECT().UpdateEvent(mock.Anything, id, mock.MatchedBy(func(in domain.UpdateEventInput) bool {
+		return in.SalesOpenAt != nil && in.SalesOpenAt.Equal(opens) &&
+			in.SalesCloseAt != nil && in.SalesCloseAt.IsZero()
+	})).Return(&domain.Event{ID: id, SalesOpenAt: &opens}, nil)
+
+	body := `{"sales_open_at":"2030-01-01T13:00:00+03:00","sales_close_at":""}`
+	req := httptest.NewRequest(http.MethodPut, "/api/events/"+id, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp dto.EventResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "2030-01-01T10:00:00Z", resp.SalesOpenAt)
+	assert.Empty(t, resp.SalesCloseAt)
+}
+
+func TestHandler_CreateEvent_InvalidSalesOpenAt(t *testing.T) {
+	_, r := newTestRouter(t)
+
+	body := `{"title":"X","description":"Y","event_date":"2030-01-01T19:00:00Z","total_spots":10,"sales_open_at":"soon"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/events", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_GetEventICS(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	id := uuid.New().String()
+	svc.calendar.EXPECT().EventICS(mock.Anything, id).
+		Return(&domain.Event{ID: id}, []byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"), nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/events/"+id+"/ics", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/calendar; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Header().Get("Content-Disposition"), "event-"+id+".ics")
+	assert.Contains(t, w.Body.String(), "BEGIN:VCALENDAR")
+}
+
+func TestHandler_CreateCalendarToken(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	id := uuid.New().String()
+	svc.calendar.EXPECT().CreateFeedToken(mock.Anything, id).Return(&domain.CalendarFeed{
+		Token: "secret", Path: "/api/users/" + id + "/calendar.ics?token=secret",
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/users/"+id+"/calendar-token", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var resp dto.CalendarFeedResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "secret", resp.Token)
+	assert.Contains(t, resp.URL, "/calendar.ics?token=secret")
+}
+
+func TestHandler_GetUserCalendar_InvalidToken(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	id := uuid.New().String()
+	svc.calendar.EXPECT().UserFeed(mock.Anything, id, "wrong").Return(nil, domain.ErrCalendarTokenInvalid)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/users/"+id+"/calendar.ics?token=wrong", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestHandler_ImportEvents_MultipartCSV(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	const csv = "title,event_date\nConcert,2030-05-01T19:00:00Z\n"
+	venueID := uuid.New().String()
+	svc.events.EXPECT().Import(mock.Anything, mock.MatchedBy(func(in domain.ImportInput) bool {
+		data, _ := io.ReadAll(in.Data)
+		return in.Format == domain.ImportCSV && !in.DryRun && string(data) == csv &&
+			in.VenueID != nil && *in.VenueID == venueID && in.TotalSpots == 40
+	})).Return(&domain.ImportResult{
+		Total:  1,
+		Events: []*domain.Event{{ID: uuid.New().String(), Title: "Concert", EventDate: time.Now()}},
+	}, nil)
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	fw, err := mw.CreateFormFile("file", "events.csv")
+	require.NoError(t, err)
+	_, _ = fw.Write([]byte(csv))
+	require.NoError(t, mw.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/events/import?venue_id="+venueID+"&total_spots=40", &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var resp dto.ImportResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Len(t, resp.Events, 1)
+	assert.Empty(t, resp.Errors)
+}
+
+func TestHandler_ImportEvents_DryRunICSBody(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	svc.events.EXPECT().Import(mock.Anything, mock.MatchedBy(func(in domain.ImportInput) bool {
+		return in.Format == domain.ImportICS && in.DryRun
+	})).Return(&domain.ImportResult{
+		DryRun: true,
+		Total:  1,
+		Errors: []domain.ImportError{{Row: 1, Title: "Meetup", Message: "validation error: total_spots must be positive"}},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/events/import?dry_run=true", bytes.NewBufferString("BEGIN:VCALENDAR"))
+	req.Header.Set("Content-Type", "text/calendar")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp dto.ImportResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.True(t, resp.DryRun)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, 1, resp.Errors[0].Row)
+}
+
+func TestHandler_ImportEvents_RowErrors(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	svc.events.EXPECT().Import(mock.Anything, mock.Anything).Return(&domain.ImportResult{
+		Total:  2,
+		Errors: []domain.ImportError{{Row: 3, Message: "validation error: title is required"}},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/events/import?format=csv", bytes.NewBufferString("title,event_date\n"))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
+
+func TestHandler_ImportEvents_UnknownFormat(t *testing.T) {
+	_, r := newTestRouter(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/events/import", bytes.NewBufferString("title,event_date\n"))
+	req.Header.Set("Content-Type", "application/octet-stream")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_ImportEvents_TooLarge(t *testing.T) {
+	_, r := newTestRouter(t)
+
+	body := bytes.Repeat([]byte("a"), maxImportSize+1)
+	req := httptest.NewRequest(http.MethodPost, "/api/events/import?format=csv", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+}
+
+func TestHandler_ExportAttendeesCSV(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	id := uuid.New().String()
+	svc.events.EXPECT().GetByID(mock.Anything, id).Return(&domain.Event{ID: id, Timezone: "UTC"}, nil)
+	svc.events.EXPECT().StreamAttendees(mock.Anything, id,
+		[]domain.BookingStatus{domain.BookingStatusConfirmed, domain.BookingStatusCancelled}, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ string, _ []domain.BookingStatus, fn func(*domain.Attendee) error) error {
+			return fn(&domain.Attendee{
+				BookingID: "b1", UserID: "u1", Username: "alice",
+				Status: domain.BookingStatusConfirmed, BookedAt: time.Date(2030, 5, 1, 9, 0, 0, 0, time.UTC),
+			})
+		})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/events/"+id+"/attendees.csv?status=confirmed,cancelled", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Header().Get("Content-Disposition"), "attendees-"+id+".csv")
+	assert.Contains(t, w.Body.String(), "b1,u1,alice,confirmed,2030-05-01T09:00:00Z,")
+}
+
+func TestHandler_ExportAttendeesXLSX_StreamErrorBeforeOutput(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	id := uuid.New().String()
+	svc.events.EXPECT().GetByID(mock.Anything, id).Return(&domain.Event{ID: id}, nil)
+	svc.events.EXPECT().StreamAttendees(mock.Anything, id, []domain.BookingStatus(nil), mock.Anything).
+		Return(errors.New("connection refused"))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/events/"+id+"/attendees.xlsx", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Empty(t, w.Header().Get("Content-Disposition"))
+	assert.Contains(t, w.Header().Get("Content-Type"), "application/json")
+}
+
+func TestHandler_ExportAttendees_UnknownStatus(t *testing.T) {
+	_, r := newTestRouter(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/events/"+uuid.New().String()+"/attendees.csv?status=paid", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_ExportAttendees_EventNotFound(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	id := uuid.New().String()
+	svc.events.EXPECT().GetByID(mock.Anything, id).Return(nil, domain.ErrEventNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/events/"+id+"/attendees.xlsx", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandler_GetAdminStats(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	msk, _ := time.LoadLocation("Europe/Moscow")
+	from := time.Date(2030, 5, 1, 0, 0, 0, 0, msk)
+	svc.stats.EXPECT().BookingStats(mock.Anything, domain.StatsInput{
+		From:     time.Date(2030, 5, 1, 0, 0, 0, 0, time.UTC),
+		To:       time.Date(2030, 5, 2, 0, 0, 0, 0, time.UTC),
+		Timezone: "Europe/Moscow",
+		Top:      5,
+	}).Return(&domain.BookingStats{
+		Range: domain.StatsRange{From: from, To: from.AddDate(0, 0, 2), Location: msk},
+		Daily: []domain.DailyBookingStats{
+			{Day: from, Created: 4, Confirmed: 3, Expired: 1},
+			{Day: from.AddDate(0, 0, 1)},
+		},
+		Conversion: domain.ConversionStats{Resolved: 4, Confirmed: 3, Rate: 0.75, AvgTimeToConfirm: 90 * time.Second},
+		TopEvents: []domain.EventOccupancy{{
+			EventID: "e1", Title: "Concert", EventDate: time.Date(2030, 5, 1, 16, 0, 0, 0, time.UTC),
+			TotalSpots: 10, Confirmed: 7, Pending: 1, FillRate: 0.8,
+		}},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/admin/stats?from=2030-05-01&to=2030-05-02&tz=Europe/Moscow&top=5", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp dto.AdminStatsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "2030-05-01", resp.From)
+	assert.Equal(t, "2030-05-02", resp.To)
+	require.Len(t, resp.Daily, 2)
+	assert.Equal(t, "2030-05-01", resp.Daily[0].Date)
+	assert.Equal(t, 1, resp.Daily[0].Expired)
+	assert.InDelta(t, 90, resp.Conversion.AvgTimeToConfirmSeconds, 1e-9)
+	require.Len(t, resp.TopEvents, 1)
+	assert.Equal(t, "2030-05-01T19:00:00+03:00", resp.TopEvents[0].EventDate)
+	assert.NotNil(t, resp.Occupancy)
+}
+
+func TestHandler_GetAdminStats_InvalidDate(t *testing.T) {
+	_, r := newTestRouter(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/admin/stats?from=01.05.2030", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// --- Categories ---
+
+func TestHandler_CreateCategory_Conflict(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	svc.categories.EXPECT().Create(mock.Anything, domain.CreateCategoryInput{Slug: "music", Name: "Music"}).
+		Return(nil, domain.ErrCategoryExists)
+
+	body := `{"slug":"music","name":"Music"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/categories", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestHandler_GetCategory_NotFound(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	id := uuid.New().String()
+	svc.categories.EXPECT().Get(mock.Anything, id).Return(nil, domain.ErrCategoryNotFound)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/categories/"+id, nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandler_FollowCategory(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	userID, categoryID := uuid.New().String(), uuid.New().String()
+	svc.categories.EXPECT().Follow(mock.Anything, userID, categoryID).Return(nil)
+
+	w := httptest.NewRecorder()
+	path := "/api/users/" + userID + "/followed-categories/" + categoryID
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPut, path, nil))
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_FollowCategory_InvalidCategoryID(t *testing.T) {
+	_, r := newTestRouter(t)
+
+	w := httptest.NewRecorder()
+	path := "/api/users/" + uuid.New().String() + "/followed-categories/music"
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPut, path, nil))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_ListFollowedCategories(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	userID := uuid.New().String()
+	svc.categories.EXPECT().ListFollowed(mock.Anything, userID).Return([]*domain.Category{
+		{ID: "c1", Slug: "music", Name: "Music"},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/users/"+userID+"/followed-categories", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp []dto.CategoryResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, "music", resp[0].Slug)
+}
+
+// --- Booking limits ---
+
+func TestHandler_GetBookingLimits(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	userID := uuid.New().String()
+	until := time.Date(2030, 5, 1, 12, 0, 0, 0, time.UTC)
+	svc.bookingLimits.EXPECT().Get(mock.Anything, userID).Return(&domain.UserBookingLimits{
+		UserID:        userID,
+		Limits:        domain.BookingLimits{MaxPending: 5, MaxPerDay: 20, MaxExpired: 3, ExpiredWindow: 24 * time.Hour, Cooldown: time.Hour},
+		Usage:         domain.BookingUsage{Pending: 1, Today: 4, Expired: 3},
+		CooldownUntil: &until,
+	}, nil)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/admin/users/"+userID+"/booking-limits", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp dto.BookingLimitsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, 5, resp.MaxPending)
+	assert.Equal(t, "1h0m0s", resp.Cooldown)
+	assert.Equal(t, 4, resp.Usage.Today)
+	assert.Equal(t, "2030-05-01T12:00:00Z", resp.CooldownUntil)
+	assert.Nil(t, resp.Override)
+}
+
+func TestHandler_SetBookingLimits(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	userID := uuid.New().String()
+	svc.bookingLimits.EXPECT().SetOverride(mock.Anything, mock.MatchedBy(func(o *domain.BookingLimitOverride) bool {
+		return o.UserID == userID && o.MaxPending != nil && *o.MaxPending == 10 && o.MaxPerDay == nil && !o.Exempt
+	})).Return(&domain.UserBookingLimits{UserID: userID}, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPut, "/api/admin/users/"+userID+"/booking-limits",
+		bytes.NewReader([]byte(`{"max_pending":10}`)))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestHandler_SetBookingLimits_Negative(t *testing.T) {
+	_, r := newTestRouter(t)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPut, "/api/admin/users/"+uuid.New().String()+"/booking-limits",
+		bytes.NewReader([]byte(`{"max_per_day":-1}`)))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_ResetBookingLimits_NotFound(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	userID := uuid.New().String()
+	svc.bookingLimits.EXPECT().DeleteOverride(mock.Anything, userID).Return(domain.ErrLimitOverrideNotFound)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/api/admin/users/"+userID+"/booking-limits", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandler_BookEvent_Cooldown(t *testing.T) {
+	svc, r := newTestRouter(t)
+
+	eventID := uuid.New().String()
+	userID := uuid.New().String()
+	svc.bookings.EXPECT().Book(mock.Anything, eventID, userID, "").
+		Return(nil, fmt.Errorf("%w: try again after 2030-05-01T12:00:00Z", domain.ErrBookingCooldown))
+
+	body, _ := json.Marshal(dto.BookRequest{UserID: userID})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/events/"+eventID+"/book", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), "try again after")
+}
@@ -38,6 +38,131 @@ func (_m *MockEventSvc) EXPECT() *MockEventSvc_Expecter {
 	return &MockEventSvc_Expecter{mock: &_m.Mock}
 }
 
+// Archive provides a mock function for the type MockEventSvc
+func (_mock *MockEventSvc) Archive(ctx context.Context, id string) (*domain.Event, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Archive")
+	}
+
+	var r0 *domain.Event
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Event, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Event); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Event)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventSvc_Archive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Archive'
+type MockEventSvc_Archive_Call struct {
+	*mock.Call
+}
+
+// Archive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockEventSvc_Expecter) Archive(ctx interface{}, id interface{}) *MockEventSvc_Archive_Call {
+	return &MockEventSvc_Archive_Call{Call: _e.mock.On("Archive", ctx, id)}
+}
+
+func (_c *MockEventSvc_Archive_Call) Run(run func(ctx context.Context, id string)) *MockEventSvc_Archive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventSvc_Archive_Call) Return(event *domain.Event, err error) *MockEventSvc_Archive_Call {
+	_c.Call.Return(event, err)
+	return _c
+}
+
+func (_c *MockEventSvc_Archive_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.Event, error)) *MockEventSvc_Archive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelEvent provides a mock function for the type MockEventSvc
+func (_mock *MockEventSvc) CancelEvent(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelEvent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEventSvc_CancelEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelEvent'
+type MockEventSvc_CancelEvent_Call struct {
+	*mock.Call
+}
+
+// CancelEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockEventSvc_Expecter) CancelEvent(ctx interface{}, id interface{}) *MockEventSvc_CancelEvent_Call {
+	return &MockEventSvc_CancelEvent_Call{Call: _e.mock.On("CancelEvent", ctx, id)}
+}
+
+func (_c *MockEventSvc_CancelEvent_Call) Run(run func(ctx context.Context, id string)) *MockEventSvc_CancelEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventSvc_CancelEvent_Call) Return(err error) *MockEventSvc_CancelEvent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEventSvc_CancelEvent_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockEventSvc_CancelEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateEvent provides a mock function for the type MockEventSvc
 func (_mock *MockEventSvc) CreateEvent(ctx context.Context, input domain.CreateEventInput) (*domain.Event, error) {
 	ret := _mock.Called(ctx, input)
@@ -71,22 +196,3515 @@ type MockEventSvc_CreateEvent_Call struct {
 	*mock.Call
 }
 
-// CreateEvent is a helper method to define mock.On call
+// CreateEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input domain.CreateEventInput
+func (_e *MockEventSvc_Expecter) CreateEvent(ctx interface{}, input interface{}) *MockEventSvc_CreateEvent_Call {
+	return &MockEventSvc_CreateEvent_Call{Call: _e.mock.On("CreateEvent", ctx, input)}
+}
+
+func (_c *MockEventSvc_CreateEvent_Call) Run(run func(ctx context.Context, input domain.CreateEventInput)) *MockEventSvc_CreateEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.CreateEventInput
+		if args[1] != nil {
+			arg1 = args[1].(domain.CreateEventInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventSvc_CreateEvent_Call) Return(event *domain.Event, err error) *MockEventSvc_CreateEvent_Call {
+	_c.Call.Return(event, err)
+	return _c
+}
+
+func (_c *MockEventSvc_CreateEvent_Call) RunAndReturn(run func(ctx context.Context, input domain.CreateEventInput) (*domain.Event, error)) *MockEventSvc_CreateEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockEventSvc
+func (_mock *MockEventSvc) GetByID(ctx context.Context, id string) (*domain.Event, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.Event
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Event, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Event); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Event)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventSvc_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockEventSvc_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockEventSvc_Expecter) GetByID(ctx interface{}, id interface{}) *MockEventSvc_GetByID_Call {
+	return &MockEventSvc_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockEventSvc_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockEventSvc_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventSvc_GetByID_Call) Return(event *domain.Event, err error) *MockEventSvc_GetByID_Call {
+	_c.Call.Return(event, err)
+	return _c
+}
+
+func (_c *MockEventSvc_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.Event, error)) *MockEventSvc_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDetails provides a mock function for the type MockEventSvc
+func (_mock *MockEventSvc) GetDetails(ctx context.Context, id string) (*domain.EventDetails, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDetails")
+	}
+
+	var r0 *domain.EventDetails
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.EventDetails, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.EventDetails); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.EventDetails)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventSvc_GetDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDetails'
+type MockEventSvc_GetDetails_Call struct {
+	*mock.Call
+}
+
+// GetDetails is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockEventSvc_Expecter) GetDetails(ctx interface{}, id interface{}) *MockEventSvc_GetDetails_Call {
+	return &MockEventSvc_GetDetails_Call{Call: _e.mock.On("GetDetails", ctx, id)}
+}
+
+func (_c *MockEventSvc_GetDetails_Call) Run(run func(ctx context.Context, id string)) *MockEventSvc_GetDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventSvc_GetDetails_Call) Return(eventDetails *domain.EventDetails, err error) *MockEventSvc_GetDetails_Call {
+	_c.Call.Return(eventDetails, err)
+	return _c
+}
+
+func (_c *MockEventSvc_GetDetails_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.EventDetails, error)) *MockEventSvc_GetDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Import provides a mock function for the type MockEventSvc
+func (_mock *MockEventSvc) Import(ctx context.Context, input domain.ImportInput) (*domain.ImportResult, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Import")
+	}
+
+	var r0 *domain.ImportResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ImportInput) (*domain.ImportResult, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ImportInput) *domain.ImportResult); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ImportResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ImportInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventSvc_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type MockEventSvc_Import_Call struct {
+	*mock.Call
+}
+
+// Import is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input domain.ImportInput
+func (_e *MockEventSvc_Expecter) Import(ctx interface{}, input interface{}) *MockEventSvc_Import_Call {
+	return &MockEventSvc_Import_Call{Call: _e.mock.On("Import", ctx, input)}
+}
+
+func (_c *MockEventSvc_Import_Call) Run(run func(ctx context.Context, input domain.ImportInput)) *MockEventSvc_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ImportInput
+		if args[1] != nil {
+			arg1 = args[1].(domain.ImportInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventSvc_Import_Call) Return(importResult *domain.ImportResult, err error) *MockEventSvc_Import_Call {
+	_c.Call.Return(importResult, err)
+	return _c
+}
+
+func (_c *MockEventSvc_Import_Call) RunAndReturn(run func(ctx context.Context, input domain.ImportInput) (*domain.ImportResult, error)) *MockEventSvc_Import_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Invite provides a mock function for the type MockEventSvc
+func (_mock *MockEventSvc) Invite(ctx context.Context, eventID string, userIDs []string) ([]*domain.EventInvitation, error) {
+	ret := _mock.Called(ctx, eventID, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Invite")
+	}
+
+	var r0 []*domain.EventInvitation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) ([]*domain.EventInvitation, error)); ok {
+		return returnFunc(ctx, eventID, userIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) []*domain.EventInvitation); ok {
+		r0 = returnFunc(ctx, eventID, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.EventInvitation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = returnFunc(ctx, eventID, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventSvc_Invite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Invite'
+type MockEventSvc_Invite_Call struct {
+	*mock.Call
+}
+
+// Invite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+//   - userIDs []string
+func (_e *MockEventSvc_Expecter) Invite(ctx interface{}, eventID interface{}, userIDs interface{}) *MockEventSvc_Invite_Call {
+	return &MockEventSvc_Invite_Call{Call: _e.mock.On("Invite", ctx, eventID, userIDs)}
+}
+
+func (_c *MockEventSvc_Invite_Call) Run(run func(ctx context.Context, eventID string, userIDs []string)) *MockEventSvc_Invite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventSvc_Invite_Call) Return(eventInvitations []*domain.EventInvitation, err error) *MockEventSvc_Invite_Call {
+	_c.Call.Return(eventInvitations, err)
+	return _c
+}
+
+func (_c *MockEventSvc_Invite_Call) RunAndReturn(run func(ctx context.Context, eventID string, userIDs []string) ([]*domain.EventInvitation, error)) *MockEventSvc_Invite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFiltered provides a mock function for the type MockEventSvc
+func (_mock *MockEventSvc) ListFiltered(ctx context.Context, filter domain.EventFilter) ([]*domain.Event, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFiltered")
+	}
+
+	var r0 []*domain.Event
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.EventFilter) ([]*domain.Event, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.EventFilter) []*domain.Event); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Event)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.EventFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventSvc_ListFiltered_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFiltered'
+type MockEventSvc_ListFiltered_Call struct {
+	*mock.Call
+}
+
+// ListFiltered is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.EventFilter
+func (_e *MockEventSvc_Expecter) ListFiltered(ctx interface{}, filter interface{}) *MockEventSvc_ListFiltered_Call {
+	return &MockEventSvc_ListFiltered_Call{Call: _e.mock.On("ListFiltered", ctx, filter)}
+}
+
+func (_c *MockEventSvc_ListFiltered_Call) Run(run func(ctx context.Context, filter domain.EventFilter)) *MockEventSvc_ListFiltered_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.EventFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.EventFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventSvc_ListFiltered_Call) Return(events []*domain.Event, err error) *MockEventSvc_ListFiltered_Call {
+	_c.Call.Return(events, err)
+	return _c
+}
+
+func (_c *MockEventSvc_ListFiltered_Call) RunAndReturn(run func(ctx context.Context, filter domain.EventFilter) ([]*domain.Event, error)) *MockEventSvc_ListFiltered_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListInvitations provides a mock function for the type MockEventSvc
+func (_mock *MockEventSvc) ListInvitations(ctx context.Context, eventID string) ([]*domain.EventInvitation, error) {
+	ret := _mock.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInvitations")
+	}
+
+	var r0 []*domain.EventInvitation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*domain.EventInvitation, error)); ok {
+		return returnFunc(ctx, eventID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*domain.EventInvitation); ok {
+		r0 = returnFunc(ctx, eventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.EventInvitation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventSvc_ListInvitations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInvitations'
+type MockEventSvc_ListInvitations_Call struct {
+	*mock.Call
+}
+
+// ListInvitations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+func (_e *MockEventSvc_Expecter) ListInvitations(ctx interface{}, eventID interface{}) *MockEventSvc_ListInvitations_Call {
+	return &MockEventSvc_ListInvitations_Call{Call: _e.mock.On("ListInvitations", ctx, eventID)}
+}
+
+func (_c *MockEventSvc_ListInvitations_Call) Run(run func(ctx context.Context, eventID string)) *MockEventSvc_ListInvitations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventSvc_ListInvitations_Call) Return(eventInvitations []*domain.EventInvitation, err error) *MockEventSvc_ListInvitations_Call {
+	_c.Call.Return(eventInvitations, err)
+	return _c
+}
+
+func (_c *MockEventSvc_ListInvitations_Call) RunAndReturn(run func(ctx context.Context, eventID string) ([]*domain.EventInvitation, error)) *MockEventSvc_ListInvitations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Publish provides a mock function for the type MockEventSvc
+func (_mock *MockEventSvc) Publish(ctx context.Context, id string) (*domain.Event, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 *domain.Event
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Event, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Event); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Event)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventSvc_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type MockEventSvc_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockEventSvc_Expecter) Publish(ctx interface{}, id interface{}) *MockEventSvc_Publish_Call {
+	return &MockEventSvc_Publish_Call{Call: _e.mock.On("Publish", ctx, id)}
+}
+
+func (_c *MockEventSvc_Publish_Call) Run(run func(ctx context.Context, id string)) *MockEventSvc_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventSvc_Publish_Call) Return(event *domain.Event, err error) *MockEventSvc_Publish_Call {
+	_c.Call.Return(event, err)
+	return _c
+}
+
+func (_c *MockEventSvc_Publish_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.Event, error)) *MockEventSvc_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeInvitation provides a mock function for the type MockEventSvc
+func (_mock *MockEventSvc) RevokeInvitation(ctx context.Context, eventID string, userID string) error {
+	ret := _mock.Called(ctx, eventID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeInvitation")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, eventID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEventSvc_RevokeInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeInvitation'
+type MockEventSvc_RevokeInvitation_Call struct {
+	*mock.Call
+}
+
+// RevokeInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+//   - userID string
+func (_e *MockEventSvc_Expecter) RevokeInvitation(ctx interface{}, eventID interface{}, userID interface{}) *MockEventSvc_RevokeInvitation_Call {
+	return &MockEventSvc_RevokeInvitation_Call{Call: _e.mock.On("RevokeInvitation", ctx, eventID, userID)}
+}
+
+func (_c *MockEventSvc_RevokeInvitation_Call) Run(run func(ctx context.Context, eventID string, userID string)) *MockEventSvc_RevokeInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventSvc_RevokeInvitation_Call) Return(err error) *MockEventSvc_RevokeInvitation_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEventSvc_RevokeInvitation_Call) RunAndReturn(run func(ctx context.Context, eventID string, userID string) error) *MockEventSvc_RevokeInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function for the type MockEventSvc
+func (_mock *MockEventSvc) Search(ctx context.Context, input domain.EventSearchInput) ([]*domain.EventSearchResult, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []*domain.EventSearchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.EventSearchInput) ([]*domain.EventSearchResult, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.EventSearchInput) []*domain.EventSearchResult); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.EventSearchResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.EventSearchInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventSvc_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockEventSvc_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input domain.EventSearchInput
+func (_e *MockEventSvc_Expecter) Search(ctx interface{}, input interface{}) *MockEventSvc_Search_Call {
+	return &MockEventSvc_Search_Call{Call: _e.mock.On("Search", ctx, input)}
+}
+
+func (_c *MockEventSvc_Search_Call) Run(run func(ctx context.Context, input domain.EventSearchInput)) *MockEventSvc_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.EventSearchInput
+		if args[1] != nil {
+			arg1 = args[1].(domain.EventSearchInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventSvc_Search_Call) Return(eventSearchResults []*domain.EventSearchResult, err error) *MockEventSvc_Search_Call {
+	_c.Call.Return(eventSearchResults, err)
+	return _c
+}
+
+func (_c *MockEventSvc_Search_Call) RunAndReturn(run func(ctx context.Context, input domain.EventSearchInput) ([]*domain.EventSearchResult, error)) *MockEventSvc_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamAttendees provides a mock function for the type MockEventSvc
+func (_mock *MockEventSvc) StreamAttendees(ctx context.Context, eventID string, statuses []domain.BookingStatus, fn func(*domain.Attendee) error) error {
+	ret := _mock.Called(ctx, eventID, statuses, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamAttendees")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []domain.BookingStatus, func(*domain.Attendee) error) error); ok {
+		r0 = returnFunc(ctx, eventID, statuses, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEventSvc_StreamAttendees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamAttendees'
+type MockEventSvc_StreamAttendees_Call struct {
+	*mock.Call
+}
+
+// StreamAttendees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+//   - statuses []domain.BookingStatus
+//   - fn func(*domain.Attendee) error
+func (_e *MockEventSvc_Expecter) StreamAttendees(ctx interface{}, eventID interface{}, statuses interface{}, fn interface{}) *MockEventSvc_StreamAttendees_Call {
+	return &MockEventSvc_StreamAttendees_Call{Call: _e.mock.On("StreamAttendees", ctx, eventID, statuses, fn)}
+}
+
+func (_c *MockEventSvc_StreamAttendees_Call) Run(run func(ctx context.Context, eventID string, statuses []domain.BookingStatus, fn func(*domain.Attendee) error)) *MockEventSvc_StreamAttendees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []domain.BookingStatus
+		if args[2] != nil {
+			arg2 = args[2].([]domain.BookingStatus)
+		}
+		var arg3 func(*domain.Attendee) error
+		if args[3] != nil {
+			arg3 = args[3].(func(*domain.Attendee) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventSvc_StreamAttendees_Call) Return(err error) *MockEventSvc_StreamAttendees_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEventSvc_StreamAttendees_Call) RunAndReturn(run func(ctx context.Context, eventID string, statuses []domain.BookingStatus, fn func(*domain.Attendee) error) error) *MockEventSvc_StreamAttendees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEvent provides a mock function for the type MockEventSvc
+func (_mock *MockEventSvc) UpdateEvent(ctx context.Context, id string, input domain.UpdateEventInput) (*domain.Event, error) {
+	ret := _mock.Called(ctx, id, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEvent")
+	}
+
+	var r0 *domain.Event
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.UpdateEventInput) (*domain.Event, error)); ok {
+		return returnFunc(ctx, id, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.UpdateEventInput) *domain.Event); ok {
+		r0 = returnFunc(ctx, id, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Event)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.UpdateEventInput) error); ok {
+		r1 = returnFunc(ctx, id, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventSvc_UpdateEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEvent'
+type MockEventSvc_UpdateEvent_Call struct {
+	*mock.Call
+}
+
+// UpdateEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - input domain.UpdateEventInput
+func (_e *MockEventSvc_Expecter) UpdateEvent(ctx interface{}, id interface{}, input interface{}) *MockEventSvc_UpdateEvent_Call {
+	return &MockEventSvc_UpdateEvent_Call{Call: _e.mock.On("UpdateEvent", ctx, id, input)}
+}
+
+func (_c *MockEventSvc_UpdateEvent_Call) Run(run func(ctx context.Context, id string, input domain.UpdateEventInput)) *MockEventSvc_UpdateEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.UpdateEventInput
+		if args[2] != nil {
+			arg2 = args[2].(domain.UpdateEventInput)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventSvc_UpdateEvent_Call) Return(event *domain.Event, err error) *MockEventSvc_UpdateEvent_Call {
+	_c.Call.Return(event, err)
+	return _c
+}
+
+func (_c *MockEventSvc_UpdateEvent_Call) RunAndReturn(run func(ctx context.Context, id string, input domain.UpdateEventInput) (*domain.Event, error)) *MockEventSvc_UpdateEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBookingSvc creates a new instance of MockBookingSvc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBookingSvc(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBookingSvc {
+	mock := &MockBookingSvc{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBookingSvc is an autogenerated mock type for the BookingSvc type
+type MockBookingSvc struct {
+	mock.Mock
+}
+
+type MockBookingSvc_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBookingSvc) EXPECT() *MockBookingSvc_Expecter {
+	return &MockBookingSvc_Expecter{mock: &_m.Mock}
+}
+
+// Book provides a mock function for the type MockBookingSvc
+func (_mock *MockBookingSvc) Book(ctx context.Context, eventID string, userID string, accessCode string) (*domain.Booking, error) {
+	ret := _mock.Called(ctx, eventID, userID, accessCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Book")
+	}
+
+	var r0 *domain.Booking
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (*domain.Booking, error)); ok {
+		return returnFunc(ctx, eventID, userID, accessCode)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) *domain.Booking); ok {
+		r0 = returnFunc(ctx, eventID, userID, accessCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Booking)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, eventID, userID, accessCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBookingSvc_Book_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Book'
+type MockBookingSvc_Book_Call struct {
+	*mock.Call
+}
+
+// Book is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+//   - userID string
+//   - accessCode string
+func (_e *MockBookingSvc_Expecter) Book(ctx interface{}, eventID interface{}, userID interface{}, accessCode interface{}) *MockBookingSvc_Book_Call {
+	return &MockBookingSvc_Book_Call{Call: _e.mock.On("Book", ctx, eventID, userID, accessCode)}
+}
+
+func (_c *MockBookingSvc_Book_Call) Run(run func(ctx context.Context, eventID string, userID string, accessCode string)) *MockBookingSvc_Book_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookingSvc_Book_Call) Return(booking *domain.Booking, err error) *MockBookingSvc_Book_Call {
+	_c.Call.Return(booking, err)
+	return _c
+}
+
+func (_c *MockBookingSvc_Book_Call) RunAndReturn(run func(ctx context.Context, eventID string, userID string, accessCode string) (*domain.Booking, error)) *MockBookingSvc_Book_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Confirm provides a mock function for the type MockBookingSvc
+func (_mock *MockBookingSvc) Confirm(ctx context.Context, eventID string, userID string) error {
+	ret := _mock.Called(ctx, eventID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Confirm")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, eventID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBookingSvc_Confirm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Confirm'
+type MockBookingSvc_Confirm_Call struct {
+	*mock.Call
+}
+
+// Confirm is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+//   - userID string
+func (_e *MockBookingSvc_Expecter) Confirm(ctx interface{}, eventID interface{}, userID interface{}) *MockBookingSvc_Confirm_Call {
+	return &MockBookingSvc_Confirm_Call{Call: _e.mock.On("Confirm", ctx, eventID, userID)}
+}
+
+func (_c *MockBookingSvc_Confirm_Call) Run(run func(ctx context.Context, eventID string, userID string)) *MockBookingSvc_Confirm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookingSvc_Confirm_Call) Return(err error) *MockBookingSvc_Confirm_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBookingSvc_Confirm_Call) RunAndReturn(run func(ctx context.Context, eventID string, userID string) error) *MockBookingSvc_Confirm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// History provides a mock function for the type MockBookingSvc
+func (_mock *MockBookingSvc) History(ctx context.Context, bookingID string) ([]*domain.BookingHistoryEntry, error) {
+	ret := _mock.Called(ctx, bookingID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for History")
+	}
+
+	var r0 []*domain.BookingHistoryEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*domain.BookingHistoryEntry, error)); ok {
+		return returnFunc(ctx, bookingID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*domain.BookingHistoryEntry); ok {
+		r0 = returnFunc(ctx, bookingID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.BookingHistoryEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, bookingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBookingSvc_History_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'History'
+type MockBookingSvc_History_Call struct {
+	*mock.Call
+}
+
+// History is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bookingID string
+func (_e *MockBookingSvc_Expecter) History(ctx interface{}, bookingID interface{}) *MockBookingSvc_History_Call {
+	return &MockBookingSvc_History_Call{Call: _e.mock.On("History", ctx, bookingID)}
+}
+
+func (_c *MockBookingSvc_History_Call) Run(run func(ctx context.Context, bookingID string)) *MockBookingSvc_History_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookingSvc_History_Call) Return(bookingHistoryEntrys []*domain.BookingHistoryEntry, err error) *MockBookingSvc_History_Call {
+	_c.Call.Return(bookingHistoryEntrys, err)
+	return _c
+}
+
+func (_c *MockBookingSvc_History_Call) RunAndReturn(run func(ctx context.Context, bookingID string) ([]*domain.BookingHistoryEntry, error)) *MockBookingSvc_History_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUser provides a mock function for the type MockBookingSvc
+func (_mock *MockBookingSvc) ListByUser(ctx context.Context, userID string) ([]*domain.Booking, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUser")
+	}
+
+	var r0 []*domain.Booking
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*domain.Booking, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*domain.Booking); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Booking)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBookingSvc_ListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUser'
+type MockBookingSvc_ListByUser_Call struct {
+	*mock.Call
+}
+
+// ListByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockBookingSvc_Expecter) ListByUser(ctx interface{}, userID interface{}) *MockBookingSvc_ListByUser_Call {
+	return &MockBookingSvc_ListByUser_Call{Call: _e.mock.On("ListByUser", ctx, userID)}
+}
+
+func (_c *MockBookingSvc_ListByUser_Call) Run(run func(ctx context.Context, userID string)) *MockBookingSvc_ListByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookingSvc_ListByUser_Call) Return(bookings []*domain.Booking, err error) *MockBookingSvc_ListByUser_Call {
+	_c.Call.Return(bookings, err)
+	return _c
+}
+
+func (_c *MockBookingSvc_ListByUser_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]*domain.Booking, error)) *MockBookingSvc_ListByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserSvc creates a new instance of MockUserSvc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserSvc(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserSvc {
+	mock := &MockUserSvc{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUserSvc is an autogenerated mock type for the UserSvc type
+type MockUserSvc struct {
+	mock.Mock
+}
+
+type MockUserSvc_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserSvc) EXPECT() *MockUserSvc_Expecter {
+	return &MockUserSvc_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockUserSvc
+func (_mock *MockUserSvc) Create(ctx context.Context, input domain.CreateUserInput) (*domain.User, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CreateUserInput) (*domain.User, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CreateUserInput) *domain.User); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.CreateUserInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserSvc_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockUserSvc_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input domain.CreateUserInput
+func (_e *MockUserSvc_Expecter) Create(ctx interface{}, input interface{}) *MockUserSvc_Create_Call {
+	return &MockUserSvc_Create_Call{Call: _e.mock.On("Create", ctx, input)}
+}
+
+func (_c *MockUserSvc_Create_Call) Run(run func(ctx context.Context, input domain.CreateUserInput)) *MockUserSvc_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.CreateUserInput
+		if args[1] != nil {
+			arg1 = args[1].(domain.CreateUserInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserSvc_Create_Call) Return(user *domain.User, err error) *MockUserSvc_Create_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserSvc_Create_Call) RunAndReturn(run func(ctx context.Context, input domain.CreateUserInput) (*domain.User, error)) *MockUserSvc_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotificationPreferences provides a mock function for the type MockUserSvc
+func (_mock *MockUserSvc) GetNotificationPreferences(ctx context.Context, userID string) (*domain.NotificationPreferences, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationPreferences")
+	}
+
+	var r0 *domain.NotificationPreferences
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.NotificationPreferences, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.NotificationPreferences); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationPreferences)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserSvc_GetNotificationPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationPreferences'
+type MockUserSvc_GetNotificationPreferences_Call struct {
+	*mock.Call
+}
+
+// GetNotificationPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockUserSvc_Expecter) GetNotificationPreferences(ctx interface{}, userID interface{}) *MockUserSvc_GetNotificationPreferences_Call {
+	return &MockUserSvc_GetNotificationPreferences_Call{Call: _e.mock.On("GetNotificationPreferences", ctx, userID)}
+}
+
+func (_c *MockUserSvc_GetNotificationPreferences_Call) Run(run func(ctx context.Context, userID string)) *MockUserSvc_GetNotificationPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserSvc_GetNotificationPreferences_Call) Return(notificationPreferences *domain.NotificationPreferences, err error) *MockUserSvc_GetNotificationPreferences_Call {
+	_c.Call.Return(notificationPreferences, err)
+	return _c
+}
+
+func (_c *MockUserSvc_GetNotificationPreferences_Call) RunAndReturn(run func(ctx context.Context, userID string) (*domain.NotificationPreferences, error)) *MockUserSvc_GetNotificationPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockUserSvc
+func (_mock *MockUserSvc) List(ctx context.Context) ([]*domain.User, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.User, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.User); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserSvc_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockUserSvc_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockUserSvc_Expecter) List(ctx interface{}) *MockUserSvc_List_Call {
+	return &MockUserSvc_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockUserSvc_List_Call) Run(run func(ctx context.Context)) *MockUserSvc_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserSvc_List_Call) Return(users []*domain.User, err error) *MockUserSvc_List_Call {
+	_c.Call.Return(users, err)
+	return _c
+}
+
+func (_c *MockUserSvc_List_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.User, error)) *MockUserSvc_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateNotificationPreferences provides a mock function for the type MockUserSvc
+func (_mock *MockUserSvc) UpdateNotificationPreferences(ctx context.Context, userID string, changes map[domain.NotificationChannel]map[domain.NotificationKind]bool) (*domain.NotificationPreferences, error) {
+	ret := _mock.Called(ctx, userID, changes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNotificationPreferences")
+	}
+
+	var r0 *domain.NotificationPreferences
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, map[domain.NotificationChannel]map[domain.NotificationKind]bool) (*domain.NotificationPreferences, error)); ok {
+		return returnFunc(ctx, userID, changes)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, map[domain.NotificationChannel]map[domain.NotificationKind]bool) *domain.NotificationPreferences); ok {
+		r0 = returnFunc(ctx, userID, changes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationPreferences)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, map[domain.NotificationChannel]map[domain.NotificationKind]bool) error); ok {
+		r1 = returnFunc(ctx, userID, changes)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserSvc_UpdateNotificationPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNotificationPreferences'
+type MockUserSvc_UpdateNotificationPreferences_Call struct {
+	*mock.Call
+}
+
+// UpdateNotificationPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - changes map[domain.NotificationChannel]map[domain.NotificationKind]bool
+func (_e *MockUserSvc_Expecter) UpdateNotificationPreferences(ctx interface{}, userID interface{}, changes interface{}) *MockUserSvc_UpdateNotificationPreferences_Call {
+	return &MockUserSvc_UpdateNotificationPreferences_Call{Call: _e.mock.On("UpdateNotificationPreferences", ctx, userID, changes)}
+}
+
+func (_c *MockUserSvc_UpdateNotificationPreferences_Call) Run(run func(ctx context.Context, userID string, changes map[domain.NotificationChannel]map[domain.NotificationKind]bool)) *MockUserSvc_UpdateNotificationPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 map[domain.NotificationChannel]map[domain.NotificationKind]bool
+		if args[2] != nil {
+			arg2 = args[2].(map[domain.NotificationChannel]map[domain.NotificationKind]bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserSvc_UpdateNotificationPreferences_Call) Return(notificationPreferences *domain.NotificationPreferences, err error) *MockUserSvc_UpdateNotificationPreferences_Call {
+	_c.Call.Return(notificationPreferences, err)
+	return _c
+}
+
+func (_c *MockUserSvc_UpdateNotificationPreferences_Call) RunAndReturn(run func(ctx context.Context, userID string, changes map[domain.NotificationChannel]map[domain.NotificationKind]bool) (*domain.NotificationPreferences, error)) *MockUserSvc_UpdateNotificationPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTelegramLinkSvc creates a new instance of MockTelegramLinkSvc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTelegramLinkSvc(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTelegramLinkSvc {
+	mock := &MockTelegramLinkSvc{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTelegramLinkSvc is an autogenerated mock type for the TelegramLinkSvc type
+type MockTelegramLinkSvc struct {
+	mock.Mock
+}
+
+type MockTelegramLinkSvc_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTelegramLinkSvc) EXPECT() *MockTelegramLinkSvc_Expecter {
+	return &MockTelegramLinkSvc_Expecter{mock: &_m.Mock}
+}
+
+// CreateLink provides a mock function for the type MockTelegramLinkSvc
+func (_mock *MockTelegramLinkSvc) CreateLink(ctx context.Context, userID string) (*domain.TelegramLink, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLink")
+	}
+
+	var r0 *domain.TelegramLink
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.TelegramLink, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.TelegramLink); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TelegramLink)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTelegramLinkSvc_CreateLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLink'
+type MockTelegramLinkSvc_CreateLink_Call struct {
+	*mock.Call
+}
+
+// CreateLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockTelegramLinkSvc_Expecter) CreateLink(ctx interface{}, userID interface{}) *MockTelegramLinkSvc_CreateLink_Call {
+	return &MockTelegramLinkSvc_CreateLink_Call{Call: _e.mock.On("CreateLink", ctx, userID)}
+}
+
+func (_c *MockTelegramLinkSvc_CreateLink_Call) Run(run func(ctx context.Context, userID string)) *MockTelegramLinkSvc_CreateLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTelegramLinkSvc_CreateLink_Call) Return(telegramLink *domain.TelegramLink, err error) *MockTelegramLinkSvc_CreateLink_Call {
+	_c.Call.Return(telegramLink, err)
+	return _c
+}
+
+func (_c *MockTelegramLinkSvc_CreateLink_Call) RunAndReturn(run func(ctx context.Context, userID string) (*domain.TelegramLink, error)) *MockTelegramLinkSvc_CreateLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWebhookSvc creates a new instance of MockWebhookSvc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookSvc(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookSvc {
+	mock := &MockWebhookSvc{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebhookSvc is an autogenerated mock type for the WebhookSvc type
+type MockWebhookSvc struct {
+	mock.Mock
+}
+
+type MockWebhookSvc_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookSvc) EXPECT() *MockWebhookSvc_Expecter {
+	return &MockWebhookSvc_Expecter{mock: &_m.Mock}
+}
+
+// CreateSubscription provides a mock function for the type MockWebhookSvc
+func (_mock *MockWebhookSvc) CreateSubscription(ctx context.Context, input domain.CreateWebhookInput) (*domain.WebhookSubscription, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSubscription")
+	}
+
+	var r0 *domain.WebhookSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CreateWebhookInput) (*domain.WebhookSubscription, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CreateWebhookInput) *domain.WebhookSubscription); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WebhookSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.CreateWebhookInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookSvc_CreateSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSubscription'
+type MockWebhookSvc_CreateSubscription_Call struct {
+	*mock.Call
+}
+
+// CreateSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input domain.CreateWebhookInput
+func (_e *MockWebhookSvc_Expecter) CreateSubscription(ctx interface{}, input interface{}) *MockWebhookSvc_CreateSubscription_Call {
+	return &MockWebhookSvc_CreateSubscription_Call{Call: _e.mock.On("CreateSubscription", ctx, input)}
+}
+
+func (_c *MockWebhookSvc_CreateSubscription_Call) Run(run func(ctx context.Context, input domain.CreateWebhookInput)) *MockWebhookSvc_CreateSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.CreateWebhookInput
+		if args[1] != nil {
+			arg1 = args[1].(domain.CreateWebhookInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookSvc_CreateSubscription_Call) Return(webhookSubscription *domain.WebhookSubscription, err error) *MockWebhookSvc_CreateSubscription_Call {
+	_c.Call.Return(webhookSubscription, err)
+	return _c
+}
+
+func (_c *MockWebhookSvc_CreateSubscription_Call) RunAndReturn(run func(ctx context.Context, input domain.CreateWebhookInput) (*domain.WebhookSubscription, error)) *MockWebhookSvc_CreateSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSubscription provides a mock function for the type MockWebhookSvc
+func (_mock *MockWebhookSvc) DeleteSubscription(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSubscription")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookSvc_DeleteSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSubscription'
+type MockWebhookSvc_DeleteSubscription_Call struct {
+	*mock.Call
+}
+
+// DeleteSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockWebhookSvc_Expecter) DeleteSubscription(ctx interface{}, id interface{}) *MockWebhookSvc_DeleteSubscription_Call {
+	return &MockWebhookSvc_DeleteSubscription_Call{Call: _e.mock.On("DeleteSubscription", ctx, id)}
+}
+
+func (_c *MockWebhookSvc_DeleteSubscription_Call) Run(run func(ctx context.Context, id string)) *MockWebhookSvc_DeleteSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookSvc_DeleteSubscription_Call) Return(err error) *MockWebhookSvc_DeleteSubscription_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookSvc_DeleteSubscription_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockWebhookSvc_DeleteSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDelivery provides a mock function for the type MockWebhookSvc
+func (_mock *MockWebhookSvc) GetDelivery(ctx context.Context, subscriptionID string, deliveryID string) (*domain.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, subscriptionID, deliveryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDelivery")
+	}
+
+	var r0 *domain.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, subscriptionID, deliveryID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, subscriptionID, deliveryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, subscriptionID, deliveryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookSvc_GetDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDelivery'
+type MockWebhookSvc_GetDelivery_Call struct {
+	*mock.Call
+}
+
+// GetDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscriptionID string
+//   - deliveryID string
+func (_e *MockWebhookSvc_Expecter) GetDelivery(ctx interface{}, subscriptionID interface{}, deliveryID interface{}) *MockWebhookSvc_GetDelivery_Call {
+	return &MockWebhookSvc_GetDelivery_Call{Call: _e.mock.On("GetDelivery", ctx, subscriptionID, deliveryID)}
+}
+
+func (_c *MockWebhookSvc_GetDelivery_Call) Run(run func(ctx context.Context, subscriptionID string, deliveryID string)) *MockWebhookSvc_GetDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookSvc_GetDelivery_Call) Return(webhookDelivery *domain.WebhookDelivery, err error) *MockWebhookSvc_GetDelivery_Call {
+	_c.Call.Return(webhookDelivery, err)
+	return _c
+}
+
+func (_c *MockWebhookSvc_GetDelivery_Call) RunAndReturn(run func(ctx context.Context, subscriptionID string, deliveryID string) (*domain.WebhookDelivery, error)) *MockWebhookSvc_GetDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubscription provides a mock function for the type MockWebhookSvc
+func (_mock *MockWebhookSvc) GetSubscription(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubscription")
+	}
+
+	var r0 *domain.WebhookSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.WebhookSubscription, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.WebhookSubscription); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WebhookSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookSvc_GetSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubscription'
+type MockWebhookSvc_GetSubscription_Call struct {
+	*mock.Call
+}
+
+// GetSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockWebhookSvc_Expecter) GetSubscription(ctx interface{}, id interface{}) *MockWebhookSvc_GetSubscription_Call {
+	return &MockWebhookSvc_GetSubscription_Call{Call: _e.mock.On("GetSubscription", ctx, id)}
+}
+
+func (_c *MockWebhookSvc_GetSubscription_Call) Run(run func(ctx context.Context, id string)) *MockWebhookSvc_GetSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookSvc_GetSubscription_Call) Return(webhookSubscription *domain.WebhookSubscription, err error) *MockWebhookSvc_GetSubscription_Call {
+	_c.Call.Return(webhookSubscription, err)
+	return _c
+}
+
+func (_c *MockWebhookSvc_GetSubscription_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.WebhookSubscription, error)) *MockWebhookSvc_GetSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDeliveries provides a mock function for the type MockWebhookSvc
+func (_mock *MockWebhookSvc) ListDeliveries(ctx context.Context, subscriptionID string) ([]*domain.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, subscriptionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeliveries")
+	}
+
+	var r0 []*domain.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*domain.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, subscriptionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*domain.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, subscriptionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, subscriptionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookSvc_ListDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeliveries'
+type MockWebhookSvc_ListDeliveries_Call struct {
+	*mock.Call
+}
+
+// ListDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscriptionID string
+func (_e *MockWebhookSvc_Expecter) ListDeliveries(ctx interface{}, subscriptionID interface{}) *MockWebhookSvc_ListDeliveries_Call {
+	return &MockWebhookSvc_ListDeliveries_Call{Call: _e.mock.On("ListDeliveries", ctx, subscriptionID)}
+}
+
+func (_c *MockWebhookSvc_ListDeliveries_Call) Run(run func(ctx context.Context, subscriptionID string)) *MockWebhookSvc_ListDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookSvc_ListDeliveries_Call) Return(webhookDeliverys []*domain.WebhookDelivery, err error) *MockWebhookSvc_ListDeliveries_Call {
+	_c.Call.Return(webhookDeliverys, err)
+	return _c
+}
+
+func (_c *MockWebhookSvc_ListDeliveries_Call) RunAndReturn(run func(ctx context.Context, subscriptionID string) ([]*domain.WebhookDelivery, error)) *MockWebhookSvc_ListDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSubscriptions provides a mock function for the type MockWebhookSvc
+func (_mock *MockWebhookSvc) ListSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSubscriptions")
+	}
+
+	var r0 []*domain.WebhookSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.WebhookSubscription, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.WebhookSubscription); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.WebhookSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookSvc_ListSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSubscriptions'
+type MockWebhookSvc_ListSubscriptions_Call struct {
+	*mock.Call
+}
+
+// ListSubscriptions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockWebhookSvc_Expecter) ListSubscriptions(ctx interface{}) *MockWebhookSvc_ListSubscriptions_Call {
+	return &MockWebhookSvc_ListSubscriptions_Call{Call: _e.mock.On("ListSubscriptions", ctx)}
+}
+
+func (_c *MockWebhookSvc_ListSubscriptions_Call) Run(run func(ctx context.Context)) *MockWebhookSvc_ListSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookSvc_ListSubscriptions_Call) Return(webhookSubscriptions []*domain.WebhookSubscription, err error) *MockWebhookSvc_ListSubscriptions_Call {
+	_c.Call.Return(webhookSubscriptions, err)
+	return _c
+}
+
+func (_c *MockWebhookSvc_ListSubscriptions_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.WebhookSubscription, error)) *MockWebhookSvc_ListSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Replay provides a mock function for the type MockWebhookSvc
+func (_mock *MockWebhookSvc) Replay(ctx context.Context, subscriptionID string, deliveryID string) (*domain.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, subscriptionID, deliveryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Replay")
+	}
+
+	var r0 *domain.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, subscriptionID, deliveryID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, subscriptionID, deliveryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, subscriptionID, deliveryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookSvc_Replay_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Replay'
+type MockWebhookSvc_Replay_Call struct {
+	*mock.Call
+}
+
+// Replay is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscriptionID string
+//   - deliveryID string
+func (_e *MockWebhookSvc_Expecter) Replay(ctx interface{}, subscriptionID interface{}, deliveryID interface{}) *MockWebhookSvc_Replay_Call {
+	return &MockWebhookSvc_Replay_Call{Call: _e.mock.On("Replay", ctx, subscriptionID, deliveryID)}
+}
+
+func (_c *MockWebhookSvc_Replay_Call) Run(run func(ctx context.Context, subscriptionID string, deliveryID string)) *MockWebhookSvc_Replay_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookSvc_Replay_Call) Return(webhookDelivery *domain.WebhookDelivery, err error) *MockWebhookSvc_Replay_Call {
+	_c.Call.Return(webhookDelivery, err)
+	return _c
+}
+
+func (_c *MockWebhookSvc_Replay_Call) RunAndReturn(run func(ctx context.Context, subscriptionID string, deliveryID string) (*domain.WebhookDelivery, error)) *MockWebhookSvc_Replay_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSubscription provides a mock function for the type MockWebhookSvc
+func (_mock *MockWebhookSvc) UpdateSubscription(ctx context.Context, id string, input domain.UpdateWebhookInput) (*domain.WebhookSubscription, error) {
+	ret := _mock.Called(ctx, id, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSubscription")
+	}
+
+	var r0 *domain.WebhookSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.UpdateWebhookInput) (*domain.WebhookSubscription, error)); ok {
+		return returnFunc(ctx, id, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.UpdateWebhookInput) *domain.WebhookSubscription); ok {
+		r0 = returnFunc(ctx, id, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WebhookSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.UpdateWebhookInput) error); ok {
+		r1 = returnFunc(ctx, id, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookSvc_UpdateSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSubscription'
+type MockWebhookSvc_UpdateSubscription_Call struct {
+	*mock.Call
+}
+
+// UpdateSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - input domain.UpdateWebhookInput
+func (_e *MockWebhookSvc_Expecter) UpdateSubscription(ctx interface{}, id interface{}, input interface{}) *MockWebhookSvc_UpdateSubscription_Call {
+	return &MockWebhookSvc_UpdateSubscription_Call{Call: _e.mock.On("UpdateSubscription", ctx, id, input)}
+}
+
+func (_c *MockWebhookSvc_UpdateSubscription_Call) Run(run func(ctx context.Context, id string, input domain.UpdateWebhookInput)) *MockWebhookSvc_UpdateSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.UpdateWebhookInput
+		if args[2] != nil {
+			arg2 = args[2].(domain.UpdateWebhookInput)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookSvc_UpdateSubscription_Call) Return(webhookSubscription *domain.WebhookSubscription, err error) *MockWebhookSvc_UpdateSubscription_Call {
+	_c.Call.Return(webhookSubscription, err)
+	return _c
+}
+
+func (_c *MockWebhookSvc_UpdateSubscription_Call) RunAndReturn(run func(ctx context.Context, id string, input domain.UpdateWebhookInput) (*domain.WebhookSubscription, error)) *MockWebhookSvc_UpdateSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSeriesSvc creates a new instance of MockSeriesSvc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSeriesSvc(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSeriesSvc {
+	mock := &MockSeriesSvc{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSeriesSvc is an autogenerated mock type for the SeriesSvc type
+type MockSeriesSvc struct {
+	mock.Mock
+}
+
+type MockSeriesSvc_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSeriesSvc) EXPECT() *MockSeriesSvc_Expecter {
+	return &MockSeriesSvc_Expecter{mock: &_m.Mock}
+}
+
+// Cancel provides a mock function for the type MockSeriesSvc
+func (_mock *MockSeriesSvc) Cancel(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cancel")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSeriesSvc_Cancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cancel'
+type MockSeriesSvc_Cancel_Call struct {
+	*mock.Call
+}
+
+// Cancel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockSeriesSvc_Expecter) Cancel(ctx interface{}, id interface{}) *MockSeriesSvc_Cancel_Call {
+	return &MockSeriesSvc_Cancel_Call{Call: _e.mock.On("Cancel", ctx, id)}
+}
+
+func (_c *MockSeriesSvc_Cancel_Call) Run(run func(ctx context.Context, id string)) *MockSeriesSvc_Cancel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesSvc_Cancel_Call) Return(err error) *MockSeriesSvc_Cancel_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSeriesSvc_Cancel_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockSeriesSvc_Cancel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockSeriesSvc
+func (_mock *MockSeriesSvc) Create(ctx context.Context, input domain.CreateSeriesInput) (*domain.SeriesDetails, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.SeriesDetails
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CreateSeriesInput) (*domain.SeriesDetails, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CreateSeriesInput) *domain.SeriesDetails); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SeriesDetails)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.CreateSeriesInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSeriesSvc_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockSeriesSvc_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input domain.CreateSeriesInput
+func (_e *MockSeriesSvc_Expecter) Create(ctx interface{}, input interface{}) *MockSeriesSvc_Create_Call {
+	return &MockSeriesSvc_Create_Call{Call: _e.mock.On("Create", ctx, input)}
+}
+
+func (_c *MockSeriesSvc_Create_Call) Run(run func(ctx context.Context, input domain.CreateSeriesInput)) *MockSeriesSvc_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.CreateSeriesInput
+		if args[1] != nil {
+			arg1 = args[1].(domain.CreateSeriesInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesSvc_Create_Call) Return(seriesDetails *domain.SeriesDetails, err error) *MockSeriesSvc_Create_Call {
+	_c.Call.Return(seriesDetails, err)
+	return _c
+}
+
+func (_c *MockSeriesSvc_Create_Call) RunAndReturn(run func(ctx context.Context, input domain.CreateSeriesInput) (*domain.SeriesDetails, error)) *MockSeriesSvc_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockSeriesSvc
+func (_mock *MockSeriesSvc) Get(ctx context.Context, id string) (*domain.SeriesDetails, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *domain.SeriesDetails
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.SeriesDetails, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.SeriesDetails); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SeriesDetails)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSeriesSvc_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockSeriesSvc_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockSeriesSvc_Expecter) Get(ctx interface{}, id interface{}) *MockSeriesSvc_Get_Call {
+	return &MockSeriesSvc_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *MockSeriesSvc_Get_Call) Run(run func(ctx context.Context, id string)) *MockSeriesSvc_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesSvc_Get_Call) Return(seriesDetails *domain.SeriesDetails, err error) *MockSeriesSvc_Get_Call {
+	_c.Call.Return(seriesDetails, err)
+	return _c
+}
+
+func (_c *MockSeriesSvc_Get_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.SeriesDetails, error)) *MockSeriesSvc_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockSeriesSvc
+func (_mock *MockSeriesSvc) List(ctx context.Context) ([]*domain.EventSeries, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*domain.EventSeries
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.EventSeries, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.EventSeries); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.EventSeries)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSeriesSvc_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockSeriesSvc_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSeriesSvc_Expecter) List(ctx interface{}) *MockSeriesSvc_List_Call {
+	return &MockSeriesSvc_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockSeriesSvc_List_Call) Run(run func(ctx context.Context)) *MockSeriesSvc_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesSvc_List_Call) Return(eventSeriess []*domain.EventSeries, err error) *MockSeriesSvc_List_Call {
+	_c.Call.Return(eventSeriess, err)
+	return _c
+}
+
+func (_c *MockSeriesSvc_List_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.EventSeries, error)) *MockSeriesSvc_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockSeriesSvc
+func (_mock *MockSeriesSvc) Update(ctx context.Context, id string, input domain.UpdateSeriesInput) (*domain.EventSeries, error) {
+	ret := _mock.Called(ctx, id, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *domain.EventSeries
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.UpdateSeriesInput) (*domain.EventSeries, error)); ok {
+		return returnFunc(ctx, id, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.UpdateSeriesInput) *domain.EventSeries); ok {
+		r0 = returnFunc(ctx, id, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.EventSeries)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.UpdateSeriesInput) error); ok {
+		r1 = returnFunc(ctx, id, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSeriesSvc_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockSeriesSvc_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - input domain.UpdateSeriesInput
+func (_e *MockSeriesSvc_Expecter) Update(ctx interface{}, id interface{}, input interface{}) *MockSeriesSvc_Update_Call {
+	return &MockSeriesSvc_Update_Call{Call: _e.mock.On("Update", ctx, id, input)}
+}
+
+func (_c *MockSeriesSvc_Update_Call) Run(run func(ctx context.Context, id string, input domain.UpdateSeriesInput)) *MockSeriesSvc_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.UpdateSeriesInput
+		if args[2] != nil {
+			arg2 = args[2].(domain.UpdateSeriesInput)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesSvc_Update_Call) Return(eventSeries *domain.EventSeries, err error) *MockSeriesSvc_Update_Call {
+	_c.Call.Return(eventSeries, err)
+	return _c
+}
+
+func (_c *MockSeriesSvc_Update_Call) RunAndReturn(run func(ctx context.Context, id string, input domain.UpdateSeriesInput) (*domain.EventSeries, error)) *MockSeriesSvc_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockVenueSvc creates a new instance of MockVenueSvc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockVenueSvc(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockVenueSvc {
+	mock := &MockVenueSvc{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockVenueSvc is an autogenerated mock type for the VenueSvc type
+type MockVenueSvc struct {
+	mock.Mock
+}
+
+type MockVenueSvc_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockVenueSvc) EXPECT() *MockVenueSvc_Expecter {
+	return &MockVenueSvc_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockVenueSvc
+func (_mock *MockVenueSvc) Create(ctx context.Context, input domain.CreateVenueInput) (*domain.Venue, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.Venue
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CreateVenueInput) (*domain.Venue, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CreateVenueInput) *domain.Venue); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Venue)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.CreateVenueInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVenueSvc_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockVenueSvc_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input domain.CreateVenueInput
+func (_e *MockVenueSvc_Expecter) Create(ctx interface{}, input interface{}) *MockVenueSvc_Create_Call {
+	return &MockVenueSvc_Create_Call{Call: _e.mock.On("Create", ctx, input)}
+}
+
+func (_c *MockVenueSvc_Create_Call) Run(run func(ctx context.Context, input domain.CreateVenueInput)) *MockVenueSvc_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.CreateVenueInput
+		if args[1] != nil {
+			arg1 = args[1].(domain.CreateVenueInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVenueSvc_Create_Call) Return(venue *domain.Venue, err error) *MockVenueSvc_Create_Call {
+	_c.Call.Return(venue, err)
+	return _c
+}
+
+func (_c *MockVenueSvc_Create_Call) RunAndReturn(run func(ctx context.Context, input domain.CreateVenueInput) (*domain.Venue, error)) *MockVenueSvc_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockVenueSvc
+func (_mock *MockVenueSvc) Delete(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockVenueSvc_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockVenueSvc_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockVenueSvc_Expecter) Delete(ctx interface{}, id interface{}) *MockVenueSvc_Delete_Call {
+	return &MockVenueSvc_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockVenueSvc_Delete_Call) Run(run func(ctx context.Context, id string)) *MockVenueSvc_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVenueSvc_Delete_Call) Return(err error) *MockVenueSvc_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockVenueSvc_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockVenueSvc_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockVenueSvc
+func (_mock *MockVenueSvc) Get(ctx context.Context, id string) (*domain.Venue, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *domain.Venue
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Venue, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Venue); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Venue)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVenueSvc_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockVenueSvc_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockVenueSvc_Expecter) Get(ctx interface{}, id interface{}) *MockVenueSvc_Get_Call {
+	return &MockVenueSvc_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *MockVenueSvc_Get_Call) Run(run func(ctx context.Context, id string)) *MockVenueSvc_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVenueSvc_Get_Call) Return(venue *domain.Venue, err error) *MockVenueSvc_Get_Call {
+	_c.Call.Return(venue, err)
+	return _c
+}
+
+func (_c *MockVenueSvc_Get_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.Venue, error)) *MockVenueSvc_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockVenueSvc
+func (_mock *MockVenueSvc) List(ctx context.Context) ([]*domain.Venue, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*domain.Venue
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.Venue, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.Venue); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Venue)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVenueSvc_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockVenueSvc_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockVenueSvc_Expecter) List(ctx interface{}) *MockVenueSvc_List_Call {
+	return &MockVenueSvc_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockVenueSvc_List_Call) Run(run func(ctx context.Context)) *MockVenueSvc_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVenueSvc_List_Call) Return(venues []*domain.Venue, err error) *MockVenueSvc_List_Call {
+	_c.Call.Return(venues, err)
+	return _c
+}
+
+func (_c *MockVenueSvc_List_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.Venue, error)) *MockVenueSvc_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockVenueSvc
+func (_mock *MockVenueSvc) Update(ctx context.Context, id string, input domain.UpdateVenueInput) (*domain.Venue, error) {
+	ret := _mock.Called(ctx, id, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *domain.Venue
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.UpdateVenueInput) (*domain.Venue, error)); ok {
+		return returnFunc(ctx, id, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.UpdateVenueInput) *domain.Venue); ok {
+		r0 = returnFunc(ctx, id, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Venue)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.UpdateVenueInput) error); ok {
+		r1 = returnFunc(ctx, id, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVenueSvc_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockVenueSvc_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - input domain.UpdateVenueInput
+func (_e *MockVenueSvc_Expecter) Update(ctx interface{}, id interface{}, input interface{}) *MockVenueSvc_Update_Call {
+	return &MockVenueSvc_Update_Call{Call: _e.mock.On("Update", ctx, id, input)}
+}
+
+func (_c *MockVenueSvc_Update_Call) Run(run func(ctx context.Context, id string, input domain.UpdateVenueInput)) *MockVenueSvc_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.UpdateVenueInput
+		if args[2] != nil {
+			arg2 = args[2].(domain.UpdateVenueInput)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVenueSvc_Update_Call) Return(venue *domain.Venue, err error) *MockVenueSvc_Update_Call {
+	_c.Call.Return(venue, err)
+	return _c
+}
+
+func (_c *MockVenueSvc_Update_Call) RunAndReturn(run func(ctx context.Context, id string, input domain.UpdateVenueInput) (*domain.Venue, error)) *MockVenueSvc_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCalendarSvc creates a new instance of MockCalendarSvc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCalendarSvc(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCalendarSvc {
+	mock := &MockCalendarSvc{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCalendarSvc is an autogenerated mock type for the CalendarSvc type
+type MockCalendarSvc struct {
+	mock.Mock
+}
+
+type MockCalendarSvc_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCalendarSvc) EXPECT() *MockCalendarSvc_Expecter {
+	return &MockCalendarSvc_Expecter{mock: &_m.Mock}
+}
+
+// CreateFeedToken provides a mock function for the type MockCalendarSvc
+func (_mock *MockCalendarSvc) CreateFeedToken(ctx context.Context, userID string) (*domain.CalendarFeed, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFeedToken")
+	}
+
+	var r0 *domain.CalendarFeed
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.CalendarFeed, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.CalendarFeed); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.CalendarFeed)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCalendarSvc_CreateFeedToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFeedToken'
+type MockCalendarSvc_CreateFeedToken_Call struct {
+	*mock.Call
+}
+
+// CreateFeedToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockCalendarSvc_Expecter) CreateFeedToken(ctx interface{}, userID interface{}) *MockCalendarSvc_CreateFeedToken_Call {
+	return &MockCalendarSvc_CreateFeedToken_Call{Call: _e.mock.On("CreateFeedToken", ctx, userID)}
+}
+
+func (_c *MockCalendarSvc_CreateFeedToken_Call) Run(run func(ctx context.Context, userID string)) *MockCalendarSvc_CreateFeedToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarSvc_CreateFeedToken_Call) Return(calendarFeed *domain.CalendarFeed, err error) *MockCalendarSvc_CreateFeedToken_Call {
+	_c.Call.Return(calendarFeed, err)
+	return _c
+}
+
+func (_c *MockCalendarSvc_CreateFeedToken_Call) RunAndReturn(run func(ctx context.Context, userID string) (*domain.CalendarFeed, error)) *MockCalendarSvc_CreateFeedToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EventICS provides a mock function for the type MockCalendarSvc
+func (_mock *MockCalendarSvc) EventICS(ctx context.Context, eventID string) (*domain.Event, []byte, error) {
+	ret := _mock.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EventICS")
+	}
+
+	var r0 *domain.Event
+	var r1 []byte
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Event, []byte, error)); ok {
+		return returnFunc(ctx, eventID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Event); ok {
+		r0 = returnFunc(ctx, eventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Event)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) []byte); ok {
+		r1 = returnFunc(ctx, eventID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]byte)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, eventID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockCalendarSvc_EventICS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EventICS'
+type MockCalendarSvc_EventICS_Call struct {
+	*mock.Call
+}
+
+// EventICS is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+func (_e *MockCalendarSvc_Expecter) EventICS(ctx interface{}, eventID interface{}) *MockCalendarSvc_EventICS_Call {
+	return &MockCalendarSvc_EventICS_Call{Call: _e.mock.On("EventICS", ctx, eventID)}
+}
+
+func (_c *MockCalendarSvc_EventICS_Call) Run(run func(ctx context.Context, eventID string)) *MockCalendarSvc_EventICS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarSvc_EventICS_Call) Return(event *domain.Event, bytes []byte, err error) *MockCalendarSvc_EventICS_Call {
+	_c.Call.Return(event, bytes, err)
+	return _c
+}
+
+func (_c *MockCalendarSvc_EventICS_Call) RunAndReturn(run func(ctx context.Context, eventID string) (*domain.Event, []byte, error)) *MockCalendarSvc_EventICS_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UserFeed provides a mock function for the type MockCalendarSvc
+func (_mock *MockCalendarSvc) UserFeed(ctx context.Context, userID string, token string) ([]byte, error) {
+	ret := _mock.Called(ctx, userID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserFeed")
+	}
+
+	var r0 []byte
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]byte, error)); ok {
+		return returnFunc(ctx, userID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []byte); ok {
+		r0 = returnFunc(ctx, userID, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, userID, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCalendarSvc_UserFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserFeed'
+type MockCalendarSvc_UserFeed_Call struct {
+	*mock.Call
+}
+
+// UserFeed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - token string
+func (_e *MockCalendarSvc_Expecter) UserFeed(ctx interface{}, userID interface{}, token interface{}) *MockCalendarSvc_UserFeed_Call {
+	return &MockCalendarSvc_UserFeed_Call{Call: _e.mock.On("UserFeed", ctx, userID, token)}
+}
+
+func (_c *MockCalendarSvc_UserFeed_Call) Run(run func(ctx context.Context, userID string, token string)) *MockCalendarSvc_UserFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarSvc_UserFeed_Call) Return(bytes []byte, err error) *MockCalendarSvc_UserFeed_Call {
+	_c.Call.Return(bytes, err)
+	return _c
+}
+
+func (_c *MockCalendarSvc_UserFeed_Call) RunAndReturn(run func(ctx context.Context, userID string, token string) ([]byte, error)) *MockCalendarSvc_UserFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockStatsSvc creates a new instance of MockStatsSvc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStatsSvc(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStatsSvc {
+	mock := &MockStatsSvc{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockStatsSvc is an autogenerated mock type for the StatsSvc type
+type MockStatsSvc struct {
+	mock.Mock
+}
+
+type MockStatsSvc_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStatsSvc) EXPECT() *MockStatsSvc_Expecter {
+	return &MockStatsSvc_Expecter{mock: &_m.Mock}
+}
+
+// BookingStats provides a mock function for the type MockStatsSvc
+func (_mock *MockStatsSvc) BookingStats(ctx context.Context, input domain.StatsInput) (*domain.BookingStats, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BookingStats")
+	}
+
+	var r0 *domain.BookingStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StatsInput) (*domain.BookingStats, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StatsInput) *domain.BookingStats); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.BookingStats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.StatsInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStatsSvc_BookingStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BookingStats'
+type MockStatsSvc_BookingStats_Call struct {
+	*mock.Call
+}
+
+// BookingStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input domain.StatsInput
+func (_e *MockStatsSvc_Expecter) BookingStats(ctx interface{}, input interface{}) *MockStatsSvc_BookingStats_Call {
+	return &MockStatsSvc_BookingStats_Call{Call: _e.mock.On("BookingStats", ctx, input)}
+}
+
+func (_c *MockStatsSvc_BookingStats_Call) Run(run func(ctx context.Context, input domain.StatsInput)) *MockStatsSvc_BookingStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.StatsInput
+		if args[1] != nil {
+			arg1 = args[1].(domain.StatsInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatsSvc_BookingStats_Call) Return(bookingStats *domain.BookingStats, err error) *MockStatsSvc_BookingStats_Call {
+	_c.Call.Return(bookingStats, err)
+	return _c
+}
+
+func (_c *MockStatsSvc_BookingStats_Call) RunAndReturn(run func(ctx context.Context, input domain.StatsInput) (*domain.BookingStats, error)) *MockStatsSvc_BookingStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCategorySvc creates a new instance of MockCategorySvc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCategorySvc(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCategorySvc {
+	mock := &MockCategorySvc{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCategorySvc is an autogenerated mock type for the CategorySvc type
+type MockCategorySvc struct {
+	mock.Mock
+}
+
+type MockCategorySvc_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCategorySvc) EXPECT() *MockCategorySvc_Expecter {
+	return &MockCategorySvc_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockCategorySvc
+func (_mock *MockCategorySvc) Create(ctx context.Context, input domain.CreateCategoryInput) (*domain.Category, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CreateCategoryInput) (*domain.Category, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CreateCategoryInput) *domain.Category); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.CreateCategoryInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategorySvc_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockCategorySvc_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input domain.CreateCategoryInput
+func (_e *MockCategorySvc_Expecter) Create(ctx interface{}, input interface{}) *MockCategorySvc_Create_Call {
+	return &MockCategorySvc_Create_Call{Call: _e.mock.On("Create", ctx, input)}
+}
+
+func (_c *MockCategorySvc_Create_Call) Run(run func(ctx context.Context, input domain.CreateCategoryInput)) *MockCategorySvc_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.CreateCategoryInput
+		if args[1] != nil {
+			arg1 = args[1].(domain.CreateCategoryInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategorySvc_Create_Call) Return(category *domain.Category, err error) *MockCategorySvc_Create_Call {
+	_c.Call.Return(category, err)
+	return _c
+}
+
+func (_c *MockCategorySvc_Create_Call) RunAndReturn(run func(ctx context.Context, input domain.CreateCategoryInput) (*domain.Category, error)) *MockCategorySvc_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockCategorySvc
+func (_mock *MockCategorySvc) Delete(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCategorySvc_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockCategorySvc_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockCategorySvc_Expecter) Delete(ctx interface{}, id interface{}) *MockCategorySvc_Delete_Call {
+	return &MockCategorySvc_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockCategorySvc_Delete_Call) Run(run func(ctx context.Context, id string)) *MockCategorySvc_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategorySvc_Delete_Call) Return(err error) *MockCategorySvc_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCategorySvc_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockCategorySvc_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Follow provides a mock function for the type MockCategorySvc
+func (_mock *MockCategorySvc) Follow(ctx context.Context, userID string, categoryID string) error {
+	ret := _mock.Called(ctx, userID, categoryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Follow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, categoryID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCategorySvc_Follow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Follow'
+type MockCategorySvc_Follow_Call struct {
+	*mock.Call
+}
+
+// Follow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - categoryID string
+func (_e *MockCategorySvc_Expecter) Follow(ctx interface{}, userID interface{}, categoryID interface{}) *MockCategorySvc_Follow_Call {
+	return &MockCategorySvc_Follow_Call{Call: _e.mock.On("Follow", ctx, userID, categoryID)}
+}
+
+func (_c *MockCategorySvc_Follow_Call) Run(run func(ctx context.Context, userID string, categoryID string)) *MockCategorySvc_Follow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategorySvc_Follow_Call) Return(err error) *MockCategorySvc_Follow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCategorySvc_Follow_Call) RunAndReturn(run func(ctx context.Context, userID string, categoryID string) error) *MockCategorySvc_Follow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockCategorySvc
+func (_mock *MockCategorySvc) Get(ctx context.Context, id string) (*domain.Category, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Category, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Category); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategorySvc_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockCategorySvc_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockCategorySvc_Expecter) Get(ctx interface{}, id interface{}) *MockCategorySvc_Get_Call {
+	return &MockCategorySvc_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *MockCategorySvc_Get_Call) Run(run func(ctx context.Context, id string)) *MockCategorySvc_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategorySvc_Get_Call) Return(category *domain.Category, err error) *MockCategorySvc_Get_Call {
+	_c.Call.Return(category, err)
+	return _c
+}
+
+func (_c *MockCategorySvc_Get_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.Category, error)) *MockCategorySvc_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockCategorySvc
+func (_mock *MockCategorySvc) List(ctx context.Context) ([]*domain.Category, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.Category, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.Category); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategorySvc_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockCategorySvc_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCategorySvc_Expecter) List(ctx interface{}) *MockCategorySvc_List_Call {
+	return &MockCategorySvc_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockCategorySvc_List_Call) Run(run func(ctx context.Context)) *MockCategorySvc_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategorySvc_List_Call) Return(categorys []*domain.Category, err error) *MockCategorySvc_List_Call {
+	_c.Call.Return(categorys, err)
+	return _c
+}
+
+func (_c *MockCategorySvc_List_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.Category, error)) *MockCategorySvc_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFollowed provides a mock function for the type MockCategorySvc
+func (_mock *MockCategorySvc) ListFollowed(ctx context.Context, userID string) ([]*domain.Category, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFollowed")
+	}
+
+	var r0 []*domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*domain.Category, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*domain.Category); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategorySvc_ListFollowed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFollowed'
+type MockCategorySvc_ListFollowed_Call struct {
+	*mock.Call
+}
+
+// ListFollowed is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input domain.CreateEventInput
-func (_e *MockEventSvc_Expecter) CreateEvent(ctx interface{}, input interface{}) *MockEventSvc_CreateEvent_Call {
-	return &MockEventSvc_CreateEvent_Call{Call: _e.mock.On("CreateEvent", ctx, input)}
+//   - userID string
+func (_e *MockCategorySvc_Expecter) ListFollowed(ctx interface{}, userID interface{}) *MockCategorySvc_ListFollowed_Call {
+	return &MockCategorySvc_ListFollowed_Call{Call: _e.mock.On("ListFollowed", ctx, userID)}
 }
 
-func (_c *MockEventSvc_CreateEvent_Call) Run(run func(ctx context.Context, input domain.CreateEventInput)) *MockEventSvc_CreateEvent_Call {
+func (_c *MockCategorySvc_ListFollowed_Call) Run(run func(ctx context.Context, userID string)) *MockCategorySvc_ListFollowed_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.CreateEventInput
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(domain.CreateEventInput)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -96,57 +3714,47 @@ func (_c *MockEventSvc_CreateEvent_Call) Run(run func(ctx context.Context, input
 	return _c
 }
 
-func (_c *MockEventSvc_CreateEvent_Call) Return(event *domain.Event, err error) *MockEventSvc_CreateEvent_Call {
-	_c.Call.Return(event, err)
+func (_c *MockCategorySvc_ListFollowed_Call) Return(categorys []*domain.Category, err error) *MockCategorySvc_ListFollowed_Call {
+	_c.Call.Return(categorys, err)
 	return _c
 }
 
-func (_c *MockEventSvc_CreateEvent_Call) RunAndReturn(run func(ctx context.Context, input domain.CreateEventInput) (*domain.Event, error)) *MockEventSvc_CreateEvent_Call {
+func (_c *MockCategorySvc_ListFollowed_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]*domain.Category, error)) *MockCategorySvc_ListFollowed_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDetails provides a mock function for the type MockEventSvc
-func (_mock *MockEventSvc) GetDetails(ctx context.Context, id string) (*domain.EventDetails, error) {
-	ret := _mock.Called(ctx, id)
+// Unfollow provides a mock function for the type MockCategorySvc
+func (_mock *MockCategorySvc) Unfollow(ctx context.Context, userID string, categoryID string) error {
+	ret := _mock.Called(ctx, userID, categoryID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDetails")
+		panic("no return value specified for Unfollow")
 	}
 
-	var r0 *domain.EventDetails
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.EventDetails, error)); ok {
-		return returnFunc(ctx, id)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.EventDetails); ok {
-		r0 = returnFunc(ctx, id)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.EventDetails)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, id)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, categoryID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockEventSvc_GetDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDetails'
-type MockEventSvc_GetDetails_Call struct {
+// MockCategorySvc_Unfollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unfollow'
+type MockCategorySvc_Unfollow_Call struct {
 	*mock.Call
 }
 
-// GetDetails is a helper method to define mock.On call
+// Unfollow is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *MockEventSvc_Expecter) GetDetails(ctx interface{}, id interface{}) *MockEventSvc_GetDetails_Call {
-	return &MockEventSvc_GetDetails_Call{Call: _e.mock.On("GetDetails", ctx, id)}
+//   - userID string
+//   - categoryID string
+func (_e *MockCategorySvc_Expecter) Unfollow(ctx interface{}, userID interface{}, categoryID interface{}) *MockCategorySvc_Unfollow_Call {
+	return &MockCategorySvc_Unfollow_Call{Call: _e.mock.On("Unfollow", ctx, userID, categoryID)}
 }
 
-func (_c *MockEventSvc_GetDetails_Call) Run(run func(ctx context.Context, id string)) *MockEventSvc_GetDetails_Call {
+func (_c *MockCategorySvc_Unfollow_Call) Run(run func(ctx context.Context, userID string, categoryID string)) *MockCategorySvc_Unfollow_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -156,93 +3764,110 @@ func (_c *MockEventSvc_GetDetails_Call) Run(run func(ctx context.Context, id str
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockEventSvc_GetDetails_Call) Return(eventDetails *domain.EventDetails, err error) *MockEventSvc_GetDetails_Call {
-	_c.Call.Return(eventDetails, err)
+func (_c *MockCategorySvc_Unfollow_Call) Return(err error) *MockCategorySvc_Unfollow_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockEventSvc_GetDetails_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.EventDetails, error)) *MockEventSvc_GetDetails_Call {
+func (_c *MockCategorySvc_Unfollow_Call) RunAndReturn(run func(ctx context.Context, userID string, categoryID string) error) *MockCategorySvc_Unfollow_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// List provides a mock function for the type MockEventSvc
-func (_mock *MockEventSvc) List(ctx context.Context) ([]*domain.Event, error) {
-	ret := _mock.Called(ctx)
+// Update provides a mock function for the type MockCategorySvc
+func (_mock *MockCategorySvc) Update(ctx context.Context, id string, input domain.UpdateCategoryInput) (*domain.Category, error) {
+	ret := _mock.Called(ctx, id, input)
 
 	if len(ret) == 0 {
-		panic("no return value specified for List")
+		panic("no return value specified for Update")
 	}
 
-	var r0 []*domain.Event
+	var r0 *domain.Category
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.Event, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.UpdateCategoryInput) (*domain.Category, error)); ok {
+		return returnFunc(ctx, id, input)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.Event); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.UpdateCategoryInput) *domain.Category); ok {
+		r0 = returnFunc(ctx, id, input)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.Event)
+			r0 = ret.Get(0).(*domain.Category)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.UpdateCategoryInput) error); ok {
+		r1 = returnFunc(ctx, id, input)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockEventSvc_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
-type MockEventSvc_List_Call struct {
+// MockCategorySvc_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockCategorySvc_Update_Call struct {
 	*mock.Call
 }
 
-// List is a helper method to define mock.On call
+// Update is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockEventSvc_Expecter) List(ctx interface{}) *MockEventSvc_List_Call {
-	return &MockEventSvc_List_Call{Call: _e.mock.On("List", ctx)}
+//   - id string
+//   - input domain.UpdateCategoryInput
+func (_e *MockCategorySvc_Expecter) Update(ctx interface{}, id interface{}, input interface{}) *MockCategorySvc_Update_Call {
+	return &MockCategorySvc_Update_Call{Call: _e.mock.On("Update", ctx, id, input)}
 }
 
-func (_c *MockEventSvc_List_Call) Run(run func(ctx context.Context)) *MockEventSvc_List_Call {
+func (_c *MockCategorySvc_Update_Call) Run(run func(ctx context.Context, id string, input domain.UpdateCategoryInput)) *MockCategorySvc_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.UpdateCategoryInput
+		if args[2] != nil {
+			arg2 = args[2].(domain.UpdateCategoryInput)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockEventSvc_List_Call) Return(events []*domain.Event, err error) *MockEventSvc_List_Call {
-	_c.Call.Return(events, err)
+func (_c *MockCategorySvc_Update_Call) Return(category *domain.Category, err error) *MockCategorySvc_Update_Call {
+	_c.Call.Return(category, err)
 	return _c
 }
 
-func (_c *MockEventSvc_List_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.Event, error)) *MockEventSvc_List_Call {
+func (_c *MockCategorySvc_Update_Call) RunAndReturn(run func(ctx context.Context, id string, input domain.UpdateCategoryInput) (*domain.Category, error)) *MockCategorySvc_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockBookingSvc creates a new instance of MockBookingSvc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockBookingLimitSvc creates a new instance of MockBookingLimitSvc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockBookingSvc(t interface {
+func NewMockBookingLimitSvc(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockBookingSvc {
-	mock := &MockBookingSvc{}
+}) *MockBookingLimitSvc {
+	mock := &MockBookingLimitSvc{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -250,61 +3875,60 @@ func NewMockBookingSvc(t interface {
 	return mock
 }
 
-// MockBookingSvc is an autogenerated mock type for the BookingSvc type
-type MockBookingSvc struct {
+// MockBookingLimitSvc is an autogenerated mock type for the BookingLimitSvc type
+type MockBookingLimitSvc struct {
 	mock.Mock
 }
 
-type MockBookingSvc_Expecter struct {
+type MockBookingLimitSvc_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockBookingSvc) EXPECT() *MockBookingSvc_Expecter {
-	return &MockBookingSvc_Expecter{mock: &_m.Mock}
+func (_m *MockBookingLimitSvc) EXPECT() *MockBookingLimitSvc_Expecter {
+	return &MockBookingLimitSvc_Expecter{mock: &_m.Mock}
 }
 
-// Book provides a mock function for the type MockBookingSvc
-func (_mock *MockBookingSvc) Book(ctx context.Context, eventID string, userID string) (*domain.Booking, error) {
-	ret := _mock.Called(ctx, eventID, userID)
+// ClearCooldown provides a mock function for the type MockBookingLimitSvc
+func (_mock *MockBookingLimitSvc) ClearCooldown(ctx context.Context, userID string) (*domain.UserBookingLimits, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Book")
+		panic("no return value specified for ClearCooldown")
 	}
 
-	var r0 *domain.Booking
+	var r0 *domain.UserBookingLimits
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.Booking, error)); ok {
-		return returnFunc(ctx, eventID, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.UserBookingLimits, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.Booking); ok {
-		r0 = returnFunc(ctx, eventID, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.UserBookingLimits); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Booking)
+			r0 = ret.Get(0).(*domain.UserBookingLimits)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = returnFunc(ctx, eventID, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockBookingSvc_Book_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Book'
-type MockBookingSvc_Book_Call struct {
+// MockBookingLimitSvc_ClearCooldown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearCooldown'
+type MockBookingLimitSvc_ClearCooldown_Call struct {
 	*mock.Call
 }
 
-// Book is a helper method to define mock.On call
+// ClearCooldown is a helper method to define mock.On call
 //   - ctx context.Context
-//   - eventID string
 //   - userID string
-func (_e *MockBookingSvc_Expecter) Book(ctx interface{}, eventID interface{}, userID interface{}) *MockBookingSvc_Book_Call {
-	return &MockBookingSvc_Book_Call{Call: _e.mock.On("Book", ctx, eventID, userID)}
+func (_e *MockBookingLimitSvc_Expecter) ClearCooldown(ctx interface{}, userID interface{}) *MockBookingLimitSvc_ClearCooldown_Call {
+	return &MockBookingLimitSvc_ClearCooldown_Call{Call: _e.mock.On("ClearCooldown", ctx, userID)}
 }
 
-func (_c *MockBookingSvc_Book_Call) Run(run func(ctx context.Context, eventID string, userID string)) *MockBookingSvc_Book_Call {
+func (_c *MockBookingLimitSvc_ClearCooldown_Call) Run(run func(ctx context.Context, userID string)) *MockBookingLimitSvc_ClearCooldown_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -314,60 +3938,54 @@ func (_c *MockBookingSvc_Book_Call) Run(run func(ctx context.Context, eventID st
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
-		if args[2] != nil {
-			arg2 = args[2].(string)
-		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockBookingSvc_Book_Call) Return(booking *domain.Booking, err error) *MockBookingSvc_Book_Call {
-	_c.Call.Return(booking, err)
+func (_c *MockBookingLimitSvc_ClearCooldown_Call) Return(userBookingLimits *domain.UserBookingLimits, err error) *MockBookingLimitSvc_ClearCooldown_Call {
+	_c.Call.Return(userBookingLimits, err)
 	return _c
 }
 
-func (_c *MockBookingSvc_Book_Call) RunAndReturn(run func(ctx context.Context, eventID string, userID string) (*domain.Booking, error)) *MockBookingSvc_Book_Call {
+func (_c *MockBookingLimitSvc_ClearCooldown_Call) RunAndReturn(run func(ctx context.Context, userID string) (*domain.UserBookingLimits, error)) *MockBookingLimitSvc_ClearCooldown_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Confirm provides a mock function for the type MockBookingSvc
-func (_mock *MockBookingSvc) Confirm(ctx context.Context, eventID string, userID string) error {
-	ret := _mock.Called(ctx, eventID, userID)
+// DeleteOverride provides a mock function for the type MockBookingLimitSvc
+func (_mock *MockBookingLimitSvc) DeleteOverride(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Confirm")
+		panic("no return value specified for DeleteOverride")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = returnFunc(ctx, eventID, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockBookingSvc_Confirm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Confirm'
-type MockBookingSvc_Confirm_Call struct {
+// MockBookingLimitSvc_DeleteOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOverride'
+type MockBookingLimitSvc_DeleteOverride_Call struct {
 	*mock.Call
 }
 
-// Confirm is a helper method to define mock.On call
+// DeleteOverride is a helper method to define mock.On call
 //   - ctx context.Context
-//   - eventID string
 //   - userID string
-func (_e *MockBookingSvc_Expecter) Confirm(ctx interface{}, eventID interface{}, userID interface{}) *MockBookingSvc_Confirm_Call {
-	return &MockBookingSvc_Confirm_Call{Call: _e.mock.On("Confirm", ctx, eventID, userID)}
+func (_e *MockBookingLimitSvc_Expecter) DeleteOverride(ctx interface{}, userID interface{}) *MockBookingLimitSvc_DeleteOverride_Call {
+	return &MockBookingLimitSvc_DeleteOverride_Call{Call: _e.mock.On("DeleteOverride", ctx, userID)}
 }
 
-func (_c *MockBookingSvc_Confirm_Call) Run(run func(ctx context.Context, eventID string, userID string)) *MockBookingSvc_Confirm_Call {
+func (_c *MockBookingLimitSvc_DeleteOverride_Call) Run(run func(ctx context.Context, userID string)) *MockBookingLimitSvc_DeleteOverride_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -377,47 +3995,42 @@ func (_c *MockBookingSvc_Confirm_Call) Run(run func(ctx context.Context, eventID
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
-		if args[2] != nil {
-			arg2 = args[2].(string)
-		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockBookingSvc_Confirm_Call) Return(err error) *MockBookingSvc_Confirm_Call {
+func (_c *MockBookingLimitSvc_DeleteOverride_Call) Return(err error) *MockBookingLimitSvc_DeleteOverride_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockBookingSvc_Confirm_Call) RunAndReturn(run func(ctx context.Context, eventID string, userID string) error) *MockBookingSvc_Confirm_Call {
+func (_c *MockBookingLimitSvc_DeleteOverride_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *MockBookingLimitSvc_DeleteOverride_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListByUser provides a mock function for the type MockBookingSvc
-func (_mock *MockBookingSvc) ListByUser(ctx context.Context, userID string) ([]*domain.Booking, error) {
+// Get provides a mock function for the type MockBookingLimitSvc
+func (_mock *MockBookingLimitSvc) Get(ctx context.Context, userID string) (*domain.UserBookingLimits, error) {
 	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListByUser")
+		panic("no return value specified for Get")
 	}
 
-	var r0 []*domain.Booking
+	var r0 *domain.UserBookingLimits
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*domain.Booking, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.UserBookingLimits, error)); ok {
 		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*domain.Booking); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.UserBookingLimits); ok {
 		r0 = returnFunc(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.Booking)
+			r0 = ret.Get(0).(*domain.UserBookingLimits)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
@@ -428,19 +4041,19 @@ func (_mock *MockBookingSvc) ListByUser(ctx context.Context, userID string) ([]*
 	return r0, r1
 }
 
-// MockBookingSvc_ListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUser'
-type MockBookingSvc_ListByUser_Call struct {
+// MockBookingLimitSvc_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockBookingLimitSvc_Get_Call struct {
 	*mock.Call
 }
 
-// ListByUser is a helper method to define mock.On call
+// Get is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID string
-func (_e *MockBookingSvc_Expecter) ListByUser(ctx interface{}, userID interface{}) *MockBookingSvc_ListByUser_Call {
-	return &MockBookingSvc_ListByUser_Call{Call: _e.mock.On("ListByUser", ctx, userID)}
+func (_e *MockBookingLimitSvc_Expecter) Get(ctx interface{}, userID interface{}) *MockBookingLimitSvc_Get_Call {
+	return &MockBookingLimitSvc_Get_Call{Call: _e.mock.On("Get", ctx, userID)}
 }
 
-func (_c *MockBookingSvc_ListByUser_Call) Run(run func(ctx context.Context, userID string)) *MockBookingSvc_ListByUser_Call {
+func (_c *MockBookingLimitSvc_Get_Call) Run(run func(ctx context.Context, userID string)) *MockBookingLimitSvc_Get_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -458,92 +4071,65 @@ func (_c *MockBookingSvc_ListByUser_Call) Run(run func(ctx context.Context, user
 	return _c
 }
 
-func (_c *MockBookingSvc_ListByUser_Call) Return(bookings []*domain.Booking, err error) *MockBookingSvc_ListByUser_Call {
-	_c.Call.Return(bookings, err)
+func (_c *MockBookingLimitSvc_Get_Call) Return(userBookingLimits *domain.UserBookingLimits, err error) *MockBookingLimitSvc_Get_Call {
+	_c.Call.Return(userBookingLimits, err)
 	return _c
 }
 
-func (_c *MockBookingSvc_ListByUser_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]*domain.Booking, error)) *MockBookingSvc_ListByUser_Call {
+func (_c *MockBookingLimitSvc_Get_Call) RunAndReturn(run func(ctx context.Context, userID string) (*domain.UserBookingLimits, error)) *MockBookingLimitSvc_Get_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockUserSvc creates a new instance of MockUserSvc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockUserSvc(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockUserSvc {
-	mock := &MockUserSvc{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockUserSvc is an autogenerated mock type for the UserSvc type
-type MockUserSvc struct {
-	mock.Mock
-}
-
-type MockUserSvc_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockUserSvc) EXPECT() *MockUserSvc_Expecter {
-	return &MockUserSvc_Expecter{mock: &_m.Mock}
-}
-
-// Create provides a mock function for the type MockUserSvc
-func (_mock *MockUserSvc) Create(ctx context.Context, input domain.CreateUserInput) (*domain.User, error) {
-	ret := _mock.Called(ctx, input)
+// SetOverride provides a mock function for the type MockBookingLimitSvc
+func (_mock *MockBookingLimitSvc) SetOverride(ctx context.Context, o *domain.BookingLimitOverride) (*domain.UserBookingLimits, error) {
+	ret := _mock.Called(ctx, o)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for SetOverride")
 	}
 
-	var r0 *domain.User
+	var r0 *domain.UserBookingLimits
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CreateUserInput) (*domain.User, error)); ok {
-		return returnFunc(ctx, input)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.BookingLimitOverride) (*domain.UserBookingLimits, error)); ok {
+		return returnFunc(ctx, o)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CreateUserInput) *domain.User); ok {
-		r0 = returnFunc(ctx, input)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.BookingLimitOverride) *domain.UserBookingLimits); ok {
+		r0 = returnFunc(ctx, o)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.User)
+			r0 = ret.Get(0).(*domain.UserBookingLimits)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.CreateUserInput) error); ok {
-		r1 = returnFunc(ctx, input)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.BookingLimitOverride) error); ok {
+		r1 = returnFunc(ctx, o)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserSvc_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockUserSvc_Create_Call struct {
+// MockBookingLimitSvc_SetOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetOverride'
+type MockBookingLimitSvc_SetOverride_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// SetOverride is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input domain.CreateUserInput
-func (_e *MockUserSvc_Expecter) Create(ctx interface{}, input interface{}) *MockUserSvc_Create_Call {
-	return &MockUserSvc_Create_Call{Call: _e.mock.On("Create", ctx, input)}
+//   - o *domain.BookingLimitOverride
+func (_e *MockBookingLimitSvc_Expecter) SetOverride(ctx interface{}, o interface{}) *MockBookingLimitSvc_SetOverride_Call {
+	return &MockBookingLimitSvc_SetOverride_Call{Call: _e.mock.On("SetOverride", ctx, o)}
 }
 
-func (_c *MockUserSvc_Create_Call) Run(run func(ctx context.Context, input domain.CreateUserInput)) *MockUserSvc_Create_Call {
+func (_c *MockBookingLimitSvc_SetOverride_Call) Run(run func(ctx context.Context, o *domain.BookingLimitOverride)) *MockBookingLimitSvc_SetOverride_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.CreateUserInput
+		var arg1 *domain.BookingLimitOverride
 		if args[1] != nil {
-			arg1 = args[1].(domain.CreateUserInput)
+			arg1 = args[1].(*domain.BookingLimitOverride)
 		}
 		run(
 			arg0,
@@ -553,74 +4139,12 @@ func (_c *MockUserSvc_Create_Call) Run(run func(ctx context.Context, input domai
 	return _c
 }
 
-func (_c *MockUserSvc_Create_Call) Return(user *domain.User, err error) *MockUserSvc_Create_Call {
-	_c.Call.Return(user, err)
-	return _c
-}
-
-func (_c *MockUserSvc_Create_Call) RunAndReturn(run func(ctx context.Context, input domain.CreateUserInput) (*domain.User, error)) *MockUserSvc_Create_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// List provides a mock function for the type MockUserSvc
-func (_mock *MockUserSvc) List(ctx context.Context) ([]*domain.User, error) {
-	ret := _mock.Called(ctx)
-
-	if len(ret) == 0 {
-		panic("no return value specified for List")
-	}
-
-	var r0 []*domain.User
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.User, error)); ok {
-		return returnFunc(ctx)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.User); ok {
-		r0 = returnFunc(ctx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.User)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
-	} else {
-		r1 = ret.Error(1)
-	}
-	return r0, r1
-}
-
-// MockUserSvc_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
-type MockUserSvc_List_Call struct {
-	*mock.Call
-}
-
-// List is a helper method to define mock.On call
-//   - ctx context.Context
-func (_e *MockUserSvc_Expecter) List(ctx interface{}) *MockUserSvc_List_Call {
-	return &MockUserSvc_List_Call{Call: _e.mock.On("List", ctx)}
-}
-
-func (_c *MockUserSvc_List_Call) Run(run func(ctx context.Context)) *MockUserSvc_List_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 context.Context
-		if args[0] != nil {
-			arg0 = args[0].(context.Context)
-		}
-		run(
-			arg0,
-		)
-	})
-	return _c
-}
-
-func (_c *MockUserSvc_List_Call) Return(users []*domain.User, err error) *MockUserSvc_List_Call {
-	_c.Call.Return(users, err)
+func (_c *MockBookingLimitSvc_SetOverride_Call) Return(userBookingLimits *domain.UserBookingLimits, err error) *MockBookingLimitSvc_SetOverride_Call {
+	_c.Call.Return(userBookingLimits, err)
 	return _c
 }
 
-func (_c *MockUserSvc_List_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.User, error)) *MockUserSvc_List_Call {
+func (_c *MockBookingLimitSvc_SetOverride_Call) RunAndReturn(run func(ctx context.Context, o *domain.BookingLimitOverride) (*domain.UserBookingLimits, error)) *MockBookingLimitSvc_SetOverride_Call {
 	_c.Call.Return(run)
 	return _c
 }
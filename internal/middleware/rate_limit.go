@@ -0,0 +1,164 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/wb-go/wbf/ginext"
+	"github.com/wb-go/wbf/logger"
+)
+
+// RateLimitStore хранит вёдра маркеров. Take атомарно берёт по маркеру из всех вёдер keys,
+// только если маркер есть в каждом, и возвращает решения в порядке keys. Ключи различны.
+type RateLimitStore interface {
+	Take(ctx context.Context, keys []domain.RateKey, now time.Time) ([]domain.RateDecision, error)
+}
+
+// RateLimitKey — чем различаются клиенты одного маршрута.
+type RateLimitKey string
+
+const (
+	RateLimitByIP RateLimitKey = "ip"
+	// RateLimitByUser считает запросы по user_id из запроса, а без него — по IP.
+	// user_id указывает сам клиент, поэтому этот ключ лишь разделяет честных
+	// пользователей за общим IP; от подмены защищает только политика по IP на том же маршруте.
+	RateLimitByUser RateLimitKey = "user"
+)
+
+// RateLimitPolicy ограничивает маршрут Method + Path, где Path — шаблон маршрута
+// в виде, как он зарегистрирован в роутере, например /api/events/:id/book.
+type RateLimitPolicy struct {
+	Method string
+	Path   string
+	Key    RateLimitKey
+	Limit  domain.RateLimit
+}
+
+// RateLimit ограничивает частоту запросов к маршрутам из policies. На один маршрут
+// можно повесить несколько политик, например по пользователю и по IP: запрос проходит,
+// только если его пропустили все, а заголовки X-RateLimit-* берутся у самой строгой.
+// Отклонённый запрос не расходует маркеры ни одной из политик.
+// При превышении отвечает 429 с Retry-After. Если хранилище недоступно, запрос
+// пропускается: ограничитель не должен ронять API.
+func RateLimit(store RateLimitStore, policies []RateLimitPolicy, log logger.Logger) ginext.HandlerFunc {
+	byRoute := make(map[string][]RateLimitPolicy, len(policies))
+	for _, p := range policies {
+		route := p.Method + " " + p.Path
+		byRoute[route] = append(byRoute[route], p)
+	}
+
+	return func(c *ginext.Context) {
+		route := c.Request.Method + " " + c.FullPath()
+		routePolicies, ok := byRoute[route]
+		if !ok {
+			c.Next()
+			return
+		}
+
+		keys := make([]domain.RateKey, len(routePolicies))
+		for i, p := range routePolicies {
+			// Номер политики в ключе разводит политики одного маршрута по разным вёдрам,
+			// даже если клиент в них определяется одинаково.
+			keys[i] = domain.RateKey{
+				Key:   route + "|" + strconv.Itoa(i) + "|" + clientKey(c, p.Key),
+				Limit: p.Limit,
+			}
+		}
+		decisions, err := store.Take(c.Request.Context(), keys, time.Now())
+		if err != nil {
+			log.LogAttrs(c.Request.Context(), logger.WarnLevel, "rate limit store failed",
+				logger.String("route", route),
+				logger.String("error", err.Error()),
+			)
+			c.Next()
+			return
+		}
+
+		d := decisions[0]
+		for _, other := range decisions[1:] {
+			if stricter(other, d) {
+				d = other
+			}
+		}
+		c.Header("X-RateLimit-Limit", strconv.Itoa(d.Limit))
+		c.Header("X-RateLimit-Remaining", strconv.Itoa(d.Remaining))
+		c.Header("X-RateLimit-Reset", strconv.Itoa(seconds(d.ResetAfter)))
+
+		if !d.Allowed {
+			c.Header("Retry-After", strconv.Itoa(seconds(d.RetryAfter)))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, ginext.H{"error": "too many requests"})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// stricter сообщает, что a ограничивает клиента сильнее b: отказ важнее разрешения,
+// среди отказов — дольше ждать, среди разрешений — меньше осталось.
+func stricter(a, b domain.RateDecision) bool {
+	if a.Allowed != b.Allowed {
+		return !a.Allowed
+	}
+	if !a.Allowed {
+		return a.RetryAfter > b.RetryAfter
+	}
+	return a.Remaining < b.Remaining
+}
+
+func clientKey(c *ginext.Context, key RateLimitKey) string {
+	if key == RateLimitByUser {
+		if id := requestUserID(c); id != "" {
+			return "user:" + id
+		}
+	}
+	return "ip:" + c.ClientIP()
+}
+
+// maxPeekBody — тела больше этого размера не разбираются в поисках user_id.
+const maxPeekBody = 64 << 10
+
+// requestUserID достаёт user_id так же, как его передают клиенты API: в query или в JSON-теле.
+// Значение не проверяется и годится только как подсказка.
+// Прочитанное начало тела возвращается на место для обработчика.
+func requestUserID(c *ginext.Context) string {
+	if id := c.Query("user_id"); id != "" {
+		return id
+	}
+	if c.Request.Body == nil || !strings.HasPrefix(c.ContentType(), "application/json") {
+		return ""
+	}
+
+	orig := c.Request.Body
+	body, err := io.ReadAll(io.LimitReader(orig, maxPeekBody+1))
+	c.Request.Body = peekedBody{Reader: io.MultiReader(bytes.NewReader(body), orig), Closer: orig}
+	if err != nil || len(body) > maxPeekBody {
+		return ""
+	}
+
+	var payload struct {
+		UserID string `json:"user_id"`
+	}
+	if json.Unmarshal(body, &payload) != nil {
+		return ""
+	}
+	return payload.UserID
+}
+
+type peekedBody struct {
+	io.Reader
+	io.Closer
+}
+
+// seconds округляет вверх: клиент, повторивший запрос через Retry-After, не должен получить 429 снова.
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+)
+
+// memorySweepInterval — как часто из памяти удаляются наполнившиеся вёдра.
+const memorySweepInterval = time.Minute
+
+// MemoryRateLimitStore хранит вёдра в памяти процесса. Подходит для одной реплики:
+// у каждой реплики свой счёт, поэтому при N репликах лимит фактически в N раз выше.
+type MemoryRateLimitStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*memoryBucket
+	lastSweep time.Time
+}
+
+type memoryBucket struct {
+	domain.RateBucket
+	fullAt time.Time
+}
+
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{buckets: make(map[string]*memoryBucket)}
+}
+
+func (s *MemoryRateLimitStore) Take(_ context.Context, keys []domain.RateKey, now time.Time) ([]domain.RateDecision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+
+	limits := make([]domain.RateLimit, len(keys))
+	buckets := make([]*domain.RateBucket, len(keys))
+	stored := make([]*memoryBucket, len(keys))
+	for i, k := range keys {
+		b, ok := s.buckets[k.Key]
+		if !ok {
+			b = &memoryBucket{}
+			s.buckets[k.Key] = b
+		}
+		limits[i], buckets[i], stored[i] = k.Limit, &b.RateBucket, b
+	}
+
+	decisions := domain.TakeAll(limits, buckets, now)
+	for i, d := range decisions {
+		stored[i].fullAt = now.Add(d.ResetAfter)
+	}
+
+	return decisions, nil
+}
+
+// sweep удаляет полные вёдра: они ничем не отличаются от отсутствующих.
+func (s *MemoryRateLimitStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < memorySweepInterval {
+		return
+	}
+	s.lastSweep = now
+	for key, b := range s.buckets {
+		if !now.Before(b.fullAt) {
+			delete(s.buckets, key)
+		}
+	}
+}
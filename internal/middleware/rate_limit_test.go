@@ -0,0 +1,146 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wb-go/wbf/ginext"
+	"github.com/wb-go/wbf/logger"
+)
+
+func newTestLogger(t *testing.T) logger.Logger {
+	t.Helper()
+	log, err := logger.InitLogger("slog", "test", "test", logger.WithLevel(logger.ErrorLevel))
+	require.NoError(t, err)
+	return log
+}
+
+func setupRateLimitRouter(t *testing.T, store RateLimitStore, policies ...RateLimitPolicy) http.Handler {
+	t.Helper()
+	r := ginext.New("test")
+	r.Use(RateLimit(store, policies, newTestLogger(t)))
+	r.POST("/api/events/:id/book", func(c *ginext.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		c.String(http.StatusCreated, string(body))
+	})
+	r.GET("/api/events", func(c *ginext.Context) { c.Status(http.StatusOK) })
+	return r
+}
+
+func book(r http.Handler, body, ip string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/api/events/e1/book", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.RemoteAddr = ip + ":1234"
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+var bookPolicy = RateLimitPolicy{
+	Method: http.MethodPost,
+	Path:   "/api/events/:id/book",
+	Key:    RateLimitByUser,
+	Limit:  domain.RateLimit{Requests: 1, Period: time.Minute, Burst: 2},
+}
+
+func TestRateLimit_RejectsOverLimit(t *testing.T) {
+	r := setupRateLimitRouter(t, NewMemoryRateLimitStore(), bookPolicy)
+	body := `{"user_id":"u1"}`
+
+	w := book(r, body, "10.0.0.1")
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, body, w.Body.String(), "тело должно дойти до обработчика")
+	assert.Equal(t, "2", w.Header().Get("X-RateLimit-Limit"))
+	assert.Equal(t, "1", w.Header().Get("X-RateLimit-Remaining"))
+
+	assert.Equal(t, http.StatusCreated, book(r, body, "10.0.0.1").Code)
+
+	w = book(r, body, "10.0.0.2")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "60", w.Header().Get("Retry-After"))
+	assert.Equal(t, "0", w.Header().Get("X-RateLimit-Remaining"))
+	assert.Equal(t, "120", w.Header().Get("X-RateLimit-Reset"))
+
+	// Другой пользователь считается отдельно, даже с того же IP.
+	assert.Equal(t, http.StatusCreated, book(r, `{"user_id":"u2"}`, "10.0.0.1").Code)
+}
+
+func TestRateLimit_UserFallsBackToIP(t *testing.T) {
+	r := setupRateLimitRouter(t, NewMemoryRateLimitStore(), bookPolicy)
+
+	assert.Equal(t, http.StatusCreated, book(r, `{}`, "10.0.0.1").Code)
+	assert.Equal(t, http.StatusCreated, book(r, `{}`, "10.0.0.1").Code)
+	assert.Equal(t, http.StatusTooManyRequests, book(r, `{}`, "10.0.0.1").Code)
+	assert.Equal(t, http.StatusCreated, book(r, `{}`, "10.0.0.2").Code)
+}
+
+func TestRateLimit_SeveralPolicies(t *testing.T) {
+	byIP := RateLimitPolicy{
+		Method: http.MethodPost,
+		Path:   "/api/events/:id/book",
+		Key:    RateLimitByIP,
+		Limit:  domain.RateLimit{Requests: 1, Period: time.Minute, Burst: 3},
+	}
+	r := setupRateLimitRouter(t, NewMemoryRateLimitStore(), bookPolicy, byIP)
+
+	// Разные пользователи укладываются в свои лимиты, но упираются в общий лимит IP.
+	for _, user := range []string{"u1", "u2", "u3"} {
+		assert.Equal(t, http.StatusCreated, book(r, `{"user_id":"`+user+`"}`, "10.0.0.1").Code)
+	}
+	assert.Equal(t, http.StatusTooManyRequests, book(r, `{"user_id":"u4"}`, "10.0.0.1").Code)
+}
+
+func TestRateLimit_RejectedRequestKeepsOtherBuckets(t *testing.T) {
+	byIP := RateLimitPolicy{
+		Method: http.MethodPost,
+		Path:   "/api/events/:id/book",
+		Key:    RateLimitByIP,
+		Limit:  domain.RateLimit{Requests: 1, Period: time.Minute, Burst: 1},
+	}
+	r := setupRateLimitRouter(t, NewMemoryRateLimitStore(), bookPolicy, byIP)
+
+	// Ведро IP 10.0.0.1 опустело, запросы u2 с него отклоняются.
+	assert.Equal(t, http.StatusCreated, book(r, `{"user_id":"u1"}`, "10.0.0.1").Code)
+	for range 3 {
+		assert.Equal(t, http.StatusTooManyRequests, book(r, `{"user_id":"u2"}`, "10.0.0.1").Code)
+	}
+
+	// Ведро u2 осталось полным: с других адресов проходят оба запроса из burst.
+	assert.Equal(t, http.StatusCreated, book(r, `{"user_id":"u2"}`, "10.0.0.2").Code)
+	assert.Equal(t, http.StatusCreated, book(r, `{"user_id":"u2"}`, "10.0.0.3").Code)
+	assert.Equal(t, http.StatusTooManyRequests, book(r, `{"user_id":"u2"}`, "10.0.0.4").Code)
+}
+
+func TestRateLimit_OtherRoutesUnlimited(t *testing.T) {
+	r := setupRateLimitRouter(t, NewMemoryRateLimitStore(), bookPolicy)
+
+	for range 5 {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/events", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("X-RateLimit-Limit"))
+	}
+}
+
+type failingStore struct{}
+
+func (failingStore) Take(context.Context, []domain.RateKey, time.Time) ([]domain.RateDecision, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestRateLimit_StoreFailureLetsRequestThrough(t *testing.T) {
+	r := setupRateLimitRouter(t, failingStore{}, bookPolicy)
+
+	for range 3 {
+		assert.Equal(t, http.StatusCreated, book(r, `{"user_id":"u1"}`, "10.0.0.1").Code)
+	}
+}
@@ -0,0 +1,105 @@
+// Package netguard защищает исходящие запросы на адреса, заданные пользователями
+// (вебхуки), от обращений во внутреннюю сеть (SSRF).
+package netguard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+var (
+	ErrInvalidURL       = errors.New("url must be an absolute http(s) URL")
+	ErrForbiddenAddress = errors.New("address is not public")
+)
+
+// dialTimeout ограничивает установку соединения отдельно от общего таймаута клиента.
+const dialTimeout = 5 * time.Second
+
+// sharedAddressSpace — 100.64.0.0/10 (CGNAT), netip не считает его частным.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// IsPublic сообщает, что на адрес можно отправлять запросы: он не loopback,
+// не частный, не link-local (в том числе метаданные облака 169.254.169.254) и не групповой.
+func IsPublic(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return ip.IsValid() &&
+		!ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() &&
+		!ip.IsUnspecified() &&
+		!sharedAddressSpace.Contains(ip)
+}
+
+// ValidateURL проверяет, что raw — абсолютный http(s) URL, а хост не указывает
+// на внутренний адрес. Хост, который сейчас не резолвится, не считается ошибкой:
+// адреса всё равно проверяются при каждом соединении клиентом из NewHTTPClient.
+func ValidateURL(ctx context.Context, raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return ErrInvalidURL
+	}
+
+	host := u.Hostname()
+	if ip, err := netip.ParseAddr(host); err == nil {
+		if !IsPublic(ip) {
+			return fmt.Errorf("%w: %s", ErrForbiddenAddress, host)
+		}
+		return nil
+	}
+
+	ips, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return nil
+	}
+	for _, ip := range ips {
+		if !IsPublic(ip) {
+			return fmt.Errorf("%w: %s resolves to %s", ErrForbiddenAddress, host, ip)
+		}
+	}
+	return nil
+}
+
+// NewHTTPClient возвращает клиент, который отказывается соединяться с непубличными
+// адресами. Проверка делается после резолвинга, на каждое соединение, поэтому
+// подмена DNS-записи после ValidateURL не помогает. Редиректы не выполняются:
+// ответ 3xx возвращается как есть.
+func NewHTTPClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{Timeout: dialTimeout, Control: controlPublic}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// Прокси не используется: иначе проверялся бы адрес прокси, а не получателя.
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func controlPublic(_, address string, _ syscall.RawConn) error {
+	addr, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("parse dial address %q: %w", address, err)
+	}
+	if !IsPublic(addr.Addr()) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, addr.Addr())
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package netguard
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPublic(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:4700::1111", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"fc00::1", false},
+		{"0.0.0.0", false},
+		{"100.64.0.1", false},
+		{"224.0.0.1", false},
+		{"::ffff:127.0.0.1", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsPublic(netip.MustParseAddr(tt.ip)))
+		})
+	}
+}
+
+func TestValidateURL(t *testing.T) {
+	ctx := context.Background()
+
+	assert.NoError(t, ValidateURL(ctx, "https://93.184.216.34/hook"))
+	assert.ErrorIs(t, ValidateURL(ctx, "ftp://93.184.216.34/hook"), ErrInvalidURL)
+	assert.ErrorIs(t, ValidateURL(ctx, "/relative"), ErrInvalidURL)
+	assert.ErrorIs(t, ValidateURL(ctx, "http://127.0.0.1:8080/hook"), ErrForbiddenAddress)
+	assert.ErrorIs(t, ValidateURL(ctx, "http://[::1]/hook"), ErrForbiddenAddress)
+	assert.ErrorIs(t, ValidateURL(ctx, "http://169.254.169.254/latest/meta-data"), ErrForbiddenAddress)
+	assert.ErrorIs(t, ValidateURL(ctx, "http://localhost/hook"), ErrForbiddenAddress)
+}
+
+func TestNewHTTPClient_RefusesLoopback(t *testing.T) {
+	called := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		called = true
+	}))
+	defer srv.Close()
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, srv.URL, http.NoBody)
+	require.NoError(t, err)
+
+	_, err = NewHTTPClient(time.Second).Do(req)
+
+	assert.ErrorIs(t, err, ErrForbiddenAddress)
+	assert.False(t, called)
+}
@@ -0,0 +1,274 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/calendar"
+	"github.com/stpnv0/EventBooker/internal/config"
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/wb-go/wbf/logger"
+)
+
+// attachment — файл, прикладываемый к письму.
+type attachment struct {
+	filename    string
+	contentType string
+	content     []byte
+}
+
+type EmailNotifier struct {
+	cfg       config.EmailConfig
+	from      *mail.Address
+	templates *Templates
+	logger    logger.Logger
+}
+
+func NewEmailNotifier(cfg config.EmailConfig, templates *Templates, logger logger.Logger) (*EmailNotifier, error) {
+	n := &EmailNotifier{cfg: cfg, templates: templates, logger: logger}
+
+	if cfg.SMTPHost == "" {
+		logger.Warn("smtp host is empty, email notifications disabled")
+		return n, nil
+	}
+
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("parse from address: %w", err)
+	}
+	n.from = from
+
+	return n, nil
+}
+
+func (n *EmailNotifier) NotifyBookingCreated(ctx context.Context, user *domain.User, event *domain.Event) error {
+	return n.send(ctx, domain.NotificationBookingCreated, user, event)
+}
+
+func (n *EmailNotifier) NotifyBookingConfirmed(ctx context.Context, user *domain.User, event *domain.Event) error {
+	return n.send(ctx, domain.NotificationBookingConfirmed, user, event)
+}
+
+func (n *EmailNotifier) NotifyBookingCancelled(ctx context.Context, user *domain.User, event *domain.Event) error {
+	return n.send(ctx, domain.NotificationBookingCancelled, user, event)
+}
+
+func (n *EmailNotifier) NotifyNewEvent(ctx context.Context, user *domain.User, event *domain.Event) error {
+	return n.send(ctx, domain.NotificationNewEvent, user, event)
+}
+
+func (n *EmailNotifier) NotifyInvitation(ctx context.Context, user *domain.User, event *domain.Event) error {
+	return n.send(ctx, domain.NotificationEventInvitation, user, event)
+}
+
+func (n *EmailNotifier) send(ctx context.Context, kind domain.NotificationKind, user *domain.User, event *domain.Event) error {
+	if n.from == nil {
+		n.logger.Debug("email skipped (smtp disabled)", logger.String("kind", string(kind)))
+		return nil
+	}
+
+	if user.Email == nil || *user.Email == "" {
+		n.logger.Debug("email skipped (no email)", logger.String("user_id", user.ID))
+		return nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	subject, text, html, err := n.templates.Email(kind, user, event)
+	if err != nil {
+		return fmt.Errorf("render email: %w", err)
+	}
+
+	// К подтверждению прикладывается .ics, чтобы мероприятие можно было добавить в календарь одним нажатием.
+	var attachments []attachment
+	if kind == domain.NotificationBookingConfirmed {
+		attachments = append(attachments, attachment{
+			filename:    calendar.Filename(event),
+			contentType: calendar.ContentType,
+			content:     calendar.Event(calendar.Entry{Event: event}),
+		})
+	}
+
+	msg, err := n.compose(*user.Email, subject, text, html, attachments...)
+	if err != nil {
+		return fmt.Errorf("compose email: %w", err)
+	}
+
+	if err = n.deliver(ctx, *user.Email, msg); err != nil {
+		return fmt.Errorf("send email notification: %w", err)
+	}
+
+	return nil
+}
+
+// compose собирает письмо multipart/alternative с текстовой и HTML-версией.
+// Если есть вложения, альтернативы оборачиваются в multipart/mixed.
+func (n *EmailNotifier) compose(to, subject, text, html string, attachments ...attachment) ([]byte, error) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for _, part := range []struct {
+		contentType string
+		content     []byte
+	}{
+		{"text/plain; charset=UTF-8", []byte(text)},
+		{"text/html; charset=UTF-8", []byte(html)},
+	} {
+		w, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create part: %w", err)
+		}
+		qp := quotedprintable.NewWriter(w)
+		if _, err = qp.Write(part.content); err != nil {
+			return nil, fmt.Errorf("write part: %w", err)
+		}
+		if err = qp.Close(); err != nil {
+			return nil, fmt.Errorf("close part: %w", err)
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, fmt.Errorf("close multipart: %w", err)
+	}
+
+	contentType := fmt.Sprintf("multipart/alternative; boundary=%q", mw.Boundary())
+	if len(attachments) > 0 {
+		mixed, err := wrapMixed(contentType, body.Bytes(), attachments)
+		if err != nil {
+			return nil, err
+		}
+		contentType, body = mixed.contentType, mixed.body
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", n.from.String())
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("UTF-8", subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&msg, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&msg, "Content-Type: %s\r\n\r\n", contentType)
+	msg.Write(body.Bytes())
+
+	return msg.Bytes(), nil
+}
+
+type mixedBody struct {
+	contentType string
+	body        bytes.Buffer
+}
+
+// wrapMixed собирает multipart/mixed: первой частью идут альтернативы, затем вложения в base64.
+func wrapMixed(alternativeType string, alternative []byte, attachments []attachment) (*mixedBody, error) {
+	res := &mixedBody{}
+	mw := multipart.NewWriter(&res.body)
+
+	w, err := mw.CreatePart(textproto.MIMEHeader{"Content-Type": {alternativeType}})
+	if err != nil {
+		return nil, fmt.Errorf("create alternative part: %w", err)
+	}
+	if _, err = w.Write(alternative); err != nil {
+		return nil, fmt.Errorf("write alternative part: %w", err)
+	}
+
+	for _, a := range attachments {
+		w, err = mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {a.contentType},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": a.filename})},
+			"Content-Transfer-Encoding": {"base64"},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create attachment part: %w", err)
+		}
+		if err = writeBase64Lines(w, a.content); err != nil {
+			return nil, fmt.Errorf("write attachment %s: %w", a.filename, err)
+		}
+	}
+	if err = mw.Close(); err != nil {
+		return nil, fmt.Errorf("close multipart: %w", err)
+	}
+
+	res.contentType = fmt.Sprintf("multipart/mixed; boundary=%q", mw.Boundary())
+	return res, nil
+}
+
+// writeBase64Lines пишет base64 строками по 76 символов, как требует RFC 2045.
+func writeBase64Lines(w io.Writer, content []byte) error {
+	encoded := base64.StdEncoding.EncodeToString(content)
+	for len(encoded) > 0 {
+		n := min(len(encoded), 76)
+		if _, err := io.WriteString(w, encoded[:n]+"\r\n"); err != nil {
+			return err
+		}
+		encoded = encoded[n:]
+	}
+	return nil
+}
+
+func (n *EmailNotifier) deliver(ctx context.Context, to string, msg []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, n.cfg.Timeout)
+	defer cancel()
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", n.cfg.Addr())
+	if err != nil {
+		return fmt.Errorf("dial smtp: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	c, err := smtp.NewClient(conn, n.cfg.SMTPHost)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("smtp handshake: %w", err)
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err = c.StartTLS(&tls.Config{ServerName: n.cfg.SMTPHost}); err != nil {
+			return fmt.Errorf("starttls: %w", err)
+		}
+	}
+
+	if n.cfg.Username != "" {
+		auth := smtp.PlainAuth("", n.cfg.Username, n.cfg.Password, n.cfg.SMTPHost)
+		if err = c.Auth(auth); err != nil {
+			return fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+
+	if err = c.Mail(n.from.Address); err != nil {
+		return fmt.Errorf("mail from: %w", err)
+	}
+	if err = c.Rcpt(to); err != nil {
+		return fmt.Errorf("rcpt to: %w", err)
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("data: %w", err)
+	}
+	if _, err = w.Write(msg); err != nil {
+		return fmt.Errorf("write message: %w", err)
+	}
+	if err = w.Close(); err != nil {
+		return fmt.Errorf("close message: %w", err)
+	}
+
+	return c.Quit()
+}
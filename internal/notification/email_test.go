@@ -0,0 +1,204 @@
+package notification
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/config"
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wb-go/wbf/logger"
+)
+
+func newTestLogger(t *testing.T) logger.Logger {
+	t.Helper()
+	log, err := logger.InitLogger("slog", "test", "test", logger.WithLevel(logger.ErrorLevel))
+	if err != nil {
+		t.Fatalf("init test logger: %v", err)
+	}
+	return log
+}
+
+// smtpMessage — письмо, принятое тестовым SMTP-сервером.
+type smtpMessage struct {
+	from string
+	to   []string
+	data string
+}
+
+// startSMTPServer поднимает минимальный SMTP-сервер без TLS и авторизации.
+func startSMTPServer(t *testing.T) (host string, port int, messages <-chan smtpMessage) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	out := make(chan smtpMessage, 10)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveSMTP(conn, out)
+		}
+	}()
+
+	addr := ln.Addr().(*net.TCPAddr)
+	return addr.IP.String(), addr.Port, out
+}
+
+func serveSMTP(conn net.Conn, out chan<- smtpMessage) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+
+	reply("220 localhost ESMTP test")
+	var msg smtpMessage
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		cmd := strings.ToUpper(line)
+
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(cmd, "MAIL FROM:"):
+			msg.from = strings.Trim(line[len("MAIL FROM:"):], "<>")
+			reply("250 OK")
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			msg.to = append(msg.to, strings.Trim(line[len("RCPT TO:"):], "<>"))
+			reply("250 OK")
+		case cmd == "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				data.WriteString(l)
+			}
+			msg.data = data.String()
+			out <- msg
+			msg = smtpMessage{}
+			reply("250 OK")
+		case cmd == "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func TestEmailNotifier_SendsMultipartMessage(t *testing.T) {
+	host, port, messages := startSMTPServer(t)
+
+	n, err := NewEmailNotifier(config.EmailConfig{
+		SMTPHost: host,
+		SMTPPort: port,
+		From:     "EventBooker <noreply@example.com>",
+		Timeout:  time.Second,
+	}, newTestTemplates(t), newTestLogger(t))
+	require.NoError(t, err)
+
+	email := "alice@example.com"
+	user := &domain.User{ID: "u1", Username: "alice", Email: &email}
+	event := &domain.Event{
+		ID:         "e1",
+		Title:      "Concert",
+		EventDate:  time.Date(2030, 5, 1, 19, 0, 0, 0, time.UTC),
+		BookingTTL: 20 * time.Minute,
+	}
+
+	n.NotifyBookingCreated(context.Background(), user, event)
+
+	select {
+	case msg := <-messages:
+		assert.Equal(t, "noreply@example.com", msg.from)
+		assert.Equal(t, []string{email}, msg.to)
+		assert.Contains(t, msg.data, "Content-Type: multipart/alternative")
+		assert.Contains(t, msg.data, "text/plain; charset=UTF-8")
+		assert.Contains(t, msg.data, "text/html; charset=UTF-8")
+		assert.Contains(t, msg.data, "Subject: =?UTF-8?q?")
+		assert.Contains(t, msg.data, "Concert")
+	case <-time.After(2 * time.Second):
+		t.Fatal("message was not delivered")
+	}
+}
+
+func TestEmailNotifier_SkipsUserWithoutEmail(t *testing.T) {
+	host, port, messages := startSMTPServer(t)
+
+	n, err := NewEmailNotifier(config.EmailConfig{
+		SMTPHost: host,
+		SMTPPort: port,
+		From:     "noreply@example.com",
+		Timeout:  time.Second,
+	}, newTestTemplates(t), newTestLogger(t))
+	require.NoError(t, err)
+
+	n.NotifyBookingConfirmed(context.Background(), &domain.User{ID: "u1"}, &domain.Event{ID: "e1"})
+
+	select {
+	case <-messages:
+		t.Fatal("unexpected message")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestEmailNotifier_Disabled(t *testing.T) {
+	n, err := NewEmailNotifier(config.EmailConfig{SMTPPort: 25}, newTestTemplates(t), newTestLogger(t))
+	require.NoError(t, err)
+
+	email := "alice@example.com"
+	// Не должен паниковать и пытаться подключиться.
+	n.NotifyBookingCancelled(context.Background(), &domain.User{ID: "u1", Email: &email}, &domain.Event{ID: "e1"})
+}
+
+func TestEmailNotifier_ConfirmationHasCalendarAttachment(t *testing.T) {
+	host, port, messages := startSMTPServer(t)
+
+	n, err := NewEmailNotifier(config.EmailConfig{
+		SMTPHost: host,
+		SMTPPort: port,
+		From:     "noreply@example.com",
+		Timeout:  time.Second,
+	}, newTestTemplates(t), newTestLogger(t))
+	require.NoError(t, err)
+
+	email := "alice@example.com"
+	user := &domain.User{ID: "u1", Username: "alice", Email: &email}
+	event := &domain.Event{
+		ID:        "e1",
+		Title:     "Concert",
+		EventDate: time.Date(2030, 5, 1, 19, 0, 0, 0, time.UTC),
+		Duration:  2 * time.Hour,
+	}
+
+	n.NotifyBookingConfirmed(context.Background(), user, event)
+
+	select {
+	case msg := <-messages:
+		assert.Contains(t, msg.data, "Content-Type: multipart/mixed")
+		assert.Contains(t, msg.data, "Content-Type: multipart/alternative")
+		assert.Contains(t, msg.data, "Content-Type: text/calendar; charset=utf-8")
+		assert.Contains(t, msg.data, `attachment; filename=event-e1.ics`)
+	case <-time.After(2 * time.Second):
+		t.Fatal("message was not delivered")
+	}
+}
@@ -0,0 +1,64 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/wb-go/wbf/logger"
+)
+
+type OutboxWriter interface {
+	Enqueue(ctx context.Context, n *domain.Notification) error
+}
+
+// OutboxNotifier не отправляет уведомления сам, а складывает их в таблицу notifications,
+// откуда их забирает воркер. Так API-процессы не зависят от внешних каналов доставки.
+type OutboxNotifier struct {
+	outbox OutboxWriter
+	logger logger.Logger
+}
+
+func NewOutboxNotifier(outbox OutboxWriter, logger logger.Logger) *OutboxNotifier {
+	return &OutboxNotifier{outbox: outbox, logger: logger}
+}
+
+func (n *OutboxNotifier) NotifyBookingCreated(ctx context.Context, user *domain.User, event *domain.Event) {
+	n.enqueue(ctx, domain.NotificationBookingCreated, user, event)
+}
+
+func (n *OutboxNotifier) NotifyBookingConfirmed(ctx context.Context, user *domain.User, event *domain.Event) {
+	n.enqueue(ctx, domain.NotificationBookingConfirmed, user, event)
+}
+
+func (n *OutboxNotifier) NotifyBookingCancelled(ctx context.Context, user *domain.User, event *domain.Event) {
+	n.enqueue(ctx, domain.NotificationBookingCancelled, user, event)
+}
+
+func (n *OutboxNotifier) NotifyNewEvent(ctx context.Context, user *domain.User, event *domain.Event) {
+	n.enqueue(ctx, domain.NotificationNewEvent, user, event)
+}
+
+func (n *OutboxNotifier) NotifyInvitation(ctx context.Context, user *domain.User, event *domain.Event) {
+	n.enqueue(ctx, domain.NotificationEventInvitation, user, event)
+}
+
+func (n *OutboxNotifier) enqueue(ctx context.Context, kind domain.NotificationKind, user *domain.User, event *domain.Event) {
+	msg := &domain.Notification{
+		ID:        uuid.New().String(),
+		Kind:      kind,
+		UserID:    user.ID,
+		EventID:   event.ID,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if err := n.outbox.Enqueue(ctx, msg); err != nil {
+		n.logger.Error("failed to enqueue notification",
+			logger.String("kind", string(kind)),
+			logger.String("user_id", user.ID),
+			logger.String("event_id", event.ID),
+			logger.String("error", err.Error()),
+		)
+	}
+}
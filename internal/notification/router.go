@@ -0,0 +1,98 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/service/ports"
+	"github.com/wb-go/wbf/logger"
+)
+
+type PreferenceReader interface {
+	Get(ctx context.Context, userID string) (*domain.NotificationPreferences, error)
+}
+
+// Router рассылает уведомление по тем каналам, которые пользователь включил для данного типа события.
+// Каждый канал доставляет независимо: Deliver сообщает, какие каналы справились, чтобы при
+// повторе после сбоя одного канала остальные не получили уведомление второй раз.
+type Router struct {
+	channels map[domain.NotificationChannel]ports.ChannelNotifier
+	prefs    PreferenceReader
+	logger   logger.Logger
+}
+
+func NewRouter(
+	channels map[domain.NotificationChannel]ports.ChannelNotifier,
+	prefs PreferenceReader,
+	logger logger.Logger,
+) *Router {
+	return &Router{channels: channels, prefs: prefs, logger: logger}
+}
+
+// Deliver отправляет уведомление kind по включённым каналам, кроме done, и возвращает
+// каналы, доставившие его сейчас. Ошибки остальных каналов собираются вместе.
+func (r *Router) Deliver(
+	ctx context.Context,
+	kind domain.NotificationKind,
+	user *domain.User,
+	event *domain.Event,
+	done []domain.NotificationChannel,
+) ([]domain.NotificationChannel, error) {
+	var (
+		delivered []domain.NotificationChannel
+		errs      []error
+	)
+	for _, ch := range r.route(ctx, user, kind) {
+		if slices.Contains(done, ch) {
+			continue
+		}
+		if err := send(ctx, r.channels[ch], kind, user, event); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", ch, err))
+			continue
+		}
+		delivered = append(delivered, ch)
+	}
+	return delivered, errors.Join(errs...)
+}
+
+func send(ctx context.Context, n ports.ChannelNotifier, kind domain.NotificationKind, user *domain.User, event *domain.Event) error {
+	switch kind {
+	case domain.NotificationBookingCreated:
+		return n.NotifyBookingCreated(ctx, user, event)
+	case domain.NotificationBookingConfirmed:
+		return n.NotifyBookingConfirmed(ctx, user, event)
+	case domain.NotificationBookingCancelled:
+		return n.NotifyBookingCancelled(ctx, user, event)
+	case domain.NotificationNewEvent:
+		return n.NotifyNewEvent(ctx, user, event)
+	case domain.NotificationEventInvitation:
+		return n.NotifyInvitation(ctx, user, event)
+	default:
+		return fmt.Errorf("unknown notification kind %q", kind)
+	}
+}
+
+// route возвращает каналы, включённые пользователем для kind.
+// Если настройки прочитать не удалось, используются значения по умолчанию.
+func (r *Router) route(ctx context.Context, user *domain.User, kind domain.NotificationKind) []domain.NotificationChannel {
+	prefs, err := r.prefs.Get(ctx, user.ID)
+	if err != nil {
+		r.logger.Error("failed to load notification preferences, using defaults",
+			logger.String("user_id", user.ID),
+			logger.String("error", err.Error()),
+		)
+		prefs = domain.NewNotificationPreferences(user.ID)
+	}
+
+	var res []domain.NotificationChannel
+	for _, ch := range domain.NotificationChannels {
+		if _, ok := r.channels[ch]; ok && prefs.Enabled(ch, kind) {
+			res = append(res, ch)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,118 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/service/ports"
+	"github.com/stpnv0/EventBooker/internal/service/ports/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRouter_RoutesToEnabledChannels(t *testing.T) {
+	tg := mocks.NewMockChannelNotifier(t)
+	email := mocks.NewMockChannelNotifier(t)
+	webhook := mocks.NewMockChannelNotifier(t)
+	prefs := mocks.NewMockNotificationPreferenceRepo(t)
+
+	r := NewRouter(map[domain.NotificationChannel]ports.ChannelNotifier{
+		domain.ChannelTelegram: tg,
+		domain.ChannelEmail:    email,
+		domain.ChannelWebhook:  webhook,
+	}, prefs, newTestLogger(t))
+
+	user := &domain.User{ID: "u1"}
+	event := &domain.Event{ID: "e1"}
+
+	p := domain.NewNotificationPreferences("u1")
+	p.Set(domain.ChannelEmail, domain.NotificationBookingCreated, false)
+	p.Set(domain.ChannelWebhook, domain.NotificationBookingCreated, true)
+	prefs.EXPECT().Get(mock.Anything, "u1").Return(p, nil)
+
+	tg.EXPECT().NotifyBookingCreated(mock.Anything, user, event).Return(nil)
+	webhook.EXPECT().NotifyBookingCreated(mock.Anything, user, event).Return(nil)
+
+	delivered, err := r.Deliver(context.Background(), domain.NotificationBookingCreated, user, event, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.NotificationChannel{domain.ChannelTelegram, domain.ChannelWebhook}, delivered)
+}
+
+func TestRouter_FallsBackToDefaults(t *testing.T) {
+	tg := mocks.NewMockChannelNotifier(t)
+	webhook := mocks.NewMockChannelNotifier(t)
+	prefs := mocks.NewMockNotificationPreferenceRepo(t)
+
+	r := NewRouter(map[domain.NotificationChannel]ports.ChannelNotifier{
+		domain.ChannelTelegram: tg,
+		domain.ChannelWebhook:  webhook,
+	}, prefs, newTestLogger(t))
+
+	user := &domain.User{ID: "u1"}
+	event := &domain.Event{ID: "e1"}
+
+	prefs.EXPECT().Get(mock.Anything, "u1").Return(nil, errors.New("db error"))
+	tg.EXPECT().NotifyBookingCancelled(mock.Anything, user, event).Return(nil)
+
+	_, err := r.Deliver(context.Background(), domain.NotificationBookingCancelled, user, event, nil)
+
+	assert.NoError(t, err)
+}
+
+func TestRouter_ReturnsChannelErrors(t *testing.T) {
+	tg := mocks.NewMockChannelNotifier(t)
+	email := mocks.NewMockChannelNotifier(t)
+	prefs := mocks.NewMockNotificationPreferenceRepo(t)
+
+	r := NewRouter(map[domain.NotificationChannel]ports.ChannelNotifier{
+		domain.ChannelTelegram: tg,
+		domain.ChannelEmail:    email,
+	}, prefs, newTestLogger(t))
+
+	user := &domain.User{ID: "u1"}
+	event := &domain.Event{ID: "e1"}
+	smtpErr := errors.New("smtp: connection refused")
+
+	prefs.EXPECT().Get(mock.Anything, "u1").Return(domain.NewNotificationPreferences("u1"), nil)
+	// Отказ одного канала не мешает остальным, но ошибка возвращается, чтобы доставку повторили.
+	tg.EXPECT().NotifyBookingConfirmed(mock.Anything, user, event).Return(nil)
+	email.EXPECT().NotifyBookingConfirmed(mock.Anything, user, event).Return(smtpErr)
+
+	delivered, err := r.Deliver(context.Background(), domain.NotificationBookingConfirmed, user, event, nil)
+
+	assert.ErrorIs(t, err, smtpErr)
+	assert.Equal(t, []domain.NotificationChannel{domain.ChannelTelegram}, delivered)
+}
+
+func TestRouter_RetrySkipsDeliveredChannels(t *testing.T) {
+	tg := mocks.NewMockChannelNotifier(t)
+	email := mocks.NewMockChannelNotifier(t)
+	prefs := mocks.NewMockNotificationPreferenceRepo(t)
+
+	r := NewRouter(map[domain.NotificationChannel]ports.ChannelNotifier{
+		domain.ChannelTelegram: tg,
+		domain.ChannelEmail:    email,
+	}, prefs, newTestLogger(t))
+
+	user := &domain.User{ID: "u1"}
+	event := &domain.Event{ID: "e1"}
+	smtpErr := errors.New("smtp: connection refused")
+
+	prefs.EXPECT().Get(mock.Anything, "u1").Return(domain.NewNotificationPreferences("u1"), nil)
+	tg.EXPECT().NotifyBookingConfirmed(mock.Anything, user, event).Return(nil).Once()
+	email.EXPECT().NotifyBookingConfirmed(mock.Anything, user, event).Return(smtpErr).Once()
+	email.EXPECT().NotifyBookingConfirmed(mock.Anything, user, event).Return(nil).Once()
+
+	done, err := r.Deliver(context.Background(), domain.NotificationBookingConfirmed, user, event, nil)
+	assert.ErrorIs(t, err, smtpErr)
+
+	// Повтор отправляет только в email: Telegram уже доставил и второго сообщения не получает.
+	delivered, err := r.Deliver(context.Background(), domain.NotificationBookingConfirmed, user, event, done)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.NotificationChannel{domain.ChannelEmail}, delivered)
+	tg.AssertNumberOfCalls(t, "NotifyBookingConfirmed", 1)
+}
@@ -5,19 +5,22 @@ import (
 	"fmt"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stpnv0/EventBooker/internal/calendar"
 	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/telegram"
 	"github.com/wb-go/wbf/logger"
 )
 
 type TelegramNotifier struct {
-	bot    *tgbotapi.BotAPI
-	logger logger.Logger
+	bot       *tgbotapi.BotAPI
+	templates *Templates
+	logger    logger.Logger
 }
 
-func NewTelegramNotifier(token string, logger logger.Logger) (*TelegramNotifier, error) {
+func NewTelegramNotifier(token string, templates *Templates, logger logger.Logger) (*TelegramNotifier, error) {
 	if token == "" {
 		logger.Warn("telegram bot token is empty, notifications disabled")
-		return &TelegramNotifier{bot: nil, logger: logger}, nil
+		return &TelegramNotifier{bot: nil, templates: templates, logger: logger}, nil
 	}
 
 	bot, err := tgbotapi.NewBotAPI(token)
@@ -25,58 +28,93 @@ func NewTelegramNotifier(token string, logger logger.Logger) (*TelegramNotifier,
 		return nil, fmt.Errorf("create telegram bot: %w", err)
 	}
 
-	return &TelegramNotifier{bot: bot, logger: logger}, nil
+	return &TelegramNotifier{bot: bot, templates: templates, logger: logger}, nil
 }
 
-func (n *TelegramNotifier) NotifyBookingConfirmed(ctx context.Context, user *domain.User, event *domain.Event) {
-	text := fmt.Sprintf(
-		"*Бронирование подтверждено!*\n\n"+"Мероприятие: %s\n"+"Дата (время указано в UTC): %s",
-		event.Title, event.EventDate.Format("02.01.2006 15:04"),
-	)
-	n.send(ctx, user.TelegramChatID, text)
+func (n *TelegramNotifier) NotifyBookingConfirmed(ctx context.Context, user *domain.User, event *domain.Event) error {
+	sent, err := n.notify(ctx, domain.NotificationBookingConfirmed, user, event, nil)
+	if sent {
+		// Вслед за подтверждением отправляем .ics, чтобы событие можно было добавить в календарь.
+		// Ошибка здесь не повторяет доставку: само подтверждение уже ушло.
+		n.sendCalendar(user.TelegramChatID, event)
+	}
+	return err
+}
+
+func (n *TelegramNotifier) NotifyBookingCancelled(ctx context.Context, user *domain.User, event *domain.Event) error {
+	_, err := n.notify(ctx, domain.NotificationBookingCancelled, user, event, nil)
+	return err
+}
+
+func (n *TelegramNotifier) NotifyNewEvent(ctx context.Context, user *domain.User, event *domain.Event) error {
+	_, err := n.notify(ctx, domain.NotificationNewEvent, user, event, nil)
+	return err
 }
 
-func (n *TelegramNotifier) NotifyBookingCancelled(ctx context.Context, user *domain.User, event *domain.Event) {
-	text := fmt.Sprintf(
-		"*Бронирование отменено (истекло время оплаты)*\n\n"+"Мероприятие: %s\n"+"Дата (время указано в UTC): %s",
-		event.Title, event.EventDate.Format("02.01.2006 15:04"),
-	)
-	n.send(ctx, user.TelegramChatID, text)
+func (n *TelegramNotifier) NotifyInvitation(ctx context.Context, user *domain.User, event *domain.Event) error {
+	// Приглашённому достаточно кнопки брони — код доступа ему не нужен.
+	_, err := n.notify(ctx, domain.NotificationEventInvitation, user, event, telegram.InvitationKeyboard(event.ID, userLocale(user)))
+	return err
 }
 
-func (n *TelegramNotifier) NotifyBookingCreated(ctx context.Context, user *domain.User, event *domain.Event) {
-	text := fmt.Sprintf(
-		"*Место забронировано!*\n\n"+"Мероприятие: %s\n"+"Дата (время указано в UTC): %s\n"+"Подтвердите бронь в течение %s, иначе она будет отменена.",
-		event.Title,
-		event.EventDate.Format("02.01.2006 15:04"),
-		event.BookingTTL.String(),
-	)
-	n.send(ctx, user.TelegramChatID, text)
+func (n *TelegramNotifier) NotifyBookingCreated(ctx context.Context, user *domain.User, event *domain.Event) error {
+	// Кнопки позволяют подтвердить или отменить бронь прямо из чата с ботом.
+	_, err := n.notify(ctx, domain.NotificationBookingCreated, user, event, telegram.BookingKeyboard(event.ID, userLocale(user)))
+	return err
 }
 
-func (n *TelegramNotifier) send(ctx context.Context, chatID *int64, text string) {
+// notify возвращает sent = true, если сообщение доставлено. Пропуск из-за выключенного бота
+// или отсутствия chat_id — не ошибка.
+func (n *TelegramNotifier) notify(
+	ctx context.Context,
+	kind domain.NotificationKind,
+	user *domain.User,
+	event *domain.Event,
+	markup any,
+) (sent bool, err error) {
 	if n.bot == nil {
-		n.logger.Debug("notification skipped (bot disabled)", logger.String("text", text))
-		return
+		n.logger.Debug("notification skipped (bot disabled)", logger.String("kind", string(kind)))
+		return false, nil
+	}
+	if user.TelegramChatID == nil {
+		n.logger.Debug("notification skipped (no chat_id)", logger.String("user_id", user.ID))
+		return false, nil
 	}
 
-	if chatID == nil {
-		n.logger.Debug("notification skipped (no chat_id)", logger.String("text", text))
-		return
+	text, err := n.templates.Telegram(kind, user, event)
+	if err != nil {
+		return false, fmt.Errorf("render telegram notification: %w", err)
+	}
+	if err = n.send(ctx, *user.TelegramChatID, text, markup); err != nil {
+		return false, err
 	}
+	return true, nil
+}
 
+func (n *TelegramNotifier) send(ctx context.Context, chatID int64, text string, markup any) error {
 	if err := ctx.Err(); err != nil {
-		n.logger.Debug("notification skipped (context cancelled)",
-			logger.Int64("chat_id", *chatID),
-		)
-		return
+		return err
 	}
 
-	msg := tgbotapi.NewMessage(*chatID, text)
+	msg := tgbotapi.NewMessage(chatID, text)
 	msg.ParseMode = "Markdown"
+	if markup != nil {
+		msg.ReplyMarkup = markup
+	}
 
 	if _, err := n.bot.Send(msg); err != nil {
-		n.logger.Error("failed to send telegram notification",
+		return fmt.Errorf("send telegram notification to chat %d: %w", chatID, err)
+	}
+	return nil
+}
+
+func (n *TelegramNotifier) sendCalendar(chatID *int64, event *domain.Event) {
+	doc := tgbotapi.NewDocument(*chatID, tgbotapi.FileBytes{
+		Name:  calendar.Filename(event),
+		Bytes: calendar.Event(calendar.Entry{Event: event}),
+	})
+	if _, err := n.bot.Send(doc); err != nil {
+		n.logger.Error("failed to send calendar file",
 			logger.Int64("chat_id", *chatID),
 			logger.String("error", err.Error()),
 		)
@@ -0,0 +1,197 @@
+package notification
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	"text/template"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+)
+
+//go:embed templates
+var embeddedTemplates embed.FS
+
+// dateLayouts — формат даты мероприятия для каждого языка.
+var dateLayouts = map[string]string{
+	"ru": "02.01.2006 15:04",
+	"en": "Jan 2, 2006 3:04 PM",
+}
+
+// timeLayouts — формат времени окончания, если мероприятие заканчивается в тот же день.
+var timeLayouts = map[string]string{
+	"ru": "15:04",
+	"en": "3:04 PM",
+}
+
+// ttlFormats — формат срока подтверждения брони (в минутах) для каждого языка.
+var ttlFormats = map[string]string{
+	"ru": "%d мин.",
+	"en": "%d min",
+}
+
+// messageData — данные, доступные во всех шаблонах уведомлений. Date, End и TimeZone —
+// в местном времени мероприятия; UserDate заполняется, только если пояс пользователя другой.
+// EventCancelled отличает отмену мероприятия от истечения срока оплаты брони.
+type messageData struct {
+	Username     string
+	Title        string
+	Date         string
+	End          string
+	TimeZone     string
+	UserDate     string
+	UserTimeZone string
+	BookingTTL   string
+
+	EventCancelled bool
+}
+
+// Templates хранит разобранные шаблоны уведомлений для всех каналов, языков и типов событий.
+// Файлы лежат по пути <channel>/<locale>/<kind>.<ext>; каталог overrideDir с той же
+// структурой позволяет администратору заменить любой из встроенных шаблонов.
+type Templates struct {
+	telegram  map[string]*template.Template
+	emailText map[string]*template.Template
+	emailHTML map[string]*htmltemplate.Template
+}
+
+func NewTemplates(overrideDir string) (*Templates, error) {
+	root, err := fs.Sub(embeddedTemplates, "templates")
+	if err != nil {
+		return nil, fmt.Errorf("embedded templates: %w", err)
+	}
+	if overrideDir != "" {
+		root = overlayFS{upper: os.DirFS(overrideDir), lower: root}
+	}
+
+	t := &Templates{
+		telegram:  make(map[string]*template.Template),
+		emailText: make(map[string]*template.Template),
+		emailHTML: make(map[string]*htmltemplate.Template),
+	}
+
+	for _, locale := range domain.SupportedLocales {
+		for _, kind := range domain.NotificationKinds {
+			key := templateKey(locale, kind)
+
+			if t.telegram[key], err = template.ParseFS(root, "telegram/"+key+".tmpl"); err != nil {
+				return nil, fmt.Errorf("parse telegram template %s: %w", key, err)
+			}
+			if t.emailText[key], err = template.ParseFS(root, "email/"+key+".txt"); err != nil {
+				return nil, fmt.Errorf("parse email text template %s: %w", key, err)
+			}
+			if t.emailHTML[key], err = htmltemplate.ParseFS(root, "email/"+key+".html"); err != nil {
+				return nil, fmt.Errorf("parse email html template %s: %w", key, err)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+// Telegram рендерит текст Telegram-сообщения на языке пользователя.
+func (t *Templates) Telegram(kind domain.NotificationKind, user *domain.User, event *domain.Event) (string, error) {
+	tmpl, ok := t.telegram[templateKey(userLocale(user), kind)]
+	if !ok {
+		return "", fmt.Errorf("no telegram template for %s", kind)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, newMessageData(user, event)); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// Email рендерит тему, текстовую и HTML-версию письма на языке пользователя.
+func (t *Templates) Email(kind domain.NotificationKind, user *domain.User, event *domain.Event) (subject, text, html string, err error) {
+	key := templateKey(userLocale(user), kind)
+	textTmpl, ok := t.emailText[key]
+	if !ok {
+		return "", "", "", fmt.Errorf("no email template for %s", kind)
+	}
+	data := newMessageData(user, event)
+
+	var subjectBuf, textBuf, htmlBuf bytes.Buffer
+	if err = textTmpl.ExecuteTemplate(&subjectBuf, "subject", data); err != nil {
+		return "", "", "", fmt.Errorf("subject: %w", err)
+	}
+	if err = textTmpl.ExecuteTemplate(&textBuf, "text", data); err != nil {
+		return "", "", "", fmt.Errorf("text body: %w", err)
+	}
+	if err = t.emailHTML[key].Execute(&htmlBuf, data); err != nil {
+		return "", "", "", fmt.Errorf("html body: %w", err)
+	}
+
+	return subjectBuf.String(), textBuf.String(), htmlBuf.String(), nil
+}
+
+func templateKey(locale string, kind domain.NotificationKind) string {
+	return locale + "/" + string(kind)
+}
+
+// userLocale возвращает язык пользователя или язык по умолчанию, если он не поддерживается.
+func userLocale(user *domain.User) string {
+	if domain.IsSupportedLocale(user.Locale) {
+		return user.Locale
+	}
+	return domain.DefaultLocale
+}
+
+// newMessageData готовит данные шаблона: время мероприятия в его поясе и, если пояс
+// пользователя отличается, ещё и в поясе пользователя — в формате его языка.
+func newMessageData(user *domain.User, event *domain.Event) messageData {
+	locale := userLocale(user)
+	layout := dateLayouts[locale]
+
+	loc := event.Location()
+	start := event.EventDate.In(loc)
+	data := messageData{
+		Username:   user.Username,
+		Title:      event.Title,
+		Date:       start.Format(layout),
+		TimeZone:   loc.String(),
+		BookingTTL: fmt.Sprintf(ttlFormats[locale], int(event.BookingTTL.Minutes())),
+
+		EventCancelled: event.CancelledAt != nil,
+	}
+
+	if event.Duration > 0 {
+		end := event.EndDate().In(loc)
+		if end.YearDay() == start.YearDay() && end.Year() == start.Year() {
+			data.End = end.Format(timeLayouts[locale])
+		} else {
+			data.End = end.Format(layout)
+		}
+	}
+
+	userLoc, err := time.LoadLocation(user.Timezone)
+	if err == nil && user.Timezone != "" && userLoc.String() != loc.String() {
+		data.UserDate = event.EventDate.In(userLoc).Format(layout)
+		data.UserTimeZone = userLoc.String()
+	}
+
+	return data
+}
+
+// overlayFS читает файл из upper, а при его отсутствии — из lower.
+type overlayFS struct {
+	upper fs.FS
+	lower fs.FS
+}
+
+func (o overlayFS) Open(name string) (fs.File, error) {
+	f, err := o.upper.Open(name)
+	if err == nil {
+		return f, nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	return o.lower.Open(name)
+}
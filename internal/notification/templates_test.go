@@ -0,0 +1,146 @@
+package notification
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTemplates(t *testing.T) *Templates {
+	t.Helper()
+	templates, err := NewTemplates("")
+	require.NoError(t, err)
+	return templates
+}
+
+func testEvent() *domain.Event {
+	return &domain.Event{
+		ID:         "e1",
+		Title:      "Concert",
+		EventDate:  time.Date(2030, 5, 1, 19, 0, 0, 0, time.UTC),
+		BookingTTL: 20 * time.Minute,
+	}
+}
+
+func TestTemplates_Telegram_DefaultLocale(t *testing.T) {
+	templates := newTestTemplates(t)
+
+	text, err := templates.Telegram(domain.NotificationBookingCreated, &domain.User{Username: "alice"}, testEvent())
+
+	require.NoError(t, err)
+	assert.Contains(t, text, "Место забронировано")
+	assert.Contains(t, text, "01.05.2030 19:00")
+	assert.Contains(t, text, "UTC")
+	assert.Contains(t, text, "20 мин.")
+}
+
+func TestTemplates_Telegram_EnglishInUserTimezone(t *testing.T) {
+	templates := newTestTemplates(t)
+	user := &domain.User{Username: "alice", Locale: "en", Timezone: "America/New_York"}
+
+	text, err := templates.Telegram(domain.NotificationBookingConfirmed, user, testEvent())
+
+	require.NoError(t, err)
+	assert.Contains(t, text, "Concert")
+	assert.Contains(t, text, "May 1, 2030 3:00 PM")
+	assert.Contains(t, text, "America/New_York")
+}
+
+func TestTemplates_Telegram_CancelledReason(t *testing.T) {
+	templates := newTestTemplates(t)
+	user := &domain.User{Username: "alice"}
+
+	text, err := templates.Telegram(domain.NotificationBookingCancelled, user, testEvent())
+	require.NoError(t, err)
+	assert.Contains(t, text, "истекло время оплаты")
+
+	event := testEvent()
+	cancelledAt := time.Date(2030, 4, 1, 10, 0, 0, 0, time.UTC)
+	event.CancelledAt = &cancelledAt
+	text, err = templates.Telegram(domain.NotificationBookingCancelled, user, event)
+	require.NoError(t, err)
+	assert.Contains(t, text, "мероприятие отменено")
+}
+
+func TestTemplates_Email_AllKindsAndLocales(t *testing.T) {
+	templates := newTestTemplates(t)
+
+	for _, locale := range domain.SupportedLocales {
+		for _, kind := range domain.NotificationKinds {
+			user := &domain.User{Username: "alice", Locale: locale}
+
+			subject, text, html, err := templates.Email(kind, user, testEvent())
+
+			require.NoError(t, err, "%s/%s", locale, kind)
+			assert.Contains(t, subject, "Concert")
+			assert.Contains(t, text, "alice")
+			assert.Contains(t, html, "Concert")
+		}
+	}
+}
+
+func TestTemplates_OverrideDir(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "telegram", "en")
+	require.NoError(t, os.MkdirAll(path, 0o755))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(path, "booking_created.tmpl"),
+		[]byte("Custom: {{.Title}}"),
+		0o644,
+	))
+
+	templates, err := NewTemplates(dir)
+	require.NoError(t, err)
+
+	text, err := templates.Telegram(domain.NotificationBookingCreated, &domain.User{Locale: "en"}, testEvent())
+	require.NoError(t, err)
+	assert.Equal(t, "Custom: Concert", text)
+
+	// Остальные шаблоны берутся из встроенных.
+	text, err = templates.Telegram(domain.NotificationBookingCreated, &domain.User{Locale: "ru"}, testEvent())
+	require.NoError(t, err)
+	assert.Contains(t, text, "Место забронировано")
+}
+
+func TestTemplates_OverrideDir_InvalidTemplate(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "telegram", "ru")
+	require.NoError(t, os.MkdirAll(path, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(path, "booking_created.tmpl"), []byte("{{.Title"), 0o644))
+
+	_, err := NewTemplates(dir)
+
+	assert.Error(t, err)
+}
+
+func TestTemplates_Telegram_EventLocalTime(t *testing.T) {
+	templates := newTestTemplates(t)
+	event := testEvent()
+	event.Timezone = "Europe/Moscow"
+	event.Duration = 2 * time.Hour
+
+	text, err := templates.Telegram(domain.NotificationBookingCreated, &domain.User{Username: "alice", Timezone: "UTC"}, event)
+
+	require.NoError(t, err)
+	assert.Contains(t, text, "01.05.2030 22:00 – 02.05.2030 00:00")
+	assert.Contains(t, text, "Europe/Moscow")
+	assert.Contains(t, text, "у вас 01.05.2030 19:00 (UTC)")
+}
+
+func TestTemplates_Telegram_SameTimezoneShownOnce(t *testing.T) {
+	templates := newTestTemplates(t)
+	event := testEvent()
+	event.Timezone = "Europe/Moscow"
+	event.Duration = time.Hour
+
+	text, err := templates.Telegram(domain.NotificationBookingConfirmed, &domain.User{Locale: "en", Timezone: "Europe/Moscow"}, event)
+
+	require.NoError(t, err)
+	assert.Contains(t, text, "May 1, 2030 10:00 PM – 11:00 PM (Europe/Moscow)")
+	assert.NotContains(t, text, "your time")
+}
@@ -0,0 +1,106 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/netguard"
+	"github.com/wb-go/wbf/logger"
+)
+
+// userWebhookTimeout ограничивает время ожидания ответа пользовательского вебхука.
+const userWebhookTimeout = 10 * time.Second
+
+// userWebhookPayload — тело запроса, отправляемого на webhook_url пользователя.
+type userWebhookPayload struct {
+	Type       domain.NotificationKind `json:"type"`
+	UserID     string                  `json:"user_id"`
+	EventID    string                  `json:"event_id"`
+	EventTitle string                  `json:"event_title"`
+	EventDate  time.Time               `json:"event_date"`
+	SentAt     time.Time               `json:"sent_at"`
+}
+
+// WebhookNotifier отправляет уведомления POST-запросом на webhook_url пользователя.
+// URL задаёт пользователь, поэтому запросы во внутреннюю сеть блокируются.
+type WebhookNotifier struct {
+	client *http.Client
+	logger logger.Logger
+}
+
+func NewWebhookNotifier(logger logger.Logger) *WebhookNotifier {
+	return &WebhookNotifier{
+		client: netguard.NewHTTPClient(userWebhookTimeout),
+		logger: logger,
+	}
+}
+
+func (n *WebhookNotifier) NotifyBookingCreated(ctx context.Context, user *domain.User, event *domain.Event) error {
+	return n.send(ctx, domain.NotificationBookingCreated, user, event)
+}
+
+func (n *WebhookNotifier) NotifyBookingConfirmed(ctx context.Context, user *domain.User, event *domain.Event) error {
+	return n.send(ctx, domain.NotificationBookingConfirmed, user, event)
+}
+
+func (n *WebhookNotifier) NotifyBookingCancelled(ctx context.Context, user *domain.User, event *domain.Event) error {
+	return n.send(ctx, domain.NotificationBookingCancelled, user, event)
+}
+
+func (n *WebhookNotifier) NotifyNewEvent(ctx context.Context, user *domain.User, event *domain.Event) error {
+	return n.send(ctx, domain.NotificationNewEvent, user, event)
+}
+
+func (n *WebhookNotifier) NotifyInvitation(ctx context.Context, user *domain.User, event *domain.Event) error {
+	return n.send(ctx, domain.NotificationEventInvitation, user, event)
+}
+
+func (n *WebhookNotifier) send(ctx context.Context, kind domain.NotificationKind, user *domain.User, event *domain.Event) error {
+	if user.WebhookURL == nil || *user.WebhookURL == "" {
+		n.logger.Debug("webhook skipped (no webhook_url)", logger.String("user_id", user.ID))
+		return nil
+	}
+
+	body, err := json.Marshal(userWebhookPayload{
+		Type:       kind,
+		UserID:     user.ID,
+		EventID:    event.ID,
+		EventTitle: event.Title,
+		EventDate:  event.EventDate,
+		SentAt:     time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal webhook payload: %w", err)
+	}
+
+	if err = n.post(ctx, *user.WebhookURL, body); err != nil {
+		return fmt.Errorf("send webhook notification: %w", err)
+	}
+
+	return nil
+}
+
+func (n *WebhookNotifier) post(ctx context.Context, url string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("do request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return nil
+}
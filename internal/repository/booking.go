@@ -29,7 +29,13 @@ func NewBookingRepo(db *dbpg.DB) *BookingRepository {
 	}
 }
 
-func (r *BookingRepository) Create(ctx context.Context, b *domain.Booking) error {
+func (r *BookingRepository) Create(
+	ctx context.Context,
+	b *domain.Booking,
+	change domain.BookingChange,
+	quota *domain.BookingQuota,
+	out *domain.Outbox,
+) error {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("begin tx: %w", err)
@@ -37,15 +43,34 @@ func (r *BookingRepository) Create(ctx context.Context, b *domain.Booking) error
 	defer tx.Rollback()
 
 	// Проверяем наличие мест
-	spotQuery := `SELECT total_spots FROM events WHERE id = $1 FOR UPDATE`
+	spotQuery := `SELECT total_spots, status, cancelled_at IS NOT NULL FROM events WHERE id = $1 FOR UPDATE`
 	var totalSpots int
 	var activeBookings int
-	if err = tx.QueryRowContext(ctx, spotQuery, b.EventID).Scan(&totalSpots); err != nil {
+	var status domain.EventStatus
+	var cancelled bool
+	if err = tx.QueryRowContext(ctx, spotQuery, b.EventID).Scan(&totalSpots, &status, &cancelled); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return domain.ErrEventNotFound
 		}
 		return fmt.Errorf("get total spots: %w", err)
 	}
+	// Отмена и снятие с публикации тоже берут блокировку строки, так что бронь не проскочит после них.
+	if cancelled {
+		return domain.ErrEventCancelled
+	}
+	switch status {
+	case domain.EventStatusPublished:
+	case domain.EventStatusArchived:
+		return domain.ErrEventArchived
+	default:
+		return domain.ErrEventNotPublished
+	}
+
+	if quota != nil {
+		if err = checkQuota(ctx, tx, b.UserID, quota, b.CreatedAt); err != nil {
+			return err
+		}
+	}
 
 	activeQuery := `SELECT COUNT(*) FROM bookings
               WHERE event_id = $1 AND status = ANY($2)`
@@ -61,8 +86,9 @@ func (r *BookingRepository) Create(ctx context.Context, b *domain.Booking) error
 	}
 
 	// Создаем бронь
-	query := `INSERT INTO bookings (id, event_id, user_id, status, created_at, updated_at)
-			  VALUES ($1, $2, $3, $4, $5, $6)`
+	// Бронь без оплаты подтверждена сразу при создании.
+	query := `INSERT INTO bookings (id, event_id, user_id, status, created_at, updated_at, confirmed_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, CASE WHEN $4 = 'confirmed' THEN $5::timestamptz END)`
 	_, err = tx.ExecContext(
 		ctx, query, b.ID, b.EventID,
 		b.UserID, b.Status, b.CreatedAt, b.UpdatedAt,
@@ -76,6 +102,13 @@ func (r *BookingRepository) Create(ctx context.Context, b *domain.Booking) error
 		return fmt.Errorf("insert booking: %w", err)
 	}
 
+	if err = insertHistory(ctx, tx, b.ID, nil, b.Status, change); err != nil {
+		return err
+	}
+	if err = writeOutbox(ctx, tx, out); err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
 
@@ -126,10 +159,15 @@ func (r *BookingRepository) ListByUser(ctx context.Context, userID string) ([]*d
 	return res, rows.Err()
 }
 
-func (r *BookingRepository) Confirm(ctx context.Context, eventID, userID string) error {
+func (r *BookingRepository) Confirm(
+	ctx context.Context,
+	eventID, userID string,
+	change domain.BookingChange,
+	outbox domain.BookingOutbox,
+) (*domain.Booking, error) {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("begin tx: %w", err)
+		return nil, fmt.Errorf("begin tx: %w", err)
 	}
 	defer tx.Rollback()
 
@@ -138,75 +176,173 @@ func (r *BookingRepository) Confirm(ctx context.Context, eventID, userID string)
 	ttlQuery := `SELECT EXTRACT(EPOCH FROM booking_ttl)::bigint FROM events WHERE id = $1`
 	if err = tx.QueryRowContext(ctx, ttlQuery, eventID).Scan(&ttlSeconds); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return domain.ErrEventNotFound
+			return nil, domain.ErrEventNotFound
 		}
-		return fmt.Errorf("get event ttl: %w", err)
+		return nil, fmt.Errorf("get event ttl: %w", err)
 	}
 
-	// Атомарно проверяем статус и TTL, обновляем бронь
-	query := `UPDATE bookings
-			  SET status = $4, updated_at = now()
-			  WHERE event_id = $1
-			    AND user_id = $2
-			    AND status = $3
-			    AND created_at + make_interval(secs => $5) >= now()`
-	res, err := tx.ExecContext(
-		ctx, query, eventID, userID,
-		domain.BookingStatusPending, domain.BookingStatusConfirmed,
-		ttlSeconds,
-	)
+	sources, err := domain.BookingTransitionSources(domain.BookingStatusConfirmed, change.Reason)
 	if err != nil {
-		return fmt.Errorf("confirm booking: %w", err)
+		return nil, err
 	}
 
-	rows, err := res.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("booking rows affected: %w", err)
-	}
-	if rows == 0 {
-		// Определяем причину: бронь не найдена, не pending, или истекла
-		var status string
-		var createdAt time.Time
+	// Атомарно проверяем статус и TTL, обновляем бронь
+	query := `UPDATE bookings b
+			  SET status = $4, updated_at = now(), confirmed_at = now()
+			  FROM (
+				  SELECT id, status FROM bookings
+				  WHERE event_id = $1
+				    AND user_id = $2
+				    AND status = ANY($3)
+				    AND created_at + make_interval(secs => $5) >= now()
+				  FOR UPDATE
+			  ) old
+			  WHERE b.id = old.id
+			  RETURNING b.id, b.event_id, b.user_id, b.status, b.created_at, b.updated_at, old.status`
+	var (
+		b    domain.Booking
+		from domain.BookingStatus
+	)
+	err = tx.QueryRowContext(
+		ctx, query, eventID, userID,
+		pq.Array(sources), domain.BookingStatusConfirmed,
+		ttlSeconds,
+	).Scan(&b.ID, &b.EventID, &b.UserID, &b.Status, &b.CreatedAt, &b.UpdatedAt, &from)
+	if errors.Is(err, sql.ErrNoRows) {
+		// Определяем причину: бронь не найдена, не в нужном статусе или истекла
+		var current domain.Booking
 		checkQuery := `SELECT status, created_at FROM bookings
 					   WHERE event_id = $1 AND user_id = $2 AND status = ANY($3)
 					   ORDER BY created_at DESC LIMIT 1`
 		scanErr := tx.QueryRowContext(ctx, checkQuery, eventID, userID, pq.Array(domain.ActiveStatuses)).
-			Scan(&status, &createdAt)
+			Scan(&current.Status, &current.CreatedAt)
 		if scanErr != nil {
-			return domain.ErrBookingNotFound
+			return nil, domain.ErrBookingNotFound
 		}
-		if status != string(domain.BookingStatusPending) {
-			return domain.ErrBookingNotPending
+		if err = current.CheckConfirm(time.Now(), time.Duration(ttlSeconds)*time.Second); err != nil {
+			return nil, err
 		}
-		ttl := time.Duration(ttlSeconds) * time.Second
-		if time.Since(createdAt) > ttl {
-			return domain.ErrBookingExpired
+		return nil, domain.ErrBookingNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("confirm booking: %w", err)
+	}
+
+	if err = insertHistory(ctx, tx, b.ID, &from, b.Status, change); err != nil {
+		return nil, err
+	}
+	if err = writeBookingOutbox(ctx, tx, outbox, []*domain.Booking{&b}); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return &b, nil
+}
+
+// Cancel отменяет бронь пользователя на мероприятие, если change.Reason разрешает отмену из её статуса.
+func (r *BookingRepository) Cancel(
+	ctx context.Context,
+	eventID, userID string,
+	change domain.BookingChange,
+	outbox domain.BookingOutbox,
+) (*domain.Booking, error) {
+	sources, err := domain.BookingTransitionSources(domain.BookingStatusCancelled, change.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `WITH old AS (
+				  SELECT id, status FROM bookings
+				  WHERE event_id = $1 AND user_id = $2 AND status = ANY($4)
+				  FOR UPDATE
+			  ),
+			  changed AS (
+				  UPDATE bookings b
+				  SET status = $3, updated_at = NOW()
+				  FROM old
+				  WHERE b.id = old.id
+				  RETURNING b.id, b.event_id, b.user_id, b.status, b.created_at, b.updated_at, old.status AS from_status
+			  ),
+			  ` + historyCTE(5) + `
+			  SELECT id, event_id, user_id, status, created_at, updated_at FROM changed`
+
+	var b domain.Booking
+	err = tx.QueryRowContext(
+		ctx, query, eventID, userID,
+		domain.BookingStatusCancelled, pq.Array(sources),
+		change.Actor, change.ActorID, change.Reason, change.RequestID,
+	).Scan(&b.ID, &b.EventID, &b.UserID, &b.Status, &b.CreatedAt, &b.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrBookingNotFound
 		}
-		return domain.ErrBookingNotFound
+		return nil, fmt.Errorf("cancel booking: %w", err)
 	}
 
-	return tx.Commit()
+	if err = writeBookingOutbox(ctx, tx, outbox, []*domain.Booking{&b}); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return &b, nil
 }
 
-func (r *BookingRepository) CancelExpired(ctx context.Context) ([]*domain.Booking, error) {
+func (r *BookingRepository) CancelExpired(
+	ctx context.Context,
+	change domain.BookingChange,
+	outbox domain.BookingOutbox,
+) ([]*domain.Booking, error) {
+	sources, err := domain.BookingTransitionSources(domain.BookingStatusCancelled, change.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
 	query := `
-        UPDATE bookings b
-        SET status = $2, updated_at = NOW()
-        FROM events e
-        WHERE b.event_id = e.id
-          AND b.status = $1
-          AND b.created_at + e.booking_ttl < NOW()
-        RETURNING b.id, b.event_id, b.user_id,
-                  b.status, b.created_at, b.updated_at`
-
-	rows, err := r.db.QueryWithRetry(
-		ctx, r.strategy, query,
-		domain.BookingStatusPending, domain.BookingStatusCancelled,
+        WITH old AS (
+            SELECT b.id, b.status
+            FROM bookings b
+            JOIN events e ON e.id = b.event_id
+            WHERE b.status = ANY($1)
+              AND b.created_at + e.booking_ttl < NOW()
+            FOR UPDATE OF b
+        ),
+        changed AS (
+            UPDATE bookings b
+            SET status = $2, updated_at = NOW(), expired_at = NOW()
+            FROM old
+            WHERE b.id = old.id
+            RETURNING b.id, b.event_id, b.user_id,
+                      b.status, b.created_at, b.updated_at, old.status AS from_status
+        ),
+        ` + historyCTE(3) + `
+        SELECT id, event_id, user_id, status, created_at, updated_at FROM changed`
+
+	rows, err := tx.QueryContext(
+		ctx, query,
+		pq.Array(sources), domain.BookingStatusCancelled,
+		change.Actor, change.ActorID, change.Reason, change.RequestID,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("cancel expired: %w", err)
 	}
-	defer rows.Close()
 
 	var res []*domain.Booking
 	for rows.Next() {
@@ -215,13 +351,27 @@ func (r *BookingRepository) CancelExpired(ctx context.Context) ([]*domain.Bookin
 			&b.ID, &b.EventID, &b.UserID,
 			&b.Status, &b.CreatedAt, &b.UpdatedAt,
 		); err != nil {
+			rows.Close()
 			return nil, fmt.Errorf("scan: %w", err)
 		}
 
 		res = append(res, &b)
 	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("cancel expired: %w", err)
+	}
 
-	return res, rows.Err()
+	// Сообщения пишутся после чтения всех строк: пока курсор открыт, транзакция занята.
+	if err = writeBookingOutbox(ctx, tx, outbox, res); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return res, nil
 }
 
 func (r *BookingRepository) ListByEvent(ctx context.Context, eventID string) ([]*domain.Booking, error) {
@@ -245,3 +395,104 @@ func (r *BookingRepository) ListByEvent(ctx context.Context, eventID string) ([]
 
 	return res, rows.Err()
 }
+
+// StreamAttendees передаёт участников мероприятия в fn по одной строке, не собирая список в памяти.
+// Ошибка из fn прерывает чтение и возвращается как есть.
+func (r *BookingRepository) StreamAttendees(
+	ctx context.Context,
+	eventID string,
+	statuses []domain.BookingStatus,
+	fn func(*domain.Attendee) error,
+) error {
+	query := `SELECT b.id, b.user_id, u.username, b.status, b.created_at, b.confirmed_at
+			  FROM bookings b
+			  JOIN users u ON u.id = b.user_id
+			  WHERE b.event_id = $1 AND b.status = ANY($2)
+			  ORDER BY u.username, b.created_at`
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, eventID, pq.Array(statuses))
+	if err != nil {
+		return fmt.Errorf("list attendees: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var a domain.Attendee
+		if err = rows.Scan(&a.BookingID, &a.UserID, &a.Username, &a.Status, &a.BookedAt, &a.ConfirmedAt); err != nil {
+			return fmt.Errorf("scan attendee: %w", err)
+		}
+		if err = fn(&a); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// History возвращает переходы статусов брони от старых к новым.
+func (r *BookingRepository) History(ctx context.Context, bookingID string) ([]*domain.BookingHistoryEntry, error) {
+	var exists bool
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, `SELECT EXISTS (SELECT 1 FROM bookings WHERE id = $1)`, bookingID)
+	if err != nil {
+		return nil, fmt.Errorf("check booking: %w", err)
+	}
+	if err = row.Scan(&exists); err != nil {
+		return nil, fmt.Errorf("scan booking: %w", err)
+	}
+	if !exists {
+		return nil, domain.ErrBookingNotFound
+	}
+
+	query := `SELECT id, booking_id, from_status, to_status, actor, actor_id, reason, request_id, created_at
+			  FROM booking_status_history
+			  WHERE booking_id = $1
+			  ORDER BY id`
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, bookingID)
+	if err != nil {
+		return nil, fmt.Errorf("list booking history: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*domain.BookingHistoryEntry
+	for rows.Next() {
+		var h domain.BookingHistoryEntry
+		if err = rows.Scan(
+			&h.ID, &h.BookingID, &h.FromStatus, &h.ToStatus,
+			&h.Actor, &h.ActorID, &h.Reason, &h.RequestID, &h.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan booking history: %w", err)
+		}
+		res = append(res, &h)
+	}
+
+	return res, rows.Err()
+}
+
+// historyCTE дописывает в историю все строки CTE changed (колонки id, status, from_status).
+// first — номер первого из параметров actor, actor_id, reason и request_id, идущих подряд.
+func historyCTE(first int) string {
+	return fmt.Sprintf(`history AS (
+				  INSERT INTO booking_status_history (booking_id, from_status, to_status, actor, actor_id, reason, request_id)
+				  SELECT id, from_status, status, $%d, NULLIF($%d, '')::uuid, $%d, NULLIF($%d, '')
+				  FROM changed
+			  )`, first, first+1, first+2, first+3)
+}
+
+// insertHistory дописывает переход в историю брони в транзакции смены статуса.
+func insertHistory(
+	ctx context.Context,
+	tx *sql.Tx,
+	bookingID string,
+	from *domain.BookingStatus,
+	to domain.BookingStatus,
+	change domain.BookingChange,
+) error {
+	query := `INSERT INTO booking_status_history (booking_id, from_status, to_status, actor, actor_id, reason, request_id)
+			  VALUES ($1, $2, $3, $4, NULLIF($5, '')::uuid, $6, NULLIF($7, ''))`
+	if _, err := tx.ExecContext(
+		ctx, query, bookingID, from, to,
+		change.Actor, change.ActorID, change.Reason, change.RequestID,
+	); err != nil {
+		return fmt.Errorf("insert booking history: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/wb-go/wbf/dbpg"
+	"github.com/wb-go/wbf/retry"
+)
+
+type BookingLimitRepository struct {
+	db       *dbpg.DB
+	strategy retry.Strategy
+}
+
+func NewBookingLimitRepo(db *dbpg.DB) *BookingLimitRepository {
+	return &BookingLimitRepository{
+		db: db,
+		strategy: retry.Strategy{
+			Attempts: 3,
+			Delay:    500 * time.Millisecond,
+			Backoff:  2,
+		},
+	}
+}
+
+// bookingUsageQuery считает брони пользователя одним запросом. Последняя истёкшая бронь нужна для конца паузы.
+// Неоплаченная бронь с истёкшим booking_ttl места уже не держит, даже если планировщик её ещё не отменил.
+const bookingUsageQuery = `SELECT COUNT(*) FILTER (WHERE b.status = 'pending' AND b.created_at + e.booking_ttl >= NOW()),
+								  COUNT(*) FILTER (WHERE b.created_at >= $2),
+								  COUNT(*) FILTER (WHERE b.expired_at >= $3),
+								  MAX(b.expired_at) FILTER (WHERE b.expired_at >= $3)
+						   FROM bookings b
+						   JOIN events e ON e.id = b.event_id
+						   WHERE b.user_id = $1`
+
+func (r *BookingLimitRepository) Usage(ctx context.Context, userID string, dayStart, expiredSince time.Time) (*domain.BookingUsage, error) {
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, bookingUsageQuery, userID, dayStart, expiredSince)
+	if err != nil {
+		return nil, fmt.Errorf("get booking usage: %w", err)
+	}
+
+	var u domain.BookingUsage
+	if err = row.Scan(&u.Pending, &u.Today, &u.Expired, &u.LastExpiredAt); err != nil {
+		return nil, fmt.Errorf("scan booking usage: %w", err)
+	}
+
+	return &u, nil
+}
+
+func (r *BookingLimitRepository) GetOverride(ctx context.Context, userID string) (*domain.BookingLimitOverride, error) {
+	query := `SELECT user_id, max_pending, max_per_day, max_expired, exempt, cooldown_cleared_at, updated_at
+			  FROM booking_limit_overrides
+			  WHERE user_id = $1`
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get booking limit override: %w", err)
+	}
+
+	var o domain.BookingLimitOverride
+	if err = row.Scan(
+		&o.UserID, &o.MaxPending, &o.MaxPerDay, &o.MaxExpired,
+		&o.Exempt, &o.CooldownClearedAt, &o.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrLimitOverrideNotFound
+		}
+		return nil, fmt.Errorf("scan booking limit override: %w", err)
+	}
+
+	return &o, nil
+}
+
+// SaveOverride создаёт или заменяет переопределение. Снятие паузы при этом сохраняется.
+func (r *BookingLimitRepository) SaveOverride(ctx context.Context, o *domain.BookingLimitOverride) error {
+	query := `INSERT INTO booking_limit_overrides (user_id, max_pending, max_per_day, max_expired, exempt, updated_at)
+			  VALUES ($1, $2, $3, $4, $5, $6)
+			  ON CONFLICT (user_id) DO UPDATE
+			  SET max_pending = EXCLUDED.max_pending,
+				  max_per_day = EXCLUDED.max_per_day,
+				  max_expired = EXCLUDED.max_expired,
+				  exempt      = EXCLUDED.exempt,
+				  updated_at  = EXCLUDED.updated_at
+			  RETURNING cooldown_cleared_at`
+
+	row, err := r.db.QueryRowWithRetry(
+		ctx, r.strategy, query,
+		o.UserID, o.MaxPending, o.MaxPerDay, o.MaxExpired, o.Exempt, o.UpdatedAt,
+	)
+	if err == nil {
+		err = row.Scan(&o.CooldownClearedAt)
+	}
+	if err != nil {
+		var pgErr *pq.Error
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return domain.ErrUserNotFound
+		}
+		return fmt.Errorf("save booking limit override: %w", err)
+	}
+
+	return nil
+}
+
+func (r *BookingLimitRepository) DeleteOverride(ctx context.Context, userID string) error {
+	query := `DELETE FROM booking_limit_overrides WHERE user_id = $1`
+	res, err := r.db.ExecWithRetry(ctx, r.strategy, query, userID)
+	if err != nil {
+		return fmt.Errorf("delete booking limit override: %w", err)
+	}
+
+	return requireAffected(res, domain.ErrLimitOverrideNotFound)
+}
+
+// ClearCooldown снимает паузу: истечения до at больше не учитываются. Лимиты не меняются.
+func (r *BookingLimitRepository) ClearCooldown(ctx context.Context, userID string, at time.Time) error {
+	query := `INSERT INTO booking_limit_overrides (user_id, cooldown_cleared_at, updated_at)
+			  VALUES ($1, $2, $2)
+			  ON CONFLICT (user_id) DO UPDATE
+			  SET cooldown_cleared_at = EXCLUDED.cooldown_cleared_at,
+				  updated_at          = EXCLUDED.updated_at`
+
+	if _, err := r.db.ExecWithRetry(ctx, r.strategy, query, userID, at); err != nil {
+		var pgErr *pq.Error
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return domain.ErrUserNotFound
+		}
+		return fmt.Errorf("clear booking cooldown: %w", err)
+	}
+
+	return nil
+}
+
+// checkQuota проверяет лимиты внутри транзакции создания брони. Строка пользователя
+// блокируется до конца транзакции, поэтому параллельные брони одного пользователя
+// считаются по очереди и не превышают лимит. FOR NO KEY UPDATE не мешает вставкам,
+// которые лишь ссылаются на пользователя.
+func checkQuota(ctx context.Context, tx *sql.Tx, userID string, quota *domain.BookingQuota, now time.Time) error {
+	var id string
+	if err := tx.QueryRowContext(ctx, `SELECT id FROM users WHERE id = $1 FOR NO KEY UPDATE`, userID).Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrUserNotFound
+		}
+		return fmt.Errorf("lock user: %w", err)
+	}
+
+	var u domain.BookingUsage
+	if err := tx.QueryRowContext(ctx, bookingUsageQuery, userID, quota.DayStart, quota.ExpiredSince).
+		Scan(&u.Pending, &u.Today, &u.Expired, &u.LastExpiredAt); err != nil {
+		return fmt.Errorf("get booking usage: %w", err)
+	}
+
+	return quota.Limits.Check(u, now)
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pressly/goose/v3"
+	"github.com/stpnv0/EventBooker/migrations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wb-go/wbf/dbpg"
+)
+
+// testDB подключается к базе из EVENTBOOKER_TEST_DSN и накатывает миграции.
+// Без переменной тест пропускается: запросы репозиториев проверяются только на живом Postgres.
+func testDB(t *testing.T) *dbpg.DB {
+	t.Helper()
+	dsn := os.Getenv("EVENTBOOKER_TEST_DSN")
+	if dsn == "" {
+		t.Skip("EVENTBOOKER_TEST_DSN is not set")
+	}
+
+	sqlDB, err := sql.Open("postgres", dsn)
+	require.NoError(t, err)
+	goose.SetBaseFS(migrations.FS)
+	require.NoError(t, goose.SetDialect("postgres"))
+	require.NoError(t, goose.Up(sqlDB, "."))
+	require.NoError(t, sqlDB.Close())
+
+	db, err := dbpg.New(dsn, nil, &dbpg.Options{MaxOpenConns: 2})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Master.Close() })
+	return db
+}
+
+func TestBookingLimitRepository_Usage_SkipsExpiredHolds(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	userID := uuid.NewString()
+	_, err := db.ExecContext(ctx, `INSERT INTO users (id, username) VALUES ($1, $2)`, userID, "limits-"+userID)
+	require.NoError(t, err)
+	t.Cleanup(func() { _, _ = db.ExecContext(ctx, `DELETE FROM users WHERE id = $1`, userID) })
+
+	book := func(createdAt time.Time) {
+		eventID := uuid.NewString()
+		_, err := db.ExecContext(ctx,
+			`INSERT INTO events (id, title, description, event_date, total_spots, booking_ttl)
+			 VALUES ($1, 'Concert', '', NOW() + INTERVAL '1 day', 10, INTERVAL '20 minutes')`, eventID)
+		require.NoError(t, err)
+		t.Cleanup(func() { _, _ = db.ExecContext(ctx, `DELETE FROM events WHERE id = $1`, eventID) })
+
+		_, err = db.ExecContext(ctx,
+			`INSERT INTO bookings (id, event_id, user_id, status, created_at) VALUES ($1, $2, $3, 'pending', $4)`,
+			uuid.NewString(), eventID, userID, createdAt)
+		require.NoError(t, err)
+	}
+	now := time.Now()
+	book(now)
+	// Срок оплаты истёк, но планировщик бронь ещё не отменил.
+	book(now.Add(-time.Hour))
+
+	u, err := NewBookingLimitRepo(db).Usage(ctx, userID, now.Add(-24*time.Hour), now.Add(-24*time.Hour))
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, u.Pending)
+	assert.Equal(t, 2, u.Today)
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/wb-go/wbf/dbpg"
+	"github.com/wb-go/wbf/retry"
+)
+
+type CalendarTokenRepository struct {
+	db       *dbpg.DB
+	strategy retry.Strategy
+}
+
+func NewCalendarTokenRepo(db *dbpg.DB) *CalendarTokenRepository {
+	return &CalendarTokenRepository{
+		db: db,
+		strategy: retry.Strategy{
+			Attempts: 3,
+			Delay:    500 * time.Millisecond,
+			Backoff:  2,
+		},
+	}
+}
+
+// Save сохраняет хеш токена подписки; прежний токен пользователя перестаёт действовать.
+func (r *CalendarTokenRepository) Save(ctx context.Context, userID, tokenHash string) error {
+	query := `INSERT INTO calendar_tokens (user_id, token_hash, created_at)
+			  VALUES ($1, $2, NOW())
+			  ON CONFLICT (user_id) DO UPDATE SET token_hash = EXCLUDED.token_hash, created_at = EXCLUDED.created_at`
+	if _, err := r.db.ExecWithRetry(ctx, r.strategy, query, userID, tokenHash); err != nil {
+		return fmt.Errorf("save calendar token: %w", err)
+	}
+
+	return nil
+}
+
+func (r *CalendarTokenRepository) GetHash(ctx context.Context, userID string) (string, error) {
+	query := `SELECT token_hash FROM calendar_tokens WHERE user_id = $1`
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, userID)
+	if err != nil {
+		return "", fmt.Errorf("get calendar token: %w", err)
+	}
+
+	var hash string
+	if err = row.Scan(&hash); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", domain.ErrCalendarTokenInvalid
+		}
+		return "", fmt.Errorf("scan calendar token: %w", err)
+	}
+
+	return hash, nil
+}
@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/wb-go/wbf/dbpg"
+	"github.com/wb-go/wbf/retry"
+)
+
+type CategoryRepository struct {
+	db       *dbpg.DB
+	strategy retry.Strategy
+}
+
+func NewCategoryRepo(db *dbpg.DB) *CategoryRepository {
+	return &CategoryRepository{
+		db: db,
+		strategy: retry.Strategy{
+			Attempts: 3,
+			Delay:    500 * time.Millisecond,
+			Backoff:  2,
+		},
+	}
+}
+
+const categoryColumns = `id, slug, name, description, created_at, updated_at`
+
+func (r *CategoryRepository) Create(ctx context.Context, c *domain.Category) error {
+	query := `INSERT INTO categories (` + categoryColumns + `)
+			  VALUES ($1, $2, $3, $4, $5, $6)`
+	_, err := r.db.ExecWithRetry(
+		ctx, r.strategy, query,
+		c.ID, c.Slug, c.Name, c.Description, c.CreatedAt, c.UpdatedAt,
+	)
+	if err != nil {
+		var pgErr *pq.Error
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.ErrCategoryExists
+		}
+		return fmt.Errorf("insert category: %w", err)
+	}
+
+	return nil
+}
+
+func (r *CategoryRepository) GetByID(ctx context.Context, id string) (*domain.Category, error) {
+	query := `SELECT ` + categoryColumns + ` FROM categories WHERE id = $1`
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("get category: %w", err)
+	}
+
+	c, err := scanCategory(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrCategoryNotFound
+		}
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (r *CategoryRepository) List(ctx context.Context) ([]*domain.Category, error) {
+	query := `SELECT ` + categoryColumns + ` FROM categories ORDER BY name`
+	return r.listCategories(ctx, query)
+}
+
+// ListFollowed возвращает рубрики, на которые подписан пользователь.
+func (r *CategoryRepository) ListFollowed(ctx context.Context, userID string) ([]*domain.Category, error) {
+	query := `SELECT ` + categoryColumns + `
+			  FROM categories
+			  WHERE id IN (SELECT category_id FROM category_follows WHERE user_id = $1)
+			  ORDER BY name`
+	return r.listCategories(ctx, query, userID)
+}
+
+func (r *CategoryRepository) listCategories(ctx context.Context, query string, args ...any) ([]*domain.Category, error) {
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list categories: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*domain.Category
+	for rows.Next() {
+		c, err := scanCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, c)
+	}
+
+	return res, rows.Err()
+}
+
+func (r *CategoryRepository) Update(ctx context.Context, c *domain.Category) error {
+	query := `UPDATE categories
+			  SET slug = $2, name = $3, description = $4, updated_at = $5
+			  WHERE id = $1`
+	res, err := r.db.ExecWithRetry(ctx, r.strategy, query, c.ID, c.Slug, c.Name, c.Description, c.UpdatedAt)
+	if err != nil {
+		var pgErr *pq.Error
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.ErrCategoryExists
+		}
+		return fmt.Errorf("update category: %w", err)
+	}
+
+	return requireAffected(res, domain.ErrCategoryNotFound)
+}
+
+// Delete удаляет рубрику. Мероприятия остаются, теряя только привязку к ней.
+func (r *CategoryRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE FROM categories WHERE id = $1`
+	res, err := r.db.ExecWithRetry(ctx, r.strategy, query, id)
+	if err != nil {
+		return fmt.Errorf("delete category: %w", err)
+	}
+
+	return requireAffected(res, domain.ErrCategoryNotFound)
+}
+
+// Follow подписывает пользователя на рубрику. Повторная подписка не считается ошибкой.
+func (r *CategoryRepository) Follow(ctx context.Context, userID, categoryID string) error {
+	query := `INSERT INTO category_follows (user_id, category_id)
+			  VALUES ($1, $2)
+			  ON CONFLICT DO NOTHING`
+	if _, err := r.db.ExecWithRetry(ctx, r.strategy, query, userID, categoryID); err != nil {
+		var pgErr *pq.Error
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			if pgErr.Constraint == "category_follows_user_id_fkey" {
+				return domain.ErrUserNotFound
+			}
+			return domain.ErrCategoryNotFound
+		}
+		return fmt.Errorf("follow category: %w", err)
+	}
+
+	return nil
+}
+
+// Unfollow отменяет подписку. Отсутствие подписки не считается ошибкой.
+func (r *CategoryRepository) Unfollow(ctx context.Context, userID, categoryID string) error {
+	query := `DELETE FROM category_follows WHERE user_id = $1 AND category_id = $2`
+	if _, err := r.db.ExecWithRetry(ctx, r.strategy, query, userID, categoryID); err != nil {
+		return fmt.Errorf("unfollow category: %w", err)
+	}
+
+	return nil
+}
+
+// ListFollowers возвращает подписчиков хотя бы одной из рубрик, каждого по одному разу.
+func (r *CategoryRepository) ListFollowers(ctx context.Context, categoryIDs []string) ([]*domain.User, error) {
+	query := `SELECT id, username, telegram_chat_id, email, webhook_url, locale, timezone, created_at
+			  FROM users
+			  WHERE id IN (SELECT user_id FROM category_follows WHERE category_id = ANY($1::uuid[]))
+			  ORDER BY username`
+
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, pq.Array(categoryIDs))
+	if err != nil {
+		return nil, fmt.Errorf("list category followers: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*domain.User
+	for rows.Next() {
+		var u domain.User
+		if err = rows.Scan(
+			&u.ID, &u.Username, &u.TelegramChatID, &u.Email, &u.WebhookURL,
+			&u.Locale, &u.Timezone, &u.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan user: %w", err)
+		}
+		res = append(res, &u)
+	}
+
+	return res, rows.Err()
+}
+
+func scanCategory(row rowScanner) (*domain.Category, error) {
+	var c domain.Category
+	err := row.Scan(&c.ID, &c.Slug, &c.Name, &c.Description, &c.CreatedAt, &c.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("scan category: %w", err)
+	}
+
+	return &c, nil
+}
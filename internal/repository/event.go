@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/lib/pq"
@@ -29,55 +30,245 @@ func NewEventRepo(db *dbpg.DB) *EventRepository {
 	}
 }
 
+// eventColumns — колонки events в порядке, ожидаемом scanEvent. Рубрики и теги читаются
+// подзапросами, поэтому таблица в запросе должна называться events, без псевдонима.
+const eventColumns = `id, title, description, event_date, total_spots, requires_payment,
+	EXTRACT(EPOCH FROM booking_ttl)::bigint, EXTRACT(EPOCH FROM duration)::bigint, venue_id, timezone,
+	sales_open_at, sales_close_at, series_id, detached, cancelled_at, created_at, updated_at,
+	status, publish_at, published_at, visibility, COALESCE(access_code, ''),
+	ARRAY(SELECT ec.category_id::text FROM event_categories ec WHERE ec.event_id = events.id ORDER BY ec.category_id),
+	ARRAY(SELECT t.name FROM event_tags et JOIN tags t ON t.id = et.tag_id WHERE et.event_id = events.id ORDER BY t.name)`
+
+// Create сохраняет мероприятие. Если указана площадка, она должна быть свободна
+// на всё время мероприятия — см. reserveVenue.
 func (r *EventRepository) Create(ctx context.Context, e *domain.Event) error {
-	query := `INSERT into events (id, title, description, event_date, total_spots, requires_payment, booking_ttl, created_at, updated_at)
-			  VALUES ($1, $2, $3, $4, $5, $6, make_interval(secs => $7), $8, $9)`
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err = insertEvent(ctx, tx, e); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// CreateBatch создаёт мероприятия одной транзакцией: либо все, либо ни одного.
+// Ошибка вставки возвращается как *domain.EventBatchError с номером мероприятия.
+// При dryRun транзакция откатывается — так проверяются и занятость площадок,
+// включая пересечения мероприятий внутри пакета.
+func (r *EventRepository) CreateBatch(ctx context.Context, events []*domain.Event, dryRun bool) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	for i, e := range events {
+		if err = insertEvent(ctx, tx, e); err != nil {
+			return &domain.EventBatchError{Index: i, Err: err}
+		}
+	}
+
+	if dryRun {
+		return nil
+	}
+
+	return tx.Commit()
+}
+
+func insertEvent(ctx context.Context, tx *sql.Tx, e *domain.Event) error {
+	if e.VenueID != nil {
+		if err := reserveVenue(ctx, tx, e); err != nil {
+			return err
+		}
+	}
+
+	query := `INSERT into events (id, title, description, event_date, total_spots, requires_payment, booking_ttl,
+								  duration, venue_id, timezone, sales_open_at, sales_close_at, created_at, updated_at,
+								  status, publish_at, published_at, visibility, access_code)
+			  VALUES ($1, $2, $3, $4, $5, $6, make_interval(secs => $7), make_interval(secs => $8), $9, $10, $11, $12, $13, $13,
+					  $14, $15, $16, $17, NULLIF($18, ''))`
 	now := time.Now().UTC()
-	_, err := r.db.ExecWithRetry(
-		ctx, r.strategy, query,
-		e.ID, e.Title, e.Description, e.EventDate,
-		e.TotalSpots, e.RequiresPayment, e.BookingTTL.Seconds(), now, now,
+	_, err := tx.ExecContext(
+		ctx, query,
+		e.ID, e.Title, e.Description, e.EventDate, e.TotalSpots, e.RequiresPayment,
+		e.BookingTTL.Seconds(), e.Duration.Seconds(), e.VenueID, e.Timezone,
+		e.SalesOpenAt, e.SalesCloseAt, now,
+		e.Status, e.PublishAt, e.PublishedAt, e.Visibility, e.AccessCode,
 	)
 	if err != nil {
 		return fmt.Errorf("insert event: %w", err)
 	}
 
+	return saveEventLabels(ctx, tx, e)
+}
+
+// saveEventLabels записывает рубрики и теги мероприятия. Новые теги создаются.
+func saveEventLabels(ctx context.Context, tx *sql.Tx, e *domain.Event) error {
+	if len(e.CategoryIDs) > 0 {
+		query := `INSERT INTO event_categories (event_id, category_id)
+				  SELECT $1, unnest($2::uuid[])`
+		if _, err := tx.ExecContext(ctx, query, e.ID, pq.Array(e.CategoryIDs)); err != nil {
+			var pgErr *pq.Error
+			if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+				return domain.ErrCategoryNotFound
+			}
+			return fmt.Errorf("insert event categories: %w", err)
+		}
+	}
+
+	if len(e.Tags) > 0 {
+		// Теги, созданные в этом же запросе, ещё не видны в tags — берём их из RETURNING.
+		query := `WITH created AS (
+					  INSERT INTO tags (name) SELECT unnest($2::text[])
+					  ON CONFLICT (name) DO NOTHING
+					  RETURNING id
+				  )
+				  INSERT INTO event_tags (event_id, tag_id)
+				  SELECT $1, id FROM created
+				  UNION
+				  SELECT $1, id FROM tags WHERE name = ANY($2)`
+		if _, err := tx.ExecContext(ctx, query, e.ID, pq.Array(e.Tags)); err != nil {
+			return fmt.Errorf("insert event tags: %w", err)
+		}
+	}
+
 	return nil
 }
 
 func (r *EventRepository) GetByID(ctx context.Context, id string) (*domain.Event, error) {
-	query := `SELECT id, title, description, event_date, total_spots, requires_payment,
-       		  		EXTRACT(EPOCH FROM booking_ttl)::bigint, created_at, updated_at
-			  FROM events 
-			  WHERE id=$1`
+	query := `SELECT ` + eventColumns + ` FROM events WHERE id=$1`
 	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+
+	e, err := scanEvent(row)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrEventNotFound
 		}
-		return nil, fmt.Errorf("get event: %w", err)
+		return nil, err
 	}
 
-	var e domain.Event
-	var ttlSeconds int64
-	if err = row.Scan(
-		&e.ID, &e.Title, &e.Description, &e.EventDate, &e.TotalSpots,
-		&e.RequiresPayment, &ttlSeconds, &e.CreatedAt, &e.UpdatedAt,
-	); err != nil {
-		return nil, fmt.Errorf("scan event: %w", err)
+	return e, nil
+}
+
+// List возвращает мероприятия без отменённых, отобранные по filter. Идущие в момент
+// HappeningAt отсортированы по началу, остальные — от поздних к ранним.
+// Пустой Statuses не ограничивает статус — публичные списки передают его явно.
+// Закрытые мероприятия видны приглашённому ViewerID или при IncludePrivate.
+func (r *EventRepository) List(ctx context.Context, filter domain.EventFilter) ([]*domain.Event, error) {
+	order := `event_date DESC`
+	if filter.HappeningAt != nil {
+		order = `event_date`
 	}
-	e.BookingTTL = time.Duration(ttlSeconds) * time.Second
 
-	return &e, nil
+	query := `SELECT ` + eventColumns + `
+			  FROM events
+			  WHERE cancelled_at IS NULL
+				AND ($1::timestamptz IS NULL OR (event_date <= $1 AND event_date + duration > $1))
+				AND ($2 = '' OR EXISTS (
+					SELECT 1 FROM event_categories ec
+					JOIN categories c ON c.id = ec.category_id
+					WHERE ec.event_id = events.id AND (c.id::text = $2 OR c.slug = $2)
+				))
+				AND ($3 = '' OR EXISTS (
+					SELECT 1 FROM event_tags et
+					JOIN tags t ON t.id = et.tag_id
+					WHERE et.event_id = events.id AND t.name = $3
+				))
+				AND (cardinality($4::text[]) = 0 OR status = ANY($4))
+				AND (visibility = 'public' OR $5 OR EXISTS (
+					SELECT 1 FROM event_invitations i
+					WHERE i.event_id = events.id AND i.user_id::text = $6
+				))
+			  ORDER BY ` + order
+
+	return r.listEvents(
+		ctx, query,
+		filter.HappeningAt, filter.Category, filter.Tag, pq.Array(filter.Statuses),
+		filter.IncludePrivate, filter.ViewerID,
+	)
+}
+
+// ListBookedByUser возвращает мероприятия, на которые у пользователя есть бронь в одном из статусов.
+func (r *EventRepository) ListBookedByUser(
+	ctx context.Context,
+	userID string,
+	statuses []domain.BookingStatus,
+) ([]*domain.Event, error) {
+	query := `SELECT ` + eventColumns + `
+			  FROM events
+			  WHERE id IN (SELECT event_id FROM bookings WHERE user_id = $1 AND status = ANY($2))
+			  ORDER BY event_date`
+
+	return r.listEvents(ctx, query, userID, pq.Array(statuses))
+}
+
+// Search ищет неотменённые мероприятия по названию и описанию. Каждое слово ищется
+// с учётом словоформ русского и английского и как префикс; все слова должны найтись.
+// Сначала идут самые релевантные, при равенстве — ближайшие по дате.
+func (r *EventRepository) Search(ctx context.Context, s domain.EventSearch) ([]*domain.EventSearchResult, error) {
+	query := `WITH q AS (
+				  SELECT to_tsquery('russian', $1) || to_tsquery('english', $1) AS query
+			  )
+			  SELECT ` + eventColumns + `,
+					 ts_rank_cd(search_vector, q.query, 32) AS rank,
+					 total_spots - booked.active AS available_spots
+			  FROM events
+			  CROSS JOIN q
+			  CROSS JOIN LATERAL (
+				  SELECT COUNT(*) AS active FROM bookings b
+				  WHERE b.event_id = events.id AND b.status = ANY($2)
+			  ) booked
+			  WHERE cancelled_at IS NULL AND status = 'published' AND visibility = 'public'
+				AND search_vector @@ q.query
+				AND ($3::timestamptz IS NULL OR event_date >= $3)
+				AND ($4::timestamptz IS NULL OR event_date < $4)
+				AND (NOT $5 OR total_spots > booked.active)
+			  ORDER BY rank DESC, event_date, id
+			  LIMIT $6 OFFSET $7`
+
+	rows, err := r.db.QueryWithRetry(
+		ctx, r.strategy, query,
+		prefixTSQuery(s.Terms), pq.Array(domain.ActiveStatuses),
+		s.From, s.To, s.Available, s.Limit, s.Offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("search events: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*domain.EventSearchResult
+	for rows.Next() {
+		var found domain.EventSearchResult
+		e, err := scanEvent(rows, &found.Rank, &found.AvailableSpots)
+		if err != nil {
+			return nil, err
+		}
+		found.Event = *e
+		res = append(res, &found)
+	}
+
+	return res, rows.Err()
 }
 
-func (r *EventRepository) List(ctx context.Context) ([]*domain.Event, error) {
-	query := `SELECT id, title, description, event_date, total_spots, requires_payment,
-		      		EXTRACT(EPOCH FROM booking_ttl)::bigint, created_at, updated_at
-			  FROM events 
-			  ORDER BY event_date DESC`
+// prefixTSQuery собирает текст tsquery «слово:* & слово:*». Слова должны быть уже
+// очищены от операторов tsquery — это делает сервис.
+func prefixTSQuery(terms []string) string {
+	parts := make([]string, len(terms))
+	for i, t := range terms {
+		parts[i] = t + ":*"
+	}
+	return strings.Join(parts, " & ")
+}
 
-	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query)
+func (r *EventRepository) listEvents(ctx context.Context, query string, args ...any) ([]*domain.Event, error) {
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("list event: %w", err)
 	}
@@ -85,16 +276,11 @@ func (r *EventRepository) List(ctx context.Context) ([]*domain.Event, error) {
 
 	var res []*domain.Event
 	for rows.Next() {
-		var e domain.Event
-		var ttlSeconds int64
-		if err = rows.Scan(
-			&e.ID, &e.Title, &e.Description, &e.EventDate, &e.TotalSpots,
-			&e.RequiresPayment, &ttlSeconds, &e.CreatedAt, &e.UpdatedAt,
-		); err != nil {
-			return nil, fmt.Errorf("scan event: %w", err)
+		e, err := scanEvent(rows)
+		if err != nil {
+			return nil, err
 		}
-		e.BookingTTL = time.Duration(ttlSeconds) * time.Second
-		res = append(res, &e)
+		res = append(res, e)
 	}
 
 	return res, rows.Err()
@@ -102,37 +288,304 @@ func (r *EventRepository) List(ctx context.Context) ([]*domain.Event, error) {
 
 func (r *EventRepository) GetDetails(ctx context.Context, eventID string) (*domain.EventDetails, error) {
 	query := `
-		SELECT
-            e.id, e.title, e.description, e.event_date,
-            e.total_spots, e.requires_payment, EXTRACT(EPOCH FROM e.booking_ttl)::bigint, 
-            e.created_at, e.updated_at,
-            e.total_spots - COUNT(b.id) AS available_spots
-        FROM events e
-        LEFT JOIN bookings b
-            ON b.event_id = e.id
-            AND b.status = ANY($2)
-        WHERE e.id = $1
-        GROUP BY e.id`
+		SELECT ` + eventColumns + `,
+			total_spots - (
+				SELECT COUNT(*) FROM bookings b
+				WHERE b.event_id = events.id AND b.status = ANY($2)
+			) AS available_spots
+		FROM events
+		WHERE id = $1`
 
 	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, eventID, pq.Array(domain.ActiveStatuses))
 	if err != nil {
 		return nil, fmt.Errorf("get details: %w", err)
 	}
-	var e domain.EventDetails
-	var ttlSeconds int64
-	err = row.Scan(
-		&e.Event.ID, &e.Event.Title, &e.Event.Description,
-		&e.Event.EventDate, &e.Event.TotalSpots, &e.Event.RequiresPayment, &ttlSeconds,
-		&e.Event.CreatedAt, &e.Event.UpdatedAt,
-		&e.AvailableSpots,
-	)
+
+	var details domain.EventDetails
+	e, err := scanEvent(row, &details.AvailableSpots)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrEventNotFound
 		}
 		return nil, fmt.Errorf("get event details: %w", err)
 	}
-	e.Event.BookingTTL = time.Duration(ttlSeconds) * time.Second
+	details.Event = *e
+
+	return &details, nil
+}
+
+// Update сохраняет изменённое мероприятие. Места нельзя урезать ниже числа активных броней —
+// проверка и запись выполняются под блокировкой строки мероприятия.
+func (r *EventRepository) Update(ctx context.Context, e *domain.Event) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	var cancelled bool
+	lockQuery := `SELECT cancelled_at IS NOT NULL FROM events WHERE id = $1 FOR UPDATE`
+	if err = tx.QueryRowContext(ctx, lockQuery, e.ID).Scan(&cancelled); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrEventNotFound
+		}
+		return fmt.Errorf("lock event: %w", err)
+	}
+	if cancelled {
+		return domain.ErrEventCancelled
+	}
+
+	var active int
+	activeQuery := `SELECT COUNT(*) FROM bookings WHERE event_id = $1 AND status = ANY($2)`
+	if err = tx.QueryRowContext(ctx, activeQuery, e.ID, pq.Array(domain.ActiveStatuses)).Scan(&active); err != nil {
+		return fmt.Errorf("count bookings: %w", err)
+	}
+	if e.TotalSpots < active {
+		return domain.ErrSpotsBelowBooked
+	}
+
+	if e.VenueID != nil {
+		if err = reserveVenue(ctx, tx, e); err != nil {
+			return err
+		}
+	}
+
+	query := `UPDATE events
+			  SET title = $2, description = $3, event_date = $4, total_spots = $5,
+			      requires_payment = $6, booking_ttl = make_interval(secs => $7),
+			      duration = make_interval(secs => $8), venue_id = $9, timezone = $10,
+			      sales_open_at = $11, sales_close_at = $12, detached = $13, updated_at = $14,
+			      publish_at = $15, visibility = $16, access_code = NULLIF($17, '')
+			  WHERE id = $1`
+	if _, err = tx.ExecContext(
+		ctx, query,
+		e.ID, e.Title, e.Description, e.EventDate, e.TotalSpots, e.RequiresPayment,
+		e.BookingTTL.Seconds(), e.Duration.Seconds(), e.VenueID, e.Timezone,
+		e.SalesOpenAt, e.SalesCloseAt, e.Detached, e.UpdatedAt,
+		e.PublishAt, e.Visibility, e.AccessCode,
+	); err != nil {
+		return fmt.Errorf("update event: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM event_categories WHERE event_id = $1`, e.ID); err != nil {
+		return fmt.Errorf("delete event categories: %w", err)
+	}
+	if _, err = tx.ExecContext(ctx, `DELETE FROM event_tags WHERE event_id = $1`, e.ID); err != nil {
+		return fmt.Errorf("delete event tags: %w", err)
+	}
+	if err = saveEventLabels(ctx, tx, e); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Publish публикует черновик. Условие на статус в UPDATE не даёт опубликовать
+// мероприятие дважды, если два запроса пришли одновременно.
+func (r *EventRepository) Publish(ctx context.Context, id string, at time.Time) error {
+	query := `UPDATE events
+			  SET status = 'published', published_at = $2, publish_at = NULL, updated_at = $2
+			  WHERE id = $1 AND status = 'draft' AND cancelled_at IS NULL`
+	res, err := r.db.ExecWithRetry(ctx, r.strategy, query, id, at)
+	if err != nil {
+		return fmt.Errorf("publish event: %w", err)
+	}
+
+	return requireAffected(res, domain.ErrEventNotDraft)
+}
+
+// PublishDue публикует черновики, у которых наступило время publish_at, и возвращает их.
+// Не начавшиеся к этому моменту мероприятия остаются черновиками.
+func (r *EventRepository) PublishDue(ctx context.Context, now time.Time) ([]*domain.Event, error) {
+	query := `UPDATE events
+			  SET status = 'published', published_at = $1, publish_at = NULL, updated_at = $1
+			  WHERE status = 'draft' AND publish_at <= $1 AND event_date > $1 AND cancelled_at IS NULL
+			  RETURNING ` + eventColumns
+
+	return r.listEvents(ctx, query, now)
+}
+
+// Archive снимает мероприятие с публикации. Брони на него остаются.
+func (r *EventRepository) Archive(ctx context.Context, id string, at time.Time) error {
+	query := `UPDATE events
+			  SET status = 'archived', publish_at = NULL, updated_at = $2
+			  WHERE id = $1 AND status <> 'archived'`
+	res, err := r.db.ExecWithRetry(ctx, r.strategy, query, id, at)
+	if err != nil {
+		return fmt.Errorf("archive event: %w", err)
+	}
+
+	return requireAffected(res, domain.ErrEventArchived)
+}
+
+// Cancel отменяет мероприятие и его активные брони, записывая сообщения outbox о каждой.
+// Для вхождения серии исходная дата добавляется в исключения, чтобы серия его не пересоздала.
+func (r *EventRepository) Cancel(
+	ctx context.Context,
+	id string,
+	change domain.BookingChange,
+	outbox domain.BookingOutbox,
+) ([]*domain.Booking, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	var cancelled bool
+	var seriesID sql.NullString
+	var occurrence sql.NullTime
+	lockQuery := `SELECT cancelled_at IS NOT NULL, series_id, occurrence_date FROM events WHERE id = $1 FOR UPDATE`
+	if err = tx.QueryRowContext(ctx, lockQuery, id).Scan(&cancelled, &seriesID, &occurrence); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrEventNotFound
+		}
+		return nil, fmt.Errorf("lock event: %w", err)
+	}
+	if cancelled {
+		return nil, domain.ErrEventCancelled
+	}
+
+	if _, err = tx.ExecContext(ctx, `UPDATE events SET cancelled_at = NOW(), updated_at = NOW() WHERE id = $1`, id); err != nil {
+		return nil, fmt.Errorf("cancel event: %w", err)
+	}
+
+	if seriesID.Valid && occurrence.Valid {
+		exceptionQuery := `UPDATE event_series
+						   SET exceptions = array_append(exceptions, $2), updated_at = NOW()
+						   WHERE id = $1 AND NOT ($2 = ANY(exceptions))`
+		if _, err = tx.ExecContext(ctx, exceptionQuery, seriesID.String, occurrence.Time); err != nil {
+			return nil, fmt.Errorf("add series exception: %w", err)
+		}
+	}
+
+	bookings, err := cancelEventBookings(ctx, tx, []string{id}, change, outbox)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return bookings, nil
+}
+
+// reserveVenue блокирует строку площадки и проверяет, что на ней нет других
+// неотменённых мероприятий, пересекающихся с [EventDate, EndDate). Блокировка
+// сериализует конкурентные записи на одну площадку до конца транзакции.
+func reserveVenue(ctx context.Context, tx *sql.Tx, e *domain.Event) error {
+	var exists bool
+	lockQuery := `SELECT TRUE FROM venues WHERE id = $1 FOR UPDATE`
+	if err := tx.QueryRowContext(ctx, lockQuery, *e.VenueID).Scan(&exists); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrVenueNotFound
+		}
+		return fmt.Errorf("lock venue: %w", err)
+	}
+
+	var busy bool
+	overlapQuery := `SELECT EXISTS (
+						SELECT 1 FROM events
+						WHERE venue_id = $1 AND id <> $2 AND cancelled_at IS NULL
+						  AND event_date < $4 AND event_date + duration > $3
+					 )`
+	if err := tx.QueryRowContext(ctx, overlapQuery, *e.VenueID, e.ID, e.EventDate, e.EndDate()).Scan(&busy); err != nil {
+		return fmt.Errorf("check venue overlap: %w", err)
+	}
+	if busy {
+		return domain.ErrVenueBusy
+	}
+
+	return nil
+}
+
+// cancelEventBookings отменяет брони мероприятий, которые change.Reason разрешает отменить,
+// в рамках транзакции, пишет переходы в историю и сообщения outbox.
+func cancelEventBookings(
+	ctx context.Context,
+	tx *sql.Tx,
+	eventIDs []string,
+	change domain.BookingChange,
+	outbox domain.BookingOutbox,
+) ([]*domain.Booking, error) {
+	sources, err := domain.BookingTransitionSources(domain.BookingStatusCancelled, change.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `WITH old AS (
+				  SELECT id, status FROM bookings
+				  WHERE event_id = ANY($1) AND status = ANY($3)
+				  FOR UPDATE
+			  ),
+			  changed AS (
+				  UPDATE bookings b
+				  SET status = $2, updated_at = NOW()
+				  FROM old
+				  WHERE b.id = old.id
+				  RETURNING b.id, b.event_id, b.user_id, b.status, b.created_at, b.updated_at, old.status AS from_status
+			  ),
+			  ` + historyCTE(4) + `
+			  SELECT id, event_id, user_id, status, created_at, updated_at FROM changed`
+
+	rows, err := tx.QueryContext(
+		ctx, query, pq.Array(eventIDs),
+		domain.BookingStatusCancelled, pq.Array(sources),
+		change.Actor, change.ActorID, change.Reason, change.RequestID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cancel event bookings: %w", err)
+	}
+
+	var res []*domain.Booking
+	for rows.Next() {
+		var b domain.Booking
+		if err = rows.Scan(&b.ID, &b.EventID, &b.UserID, &b.Status, &b.CreatedAt, &b.UpdatedAt); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scan booking: %w", err)
+		}
+		res = append(res, &b)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("cancel event bookings: %w", err)
+	}
+
+	// Сообщения пишутся после чтения всех строк: пока курсор открыт, транзакция занята.
+	if err = writeBookingOutbox(ctx, tx, outbox, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// scanEvent читает строку eventColumns; extra — дополнительные колонки после них.
+func scanEvent(row rowScanner, extra ...any) (*domain.Event, error) {
+	var e domain.Event
+	var ttlSeconds, durationSeconds int64
+	var venueID, seriesID sql.NullString
+	dest := append([]any{
+		&e.ID, &e.Title, &e.Description, &e.EventDate, &e.TotalSpots, &e.RequiresPayment,
+		&ttlSeconds, &durationSeconds, &venueID, &e.Timezone,
+		&e.SalesOpenAt, &e.SalesCloseAt, &seriesID, &e.Detached, &e.CancelledAt, &e.CreatedAt, &e.UpdatedAt,
+		&e.Status, &e.PublishAt, &e.PublishedAt, &e.Visibility, &e.AccessCode,
+		pq.Array(&e.CategoryIDs), pq.Array(&e.Tags),
+	}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("scan event: %w", err)
+	}
+	e.BookingTTL = time.Duration(ttlSeconds) * time.Second
+	e.Duration = time.Duration(durationSeconds) * time.Second
+	if venueID.Valid {
+		e.VenueID = &venueID.String
+	}
+	if seriesID.Valid {
+		e.SeriesID = &seriesID.String
+	}
 
 	return &e, nil
 }
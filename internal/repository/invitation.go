@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stpnv0/EventBooker/internal/domain"
+)
+
+const inviteeColumns = `u.id, u.username, u.telegram_chat_id, u.email, u.webhook_url, u.locale, u.timezone, u.created_at`
+
+// Invite приглашает пользователей на мероприятие и возвращает только новых приглашённых:
+// повторное приглашение не считается ошибкой и не порождает второго уведомления.
+func (r *EventRepository) Invite(ctx context.Context, eventID string, userIDs []string, at time.Time) ([]*domain.User, error) {
+	query := `WITH invited AS (
+				  INSERT INTO event_invitations (event_id, user_id, created_at)
+				  SELECT $1, unnest($2::uuid[]), $3
+				  ON CONFLICT DO NOTHING
+				  RETURNING user_id
+			  )
+			  SELECT ` + inviteeColumns + `
+			  FROM users u
+			  JOIN invited ON invited.user_id = u.id
+			  ORDER BY u.username`
+
+	users, err := r.listInvitees(ctx, query, eventID, pq.Array(userIDs), at)
+	if err != nil {
+		var pgErr *pq.Error
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			if pgErr.Constraint == "event_invitations_user_id_fkey" {
+				return nil, domain.ErrUserNotFound
+			}
+			return nil, domain.ErrEventNotFound
+		}
+		return nil, fmt.Errorf("invite users: %w", err)
+	}
+
+	return users, nil
+}
+
+// ListInvitations возвращает приглашения на мероприятие с именами пользователей.
+func (r *EventRepository) ListInvitations(ctx context.Context, eventID string) ([]*domain.EventInvitation, error) {
+	query := `SELECT i.event_id, i.user_id, u.username, i.created_at
+			  FROM event_invitations i
+			  JOIN users u ON u.id = i.user_id
+			  WHERE i.event_id = $1
+			  ORDER BY u.username`
+
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list invitations: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*domain.EventInvitation
+	for rows.Next() {
+		var inv domain.EventInvitation
+		if err = rows.Scan(&inv.EventID, &inv.UserID, &inv.Username, &inv.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan invitation: %w", err)
+		}
+		res = append(res, &inv)
+	}
+
+	return res, rows.Err()
+}
+
+// ListInvitees возвращает приглашённых на мероприятие пользователей.
+func (r *EventRepository) ListInvitees(ctx context.Context, eventID string) ([]*domain.User, error) {
+	query := `SELECT ` + inviteeColumns + `
+			  FROM users u
+			  WHERE u.id IN (SELECT user_id FROM event_invitations WHERE event_id = $1)
+			  ORDER BY u.username`
+
+	users, err := r.listInvitees(ctx, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list invitees: %w", err)
+	}
+
+	return users, nil
+}
+
+func (r *EventRepository) RevokeInvitation(ctx context.Context, eventID, userID string) error {
+	query := `DELETE FROM event_invitations WHERE event_id = $1 AND user_id = $2`
+	res, err := r.db.ExecWithRetry(ctx, r.strategy, query, eventID, userID)
+	if err != nil {
+		return fmt.Errorf("revoke invitation: %w", err)
+	}
+
+	return requireAffected(res, domain.ErrInvitationNotFound)
+}
+
+func (r *EventRepository) IsInvited(ctx context.Context, eventID, userID string) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM event_invitations WHERE event_id = $1 AND user_id = $2)`
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, eventID, userID)
+	if err != nil {
+		return false, fmt.Errorf("check invitation: %w", err)
+	}
+
+	var invited bool
+	if err = row.Scan(&invited); err != nil {
+		return false, fmt.Errorf("check invitation: %w", err)
+	}
+
+	return invited, nil
+}
+
+func (r *EventRepository) listInvitees(ctx context.Context, query string, args ...any) ([]*domain.User, error) {
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*domain.User
+	for rows.Next() {
+		var u domain.User
+		if err = rows.Scan(
+			&u.ID, &u.Username, &u.TelegramChatID, &u.Email, &u.WebhookURL,
+			&u.Locale, &u.Timezone, &u.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan user: %w", err)
+		}
+		res = append(res, &u)
+	}
+
+	return res, rows.Err()
+}
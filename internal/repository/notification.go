@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/wb-go/wbf/dbpg"
+	"github.com/wb-go/wbf/retry"
+)
+
+// notificationMaxAttempts ограничивает число попыток доставки одного уведомления.
+const notificationMaxAttempts = 5
+
+type NotificationRepository struct {
+	db       *dbpg.DB
+	strategy retry.Strategy
+}
+
+func NewNotificationRepo(db *dbpg.DB) *NotificationRepository {
+	return &NotificationRepository{
+		db: db,
+		strategy: retry.Strategy{
+			Attempts: 3,
+			Delay:    500 * time.Millisecond,
+			Backoff:  2,
+		},
+	}
+}
+
+func (r *NotificationRepository) Enqueue(ctx context.Context, n *domain.Notification) error {
+	query := `INSERT INTO notifications (id, kind, user_id, event_id, created_at)
+			  VALUES ($1, $2, $3, $4, $5)`
+	_, err := r.db.ExecWithRetry(ctx, r.strategy, query, n.ID, n.Kind, n.UserID, n.EventID, n.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("insert notification: %w", err)
+	}
+
+	return nil
+}
+
+// Claim резервирует до limit неотправленных уведомлений на время lease.
+// SKIP LOCKED позволяет нескольким воркерам разбирать очередь без пересечений;
+// если воркер упадёт, уведомление снова станет доступно после истечения lease.
+func (r *NotificationRepository) Claim(ctx context.Context, limit int, lease time.Duration) ([]*domain.Notification, error) {
+	query := `
+		UPDATE notifications
+		SET locked_until = NOW() + make_interval(secs => $2), attempts = attempts + 1
+		WHERE id IN (
+			SELECT id FROM notifications
+			WHERE sent_at IS NULL
+			  AND (locked_until IS NULL OR locked_until < NOW())
+			  AND attempts < $3
+			ORDER BY created_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, kind, user_id, event_id, attempts, delivered_channels, created_at`
+
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, limit, lease.Seconds(), notificationMaxAttempts)
+	if err != nil {
+		return nil, fmt.Errorf("claim notifications: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*domain.Notification
+	for rows.Next() {
+		var (
+			n         domain.Notification
+			delivered []string
+		)
+		if err = rows.Scan(
+			&n.ID, &n.Kind, &n.UserID, &n.EventID, &n.Attempts, pq.Array(&delivered), &n.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan notification: %w", err)
+		}
+		for _, ch := range delivered {
+			n.Delivered = append(n.Delivered, domain.NotificationChannel(ch))
+		}
+		res = append(res, &n)
+	}
+
+	return res, rows.Err()
+}
+
+func (r *NotificationRepository) MarkSent(ctx context.Context, id string) error {
+	query := `UPDATE notifications SET sent_at = NOW(), locked_until = NULL WHERE id = $1`
+	if _, err := r.db.ExecWithRetry(ctx, r.strategy, query, id); err != nil {
+		return fmt.Errorf("mark notification sent: %w", err)
+	}
+
+	return nil
+}
+
+// MarkDelivered дописывает channels к каналам, уже доставившим уведомление.
+func (r *NotificationRepository) MarkDelivered(ctx context.Context, id string, channels []domain.NotificationChannel) error {
+	names := make([]string, len(channels))
+	for i, ch := range channels {
+		names[i] = string(ch)
+	}
+
+	query := `UPDATE notifications SET delivered_channels = delivered_channels || $2::text[] WHERE id = $1`
+	if _, err := r.db.ExecWithRetry(ctx, r.strategy, query, id, pq.Array(names)); err != nil {
+		return fmt.Errorf("mark notification channels delivered: %w", err)
+	}
+
+	return nil
+}
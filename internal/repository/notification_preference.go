@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/wb-go/wbf/dbpg"
+	"github.com/wb-go/wbf/retry"
+)
+
+type NotificationPreferenceRepository struct {
+	db       *dbpg.DB
+	strategy retry.Strategy
+}
+
+func NewNotificationPreferenceRepo(db *dbpg.DB) *NotificationPreferenceRepository {
+	return &NotificationPreferenceRepository{
+		db: db,
+		strategy: retry.Strategy{
+			Attempts: 3,
+			Delay:    500 * time.Millisecond,
+			Backoff:  2,
+		},
+	}
+}
+
+// Get возвращает настройки пользователя; отсутствующие в БД значения берутся по умолчанию.
+func (r *NotificationPreferenceRepository) Get(ctx context.Context, userID string) (*domain.NotificationPreferences, error) {
+	query := `SELECT channel, kind, enabled
+			  FROM notification_preferences
+			  WHERE user_id = $1`
+
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get notification preferences: %w", err)
+	}
+	defer rows.Close()
+
+	prefs := domain.NewNotificationPreferences(userID)
+	for rows.Next() {
+		var (
+			ch      domain.NotificationChannel
+			kind    domain.NotificationKind
+			enabled bool
+		)
+		if err = rows.Scan(&ch, &kind, &enabled); err != nil {
+			return nil, fmt.Errorf("scan notification preference: %w", err)
+		}
+		prefs.Set(ch, kind, enabled)
+	}
+
+	return prefs, rows.Err()
+}
+
+func (r *NotificationPreferenceRepository) Save(ctx context.Context, prefs *domain.NotificationPreferences) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `INSERT INTO notification_preferences (user_id, channel, kind, enabled, updated_at)
+			  VALUES ($1, $2, $3, $4, NOW())
+			  ON CONFLICT (user_id, channel, kind)
+			  DO UPDATE SET enabled = EXCLUDED.enabled, updated_at = NOW()`
+	for ch, kinds := range prefs.Settings {
+		for kind, enabled := range kinds {
+			if _, err = tx.ExecContext(ctx, query, prefs.UserID, ch, kind, enabled); err != nil {
+				return fmt.Errorf("upsert notification preference: %w", err)
+			}
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+)
+
+// writeOutbox записывает сообщения out в транзакции tx. Вебхук превращается в доставку
+// на каждую активную подписку на его тип — подписчики берутся на момент транзакции.
+func writeOutbox(ctx context.Context, tx *sql.Tx, out *domain.Outbox) error {
+	if out == nil {
+		return nil
+	}
+
+	notificationQuery := `INSERT INTO notifications (id, kind, user_id, event_id, created_at)
+						  VALUES ($1, $2, $3, $4, $5)`
+	for _, n := range out.Notifications {
+		if _, err := tx.ExecContext(ctx, notificationQuery, n.ID, n.Kind, n.UserID, n.EventID, n.CreatedAt); err != nil {
+			return fmt.Errorf("insert notification: %w", err)
+		}
+	}
+
+	deliveryQuery := `INSERT INTO webhook_deliveries (id, subscription_id, event_type, payload, status, next_attempt_at, created_at)
+					  SELECT gen_random_uuid(), id, $1::text, $2, $3, NOW(), NOW()
+					  FROM webhook_subscriptions
+					  WHERE active AND $1::text = ANY(event_types)`
+	for _, m := range out.Webhooks {
+		if _, err := tx.ExecContext(
+			ctx, deliveryQuery, string(m.Type), []byte(m.Payload), domain.WebhookDeliveryPending,
+		); err != nil {
+			return fmt.Errorf("insert webhook deliveries: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// writeBookingOutbox записывает сообщения outbox о бронях bookings; nil outbox ничего не пишет.
+// Мероприятие каждой брони читается в той же транзакции, один раз на мероприятие.
+func writeBookingOutbox(ctx context.Context, tx *sql.Tx, outbox domain.BookingOutbox, bookings []*domain.Booking) error {
+	if outbox == nil {
+		return nil
+	}
+
+	events := make(map[string]*domain.Event)
+	for _, b := range bookings {
+		event, ok := events[b.EventID]
+		if !ok {
+			row := tx.QueryRowContext(ctx, `SELECT `+eventColumns+` FROM events WHERE id = $1`, b.EventID)
+			var err error
+			if event, err = scanEvent(row); err != nil {
+				return fmt.Errorf("get booking event: %w", err)
+			}
+			events[b.EventID] = event
+		}
+
+		out, err := outbox(b, event)
+		if err != nil {
+			return fmt.Errorf("build outbox: %w", err)
+		}
+		if err = writeOutbox(ctx, tx, out); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/wb-go/wbf/dbpg"
+	"github.com/wb-go/wbf/retry"
+)
+
+// RateLimitRepository хранит вёдра ограничителя частоты запросов в Postgres,
+// чтобы лимит был общим для всех реплик API.
+type RateLimitRepository struct {
+	db       *dbpg.DB
+	strategy retry.Strategy
+}
+
+func NewRateLimitRepo(db *dbpg.DB) *RateLimitRepository {
+	return &RateLimitRepository{
+		db: db,
+		// Без повторов: ограничитель не должен задерживать запрос, при сбое он его пропускает.
+		strategy: retry.Strategy{
+			Attempts: 1,
+			Delay:    0,
+			Backoff:  1,
+		},
+	}
+}
+
+// Take берёт по маркеру из всех вёдер keys, только если маркер есть в каждом.
+// Строки вёдер блокируются в одной транзакции в порядке ключей, поэтому параллельные
+// реплики не теряют списания и не ждут друг друга по кругу.
+func (r *RateLimitRepository) Take(ctx context.Context, keys []domain.RateKey, now time.Time) ([]domain.RateDecision, error) {
+	order := make([]int, len(keys))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool { return keys[order[a]].Key < keys[order[b]].Key })
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Новое ведро вставляется полным; для существующего пустое обновление только берёт блокировку.
+	lockQuery := `INSERT INTO rate_limit_buckets AS b (key, tokens, updated_at, expires_at)
+				  VALUES ($1, $2, $3, $3)
+				  ON CONFLICT (key) DO UPDATE SET key = b.key
+				  RETURNING tokens, updated_at`
+
+	limits := make([]domain.RateLimit, len(keys))
+	buckets := make([]*domain.RateBucket, len(keys))
+	for _, i := range order {
+		var b domain.RateBucket
+		err = tx.QueryRowContext(ctx, lockQuery, keys[i].Key, float64(keys[i].Limit.Capacity()), now).
+			Scan(&b.Tokens, &b.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("lock rate limit bucket: %w", err)
+		}
+		limits[i], buckets[i] = keys[i].Limit, &b
+	}
+
+	decisions := domain.TakeAll(limits, buckets, now)
+
+	updateQuery := `UPDATE rate_limit_buckets SET tokens = $2, updated_at = $3, expires_at = $4 WHERE key = $1`
+	for _, i := range order {
+		_, err = tx.ExecContext(ctx, updateQuery, keys[i].Key, buckets[i].Tokens, now, now.Add(decisions[i].ResetAfter))
+		if err != nil {
+			return nil, fmt.Errorf("update rate limit bucket: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return decisions, nil
+}
+
+// DeleteExpired удаляет вёдра, которые уже наполнились, и возвращает их число.
+func (r *RateLimitRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	query := `DELETE FROM rate_limit_buckets WHERE expires_at < $1`
+	res, err := r.db.ExecWithRetry(ctx, r.strategy, query, now)
+	if err != nil {
+		return 0, fmt.Errorf("delete expired rate limit buckets: %w", err)
+	}
+
+	return res.RowsAffected()
+}
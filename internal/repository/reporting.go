@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/wb-go/wbf/dbpg"
+	"github.com/wb-go/wbf/retry"
+)
+
+// ReportingRepository считает агрегаты для панели администратора целиком в SQL:
+// в приложение приходят только итоговые строки, а не брони.
+type ReportingRepository struct {
+	db       *dbpg.DB
+	strategy retry.Strategy
+}
+
+func NewReportingRepo(db *dbpg.DB) *ReportingRepository {
+	return &ReportingRepository{
+		db: db,
+		strategy: retry.Strategy{
+			Attempts: 3,
+			Delay:    500 * time.Millisecond,
+			Backoff:  2,
+		},
+	}
+}
+
+// DailyBookings возвращает по строке на каждый день периода, включая дни без броней.
+// Границы дня берутся в часовом поясе отчёта.
+func (r *ReportingRepository) DailyBookings(ctx context.Context, rng domain.StatsRange) ([]domain.DailyBookingStats, error) {
+	query := `WITH days AS (
+				  SELECT d::date AS day
+				  FROM generate_series(
+					  ($1::timestamptz AT TIME ZONE $3)::date,
+					  (($2::timestamptz - interval '1 microsecond') AT TIME ZONE $3)::date,
+					  interval '1 day'
+				  ) AS d
+			  ),
+			  created AS (
+				  SELECT (created_at AT TIME ZONE $3)::date AS day, COUNT(*) AS n
+				  FROM bookings
+				  WHERE created_at >= $1 AND created_at < $2
+				  GROUP BY 1
+			  ),
+			  confirmed AS (
+				  SELECT (confirmed_at AT TIME ZONE $3)::date AS day, COUNT(*) AS n
+				  FROM bookings
+				  WHERE confirmed_at >= $1 AND confirmed_at < $2
+				  GROUP BY 1
+			  ),
+			  expired AS (
+				  SELECT (expired_at AT TIME ZONE $3)::date AS day, COUNT(*) AS n
+				  FROM bookings
+				  WHERE expired_at >= $1 AND expired_at < $2
+				  GROUP BY 1
+			  )
+			  SELECT days.day, COALESCE(created.n, 0), COALESCE(confirmed.n, 0), COALESCE(expired.n, 0)
+			  FROM days
+			  LEFT JOIN created USING (day)
+			  LEFT JOIN confirmed USING (day)
+			  LEFT JOIN expired USING (day)
+			  ORDER BY days.day`
+
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, rng.From, rng.To, rng.Location.String())
+	if err != nil {
+		return nil, fmt.Errorf("daily bookings: %w", err)
+	}
+	defer rows.Close()
+
+	var res []domain.DailyBookingStats
+	for rows.Next() {
+		var (
+			s   domain.DailyBookingStats
+			day time.Time
+		)
+		if err = rows.Scan(&day, &s.Created, &s.Confirmed, &s.Expired); err != nil {
+			return nil, fmt.Errorf("scan daily bookings: %w", err)
+		}
+		// date приходит полуночью UTC — переносим календарный день в пояс отчёта.
+		s.Day = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, rng.Location)
+		res = append(res, s)
+	}
+
+	return res, rows.Err()
+}
+
+// Conversion считает путь pending → confirmed. Бронь без оплаты подтверждается в момент создания
+// (confirmed_at = created_at) и в конверсию не входит.
+func (r *ReportingRepository) Conversion(ctx context.Context, rng domain.StatsRange) (*domain.ConversionStats, error) {
+	query := `SELECT
+				  COUNT(*) FILTER (WHERE status <> $3),
+				  COUNT(*) FILTER (WHERE confirmed_at IS NOT NULL),
+				  (SELECT COALESCE(EXTRACT(EPOCH FROM AVG(confirmed_at - created_at)), 0)
+				   FROM bookings
+				   WHERE confirmed_at >= $1 AND confirmed_at < $2 AND confirmed_at > created_at)
+			  FROM bookings
+			  WHERE created_at >= $1 AND created_at < $2
+				AND confirmed_at IS DISTINCT FROM created_at`
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, rng.From, rng.To, domain.BookingStatusPending)
+	if err != nil {
+		return nil, fmt.Errorf("conversion: %w", err)
+	}
+
+	var (
+		s          domain.ConversionStats
+		avgSeconds float64
+	)
+	if err = row.Scan(&s.Resolved, &s.Confirmed, &avgSeconds); err != nil {
+		return nil, fmt.Errorf("scan conversion: %w", err)
+	}
+	if s.Resolved > 0 {
+		s.Rate = float64(s.Confirmed) / float64(s.Resolved)
+	}
+	s.AvgTimeToConfirm = time.Duration(avgSeconds * float64(time.Second))
+
+	return &s, nil
+}
+
+// occupancyQuery — заполненность неотменённых мероприятий, начинающихся в периоде.
+const occupancyQuery = `SELECT e.id, e.title, e.event_date, e.total_spots,
+							   COUNT(b.id) FILTER (WHERE b.status = $3) AS confirmed,
+							   COUNT(b.id) FILTER (WHERE b.status = $4) AS pending,
+							   COUNT(b.id)::float8 / e.total_spots AS fill_rate
+						FROM events e
+						LEFT JOIN bookings b ON b.event_id = e.id AND b.status IN ($3, $4)
+						WHERE e.cancelled_at IS NULL AND e.event_date >= $1 AND e.event_date < $2
+						GROUP BY e.id`
+
+// Occupancy возвращает заполненность мероприятий периода по дате начала.
+func (r *ReportingRepository) Occupancy(ctx context.Context, rng domain.StatsRange) ([]domain.EventOccupancy, error) {
+	return r.listOccupancy(ctx, occupancyQuery+` ORDER BY e.event_date, e.id`,
+		rng.From, rng.To, domain.BookingStatusConfirmed, domain.BookingStatusPending)
+}
+
+// TopEvents возвращает limit самых заполненных мероприятий периода.
+func (r *ReportingRepository) TopEvents(ctx context.Context, rng domain.StatsRange, limit int) ([]domain.EventOccupancy, error) {
+	return r.listOccupancy(ctx, occupancyQuery+` ORDER BY fill_rate DESC, e.event_date, e.id LIMIT $5`,
+		rng.From, rng.To, domain.BookingStatusConfirmed, domain.BookingStatusPending, limit)
+}
+
+func (r *ReportingRepository) listOccupancy(ctx context.Context, query string, args ...any) ([]domain.EventOccupancy, error) {
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("occupancy: %w", err)
+	}
+	defer rows.Close()
+
+	var res []domain.EventOccupancy
+	for rows.Next() {
+		var o domain.EventOccupancy
+		if err = rows.Scan(
+			&o.EventID, &o.Title, &o.EventDate, &o.TotalSpots,
+			&o.Confirmed, &o.Pending, &o.FillRate,
+		); err != nil {
+			return nil, fmt.Errorf("scan occupancy: %w", err)
+		}
+		res = append(res, o)
+	}
+
+	return res, rows.Err()
+}
@@ -0,0 +1,379 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/wb-go/wbf/dbpg"
+	"github.com/wb-go/wbf/retry"
+)
+
+type SeriesRepository struct {
+	db       *dbpg.DB
+	strategy retry.Strategy
+}
+
+func NewSeriesRepo(db *dbpg.DB) *SeriesRepository {
+	return &SeriesRepository{
+		db: db,
+		strategy: retry.Strategy{
+			Attempts: 3,
+			Delay:    500 * time.Millisecond,
+			Backoff:  2,
+		},
+	}
+}
+
+// seriesColumns — колонки event_series в порядке, ожидаемом scanSeries. Исключения
+// читаются как JSON: так timestamptz[] разбирается без зависимости от настроек сессии.
+const seriesColumns = `id, title, description, total_spots, requires_payment,
+	EXTRACT(EPOCH FROM booking_ttl)::bigint, start_at, timezone,
+	freq, repeat_interval, by_weekday, by_month_day, repeat_count, until_at,
+	array_to_json(exceptions)::text, materialized_until, cancelled_at, created_at, updated_at`
+
+func (r *SeriesRepository) Create(ctx context.Context, s *domain.EventSeries) error {
+	query := `INSERT INTO event_series (
+				id, title, description, total_spots, requires_payment, booking_ttl, start_at, timezone,
+				freq, repeat_interval, by_weekday, by_month_day, repeat_count, until_at, exceptions,
+				created_at, updated_at)
+			  VALUES ($1, $2, $3, $4, $5, make_interval(secs => $6), $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)`
+	_, err := r.db.ExecWithRetry(
+		ctx, r.strategy, query,
+		s.ID, s.Title, s.Description, s.TotalSpots, s.RequiresPayment, s.BookingTTL.Seconds(),
+		s.Start, s.Timezone, s.Recurrence.Freq, s.Recurrence.Interval,
+		pq.Array(weekdaysToInts(s.Recurrence.ByWeekday)), pq.Array(intsToInt64(s.Recurrence.ByMonthDay)),
+		s.Recurrence.Count, s.Recurrence.Until, pq.Array(timesToStrings(s.Exceptions)),
+		s.CreatedAt, s.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert event series: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SeriesRepository) GetByID(ctx context.Context, id string) (*domain.EventSeries, error) {
+	query := `SELECT ` + seriesColumns + ` FROM event_series WHERE id = $1`
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("get event series: %w", err)
+	}
+
+	s, err := scanSeries(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrSeriesNotFound
+		}
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (r *SeriesRepository) List(ctx context.Context) ([]*domain.EventSeries, error) {
+	query := `SELECT ` + seriesColumns + ` FROM event_series ORDER BY start_at DESC`
+	return r.list(ctx, query)
+}
+
+// ListDue возвращает активные серии, вхождения которых созданы не до horizon.
+func (r *SeriesRepository) ListDue(ctx context.Context, horizon time.Time) ([]*domain.EventSeries, error) {
+	query := `SELECT ` + seriesColumns + `
+			  FROM event_series
+			  WHERE cancelled_at IS NULL
+			    AND (materialized_until IS NULL OR materialized_until < $1)`
+	return r.list(ctx, query, horizon)
+}
+
+func (r *SeriesRepository) list(ctx context.Context, query string, args ...any) ([]*domain.EventSeries, error) {
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list event series: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*domain.EventSeries
+	for rows.Next() {
+		s, err := scanSeries(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, s)
+	}
+
+	return res, rows.Err()
+}
+
+func (r *SeriesRepository) ListOccurrences(ctx context.Context, seriesID string) ([]*domain.Event, error) {
+	query := `SELECT ` + eventColumns + `
+			  FROM events
+			  WHERE series_id = $1
+			  ORDER BY event_date`
+
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("list series occurrences: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*domain.Event
+	for rows.Next() {
+		e, err := scanEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, e)
+	}
+
+	return res, rows.Err()
+}
+
+// Update сохраняет шаблон серии и переносит его на будущие вхождения,
+// кроме отменённых и отредактированных отдельно.
+func (r *SeriesRepository) Update(ctx context.Context, s *domain.EventSeries) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err = lockActiveSeries(ctx, tx, s.ID); err != nil {
+		return err
+	}
+
+	// Блокируем будущие вхождения, чтобы число броней не изменилось до записи.
+	lockQuery := `SELECT id FROM events WHERE series_id = $1 AND event_date > NOW() FOR UPDATE`
+	if _, err = tx.ExecContext(ctx, lockQuery, s.ID); err != nil {
+		return fmt.Errorf("lock occurrences: %w", err)
+	}
+
+	var overbooked bool
+	checkQuery := `SELECT EXISTS (
+					 SELECT 1 FROM events e
+					 WHERE e.series_id = $1 AND NOT e.detached AND e.cancelled_at IS NULL AND e.event_date > NOW()
+					   AND (SELECT COUNT(*) FROM bookings b WHERE b.event_id = e.id AND b.status = ANY($3)) > $2
+				   )`
+	if err = tx.QueryRowContext(ctx, checkQuery, s.ID, s.TotalSpots, pq.Array(domain.ActiveStatuses)).Scan(&overbooked); err != nil {
+		return fmt.Errorf("check occurrences capacity: %w", err)
+	}
+	if overbooked {
+		return domain.ErrSpotsBelowBooked
+	}
+
+	seriesQuery := `UPDATE event_series
+					SET title = $2, description = $3, total_spots = $4, requires_payment = $5,
+					    booking_ttl = make_interval(secs => $6), updated_at = $7
+					WHERE id = $1`
+	if _, err = tx.ExecContext(
+		ctx, seriesQuery,
+		s.ID, s.Title, s.Description, s.TotalSpots, s.RequiresPayment, s.BookingTTL.Seconds(), s.UpdatedAt,
+	); err != nil {
+		return fmt.Errorf("update event series: %w", err)
+	}
+
+	eventsQuery := `UPDATE events
+					SET title = $2, description = $3, total_spots = $4, requires_payment = $5,
+					    booking_ttl = make_interval(secs => $6), updated_at = $7
+					WHERE series_id = $1 AND NOT detached AND cancelled_at IS NULL AND event_date > NOW()`
+	if _, err = tx.ExecContext(
+		ctx, eventsQuery,
+		s.ID, s.Title, s.Description, s.TotalSpots, s.RequiresPayment, s.BookingTTL.Seconds(), s.UpdatedAt,
+	); err != nil {
+		return fmt.Errorf("update series occurrences: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// Cancel останавливает серию и отменяет её будущие вхождения вместе с активными бронями,
+// записывая сообщения outbox о каждой. Прошедшие вхождения не трогаются.
+func (r *SeriesRepository) Cancel(
+	ctx context.Context,
+	id string,
+	change domain.BookingChange,
+	outbox domain.BookingOutbox,
+) ([]*domain.Booking, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err = lockActiveSeries(ctx, tx, id); err != nil {
+		return nil, err
+	}
+
+	if _, err = tx.ExecContext(ctx, `UPDATE event_series SET cancelled_at = NOW(), updated_at = NOW() WHERE id = $1`, id); err != nil {
+		return nil, fmt.Errorf("cancel event series: %w", err)
+	}
+
+	eventsQuery := `UPDATE events
+					SET cancelled_at = NOW(), updated_at = NOW()
+					WHERE series_id = $1 AND cancelled_at IS NULL AND event_date > NOW()
+					RETURNING id`
+	rows, err := tx.QueryContext(ctx, eventsQuery, id)
+	if err != nil {
+		return nil, fmt.Errorf("cancel series occurrences: %w", err)
+	}
+	var eventIDs []string
+	for rows.Next() {
+		var eventID string
+		if err = rows.Scan(&eventID); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scan event id: %w", err)
+		}
+		eventIDs = append(eventIDs, eventID)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("cancel series occurrences: %w", err)
+	}
+
+	bookings, err := cancelEventBookings(ctx, tx, eventIDs, change, outbox)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return bookings, nil
+}
+
+// AddOccurrences создаёт вхождения серии и сдвигает materialized_until. Уже существующие
+// вхождения пропускаются, так что повторный запуск безопасен. Возвращает только созданные.
+func (r *SeriesRepository) AddOccurrences(
+	ctx context.Context,
+	seriesID string,
+	events []*domain.Event,
+	until time.Time,
+) ([]*domain.Event, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Серию могли отменить, пока планировщик считал вхождения.
+	if err = lockActiveSeries(ctx, tx, seriesID); err != nil {
+		if errors.Is(err, domain.ErrSeriesCancelled) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// Вхождения серии публикуются сразу: черновиков у серий нет.
+	query := `INSERT INTO events (id, title, description, event_date, total_spots, requires_payment, booking_ttl,
+								  duration, timezone, series_id, occurrence_date, created_at, updated_at,
+								  status, published_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, make_interval(secs => $7), make_interval(secs => $8), $9, $10, $4, $11, $11,
+					  'published', $11)
+			  ON CONFLICT (series_id, occurrence_date) WHERE series_id IS NOT NULL DO NOTHING`
+	var created []*domain.Event
+	for _, e := range events {
+		res, err := tx.ExecContext(
+			ctx, query,
+			e.ID, e.Title, e.Description, e.EventDate, e.TotalSpots, e.RequiresPayment,
+			e.BookingTTL.Seconds(), e.Duration.Seconds(), e.Timezone, seriesID, e.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("insert occurrence: %w", err)
+		}
+		if n, _ := res.RowsAffected(); n > 0 {
+			created = append(created, e)
+		}
+	}
+
+	untilQuery := `UPDATE event_series
+				   SET materialized_until = GREATEST(COALESCE(materialized_until, $2), $2)
+				   WHERE id = $1`
+	if _, err = tx.ExecContext(ctx, untilQuery, seriesID, until); err != nil {
+		return nil, fmt.Errorf("update materialized_until: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return created, nil
+}
+
+// lockActiveSeries блокирует строку серии до конца транзакции.
+func lockActiveSeries(ctx context.Context, tx *sql.Tx, id string) error {
+	var cancelled bool
+	query := `SELECT cancelled_at IS NOT NULL FROM event_series WHERE id = $1 FOR UPDATE`
+	if err := tx.QueryRowContext(ctx, query, id).Scan(&cancelled); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrSeriesNotFound
+		}
+		return fmt.Errorf("lock event series: %w", err)
+	}
+	if cancelled {
+		return domain.ErrSeriesCancelled
+	}
+	return nil
+}
+
+func scanSeries(row rowScanner) (*domain.EventSeries, error) {
+	var s domain.EventSeries
+	var ttlSeconds int64
+	var weekdays, monthDays []int64
+	var exceptions string
+	if err := row.Scan(
+		&s.ID, &s.Title, &s.Description, &s.TotalSpots, &s.RequiresPayment,
+		&ttlSeconds, &s.Start, &s.Timezone,
+		&s.Recurrence.Freq, &s.Recurrence.Interval, pq.Array(&weekdays), pq.Array(&monthDays),
+		&s.Recurrence.Count, &s.Recurrence.Until,
+		&exceptions, &s.MaterializedUntil, &s.CancelledAt, &s.CreatedAt, &s.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("scan event series: %w", err)
+	}
+
+	s.BookingTTL = time.Duration(ttlSeconds) * time.Second
+	for _, d := range weekdays {
+		s.Recurrence.ByWeekday = append(s.Recurrence.ByWeekday, time.Weekday(d))
+	}
+	for _, d := range monthDays {
+		s.Recurrence.ByMonthDay = append(s.Recurrence.ByMonthDay, int(d))
+	}
+	if err := json.Unmarshal([]byte(exceptions), &s.Exceptions); err != nil {
+		return nil, fmt.Errorf("decode series exceptions: %w", err)
+	}
+
+	return &s, nil
+}
+
+func weekdaysToInts(days []time.Weekday) []int64 {
+	res := make([]int64, len(days))
+	for i, d := range days {
+		res[i] = int64(d)
+	}
+	return res
+}
+
+func intsToInt64(values []int) []int64 {
+	res := make([]int64, len(values))
+	for i, v := range values {
+		res[i] = int64(v)
+	}
+	return res
+}
+
+// timesToStrings нужен для timestamptz[]: pq.Array не умеет кодировать []time.Time.
+func timesToStrings(times []time.Time) []string {
+	res := make([]string, len(times))
+	for i, t := range times {
+		res[i] = t.UTC().Format(time.RFC3339Nano)
+	}
+	return res
+}
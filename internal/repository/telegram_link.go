@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/wb-go/wbf/dbpg"
+	"github.com/wb-go/wbf/retry"
+)
+
+type TelegramLinkRepository struct {
+	db       *dbpg.DB
+	strategy retry.Strategy
+}
+
+func NewTelegramLinkRepo(db *dbpg.DB) *TelegramLinkRepository {
+	return &TelegramLinkRepository{
+		db: db,
+		strategy: retry.Strategy{
+			Attempts: 3,
+			Delay:    500 * time.Millisecond,
+			Backoff:  2,
+		},
+	}
+}
+
+// Create сохраняет токен. Прежние неиспользованные токены пользователя удаляются,
+// так что действительна только последняя выданная ссылка.
+func (r *TelegramLinkRepository) Create(ctx context.Context, tokenHash, userID string, expiresAt time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	deleteQuery := `DELETE FROM telegram_link_tokens
+					WHERE user_id = $1 AND (used_at IS NULL OR expires_at < NOW())`
+	if _, err = tx.ExecContext(ctx, deleteQuery, userID); err != nil {
+		return fmt.Errorf("delete old tokens: %w", err)
+	}
+
+	query := `INSERT INTO telegram_link_tokens (token_hash, user_id, expires_at)
+			  VALUES ($1, $2, $3)`
+	if _, err = tx.ExecContext(ctx, query, tokenHash, userID, expiresAt); err != nil {
+		return fmt.Errorf("insert token: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// Link в одной транзакции помечает токен использованным и привязывает chatID к его владельцу.
+// Если привязать чат не удалось, токен остаётся действительным.
+func (r *TelegramLinkRepository) Link(ctx context.Context, tokenHash string, chatID int64) (string, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE telegram_link_tokens
+			  SET used_at = NOW()
+			  WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW()
+			  RETURNING user_id`
+
+	var userID string
+	if err = tx.QueryRowContext(ctx, query, tokenHash).Scan(&userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", domain.ErrTelegramLinkInvalid
+		}
+		return "", fmt.Errorf("consume token: %w", err)
+	}
+
+	if err = setTelegramChatID(ctx, tx, userID, chatID); err != nil {
+		return "", err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return "", fmt.Errorf("commit: %w", err)
+	}
+
+	return userID, nil
+}
@@ -30,9 +30,13 @@ func NewUserRepo(db *dbpg.DB) *UserRepository {
 }
 
 func (r *UserRepository) Create(ctx context.Context, user *domain.User) error {
-	query := `INSERT INTO users (id, username, telegram_chat_id, created_at)
- 			  VALUES ($1, $2, $3, $4)`
-	_, err := r.db.ExecWithRetry(ctx, r.strategy, query, user.ID, user.Username, user.TelegramChatID, time.Now())
+	query := `INSERT INTO users (id, username, telegram_chat_id, email, webhook_url, locale, timezone, created_at)
+ 			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	_, err := r.db.ExecWithRetry(
+		ctx, r.strategy, query,
+		user.ID, user.Username, user.TelegramChatID, user.Email, user.WebhookURL,
+		user.Locale, user.Timezone, time.Now(),
+	)
 	if err != nil {
 		var pgErr *pq.Error
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
@@ -45,7 +49,7 @@ func (r *UserRepository) Create(ctx context.Context, user *domain.User) error {
 }
 
 func (r *UserRepository) GetByID(ctx context.Context, id string) (*domain.User, error) {
-	query := `SELECT id, username, telegram_chat_id, created_at 
+	query := `SELECT id, username, telegram_chat_id, email, webhook_url, locale, timezone, created_at 
     		  FROM users
     		  WHERE id=$1`
 
@@ -55,7 +59,10 @@ func (r *UserRepository) GetByID(ctx context.Context, id string) (*domain.User,
 	}
 
 	var u domain.User
-	if err = row.Scan(&u.ID, &u.Username, &u.TelegramChatID, &u.CreatedAt); err != nil {
+	if err = row.Scan(
+		&u.ID, &u.Username, &u.TelegramChatID, &u.Email, &u.WebhookURL,
+		&u.Locale, &u.Timezone, &u.CreatedAt,
+	); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrUserNotFound
 		}
@@ -66,7 +73,7 @@ func (r *UserRepository) GetByID(ctx context.Context, id string) (*domain.User,
 }
 
 func (r *UserRepository) GetByUsername(ctx context.Context, username string) (*domain.User, error) {
-	query := `SELECT id, username, telegram_chat_id, created_at 
+	query := `SELECT id, username, telegram_chat_id, email, webhook_url, locale, timezone, created_at 
     		  FROM users
     		  WHERE username=$1`
 
@@ -76,7 +83,10 @@ func (r *UserRepository) GetByUsername(ctx context.Context, username string) (*d
 	}
 
 	var u domain.User
-	if err = row.Scan(&u.ID, &u.Username, &u.TelegramChatID, &u.CreatedAt); err != nil {
+	if err = row.Scan(
+		&u.ID, &u.Username, &u.TelegramChatID, &u.Email, &u.WebhookURL,
+		&u.Locale, &u.Timezone, &u.CreatedAt,
+	); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrUserNotFound
 		}
@@ -86,8 +96,60 @@ func (r *UserRepository) GetByUsername(ctx context.Context, username string) (*d
 	return &u, nil
 }
 
+func (r *UserRepository) GetByTelegramChatID(ctx context.Context, chatID int64) (*domain.User, error) {
+	query := `SELECT id, username, telegram_chat_id, email, webhook_url, locale, timezone, created_at
+    		  FROM users
+    		  WHERE telegram_chat_id=$1
+    		  ORDER BY created_at DESC
+    		  LIMIT 1`
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, chatID)
+	if err != nil {
+		return nil, fmt.Errorf("get user by chat id: %w", err)
+	}
+
+	var u domain.User
+	if err = row.Scan(
+		&u.ID, &u.Username, &u.TelegramChatID, &u.Email, &u.WebhookURL,
+		&u.Locale, &u.Timezone, &u.CreatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("scan user: %w", err)
+	}
+
+	return &u, nil
+}
+
+// setTelegramChatID привязывает чат к пользователю, отвязывая его от других аккаунтов,
+// чтобы команды бота однозначно выполнялись от имени одного пользователя.
+func setTelegramChatID(ctx context.Context, tx *sql.Tx, userID string, chatID int64) error {
+	unlinkQuery := `UPDATE users SET telegram_chat_id = NULL
+					WHERE telegram_chat_id = $1 AND id <> $2`
+	if _, err := tx.ExecContext(ctx, unlinkQuery, chatID, userID); err != nil {
+		return fmt.Errorf("unlink chat: %w", err)
+	}
+
+	query := `UPDATE users SET telegram_chat_id = $2 WHERE id = $1`
+	res, err := tx.ExecContext(ctx, query, userID, chatID)
+	if err != nil {
+		return fmt.Errorf("update telegram chat id: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("user rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	return nil
+}
+
 func (r *UserRepository) List(ctx context.Context) ([]*domain.User, error) {
-	query := `SELECT id, username, telegram_chat_id, created_at 
+	query := `SELECT id, username, telegram_chat_id, email, webhook_url, locale, timezone, created_at 
 			  FROM users 
 			  ORDER BY username DESC`
 
@@ -100,7 +162,10 @@ func (r *UserRepository) List(ctx context.Context) ([]*domain.User, error) {
 	var res []*domain.User
 	for rows.Next() {
 		var u domain.User
-		if err = rows.Scan(&u.ID, &u.Username, &u.TelegramChatID, &u.CreatedAt); err != nil {
+		if err = rows.Scan(
+			&u.ID, &u.Username, &u.TelegramChatID, &u.Email, &u.WebhookURL,
+			&u.Locale, &u.Timezone, &u.CreatedAt,
+		); err != nil {
 			return nil, fmt.Errorf("scan user: %w", err)
 		}
 		res = append(res, &u)
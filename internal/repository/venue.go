@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/wb-go/wbf/dbpg"
+	"github.com/wb-go/wbf/retry"
+)
+
+type VenueRepository struct {
+	db       *dbpg.DB
+	strategy retry.Strategy
+}
+
+func NewVenueRepo(db *dbpg.DB) *VenueRepository {
+	return &VenueRepository{
+		db: db,
+		strategy: retry.Strategy{
+			Attempts: 3,
+			Delay:    500 * time.Millisecond,
+			Backoff:  2,
+		},
+	}
+}
+
+const venueColumns = `id, name, address, latitude, longitude, capacity, timezone, created_at, updated_at`
+
+func (r *VenueRepository) Create(ctx context.Context, v *domain.Venue) error {
+	query := `INSERT INTO venues (` + venueColumns + `)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	_, err := r.db.ExecWithRetry(
+		ctx, r.strategy, query,
+		v.ID, v.Name, v.Address, v.Latitude, v.Longitude, v.Capacity, v.Timezone, v.CreatedAt, v.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert venue: %w", err)
+	}
+
+	return nil
+}
+
+func (r *VenueRepository) GetByID(ctx context.Context, id string) (*domain.Venue, error) {
+	query := `SELECT ` + venueColumns + ` FROM venues WHERE id = $1`
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("get venue: %w", err)
+	}
+
+	v, err := scanVenue(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrVenueNotFound
+		}
+		return nil, err
+	}
+
+	return v, nil
+}
+
+func (r *VenueRepository) List(ctx context.Context) ([]*domain.Venue, error) {
+	query := `SELECT ` + venueColumns + ` FROM venues ORDER BY name`
+	return r.listVenues(ctx, query)
+}
+
+func (r *VenueRepository) ListByIDs(ctx context.Context, ids []string) ([]*domain.Venue, error) {
+	query := `SELECT ` + venueColumns + ` FROM venues WHERE id = ANY($1)`
+	return r.listVenues(ctx, query, pq.Array(ids))
+}
+
+func (r *VenueRepository) listVenues(ctx context.Context, query string, args ...any) ([]*domain.Venue, error) {
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list venues: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*domain.Venue
+	for rows.Next() {
+		v, err := scanVenue(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+
+	return res, rows.Err()
+}
+
+func (r *VenueRepository) Update(ctx context.Context, v *domain.Venue) error {
+	query := `UPDATE venues
+			  SET name = $2, address = $3, latitude = $4, longitude = $5,
+			      capacity = $6, timezone = $7, updated_at = $8
+			  WHERE id = $1`
+	res, err := r.db.ExecWithRetry(
+		ctx, r.strategy, query,
+		v.ID, v.Name, v.Address, v.Latitude, v.Longitude, v.Capacity, v.Timezone, v.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("update venue: %w", err)
+	}
+
+	return requireAffected(res, domain.ErrVenueNotFound)
+}
+
+// Delete удаляет площадку. Если на ней есть мероприятия (в том числе прошедшие
+// или отменённые), внешний ключ не даст удалить — возвращается ErrVenueInUse.
+func (r *VenueRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE FROM venues WHERE id = $1`
+	res, err := r.db.ExecWithRetry(ctx, r.strategy, query, id)
+	if err != nil {
+		var pgErr *pq.Error
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return domain.ErrVenueInUse
+		}
+		return fmt.Errorf("delete venue: %w", err)
+	}
+
+	return requireAffected(res, domain.ErrVenueNotFound)
+}
+
+func scanVenue(row rowScanner) (*domain.Venue, error) {
+	var v domain.Venue
+	var lat, lon sql.NullFloat64
+	err := row.Scan(
+		&v.ID, &v.Name, &v.Address, &lat, &lon, &v.Capacity, &v.Timezone, &v.CreatedAt, &v.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("scan venue: %w", err)
+	}
+	if lat.Valid && lon.Valid {
+		v.Latitude, v.Longitude = &lat.Float64, &lon.Float64
+	}
+
+	return &v, nil
+}
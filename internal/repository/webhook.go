@@ -0,0 +1,337 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/wb-go/wbf/dbpg"
+	"github.com/wb-go/wbf/retry"
+)
+
+type WebhookRepository struct {
+	db       *dbpg.DB
+	strategy retry.Strategy
+}
+
+func NewWebhookRepo(db *dbpg.DB) *WebhookRepository {
+	return &WebhookRepository{
+		db: db,
+		strategy: retry.Strategy{
+			Attempts: 3,
+			Delay:    500 * time.Millisecond,
+			Backoff:  2,
+		},
+	}
+}
+
+const subscriptionColumns = `id, url, secret, event_types, active, created_at, updated_at`
+
+const deliveryColumns = `id, subscription_id, event_type, payload, status, attempts,
+	next_attempt_at, response_status, last_error, created_at, delivered_at`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func (r *WebhookRepository) CreateSubscription(ctx context.Context, s *domain.WebhookSubscription) error {
+	query := `INSERT INTO webhook_subscriptions (` + subscriptionColumns + `)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	_, err := r.db.ExecWithRetry(
+		ctx, r.strategy, query,
+		s.ID, s.URL, s.Secret, pq.Array(eventTypesToStrings(s.EventTypes)), s.Active, s.CreatedAt, s.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert webhook subscription: %w", err)
+	}
+
+	return nil
+}
+
+func (r *WebhookRepository) GetSubscription(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	query := `SELECT ` + subscriptionColumns + ` FROM webhook_subscriptions WHERE id = $1`
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("get webhook subscription: %w", err)
+	}
+
+	s, err := scanSubscription(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrWebhookNotFound
+		}
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (r *WebhookRepository) ListSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error) {
+	query := `SELECT ` + subscriptionColumns + ` FROM webhook_subscriptions ORDER BY created_at`
+	return r.listSubscriptions(ctx, query)
+}
+
+// ListSubscribers возвращает активные подписки на eventType.
+func (r *WebhookRepository) ListSubscribers(ctx context.Context, eventType domain.WebhookEventType) ([]*domain.WebhookSubscription, error) {
+	query := `SELECT ` + subscriptionColumns + `
+			  FROM webhook_subscriptions
+			  WHERE active AND $1 = ANY(event_types)`
+	return r.listSubscriptions(ctx, query, string(eventType))
+}
+
+func (r *WebhookRepository) listSubscriptions(ctx context.Context, query string, args ...any) ([]*domain.WebhookSubscription, error) {
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list webhook subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*domain.WebhookSubscription
+	for rows.Next() {
+		s, err := scanSubscription(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, s)
+	}
+
+	return res, rows.Err()
+}
+
+func (r *WebhookRepository) UpdateSubscription(ctx context.Context, s *domain.WebhookSubscription) error {
+	query := `UPDATE webhook_subscriptions
+			  SET url = $2, event_types = $3, active = $4, updated_at = $5
+			  WHERE id = $1`
+	res, err := r.db.ExecWithRetry(
+		ctx, r.strategy, query,
+		s.ID, s.URL, pq.Array(eventTypesToStrings(s.EventTypes)), s.Active, s.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("update webhook subscription: %w", err)
+	}
+
+	return requireAffected(res, domain.ErrWebhookNotFound)
+}
+
+func (r *WebhookRepository) DeleteSubscription(ctx context.Context, id string) error {
+	query := `DELETE FROM webhook_subscriptions WHERE id = $1`
+	res, err := r.db.ExecWithRetry(ctx, r.strategy, query, id)
+	if err != nil {
+		return fmt.Errorf("delete webhook subscription: %w", err)
+	}
+
+	return requireAffected(res, domain.ErrWebhookNotFound)
+}
+
+func (r *WebhookRepository) EnqueueDeliveries(ctx context.Context, deliveries []*domain.WebhookDelivery) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `INSERT INTO webhook_deliveries (id, subscription_id, event_type, payload, status, next_attempt_at, created_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	for _, d := range deliveries {
+		if _, err = tx.ExecContext(
+			ctx, query,
+			d.ID, d.SubscriptionID, d.EventType, []byte(d.Payload), d.Status, d.NextAttemptAt, d.CreatedAt,
+		); err != nil {
+			return fmt.Errorf("insert webhook delivery: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ClaimDeliveries резервирует до limit доставок, время которых подошло, на время lease.
+func (r *WebhookRepository) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*domain.WebhookDelivery, error) {
+	query := `
+		UPDATE webhook_deliveries
+		SET locked_until = NOW() + make_interval(secs => $2)
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = $3
+			  AND next_attempt_at <= NOW()
+			  AND (locked_until IS NULL OR locked_until < NOW())
+			ORDER BY next_attempt_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + deliveryColumns
+
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, limit, lease.Seconds(), domain.WebhookDeliveryPending)
+	if err != nil {
+		return nil, fmt.Errorf("claim webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*domain.WebhookDelivery
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, d)
+	}
+
+	return res, rows.Err()
+}
+
+// RecordAttempt сохраняет итог попытки и новое состояние доставки в одной транзакции.
+func (r *WebhookRepository) RecordAttempt(ctx context.Context, d *domain.WebhookDelivery, a domain.WebhookDeliveryAttempt) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	updateQuery := `UPDATE webhook_deliveries
+					SET status = $2, attempts = $3, next_attempt_at = $4, response_status = $5,
+					    last_error = $6, delivered_at = $7, locked_until = NULL
+					WHERE id = $1`
+	if _, err = tx.ExecContext(
+		ctx, updateQuery,
+		d.ID, d.Status, d.Attempts, d.NextAttemptAt, d.ResponseStatus, d.LastError, d.DeliveredAt,
+	); err != nil {
+		return fmt.Errorf("update webhook delivery: %w", err)
+	}
+
+	logQuery := `INSERT INTO webhook_delivery_attempts (delivery_id, attempt, response_status, error, duration_ms, created_at)
+				 VALUES ($1, $2, $3, $4, $5, $6)`
+	if _, err = tx.ExecContext(
+		ctx, logQuery,
+		d.ID, a.Attempt, a.ResponseStatus, a.Error, a.Duration.Milliseconds(), a.CreatedAt,
+	); err != nil {
+		return fmt.Errorf("insert webhook delivery attempt: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+func (r *WebhookRepository) ListDeliveries(ctx context.Context, subscriptionID string, limit int) ([]*domain.WebhookDelivery, error) {
+	query := `SELECT ` + deliveryColumns + `
+			  FROM webhook_deliveries
+			  WHERE subscription_id = $1
+			  ORDER BY created_at DESC
+			  LIMIT $2`
+
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, subscriptionID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*domain.WebhookDelivery
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, d)
+	}
+
+	return res, rows.Err()
+}
+
+// GetDelivery возвращает доставку вместе с журналом попыток.
+func (r *WebhookRepository) GetDelivery(ctx context.Context, id string) (*domain.WebhookDelivery, error) {
+	query := `SELECT ` + deliveryColumns + ` FROM webhook_deliveries WHERE id = $1`
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("get webhook delivery: %w", err)
+	}
+
+	d, err := scanDelivery(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrWebhookDeliveryNotFound
+		}
+		return nil, err
+	}
+
+	attemptsQuery := `SELECT attempt, response_status, error, duration_ms, created_at
+					  FROM webhook_delivery_attempts
+					  WHERE delivery_id = $1
+					  ORDER BY attempt`
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, attemptsQuery, id)
+	if err != nil {
+		return nil, fmt.Errorf("list webhook delivery attempts: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var a domain.WebhookDeliveryAttempt
+		var durationMs int64
+		if err = rows.Scan(&a.Attempt, &a.ResponseStatus, &a.Error, &durationMs, &a.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan webhook delivery attempt: %w", err)
+		}
+		a.Duration = time.Duration(durationMs) * time.Millisecond
+		d.AttemptLog = append(d.AttemptLog, a)
+	}
+
+	return d, rows.Err()
+}
+
+func scanSubscription(row rowScanner) (*domain.WebhookSubscription, error) {
+	var s domain.WebhookSubscription
+	var eventTypes []string
+	if err := row.Scan(
+		&s.ID, &s.URL, &s.Secret, pq.Array(&eventTypes), &s.Active, &s.CreatedAt, &s.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("scan webhook subscription: %w", err)
+	}
+
+	s.EventTypes = make([]domain.WebhookEventType, len(eventTypes))
+	for i, t := range eventTypes {
+		s.EventTypes[i] = domain.WebhookEventType(t)
+	}
+
+	return &s, nil
+}
+
+func scanDelivery(row rowScanner) (*domain.WebhookDelivery, error) {
+	var d domain.WebhookDelivery
+	var payload []byte
+	if err := row.Scan(
+		&d.ID, &d.SubscriptionID, &d.EventType, &payload, &d.Status, &d.Attempts,
+		&d.NextAttemptAt, &d.ResponseStatus, &d.LastError, &d.CreatedAt, &d.DeliveredAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("scan webhook delivery: %w", err)
+	}
+	d.Payload = payload
+
+	return &d, nil
+}
+
+func eventTypesToStrings(types []domain.WebhookEventType) []string {
+	res := make([]string, len(types))
+	for i, t := range types {
+		res[i] = string(t)
+	}
+	return res
+}
+
+// requireAffected возвращает notFound, если запрос не изменил ни одной строки.
+func requireAffected(res sql.Result, notFound error) error {
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+	if rows == 0 {
+		return notFound
+	}
+	return nil
+}
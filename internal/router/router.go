@@ -1,6 +1,10 @@
 package router
 
 import (
+	"context"
+	"fmt"
+	"html/template"
+	"io/fs"
 	"net/http"
 
 	"github.com/wb-go/wbf/ginext"
@@ -10,44 +14,195 @@ type Handler interface {
 	CreateEvent(c *ginext.Context)
 	GetEvent(c *ginext.Context)
 	ListEvents(c *ginext.Context)
+	SearchEvents(c *ginext.Context)
+	UpdateEvent(c *ginext.Context)
+	CancelEvent(c *ginext.Context)
+	PublishEvent(c *ginext.Context)
+	ArchiveEvent(c *ginext.Context)
+	InviteToEvent(c *ginext.Context)
+	ListEventInvitations(c *ginext.Context)
+	RevokeEventInvitation(c *ginext.Context)
+	GetEventICS(c *ginext.Context)
+	ImportEvents(c *ginext.Context)
+	ExportAttendeesCSV(c *ginext.Context)
+	ExportAttendeesXLSX(c *ginext.Context)
+	CreateSeries(c *ginext.Context)
+	ListSeries(c *ginext.Context)
+	GetSeries(c *ginext.Context)
+	UpdateSeries(c *ginext.Context)
+	CancelSeries(c *ginext.Context)
+	CreateVenue(c *ginext.Context)
+	ListVenues(c *ginext.Context)
+	GetVenue(c *ginext.Context)
+	UpdateVenue(c *ginext.Context)
+	DeleteVenue(c *ginext.Context)
+	CreateCategory(c *ginext.Context)
+	ListCategories(c *ginext.Context)
+	GetCategory(c *ginext.Context)
+	UpdateCategory(c *ginext.Context)
+	DeleteCategory(c *ginext.Context)
 	BookEvent(c *ginext.Context)
 	ConfirmBooking(c *ginext.Context)
+	GetBookingHistory(c *ginext.Context)
 	CreateUser(c *ginext.Context)
 	ListUsers(c *ginext.Context)
 	GetUserBookings(c *ginext.Context)
+	GetNotificationPreferences(c *ginext.Context)
+	UpdateNotificationPreferences(c *ginext.Context)
+	CreateTelegramLink(c *ginext.Context)
+	CreateCalendarToken(c *ginext.Context)
+	GetUserCalendar(c *ginext.Context)
+	ListFollowedCategories(c *ginext.Context)
+	FollowCategory(c *ginext.Context)
+	UnfollowCategory(c *ginext.Context)
+	CreateWebhook(c *ginext.Context)
+	ListWebhooks(c *ginext.Context)
+	GetWebhook(c *ginext.Context)
+	UpdateWebhook(c *ginext.Context)
+	DeleteWebhook(c *ginext.Context)
+	ListWebhookDeliveries(c *ginext.Context)
+	GetWebhookDelivery(c *ginext.Context)
+	ReplayWebhookDelivery(c *ginext.Context)
+	GetAdminStats(c *ginext.Context)
+	GetBookingLimits(c *ginext.Context)
+	SetBookingLimits(c *ginext.Context)
+	ResetBookingLimits(c *ginext.Context)
+	ClearBookingCooldown(c *ginext.Context)
 }
 
-func InitRouter(mode string, h Handler, mw ...ginext.HandlerFunc) *ginext.Engine {
-	router := ginext.New(mode)
+// InitRouter собирает маршруты API и веб-интерфейса.
+// assets должен содержать каталоги templates и static. trustedProxies — прокси,
+// которым можно верить в X-Forwarded-For; nil — адрес клиента берётся из соединения.
+func InitRouter(mode string, trustedProxies []string, h Handler, assets fs.FS, mw ...ginext.HandlerFunc) (*ginext.Engine, error) {
+	router, err := newEngine(mode, trustedProxies)
+	if err != nil {
+		return nil, err
+	}
 	router.Use(mw...)
 
 	api := router.Group("/api")
 	{
 		// Events
 		api.POST("/events", h.CreateEvent)
+		api.POST("/events/import", h.ImportEvents)
 		api.GET("/events", h.ListEvents)
+		api.GET("/events/search", h.SearchEvents)
 		api.GET("/events/:id", h.GetEvent)
+		api.PUT("/events/:id", h.UpdateEvent)
+		api.POST("/events/:id/cancel", h.CancelEvent)
+		api.POST("/events/:id/publish", h.PublishEvent)
+		api.POST("/events/:id/archive", h.ArchiveEvent)
+		api.POST("/events/:id/invitations", h.InviteToEvent)
+		api.GET("/events/:id/invitations", h.ListEventInvitations)
+		api.DELETE("/events/:id/invitations/:user_id", h.RevokeEventInvitation)
+		api.GET("/events/:id/ics", h.GetEventICS)
+		api.GET("/events/:id/attendees.csv", h.ExportAttendeesCSV)
+		api.GET("/events/:id/attendees.xlsx", h.ExportAttendeesXLSX)
+
+		// Series
+		api.POST("/series", h.CreateSeries)
+		api.GET("/series", h.ListSeries)
+		api.GET("/series/:id", h.GetSeries)
+		api.PUT("/series/:id", h.UpdateSeries)
+		api.POST("/series/:id/cancel", h.CancelSeries)
+
+		// Venues
+		api.POST("/venues", h.CreateVenue)
+		api.GET("/venues", h.ListVenues)
+		api.GET("/venues/:id", h.GetVenue)
+		api.PUT("/venues/:id", h.UpdateVenue)
+		api.DELETE("/venues/:id", h.DeleteVenue)
+
+		// Categories
+		api.POST("/categories", h.CreateCategory)
+		api.GET("/categories", h.ListCategories)
+		api.GET("/categories/:id", h.GetCategory)
+		api.PUT("/categories/:id", h.UpdateCategory)
+		api.DELETE("/categories/:id", h.DeleteCategory)
 
 		// Bookings
 		api.POST("/events/:id/book", h.BookEvent)
 		api.POST("/events/:id/confirm", h.ConfirmBooking)
+		api.GET("/bookings/:id/history", h.GetBookingHistory)
 
 		// Users
 		api.POST("/users", h.CreateUser)
 		api.GET("/users", h.ListUsers)
 		api.GET("/users/:id/bookings", h.GetUserBookings)
+		api.GET("/users/:id/notification-preferences", h.GetNotificationPreferences)
+		api.PUT("/users/:id/notification-preferences", h.UpdateNotificationPreferences)
+		api.POST("/users/:id/telegram-link", h.CreateTelegramLink)
+		api.POST("/users/:id/calendar-token", h.CreateCalendarToken)
+		api.GET("/users/:id/calendar.ics", h.GetUserCalendar)
+		api.GET("/users/:id/followed-categories", h.ListFollowedCategories)
+		api.PUT("/users/:id/followed-categories/:category_id", h.FollowCategory)
+		api.DELETE("/users/:id/followed-categories/:category_id", h.UnfollowCategory)
+
+		// Webhooks
+		api.POST("/webhooks", h.CreateWebhook)
+		api.GET("/webhooks", h.ListWebhooks)
+		api.GET("/webhooks/:id", h.GetWebhook)
+		api.PUT("/webhooks/:id", h.UpdateWebhook)
+		api.DELETE("/webhooks/:id", h.DeleteWebhook)
+		api.GET("/webhooks/:id/deliveries", h.ListWebhookDeliveries)
+		api.GET("/webhooks/:id/deliveries/:delivery_id", h.GetWebhookDelivery)
+		api.POST("/webhooks/:id/deliveries/:delivery_id/replay", h.ReplayWebhookDelivery)
+
+		// Admin
+		api.GET("/admin/stats", h.GetAdminStats)
+		api.GET("/admin/users/:id/booking-limits", h.GetBookingLimits)
+		api.PUT("/admin/users/:id/booking-limits", h.SetBookingLimits)
+		api.DELETE("/admin/users/:id/booking-limits", h.ResetBookingLimits)
+		api.POST("/admin/users/:id/booking-limits/clear-cooldown", h.ClearBookingCooldown)
 	}
 
 	router.GET("/health", func(c *ginext.Context) {
 		c.JSON(http.StatusOK, ginext.H{"status": "ok"})
 	})
 
-	router.LoadHTMLGlob("web/templates/*")
-	router.Static("/static", "web/static")
+	tmpl, err := template.ParseFS(assets, "templates/*")
+	if err != nil {
+		return nil, fmt.Errorf("parse templates: %w", err)
+	}
+	router.SetHTMLTemplate(tmpl)
+
+	static, err := fs.Sub(assets, "static")
+	if err != nil {
+		return nil, fmt.Errorf("static assets: %w", err)
+	}
+	router.StaticFS("/static", http.FS(static))
 
 	router.GET("/", func(c *ginext.Context) {
 		c.HTML(http.StatusOK, "index.html", nil)
 	})
 
+	return router, nil
+}
+
+// newEngine создаёт движок, который верит заголовкам X-Forwarded-For и X-Real-IP
+// только от trustedProxies. По умолчанию gin верит любому отправителю, и клиент
+// мог бы подменить свой адрес, а с ним — ключ ограничения частоты запросов.
+func newEngine(mode string, trustedProxies []string) (*ginext.Engine, error) {
+	router := ginext.New(mode)
+	if err := router.SetTrustedProxies(trustedProxies); err != nil {
+		return nil, fmt.Errorf("trusted proxies: %w", err)
+	}
+	return router, nil
+}
+
+// InitHealthRouter собирает роутер с единственным /health для процесса-воркера.
+func InitHealthRouter(mode string, check func(ctx context.Context) error, mw ...ginext.HandlerFunc) *ginext.Engine {
+	// Без доверенных прокси newEngine не возвращает ошибку.
+	router, _ := newEngine(mode, nil)
+	router.Use(mw...)
+
+	router.GET("/health", func(c *ginext.Context) {
+		if err := check(c.Request.Context()); err != nil {
+			c.JSON(http.StatusServiceUnavailable, ginext.H{"status": "unavailable", "error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, ginext.H{"status": "ok"})
+	})
+
 	return router
 }
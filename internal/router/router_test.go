@@ -0,0 +1,62 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wb-go/wbf/ginext"
+	"github.com/wb-go/wbf/logger"
+)
+
+func setupLimitedEngine(t *testing.T, trustedProxies []string) http.Handler {
+	t.Helper()
+	log, err := logger.InitLogger("slog", "test", "test", logger.WithLevel(logger.ErrorLevel))
+	require.NoError(t, err)
+
+	r, err := newEngine("test", trustedProxies)
+	require.NoError(t, err)
+	r.Use(middleware.RateLimit(middleware.NewMemoryRateLimitStore(), []middleware.RateLimitPolicy{{
+		Method: http.MethodGet,
+		Path:   "/ping",
+		Key:    middleware.RateLimitByIP,
+		Limit:  domain.RateLimit{Requests: 1, Period: time.Minute},
+	}}, log))
+	r.GET("/ping", func(c *ginext.Context) { c.Status(http.StatusOK) })
+	return r
+}
+
+func ping(r http.Handler, forwardedFor string) int {
+	req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	req.Header.Set("X-Forwarded-For", forwardedFor)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w.Code
+}
+
+func TestNewEngine_IgnoresSpoofedForwardedFor(t *testing.T) {
+	r := setupLimitedEngine(t, nil)
+
+	assert.Equal(t, http.StatusOK, ping(r, "203.0.113.1"))
+	assert.Equal(t, http.StatusTooManyRequests, ping(r, "203.0.113.2"),
+		"подменённый X-Forwarded-For не должен давать новое ведро")
+}
+
+func TestNewEngine_TrustedProxyForwardsClientIP(t *testing.T) {
+	r := setupLimitedEngine(t, []string{"10.0.0.0/8"})
+
+	assert.Equal(t, http.StatusOK, ping(r, "203.0.113.1"))
+	assert.Equal(t, http.StatusOK, ping(r, "203.0.113.2"))
+	assert.Equal(t, http.StatusTooManyRequests, ping(r, "203.0.113.1"))
+}
+
+func TestNewEngine_InvalidTrustedProxy(t *testing.T) {
+	_, err := newEngine("test", []string{"not-an-ip"})
+	assert.Error(t, err)
+}
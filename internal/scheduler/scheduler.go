@@ -2,20 +2,36 @@ package scheduler
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/stpnv0/EventBooker/internal/domain"
 	"github.com/wb-go/wbf/logger"
 )
 
+// staleFactor — сколько интервалов может пройти без тика, прежде чем планировщик считается зависшим.
+const staleFactor = 3
+
 type BookingCanceller interface {
 	CancelExpired(ctx context.Context) ([]*domain.Booking, error)
 }
 
+// Job — дополнительная периодическая задача, выполняемая вместе с отменой просроченных броней.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
 type Scheduler struct {
 	bookingService BookingCanceller
 	interval       time.Duration
 	logger         logger.Logger
+
+	jobs     []Job
+	lastTick atomic.Int64
 }
 
 func New(
@@ -30,17 +46,36 @@ func New(
 	}
 }
 
+// Register добавляет задачу. Должен вызываться до Start.
+func (s *Scheduler) Register(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+// Start блокируется до отмены ctx и завершения всех задач.
 func (s *Scheduler) Start(ctx context.Context) {
+	s.lastTick.Store(time.Now().UnixNano())
+
+	var wg sync.WaitGroup
+	for _, job := range s.jobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.runJob(ctx, job)
+		}()
+	}
+
 	ticker := time.NewTicker(s.interval)
 	defer ticker.Stop()
 
 	s.logger.Info("scheduler started",
 		logger.Duration("interval", s.interval),
+		logger.Int("jobs", len(s.jobs)),
 	)
 
 	for {
 		select {
 		case <-ctx.Done():
+			wg.Wait()
 			s.logger.Info("scheduler stopped")
 			return
 		case <-ticker.C:
@@ -49,7 +84,23 @@ func (s *Scheduler) Start(ctx context.Context) {
 	}
 }
 
+// Healthy возвращает ошибку, если основной цикл давно не выполнялся.
+func (s *Scheduler) Healthy() error {
+	last := s.lastTick.Load()
+	if last == 0 {
+		return fmt.Errorf("scheduler is not running")
+	}
+
+	if since := time.Since(time.Unix(0, last)); since > staleFactor*s.interval {
+		return fmt.Errorf("scheduler last tick was %s ago", since.Round(time.Second))
+	}
+
+	return nil
+}
+
 func (s *Scheduler) tick(ctx context.Context) {
+	defer s.lastTick.Store(time.Now().UnixNano())
+
 	cancelled, err := s.bookingService.CancelExpired(ctx)
 	if err != nil {
 		s.logger.Error("failed to cancel expired bookings",
@@ -66,3 +117,22 @@ func (s *Scheduler) tick(ctx context.Context) {
 		)
 	}
 }
+
+func (s *Scheduler) runJob(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := job.Run(ctx); err != nil {
+				s.logger.Error("scheduler job failed",
+					logger.String("job", job.Name),
+					logger.String("error", err.Error()),
+				)
+			}
+		}
+	}
+}
@@ -3,6 +3,7 @@ package scheduler
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -97,3 +98,48 @@ func TestScheduler_MultipleTicks(t *testing.T) {
 	calls := len(canceller.Calls)
 	assert.GreaterOrEqual(t, calls, 3)
 }
+
+func TestScheduler_RunsRegisteredJobs(t *testing.T) {
+	canceller := mocks.NewMockBookingCanceller(t)
+	log := newTestLogger(t)
+
+	s := New(canceller, time.Second, log)
+
+	var runs atomic.Int32
+	s.Register(Job{
+		Name:     "test",
+		Interval: 20 * time.Millisecond,
+		Run: func(context.Context) error {
+			runs.Add(1)
+			return nil
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 70*time.Millisecond)
+	defer cancel()
+
+	s.Start(ctx)
+
+	assert.GreaterOrEqual(t, int(runs.Load()), 2)
+}
+
+func TestScheduler_Healthy(t *testing.T) {
+	canceller := mocks.NewMockBookingCanceller(t)
+	log := newTestLogger(t)
+
+	s := New(canceller, time.Second, log)
+	assert.Error(t, s.Healthy(), "not started")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.Start(ctx)
+		close(done)
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	assert.NoError(t, s.Healthy())
+
+	cancel()
+	<-done
+}
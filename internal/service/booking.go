@@ -11,11 +11,14 @@ import (
 	"github.com/wb-go/wbf/logger"
 )
 
+// BookingService меняет статусы броней. Уведомления пользователям и вебхуки партнёрам
+// записываются в outbox в той же транзакции, что и смена статуса, а отправляет их воркер.
 type BookingService struct {
 	bookingRepo ports.BookingRepo
 	eventRepo   ports.EventRepo
 	userRepo    ports.UserRepo
-	notifier    ports.BookingNotifier
+	webhooks    ports.WebhookPublisher
+	limiter     ports.BookingLimiter
 	logger      logger.Logger
 }
 
@@ -23,44 +26,71 @@ func NewBookingService(
 	bookingRepo ports.BookingRepo,
 	eventRepo ports.EventRepo,
 	userRepo ports.UserRepo,
-	notifier ports.BookingNotifier,
+	webhooks ports.WebhookPublisher,
+	limiter ports.BookingLimiter,
 	logger logger.Logger,
 ) *BookingService {
 	return &BookingService{
 		bookingRepo: bookingRepo,
 		eventRepo:   eventRepo,
 		userRepo:    userRepo,
-		notifier:    notifier,
+		webhooks:    webhooks,
+		limiter:     limiter,
 		logger:      logger,
 	}
 }
 
-func (s *BookingService) Book(ctx context.Context, eventID, userID string) (*domain.Booking, error) {
+// Book бронирует место. На закрытое мероприятие нужен accessCode или приглашение.
+// Лимиты пользователя проверяются в транзакции создания брони.
+func (s *BookingService) Book(ctx context.Context, eventID, userID, accessCode string) (*domain.Booking, error) {
 	// проверка, что eventID, userID exist
 	event, err := s.eventRepo.GetByID(ctx, eventID)
 	if err != nil {
 		return nil, fmt.Errorf("check event: %w", err)
 	}
+	if err = event.CheckBookable(time.Now()); err != nil {
+		return nil, err
+	}
+	if event.IsPrivate() {
+		var invited bool
+		if invited, err = s.eventRepo.IsInvited(ctx, eventID, userID); err != nil {
+			return nil, err
+		}
+		if err = event.CheckAccess(accessCode, invited); err != nil {
+			return nil, err
+		}
+	}
 
-	user, err := s.userRepo.GetByID(ctx, userID)
-	if err != nil {
+	if _, err = s.userRepo.GetByID(ctx, userID); err != nil {
 		return nil, fmt.Errorf("check user: %w", err)
 	}
-
-	status := domain.BookingStatusPending
-	if !event.RequiresPayment {
-		status = domain.BookingStatusConfirmed
+	quota, err := s.limiter.Quota(ctx, userID)
+	if err != nil {
+		return nil, err
 	}
 
+	now := time.Now().UTC()
 	booking := &domain.Booking{
 		ID:        uuid.New().String(),
 		EventID:   eventID,
 		UserID:    userID,
-		Status:    status,
-		CreatedAt: time.Now().UTC(),
-		UpdatedAt: time.Now().UTC(),
+		CreatedAt: now,
+	}
+	change := bookingChange(ctx, domain.BookingActorUser, userID, domain.BookingReasonCreated)
+	events, err := booking.Transition(event.InitialBookingStatus(), change, now)
+	if err != nil {
+		return nil, err
+	}
+	notify := domain.NotificationBookingConfirmed
+	if event.RequiresPayment {
+		notify = domain.NotificationBookingCreated
 	}
-	if err = s.bookingRepo.Create(ctx, booking); err != nil {
+	// Бронь без оплаты порождает и created, и confirmed — партнёрам это важно так же, как ручное подтверждение.
+	out, err := bookingOutbox(s.webhooks, booking, event, events, notify)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.bookingRepo.Create(ctx, booking, change, quota, out); err != nil {
 		return nil, fmt.Errorf("create booking: %w", err)
 	}
 
@@ -70,12 +100,6 @@ func (s *BookingService) Book(ctx context.Context, eventID, userID string) (*dom
 		logger.String("user_id", userID),
 	)
 
-	if event.RequiresPayment {
-		go s.notifier.NotifyBookingCreated(context.WithoutCancel(ctx), user, event)
-	} else {
-		go s.notifier.NotifyBookingConfirmed(context.WithoutCancel(ctx), user, event)
-	}
-
 	return booking, nil
 }
 
@@ -90,7 +114,11 @@ func (s *BookingService) Confirm(ctx context.Context, eventID, userID string) er
 	}
 
 	// Проверка статуса, TTL и обновление — атомарно в репозитории
-	if err = s.bookingRepo.Confirm(ctx, eventID, userID); err != nil {
+	change := bookingChange(ctx, domain.BookingActorUser, userID, domain.BookingReasonPaymentConfirmed)
+	outbox := func(b *domain.Booking, event *domain.Event) (*domain.Outbox, error) {
+		return bookingOutbox(s.webhooks, b, event, domain.BookingEvents(b, change), domain.NotificationBookingConfirmed)
+	}
+	if _, err = s.bookingRepo.Confirm(ctx, eventID, userID, change, outbox); err != nil {
 		return fmt.Errorf("confirm booking: %w", err)
 	}
 
@@ -99,22 +127,33 @@ func (s *BookingService) Confirm(ctx context.Context, eventID, userID string) er
 		logger.String("user_id", userID),
 	)
 
-	user, err := s.userRepo.GetByID(ctx, userID)
+	return nil
+}
+
+// Cancel отменяет бронь по запросу пользователя. Уведомление не отправляется:
+// пользователь сам инициировал отмену и получает ответ сразу.
+func (s *BookingService) Cancel(ctx context.Context, eventID, userID string) error {
+	change := bookingChange(ctx, domain.BookingActorUser, userID, domain.BookingReasonUserCancelled)
+	outbox := func(b *domain.Booking, event *domain.Event) (*domain.Outbox, error) {
+		return bookingOutbox(s.webhooks, b, event, domain.BookingEvents(b, change), "")
+	}
+	booking, err := s.bookingRepo.Cancel(ctx, eventID, userID, change, outbox)
 	if err != nil {
-		s.logger.LogAttrs(ctx, logger.ErrorLevel, "failed to get user for notification",
-			logger.String("user_id", userID),
-			logger.String("error", err.Error()),
-		)
-		return nil
+		return fmt.Errorf("cancel booking: %w", err)
 	}
 
-	go s.notifier.NotifyBookingConfirmed(context.WithoutCancel(ctx), user, event)
+	s.logger.LogAttrs(ctx, logger.InfoLevel, "booking cancelled",
+		logger.String("booking_id", booking.ID),
+		logger.String("event_id", eventID),
+		logger.String("user_id", userID),
+	)
 
 	return nil
 }
 
 func (s *BookingService) CancelExpired(ctx context.Context) ([]*domain.Booking, error) {
-	cancelled, err := s.bookingRepo.CancelExpired(ctx)
+	change := bookingChange(ctx, domain.BookingActorSystem, "", domain.BookingReasonExpired)
+	cancelled, err := s.bookingRepo.CancelExpired(ctx, change, cancelledBookingOutbox(s.webhooks, change))
 	if err != nil {
 		return nil, fmt.Errorf("cancel expired: %w", err)
 	}
@@ -123,34 +162,85 @@ func (s *BookingService) CancelExpired(ctx context.Context) ([]*domain.Booking,
 		s.logger.LogAttrs(ctx, logger.InfoLevel, "expired bookings cancelled",
 			logger.Int("count", len(cancelled)),
 		)
-
-		go s.notifyCancelled(context.WithoutCancel(ctx), cancelled)
 	}
 
 	return cancelled, nil
 }
 
-func (s *BookingService) notifyCancelled(ctx context.Context, bookings []*domain.Booking) {
-	for _, b := range bookings {
-		user, err := s.userRepo.GetByID(ctx, b.UserID)
-		if err != nil {
-			s.logger.LogAttrs(ctx, logger.ErrorLevel, "failed to get user for cancel notification",
-				logger.String("user_id", b.UserID),
-			)
+// bookingChange описывает смену статуса для истории брони; ID запроса берётся из контекста.
+func bookingChange(
+	ctx context.Context,
+	actor domain.BookingActor,
+	actorID string,
+	reason domain.BookingReason,
+) domain.BookingChange {
+	return domain.BookingChange{
+		Actor:     actor,
+		ActorID:   actorID,
+		Reason:    reason,
+		RequestID: logger.GetRequestID(ctx),
+	}
+}
+
+// bookingWebhooks — какие вебхуки уходят партнёрам на доменные события брони.
+// Истечение для партнёров — та же отмена.
+var bookingWebhooks = map[domain.BookingEventType]domain.WebhookEventType{
+	domain.BookingEventCreated:   domain.WebhookBookingCreated,
+	domain.BookingEventConfirmed: domain.WebhookBookingConfirmed,
+	domain.BookingEventCancelled: domain.WebhookBookingCancelled,
+	domain.BookingEventExpired:   domain.WebhookBookingCancelled,
+}
+
+// cancelledBookingOutbox — сообщения об отменённой не по желанию пользователя брони:
+// уведомление владельцу и вебхуки партнёрам.
+func cancelledBookingOutbox(webhooks ports.WebhookPublisher, change domain.BookingChange) domain.BookingOutbox {
+	return func(b *domain.Booking, event *domain.Event) (*domain.Outbox, error) {
+		return bookingOutbox(webhooks, b, event, domain.BookingEvents(b, change), domain.NotificationBookingCancelled)
+	}
+}
+
+// bookingOutbox собирает сообщения о брони b для outbox: уведомление пользователю
+// вида notify (пустой — без уведомления) и вебхуки партнёрам на события events.
+// Брони закрытых мероприятий партнёрам не раскрываются, как и сами мероприятия.
+func bookingOutbox(
+	webhooks ports.WebhookPublisher,
+	b *domain.Booking,
+	event *domain.Event,
+	events []domain.BookingEvent,
+	notify domain.NotificationKind,
+) (*domain.Outbox, error) {
+	out := &domain.Outbox{}
+	if notify != "" {
+		out.Notifications = append(out.Notifications, &domain.Notification{
+			ID:        uuid.New().String(),
+			Kind:      notify,
+			UserID:    b.UserID,
+			EventID:   b.EventID,
+			CreatedAt: time.Now().UTC(),
+		})
+	}
+	if event.IsPrivate() {
+		return out, nil
+	}
+	for _, e := range events {
+		t, ok := bookingWebhooks[e.Type]
+		if !ok {
 			continue
 		}
-
-		event, err := s.eventRepo.GetByID(ctx, b.EventID)
+		msg, err := webhooks.BookingMessage(t, e.Booking)
 		if err != nil {
-			s.logger.LogAttrs(ctx, logger.ErrorLevel, "failed to get event for cancel notification",
-				logger.String("event_id", b.EventID),
-			)
-			continue
+			return nil, err
 		}
-
-		s.notifier.NotifyBookingCancelled(ctx, user, event)
+		out.Webhooks = append(out.Webhooks, msg)
 	}
+	return out, nil
 }
+
 func (s *BookingService) ListByUser(ctx context.Context, userID string) ([]*domain.Booking, error) {
 	return s.bookingRepo.ListByUser(ctx, userID)
 }
+
+// History возвращает историю статусов брони.
+func (s *BookingService) History(ctx context.Context, bookingID string) ([]*domain.BookingHistoryEntry, error) {
+	return s.bookingRepo.History(ctx, bookingID)
+}
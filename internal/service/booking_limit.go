@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/service/ports"
+)
+
+// BookingLimitService применяет правила против скупки мест и управляет
+// индивидуальными переопределениями пользователей.
+type BookingLimitService struct {
+	repo     ports.BookingLimitRepo
+	userRepo ports.UserRepo
+	limits   domain.BookingLimits
+}
+
+func NewBookingLimitService(repo ports.BookingLimitRepo, userRepo ports.UserRepo, limits domain.BookingLimits) *BookingLimitService {
+	return &BookingLimitService{repo: repo, userRepo: userRepo, limits: limits}
+}
+
+// Quota возвращает правила для новой брони пользователя или nil, если администратор
+// снял с него ограничения. Сами лимиты проверяются при создании брони.
+func (s *BookingLimitService) Quota(ctx context.Context, userID string) (*domain.BookingQuota, error) {
+	override, err := s.override(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get booking limits: %w", err)
+	}
+	if override != nil && override.Exempt {
+		return nil, nil
+	}
+	quota := s.quota(override, time.Now().UTC())
+	return &quota, nil
+}
+
+// Get возвращает действующие для пользователя правила и их потребление.
+func (s *BookingLimitService) Get(ctx context.Context, userID string) (*domain.UserBookingLimits, error) {
+	if _, err := s.userRepo.GetByID(ctx, userID); err != nil {
+		return nil, err
+	}
+	return s.effective(ctx, userID)
+}
+
+func (s *BookingLimitService) effective(ctx context.Context, userID string) (*domain.UserBookingLimits, error) {
+	override, err := s.override(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	quota := s.quota(override, now)
+	usage, err := s.repo.Usage(ctx, userID, quota.DayStart, quota.ExpiredSince)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.UserBookingLimits{
+		UserID:        userID,
+		Limits:        quota.Limits,
+		Override:      override,
+		Usage:         *usage,
+		CooldownUntil: quota.Limits.CooldownUntil(*usage, now),
+	}, nil
+}
+
+// override возвращает переопределение пользователя или nil, если его нет.
+func (s *BookingLimitService) override(ctx context.Context, userID string) (*domain.BookingLimitOverride, error) {
+	override, err := s.repo.GetOverride(ctx, userID)
+	if errors.Is(err, domain.ErrLimitOverrideNotFound) {
+		return nil, nil
+	}
+	return override, err
+}
+
+func (s *BookingLimitService) quota(override *domain.BookingLimitOverride, now time.Time) domain.BookingQuota {
+	limits := s.limits.Apply(override)
+	return domain.BookingQuota{
+		Limits:       limits,
+		DayStart:     now.Add(-24 * time.Hour),
+		ExpiredSince: limits.ExpiredSince(override, now),
+	}
+}
+
+// SetOverride заменяет индивидуальные правила пользователя.
+func (s *BookingLimitService) SetOverride(ctx context.Context, o *domain.BookingLimitOverride) (*domain.UserBookingLimits, error) {
+	if err := o.Validate(); err != nil {
+		return nil, err
+	}
+	o.UpdatedAt = time.Now().UTC()
+	if err := s.repo.SaveOverride(ctx, o); err != nil {
+		return nil, err
+	}
+	return s.effective(ctx, o.UserID)
+}
+
+// DeleteOverride возвращает пользователя к общим правилам.
+func (s *BookingLimitService) DeleteOverride(ctx context.Context, userID string) error {
+	return s.repo.DeleteOverride(ctx, userID)
+}
+
+// ClearCooldown снимает паузу после истёкших броней: они перестают учитываться.
+func (s *BookingLimitService) ClearCooldown(ctx context.Context, userID string) (*domain.UserBookingLimits, error) {
+	if err := s.repo.ClearCooldown(ctx, userID, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	return s.effective(ctx, userID)
+}
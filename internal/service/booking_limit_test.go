@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/service/ports/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testBookingLimits = domain.BookingLimits{
+	MaxPending:    2,
+	MaxPerDay:     5,
+	MaxExpired:    3,
+	ExpiredWindow: 24 * time.Hour,
+	Cooldown:      time.Hour,
+}
+
+func intPtr(v int) *int { return &v }
+
+func TestBookingLimitService_Quota(t *testing.T) {
+	tests := []struct {
+		name     string
+		override *domain.BookingLimitOverride
+		want     *domain.BookingLimits
+	}{
+		{"defaults", nil, &testBookingLimits},
+		{"raised limit", &domain.BookingLimitOverride{MaxPending: intPtr(10)}, &domain.BookingLimits{
+			MaxPending: 10, MaxPerDay: 5, MaxExpired: 3, ExpiredWindow: 24 * time.Hour, Cooldown: time.Hour,
+		}},
+		{"limit disabled", &domain.BookingLimitOverride{MaxPerDay: intPtr(0)}, &domain.BookingLimits{
+			MaxPending: 2, MaxExpired: 3, ExpiredWindow: 24 * time.Hour, Cooldown: time.Hour,
+		}},
+		{"exempt", &domain.BookingLimitOverride{Exempt: true}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockBookingLimitRepo(t)
+			svc := NewBookingLimitService(repo, nil, testBookingLimits)
+
+			if tt.override != nil {
+				repo.EXPECT().GetOverride(mock.Anything, "u1").Return(tt.override, nil)
+			} else {
+				repo.EXPECT().GetOverride(mock.Anything, "u1").Return(nil, domain.ErrLimitOverrideNotFound)
+			}
+
+			quota, err := svc.Quota(context.Background(), "u1")
+
+			require.NoError(t, err)
+			if tt.want == nil {
+				assert.Nil(t, quota)
+				return
+			}
+			require.NotNil(t, quota)
+			assert.Equal(t, *tt.want, quota.Limits)
+			assert.WithinDuration(t, time.Now().Add(-24*time.Hour), quota.DayStart, time.Minute)
+		})
+	}
+}
+
+func TestBookingLimitService_Quota_CooldownClearedNarrowsWindow(t *testing.T) {
+	repo := mocks.NewMockBookingLimitRepo(t)
+	svc := NewBookingLimitService(repo, nil, testBookingLimits)
+
+	cleared := time.Now().Add(-5 * time.Minute)
+	repo.EXPECT().GetOverride(mock.Anything, "u1").Return(&domain.BookingLimitOverride{CooldownClearedAt: &cleared}, nil)
+
+	quota, err := svc.Quota(context.Background(), "u1")
+
+	require.NoError(t, err)
+	// Истечения считаются только после снятия паузы, а не за всё окно.
+	assert.True(t, cleared.Equal(quota.ExpiredSince))
+}
+
+func TestBookingLimitService_Get_UserNotFound(t *testing.T) {
+	userRepo := mocks.NewMockUserRepo(t)
+	svc := NewBookingLimitService(mocks.NewMockBookingLimitRepo(t), userRepo, testBookingLimits)
+
+	userRepo.EXPECT().GetByID(mock.Anything, "u1").Return(nil, domain.ErrUserNotFound)
+
+	_, err := svc.Get(context.Background(), "u1")
+
+	assert.ErrorIs(t, err, domain.ErrUserNotFound)
+}
+
+func TestBookingLimitService_Get_ReportsCooldown(t *testing.T) {
+	repo := mocks.NewMockBookingLimitRepo(t)
+	userRepo := mocks.NewMockUserRepo(t)
+	svc := NewBookingLimitService(repo, userRepo, testBookingLimits)
+
+	lastExpired := time.Now().Add(-15 * time.Minute)
+	userRepo.EXPECT().GetByID(mock.Anything, "u1").Return(&domain.User{ID: "u1"}, nil)
+	repo.EXPECT().GetOverride(mock.Anything, "u1").Return(nil, domain.ErrLimitOverrideNotFound)
+	repo.EXPECT().Usage(mock.Anything, "u1", mock.Anything, mock.Anything).
+		Return(&domain.BookingUsage{Expired: 3, LastExpiredAt: &lastExpired}, nil)
+
+	info, err := svc.Get(context.Background(), "u1")
+	require.NoError(t, err)
+
+	require.NotNil(t, info.CooldownUntil)
+	assert.True(t, lastExpired.Add(time.Hour).Equal(*info.CooldownUntil))
+	assert.Equal(t, testBookingLimits, info.Limits)
+}
+
+func TestBookingLimitService_SetOverride_Negative(t *testing.T) {
+	svc := NewBookingLimitService(mocks.NewMockBookingLimitRepo(t), nil, testBookingLimits)
+
+	_, err := svc.SetOverride(context.Background(), &domain.BookingLimitOverride{UserID: "u1", MaxPerDay: intPtr(-1)})
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
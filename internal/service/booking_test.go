@@ -23,18 +23,61 @@ func newTestLogger(t *testing.T) logger.Logger {
 	return log
 }
 
+// nopWebhooks — публикатор вебхуков для тестов, которым не важны исходящие события.
+// BookingMessage возвращает событие без тела, чтобы тесты могли проверить типы в outbox.
+func nopWebhooks(t *testing.T) *mocks.MockWebhookPublisher {
+	t.Helper()
+	w := mocks.NewMockWebhookPublisher(t)
+	w.EXPECT().PublishEvent(mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+	w.EXPECT().BookingMessage(mock.Anything, mock.Anything).
+		RunAndReturn(func(t domain.WebhookEventType, _ *domain.Booking) (*domain.WebhookMessage, error) {
+			return &domain.WebhookMessage{Type: t}, nil
+		}).Maybe()
+	return w
+}
+
+// outboxKinds возвращает виды уведомлений и типы вебхуков из out.
+func outboxKinds(out *domain.Outbox) ([]domain.NotificationKind, []domain.WebhookEventType) {
+	var kinds []domain.NotificationKind
+	for _, n := range out.Notifications {
+		kinds = append(kinds, n.Kind)
+	}
+	var types []domain.WebhookEventType
+	for _, m := range out.Webhooks {
+		types = append(types, m.Type)
+	}
+	return kinds, types
+}
+
+// runOutbox вызывает outbox так же, как репозиторий в транзакции смены статуса.
+func runOutbox(t *testing.T, outbox domain.BookingOutbox, b *domain.Booking, event *domain.Event) *domain.Outbox {
+	t.Helper()
+	require.NotNil(t, outbox)
+	out, err := outbox(b, event)
+	require.NoError(t, err)
+	return out
+}
+
+// nopLimiter — лимиты броней для тестов, которые их не проверяют.
+func nopLimiter(t *testing.T) *mocks.MockBookingLimiter {
+	t.Helper()
+	l := mocks.NewMockBookingLimiter(t)
+	l.EXPECT().Quota(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	return l
+}
+
 func TestBookingService_Book_RequiresPayment(t *testing.T) {
 	bookingRepo := mocks.NewMockBookingRepo(t)
 	eventRepo := mocks.NewMockEventRepo(t)
 	userRepo := mocks.NewMockUserRepo(t)
-	notifier := mocks.NewMockBookingNotifier(t)
 	log := newTestLogger(t)
 
-	svc := NewBookingService(bookingRepo, eventRepo, userRepo, notifier, log)
+	svc := NewBookingService(bookingRepo, eventRepo, userRepo, nopWebhooks(t), nopLimiter(t), log)
 
 	event := &domain.Event{
 		ID:              "e1",
 		Title:           "Concert",
+		EventDate:       time.Now().Add(24 * time.Hour),
 		RequiresPayment: true,
 		BookingTTL:      20 * time.Minute,
 	}
@@ -42,10 +85,14 @@ func TestBookingService_Book_RequiresPayment(t *testing.T) {
 
 	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(event, nil)
 	userRepo.EXPECT().GetByID(mock.Anything, "u1").Return(user, nil)
-	bookingRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
-	notifier.EXPECT().NotifyBookingCreated(mock.Anything, user, event).Return()
+	var out *domain.Outbox
+	bookingRepo.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _ *domain.Booking, _ domain.BookingChange, _ *domain.BookingQuota, o *domain.Outbox) {
+			out = o
+		}).
+		Return(nil)
 
-	booking, err := svc.Book(context.Background(), "e1", "u1")
+	booking, err := svc.Book(context.Background(), "e1", "u1", "")
 
 	require.NoError(t, err)
 	assert.Equal(t, domain.BookingStatusPending, booking.Status)
@@ -53,67 +100,236 @@ func TestBookingService_Book_RequiresPayment(t *testing.T) {
 	assert.Equal(t, "u1", booking.UserID)
 	assert.NotEmpty(t, booking.ID)
 
-	time.Sleep(50 * time.Millisecond) // goroutine notify
+	// Уведомление и вебхук записываются в outbox вместе с бронью.
+	require.NotNil(t, out)
+	kinds, types := outboxKinds(out)
+	assert.Equal(t, []domain.NotificationKind{domain.NotificationBookingCreated}, kinds)
+	assert.Equal(t, []domain.WebhookEventType{domain.WebhookBookingCreated}, types)
+	assert.Equal(t, "u1", out.Notifications[0].UserID)
+	assert.Equal(t, "e1", out.Notifications[0].EventID)
 }
 
 func TestBookingService_Book_NoPaymentRequired(t *testing.T) {
 	bookingRepo := mocks.NewMockBookingRepo(t)
 	eventRepo := mocks.NewMockEventRepo(t)
 	userRepo := mocks.NewMockUserRepo(t)
-	notifier := mocks.NewMockBookingNotifier(t)
 	log := newTestLogger(t)
 
-	svc := NewBookingService(bookingRepo, eventRepo, userRepo, notifier, log)
+	svc := NewBookingService(bookingRepo, eventRepo, userRepo, nopWebhooks(t), nopLimiter(t), log)
 
 	event := &domain.Event{
 		ID:              "e1",
+		EventDate:       time.Now().Add(24 * time.Hour),
 		RequiresPayment: false,
 	}
 	user := &domain.User{ID: "u1", Username: "alice"}
 
 	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(event, nil)
 	userRepo.EXPECT().GetByID(mock.Anything, "u1").Return(user, nil)
-	bookingRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
-	notifier.EXPECT().NotifyBookingConfirmed(mock.Anything, user, event).Return()
+	var out *domain.Outbox
+	bookingRepo.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _ *domain.Booking, _ domain.BookingChange, _ *domain.BookingQuota, o *domain.Outbox) {
+			out = o
+		}).
+		Return(nil)
 
-	booking, err := svc.Book(context.Background(), "e1", "u1")
+	booking, err := svc.Book(context.Background(), "e1", "u1", "")
 
 	require.NoError(t, err)
 	assert.Equal(t, domain.BookingStatusConfirmed, booking.Status)
 
-	time.Sleep(50 * time.Millisecond)
+	// Бронь без оплаты создаётся сразу подтверждённой: партнёры получают оба события.
+	require.NotNil(t, out)
+	kinds, types := outboxKinds(out)
+	assert.Equal(t, []domain.NotificationKind{domain.NotificationBookingConfirmed}, kinds)
+	assert.Equal(t, []domain.WebhookEventType{domain.WebhookBookingCreated, domain.WebhookBookingConfirmed}, types)
 }
 
 func TestBookingService_Book_EventNotFound(t *testing.T) {
 	bookingRepo := mocks.NewMockBookingRepo(t)
 	eventRepo := mocks.NewMockEventRepo(t)
 	userRepo := mocks.NewMockUserRepo(t)
-	notifier := mocks.NewMockBookingNotifier(t)
 	log := newTestLogger(t)
 
-	svc := NewBookingService(bookingRepo, eventRepo, userRepo, notifier, log)
+	svc := NewBookingService(bookingRepo, eventRepo, userRepo, nopWebhooks(t), nopLimiter(t), log)
 
 	eventRepo.EXPECT().GetByID(mock.Anything, "missing").Return(nil, domain.ErrEventNotFound)
 
-	_, err := svc.Book(context.Background(), "missing", "u1")
+	_, err := svc.Book(context.Background(), "missing", "u1", "")
 
 	require.Error(t, err)
 	assert.ErrorIs(t, err, domain.ErrEventNotFound)
 }
 
+func TestBookingService_Book_EventCancelled(t *testing.T) {
+	bookingRepo := mocks.NewMockBookingRepo(t)
+	eventRepo := mocks.NewMockEventRepo(t)
+	userRepo := mocks.NewMockUserRepo(t)
+
+	svc := NewBookingService(bookingRepo, eventRepo, userRepo, nopWebhooks(t), nopLimiter(t), newTestLogger(t))
+
+	cancelledAt := time.Now()
+	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(&domain.Event{ID: "e1", CancelledAt: &cancelledAt}, nil)
+
+	_, err := svc.Book(context.Background(), "e1", "u1", "")
+
+	assert.ErrorIs(t, err, domain.ErrEventCancelled)
+}
+
+func TestBookingService_Book_PrivateEvent(t *testing.T) {
+	event := &domain.Event{
+		ID:         "e1",
+		EventDate:  time.Now().Add(24 * time.Hour),
+		Visibility: domain.EventVisibilityPrivate,
+		AccessCode: "secret",
+	}
+
+	tests := []struct {
+		name    string
+		code    string
+		invited bool
+		err     error
+	}{
+		{"no code", "", false, domain.ErrEventAccessDenied},
+		{"wrong code", "guess", false, domain.ErrEventAccessDenied},
+		// Доступ пройден — дальше бронь спотыкается о несуществующего пользователя.
+		{"valid code", "secret", false, domain.ErrUserNotFound},
+		{"invited", "", true, domain.ErrUserNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventRepo := mocks.NewMockEventRepo(t)
+			userRepo := mocks.NewMockUserRepo(t)
+			svc := NewBookingService(nil, eventRepo, userRepo, nopWebhooks(t), nopLimiter(t), newTestLogger(t))
+
+			eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(event, nil)
+			eventRepo.EXPECT().IsInvited(mock.Anything, "e1", "u1").Return(tt.invited, nil)
+			if tt.err == domain.ErrUserNotFound {
+				userRepo.EXPECT().GetByID(mock.Anything, "u1").Return(nil, domain.ErrUserNotFound)
+			}
+
+			_, err := svc.Book(context.Background(), "e1", "u1", tt.code)
+
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestBookingService_Book_PrivateEventSkipsWebhooks(t *testing.T) {
+	bookingRepo := mocks.NewMockBookingRepo(t)
+	eventRepo := mocks.NewMockEventRepo(t)
+	userRepo := mocks.NewMockUserRepo(t)
+	// Без ожиданий на BookingMessage: вебхук о брони закрытого мероприятия провалит тест.
+	svc := NewBookingService(bookingRepo, eventRepo, userRepo, mocks.NewMockWebhookPublisher(t), nopLimiter(t), newTestLogger(t))
+
+	event := &domain.Event{
+		ID:         "e1",
+		EventDate:  time.Now().Add(24 * time.Hour),
+		Visibility: domain.EventVisibilityPrivate,
+	}
+	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(event, nil)
+	eventRepo.EXPECT().IsInvited(mock.Anything, "e1", "u1").Return(true, nil)
+	userRepo.EXPECT().GetByID(mock.Anything, "u1").Return(&domain.User{ID: "u1"}, nil)
+	var out *domain.Outbox
+	bookingRepo.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _ *domain.Booking, _ domain.BookingChange, _ *domain.BookingQuota, o *domain.Outbox) {
+			out = o
+		}).
+		Return(nil)
+
+	_, err := svc.Book(context.Background(), "e1", "u1", "")
+
+	require.NoError(t, err)
+	kinds, types := outboxKinds(out)
+	assert.Equal(t, []domain.NotificationKind{domain.NotificationBookingConfirmed}, kinds)
+	assert.Empty(t, types)
+}
+
+func TestBookingService_Book_SalesWindow(t *testing.T) {
+	now := time.Now()
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+
+	tests := []struct {
+		name  string
+		event *domain.Event
+		want  error
+	}{
+		{"event started", &domain.Event{EventDate: past}, domain.ErrEventStarted},
+		{"sales not open", &domain.Event{EventDate: now.Add(48 * time.Hour), SalesOpenAt: &future}, domain.ErrSalesNotOpen},
+		{"sales closed", &domain.Event{EventDate: now.Add(48 * time.Hour), SalesCloseAt: &past}, domain.ErrSalesClosed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventRepo := mocks.NewMockEventRepo(t)
+			svc := NewBookingService(nil, eventRepo, nil, nopWebhooks(t), nopLimiter(t), newTestLogger(t))
+
+			tt.event.ID = "e1"
+			eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(tt.event, nil)
+
+			_, err := svc.Book(context.Background(), "e1", "u1", "")
+
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestBookingService_Book_InsideSalesWindow(t *testing.T) {
+	bookingRepo := mocks.NewMockBookingRepo(t)
+	eventRepo := mocks.NewMockEventRepo(t)
+	userRepo := mocks.NewMockUserRepo(t)
+
+	svc := NewBookingService(bookingRepo, eventRepo, userRepo, nopWebhooks(t), nopLimiter(t), newTestLogger(t))
+
+	now := time.Now()
+	opens, closes := now.Add(-time.Hour), now.Add(time.Hour)
+	event := &domain.Event{
+		ID: "e1", EventDate: now.Add(2 * time.Hour), RequiresPayment: true,
+		SalesOpenAt: &opens, SalesCloseAt: &closes,
+	}
+	user := &domain.User{ID: "u1"}
+	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(event, nil)
+	userRepo.EXPECT().GetByID(mock.Anything, "u1").Return(user, nil)
+	bookingRepo.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	_, err := svc.Book(context.Background(), "e1", "u1", "")
+	require.NoError(t, err)
+}
+
+func TestBookingService_Book_LimitExceeded(t *testing.T) {
+	bookingRepo := mocks.NewMockBookingRepo(t)
+	eventRepo := mocks.NewMockEventRepo(t)
+	userRepo := mocks.NewMockUserRepo(t)
+	limiter := mocks.NewMockBookingLimiter(t)
+
+	// Отказ по лимиту — бронь не создаётся, поэтому уведомлений нет.
+	svc := NewBookingService(bookingRepo, eventRepo, userRepo, nopWebhooks(t), limiter, newTestLogger(t))
+
+	quota := &domain.BookingQuota{Limits: domain.BookingLimits{MaxPending: 1}}
+	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(&domain.Event{ID: "e1", EventDate: time.Now().Add(time.Hour)}, nil)
+	userRepo.EXPECT().GetByID(mock.Anything, "u1").Return(&domain.User{ID: "u1"}, nil)
+	limiter.EXPECT().Quota(mock.Anything, "u1").Return(quota, nil)
+	// Лимит проверяется в транзакции создания брони.
+	bookingRepo.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything, quota, mock.Anything).Return(domain.ErrTooManyPendingBookings)
+
+	_, err := svc.Book(context.Background(), "e1", "u1", "")
+
+	assert.ErrorIs(t, err, domain.ErrTooManyPendingBookings)
+}
+
 func TestBookingService_Book_UserNotFound(t *testing.T) {
 	bookingRepo := mocks.NewMockBookingRepo(t)
 	eventRepo := mocks.NewMockEventRepo(t)
 	userRepo := mocks.NewMockUserRepo(t)
-	notifier := mocks.NewMockBookingNotifier(t)
 	log := newTestLogger(t)
 
-	svc := NewBookingService(bookingRepo, eventRepo, userRepo, notifier, log)
+	svc := NewBookingService(bookingRepo, eventRepo, userRepo, nopWebhooks(t), nopLimiter(t), log)
 
-	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(&domain.Event{ID: "e1"}, nil)
+	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(&domain.Event{ID: "e1", EventDate: time.Now().Add(time.Hour)}, nil)
 	userRepo.EXPECT().GetByID(mock.Anything, "missing").Return(nil, domain.ErrUserNotFound)
 
-	_, err := svc.Book(context.Background(), "e1", "missing")
+	_, err := svc.Book(context.Background(), "e1", "missing", "")
 
 	require.Error(t, err)
 	assert.ErrorIs(t, err, domain.ErrUserNotFound)
@@ -123,16 +339,16 @@ func TestBookingService_Book_CreateError(t *testing.T) {
 	bookingRepo := mocks.NewMockBookingRepo(t)
 	eventRepo := mocks.NewMockEventRepo(t)
 	userRepo := mocks.NewMockUserRepo(t)
-	notifier := mocks.NewMockBookingNotifier(t)
 	log := newTestLogger(t)
 
-	svc := NewBookingService(bookingRepo, eventRepo, userRepo, notifier, log)
+	svc := NewBookingService(bookingRepo, eventRepo, userRepo, nopWebhooks(t), nopLimiter(t), log)
 
-	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(&domain.Event{ID: "e1", RequiresPayment: true}, nil)
+	eventRepo.EXPECT().GetByID(mock.Anything, "e1").
+		Return(&domain.Event{ID: "e1", EventDate: time.Now().Add(time.Hour), RequiresPayment: true}, nil)
 	userRepo.EXPECT().GetByID(mock.Anything, "u1").Return(&domain.User{ID: "u1"}, nil)
-	bookingRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(domain.ErrNoAvailableSpots)
+	bookingRepo.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(domain.ErrNoAvailableSpots)
 
-	_, err := svc.Book(context.Background(), "e1", "u1")
+	_, err := svc.Book(context.Background(), "e1", "u1", "")
 
 	require.Error(t, err)
 	assert.ErrorIs(t, err, domain.ErrNoAvailableSpots)
@@ -141,38 +357,38 @@ func TestBookingService_Book_CreateError(t *testing.T) {
 func TestBookingService_Confirm_Success(t *testing.T) {
 	bookingRepo := mocks.NewMockBookingRepo(t)
 	eventRepo := mocks.NewMockEventRepo(t)
-	userRepo := mocks.NewMockUserRepo(t)
-	notifier := mocks.NewMockBookingNotifier(t)
 	log := newTestLogger(t)
 
-	svc := NewBookingService(bookingRepo, eventRepo, userRepo, notifier, log)
+	svc := NewBookingService(bookingRepo, eventRepo, nil, nopWebhooks(t), nopLimiter(t), log)
 
 	event := &domain.Event{
 		ID:              "e1",
 		RequiresPayment: true,
 		BookingTTL:      20 * time.Minute,
 	}
-	user := &domain.User{ID: "u1", Username: "alice"}
+	booking := &domain.Booking{ID: "b1", EventID: "e1", UserID: "u1", Status: domain.BookingStatusConfirmed}
 
 	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(event, nil)
-	bookingRepo.EXPECT().Confirm(mock.Anything, "e1", "u1").Return(nil)
-	userRepo.EXPECT().GetByID(mock.Anything, "u1").Return(user, nil)
-	notifier.EXPECT().NotifyBookingConfirmed(mock.Anything, user, event).Return()
+	var outbox domain.BookingOutbox
+	bookingRepo.EXPECT().Confirm(mock.Anything, "e1", "u1", mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _, _ string, _ domain.BookingChange, o domain.BookingOutbox) { outbox = o }).
+		Return(booking, nil)
 
 	err := svc.Confirm(context.Background(), "e1", "u1")
 
 	require.NoError(t, err)
-	time.Sleep(50 * time.Millisecond)
+	kinds, types := outboxKinds(runOutbox(t, outbox, booking, event))
+	assert.Equal(t, []domain.NotificationKind{domain.NotificationBookingConfirmed}, kinds)
+	assert.Equal(t, []domain.WebhookEventType{domain.WebhookBookingConfirmed}, types)
 }
 
 func TestBookingService_Confirm_EventNotFound(t *testing.T) {
 	bookingRepo := mocks.NewMockBookingRepo(t)
 	eventRepo := mocks.NewMockEventRepo(t)
 	userRepo := mocks.NewMockUserRepo(t)
-	notifier := mocks.NewMockBookingNotifier(t)
 	log := newTestLogger(t)
 
-	svc := NewBookingService(bookingRepo, eventRepo, userRepo, notifier, log)
+	svc := NewBookingService(bookingRepo, eventRepo, userRepo, nopWebhooks(t), nopLimiter(t), log)
 
 	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(nil, domain.ErrEventNotFound)
 
@@ -186,10 +402,9 @@ func TestBookingService_Confirm_NoPaymentRequired(t *testing.T) {
 	bookingRepo := mocks.NewMockBookingRepo(t)
 	eventRepo := mocks.NewMockEventRepo(t)
 	userRepo := mocks.NewMockUserRepo(t)
-	notifier := mocks.NewMockBookingNotifier(t)
 	log := newTestLogger(t)
 
-	svc := NewBookingService(bookingRepo, eventRepo, userRepo, notifier, log)
+	svc := NewBookingService(bookingRepo, eventRepo, userRepo, nopWebhooks(t), nopLimiter(t), log)
 
 	event := &domain.Event{ID: "e1", RequiresPayment: false}
 	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(event, nil)
@@ -204,15 +419,14 @@ func TestBookingService_Confirm_BookingNotPending(t *testing.T) {
 	bookingRepo := mocks.NewMockBookingRepo(t)
 	eventRepo := mocks.NewMockEventRepo(t)
 	userRepo := mocks.NewMockUserRepo(t)
-	notifier := mocks.NewMockBookingNotifier(t)
 	log := newTestLogger(t)
 
-	svc := NewBookingService(bookingRepo, eventRepo, userRepo, notifier, log)
+	svc := NewBookingService(bookingRepo, eventRepo, userRepo, nopWebhooks(t), nopLimiter(t), log)
 
 	event := &domain.Event{ID: "e1", RequiresPayment: true, BookingTTL: 20 * time.Minute}
 
 	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(event, nil)
-	bookingRepo.EXPECT().Confirm(mock.Anything, "e1", "u1").Return(domain.ErrBookingNotPending)
+	bookingRepo.EXPECT().Confirm(mock.Anything, "e1", "u1", mock.Anything, mock.Anything).Return(nil, domain.ErrBookingNotPending)
 
 	err := svc.Confirm(context.Background(), "e1", "u1")
 
@@ -224,15 +438,14 @@ func TestBookingService_Confirm_Expired(t *testing.T) {
 	bookingRepo := mocks.NewMockBookingRepo(t)
 	eventRepo := mocks.NewMockEventRepo(t)
 	userRepo := mocks.NewMockUserRepo(t)
-	notifier := mocks.NewMockBookingNotifier(t)
 	log := newTestLogger(t)
 
-	svc := NewBookingService(bookingRepo, eventRepo, userRepo, notifier, log)
+	svc := NewBookingService(bookingRepo, eventRepo, userRepo, nopWebhooks(t), nopLimiter(t), log)
 
 	event := &domain.Event{ID: "e1", RequiresPayment: true, BookingTTL: 10 * time.Minute}
 
 	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(event, nil)
-	bookingRepo.EXPECT().Confirm(mock.Anything, "e1", "u1").Return(domain.ErrBookingExpired)
+	bookingRepo.EXPECT().Confirm(mock.Anything, "e1", "u1", mock.Anything, mock.Anything).Return(nil, domain.ErrBookingExpired)
 
 	err := svc.Confirm(context.Background(), "e1", "u1")
 
@@ -244,14 +457,13 @@ func TestBookingService_Confirm_BookingNotFound(t *testing.T) {
 	bookingRepo := mocks.NewMockBookingRepo(t)
 	eventRepo := mocks.NewMockEventRepo(t)
 	userRepo := mocks.NewMockUserRepo(t)
-	notifier := mocks.NewMockBookingNotifier(t)
 	log := newTestLogger(t)
 
-	svc := NewBookingService(bookingRepo, eventRepo, userRepo, notifier, log)
+	svc := NewBookingService(bookingRepo, eventRepo, userRepo, nopWebhooks(t), nopLimiter(t), log)
 
 	event := &domain.Event{ID: "e1", RequiresPayment: true}
 	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(event, nil)
-	bookingRepo.EXPECT().Confirm(mock.Anything, "e1", "u1").Return(domain.ErrBookingNotFound)
+	bookingRepo.EXPECT().Confirm(mock.Anything, "e1", "u1", mock.Anything, mock.Anything).Return(nil, domain.ErrBookingNotFound)
 
 	err := svc.Confirm(context.Background(), "e1", "u1")
 
@@ -261,48 +473,68 @@ func TestBookingService_Confirm_BookingNotFound(t *testing.T) {
 
 func TestBookingService_CancelExpired_Success(t *testing.T) {
 	bookingRepo := mocks.NewMockBookingRepo(t)
-	eventRepo := mocks.NewMockEventRepo(t)
-	userRepo := mocks.NewMockUserRepo(t)
-	notifier := mocks.NewMockBookingNotifier(t)
 	log := newTestLogger(t)
 
-	svc := NewBookingService(bookingRepo, eventRepo, userRepo, notifier, log)
+	svc := NewBookingService(bookingRepo, nil, nil, nopWebhooks(t), nopLimiter(t), log)
 
 	cancelled := []*domain.Booking{
-		{ID: "b1", EventID: "e1", UserID: "u1"},
-		{ID: "b2", EventID: "e2", UserID: "u2"},
+		{ID: "b1", EventID: "e1", UserID: "u1", Status: domain.BookingStatusCancelled},
+		{ID: "b2", EventID: "e2", UserID: "u2", Status: domain.BookingStatusCancelled},
 	}
-	user1 := &domain.User{ID: "u1"}
-	user2 := &domain.User{ID: "u2"}
-	event1 := &domain.Event{ID: "e1", Title: "Event 1"}
-	event2 := &domain.Event{ID: "e2", Title: "Event 2"}
-
-	bookingRepo.EXPECT().CancelExpired(mock.Anything).Return(cancelled, nil)
-	userRepo.EXPECT().GetByID(mock.Anything, "u1").Return(user1, nil)
-	userRepo.EXPECT().GetByID(mock.Anything, "u2").Return(user2, nil)
-	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(event1, nil)
-	eventRepo.EXPECT().GetByID(mock.Anything, "e2").Return(event2, nil)
-	notifier.EXPECT().NotifyBookingCancelled(mock.Anything, user1, event1).Return()
-	notifier.EXPECT().NotifyBookingCancelled(mock.Anything, user2, event2).Return()
+
+	var outbox domain.BookingOutbox
+	bookingRepo.EXPECT().CancelExpired(mock.Anything, domain.BookingChange{
+		Actor:  domain.BookingActorSystem,
+		Reason: domain.BookingReasonExpired,
+	}, mock.Anything).
+		Run(func(_ context.Context, _ domain.BookingChange, o domain.BookingOutbox) { outbox = o }).
+		Return(cancelled, nil)
 
 	result, err := svc.CancelExpired(context.Background())
 
 	require.NoError(t, err)
 	assert.Len(t, result, 2)
 
-	time.Sleep(100 * time.Millisecond) // goroutine notify
+	// Каждому пользователю — уведомление об отмене, партнёрам — booking.cancelled.
+	for _, b := range cancelled {
+		out := runOutbox(t, outbox, b, &domain.Event{ID: b.EventID})
+		kinds, types := outboxKinds(out)
+		assert.Equal(t, []domain.NotificationKind{domain.NotificationBookingCancelled}, kinds)
+		assert.Equal(t, []domain.WebhookEventType{domain.WebhookBookingCancelled}, types)
+		assert.Equal(t, b.UserID, out.Notifications[0].UserID)
+		assert.Equal(t, b.EventID, out.Notifications[0].EventID)
+	}
+}
+
+func TestBookingService_CancelExpired_PrivateEventSkipsWebhooks(t *testing.T) {
+	bookingRepo := mocks.NewMockBookingRepo(t)
+	svc := NewBookingService(bookingRepo, nil, nil, mocks.NewMockWebhookPublisher(t), nopLimiter(t), newTestLogger(t))
+
+	var outbox domain.BookingOutbox
+	bookingRepo.EXPECT().CancelExpired(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _ domain.BookingChange, o domain.BookingOutbox) { outbox = o }).
+		Return(nil, nil)
+
+	_, err := svc.CancelExpired(context.Background())
+	require.NoError(t, err)
+
+	// Пользователь получает уведомление, а партнёры о бронях закрытого мероприятия не узнают.
+	b := &domain.Booking{ID: "b1", EventID: "e1", UserID: "u1", Status: domain.BookingStatusCancelled}
+	private := &domain.Event{ID: "e1", Visibility: domain.EventVisibilityPrivate}
+	kinds, types := outboxKinds(runOutbox(t, outbox, b, private))
+	assert.Equal(t, []domain.NotificationKind{domain.NotificationBookingCancelled}, kinds)
+	assert.Empty(t, types)
 }
 
 func TestBookingService_CancelExpired_NoneExpired(t *testing.T) {
 	bookingRepo := mocks.NewMockBookingRepo(t)
 	eventRepo := mocks.NewMockEventRepo(t)
 	userRepo := mocks.NewMockUserRepo(t)
-	notifier := mocks.NewMockBookingNotifier(t)
 	log := newTestLogger(t)
 
-	svc := NewBookingService(bookingRepo, eventRepo, userRepo, notifier, log)
+	svc := NewBookingService(bookingRepo, eventRepo, userRepo, nopWebhooks(t), nopLimiter(t), log)
 
-	bookingRepo.EXPECT().CancelExpired(mock.Anything).Return(nil, nil)
+	bookingRepo.EXPECT().CancelExpired(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
 
 	result, err := svc.CancelExpired(context.Background())
 
@@ -314,26 +546,62 @@ func TestBookingService_CancelExpired_RepoError(t *testing.T) {
 	bookingRepo := mocks.NewMockBookingRepo(t)
 	eventRepo := mocks.NewMockEventRepo(t)
 	userRepo := mocks.NewMockUserRepo(t)
-	notifier := mocks.NewMockBookingNotifier(t)
 	log := newTestLogger(t)
 
-	svc := NewBookingService(bookingRepo, eventRepo, userRepo, notifier, log)
+	svc := NewBookingService(bookingRepo, eventRepo, userRepo, nopWebhooks(t), nopLimiter(t), log)
 
-	bookingRepo.EXPECT().CancelExpired(mock.Anything).Return(nil, errors.New("db error"))
+	bookingRepo.EXPECT().CancelExpired(mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
 
 	_, err := svc.CancelExpired(context.Background())
 
 	require.Error(t, err)
 }
 
+func TestBookingService_Cancel_Success(t *testing.T) {
+	bookingRepo := mocks.NewMockBookingRepo(t)
+
+	svc := NewBookingService(bookingRepo, nil, nil, nopWebhooks(t), nopLimiter(t), newTestLogger(t))
+
+	booking := &domain.Booking{ID: "b1", EventID: "e1", UserID: "u1", Status: domain.BookingStatusCancelled}
+	var outbox domain.BookingOutbox
+	bookingRepo.EXPECT().Cancel(mock.Anything, "e1", "u1", domain.BookingChange{
+		Actor:     domain.BookingActorUser,
+		ActorID:   "u1",
+		Reason:    domain.BookingReasonUserCancelled,
+		RequestID: "req-1",
+	}, mock.Anything).
+		Run(func(_ context.Context, _, _ string, _ domain.BookingChange, o domain.BookingOutbox) { outbox = o }).
+		Return(booking, nil)
+
+	err := svc.Cancel(logger.SetRequestID(context.Background(), "req-1"), "e1", "u1")
+
+	require.NoError(t, err)
+	// Пользователь сам отменил бронь — уведомления нет, партнёрам уходит вебхук.
+	kinds, types := outboxKinds(runOutbox(t, outbox, booking, &domain.Event{ID: "e1"}))
+	assert.Empty(t, kinds)
+	assert.Equal(t, []domain.WebhookEventType{domain.WebhookBookingCancelled}, types)
+}
+
+func TestBookingService_Cancel_NotFound(t *testing.T) {
+	bookingRepo := mocks.NewMockBookingRepo(t)
+
+	svc := NewBookingService(bookingRepo, nil, nil, nopWebhooks(t), nopLimiter(t), newTestLogger(t))
+
+	bookingRepo.EXPECT().Cancel(mock.Anything, "e1", "u1", mock.Anything, mock.Anything).Return(nil, domain.ErrBookingNotFound)
+
+	err := svc.Cancel(context.Background(), "e1", "u1")
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrBookingNotFound)
+}
+
 func TestBookingService_ListByUser_Success(t *testing.T) {
 	bookingRepo := mocks.NewMockBookingRepo(t)
 	eventRepo := mocks.NewMockEventRepo(t)
 	userRepo := mocks.NewMockUserRepo(t)
-	notifier := mocks.NewMockBookingNotifier(t)
 	log := newTestLogger(t)
 
-	svc := NewBookingService(bookingRepo, eventRepo, userRepo, notifier, log)
+	svc := NewBookingService(bookingRepo, eventRepo, userRepo, nopWebhooks(t), nopLimiter(t), log)
 
 	bookings := []*domain.Booking{
 		{ID: "b1", EventID: "e1", UserID: "u1", Status: domain.BookingStatusPending},
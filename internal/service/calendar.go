@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/url"
+
+	"github.com/stpnv0/EventBooker/internal/calendar"
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/service/ports"
+)
+
+// calendarTokenBytes — длина секрета календарной подписки.
+const calendarTokenBytes = 32
+
+// CalendarService отдаёт мероприятия в формате iCalendar и ведёт
+// секретные ссылки на подписку с бронями пользователя.
+type CalendarService struct {
+	eventRepo ports.EventRepo
+	venueRepo ports.VenueRepo
+	userRepo  ports.UserRepo
+	tokenRepo ports.CalendarTokenRepo
+}
+
+func NewCalendarService(
+	eventRepo ports.EventRepo,
+	venueRepo ports.VenueRepo,
+	userRepo ports.UserRepo,
+	tokenRepo ports.CalendarTokenRepo,
+) *CalendarService {
+	return &CalendarService{
+		eventRepo: eventRepo,
+		venueRepo: venueRepo,
+		userRepo:  userRepo,
+		tokenRepo: tokenRepo,
+	}
+}
+
+// EventICS возвращает календарь с одним мероприятием.
+func (s *CalendarService) EventICS(ctx context.Context, eventID string) (*domain.Event, []byte, error) {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entries, err := s.withVenues(ctx, []*domain.Event{event})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return event, calendar.Event(entries[0]), nil
+}
+
+// CreateFeedToken выпускает новую ссылку на подписку; прежняя перестаёт работать.
+func (s *CalendarService) CreateFeedToken(ctx context.Context, userID string) (*domain.CalendarFeed, error) {
+	if _, err := s.userRepo.GetByID(ctx, userID); err != nil {
+		return nil, fmt.Errorf("check user: %w", err)
+	}
+
+	token, err := newToken(calendarTokenBytes)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.tokenRepo.Save(ctx, userID, hashToken(token)); err != nil {
+		return nil, fmt.Errorf("save token: %w", err)
+	}
+
+	return &domain.CalendarFeed{
+		Token: token,
+		Path:  "/api/users/" + userID + "/calendar.ics?token=" + url.QueryEscape(token),
+	}, nil
+}
+
+// UserFeed возвращает подписку с мероприятиями, на которые у пользователя подтверждена бронь.
+func (s *CalendarService) UserFeed(ctx context.Context, userID, token string) ([]byte, error) {
+	if token == "" {
+		return nil, domain.ErrCalendarTokenInvalid
+	}
+	hash, err := s.tokenRepo.GetHash(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(hashToken(token))) != 1 {
+		return nil, domain.ErrCalendarTokenInvalid
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+
+	events, err := s.eventRepo.ListBookedByUser(ctx, userID, []domain.BookingStatus{domain.BookingStatusConfirmed})
+	if err != nil {
+		return nil, fmt.Errorf("list booked events: %w", err)
+	}
+
+	entries, err := s.withVenues(ctx, events)
+	if err != nil {
+		return nil, err
+	}
+
+	return calendar.Feed("EventBooker: "+user.Username, entries), nil
+}
+
+// withVenues подгружает площадки мероприятий одним запросом.
+func (s *CalendarService) withVenues(ctx context.Context, events []*domain.Event) ([]calendar.Entry, error) {
+	var ids []string
+	for _, e := range events {
+		if e.VenueID != nil {
+			ids = append(ids, *e.VenueID)
+		}
+	}
+
+	venues := make(map[string]*domain.Venue)
+	if len(ids) > 0 {
+		list, err := s.venueRepo.ListByIDs(ctx, ids)
+		if err != nil {
+			return nil, fmt.Errorf("list venues: %w", err)
+		}
+		for _, v := range list {
+			venues[v.ID] = v
+		}
+	}
+
+	entries := make([]calendar.Entry, len(events))
+	for i, e := range events {
+		entries[i] = calendar.Entry{Event: e}
+		if e.VenueID != nil {
+			entries[i].Venue = venues[*e.VenueID]
+		}
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/service/ports/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendarService_CreateFeedToken_StoresHash(t *testing.T) {
+	users := mocks.NewMockUserRepo(t)
+	tokens := mocks.NewMockCalendarTokenRepo(t)
+	svc := NewCalendarService(nil, nil, users, tokens)
+
+	var stored string
+	users.EXPECT().GetByID(mock.Anything, "u1").Return(&domain.User{ID: "u1"}, nil)
+	tokens.EXPECT().Save(mock.Anything, "u1", mock.Anything).
+		Run(func(_ context.Context, _ string, hash string) { stored = hash }).
+		Return(nil)
+
+	feed, err := svc.CreateFeedToken(context.Background(), "u1")
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, feed.Token)
+	assert.Equal(t, hashToken(feed.Token), stored)
+	assert.NotContains(t, stored, feed.Token)
+	assert.True(t, strings.HasPrefix(feed.Path, "/api/users/u1/calendar.ics?token="))
+}
+
+func TestCalendarService_UserFeed_InvalidToken(t *testing.T) {
+	tokens := mocks.NewMockCalendarTokenRepo(t)
+	svc := NewCalendarService(nil, nil, nil, tokens)
+
+	tokens.EXPECT().GetHash(mock.Anything, "u1").Return(hashToken("secret"), nil)
+
+	_, err := svc.UserFeed(context.Background(), "u1", "guess")
+	assert.ErrorIs(t, err, domain.ErrCalendarTokenInvalid)
+
+	_, err = svc.UserFeed(context.Background(), "u1", "")
+	assert.ErrorIs(t, err, domain.ErrCalendarTokenInvalid)
+}
+
+func TestCalendarService_UserFeed_ConfirmedBookings(t *testing.T) {
+	events := mocks.NewMockEventRepo(t)
+	venues := mocks.NewMockVenueRepo(t)
+	users := mocks.NewMockUserRepo(t)
+	tokens := mocks.NewMockCalendarTokenRepo(t)
+	svc := NewCalendarService(events, venues, users, tokens)
+
+	venueID := "v1"
+	date := time.Date(2030, 5, 1, 19, 0, 0, 0, time.UTC)
+	tokens.EXPECT().GetHash(mock.Anything, "u1").Return(hashToken("secret"), nil)
+	users.EXPECT().GetByID(mock.Anything, "u1").Return(&domain.User{ID: "u1", Username: "alice"}, nil)
+	events.EXPECT().ListBookedByUser(mock.Anything, "u1", []domain.BookingStatus{domain.BookingStatusConfirmed}).
+		Return([]*domain.Event{
+			{ID: "e1", Title: "Concert", EventDate: date, Duration: time.Hour, VenueID: &venueID},
+			{ID: "e2", Title: "Lecture", EventDate: date, Duration: time.Hour},
+		}, nil)
+	venues.EXPECT().ListByIDs(mock.Anything, []string{"v1"}).
+		Return([]*domain.Venue{{ID: "v1", Name: "Hall", Address: "Tverskaya 1"}}, nil)
+
+	out, err := svc.UserFeed(context.Background(), "u1", "secret")
+
+	require.NoError(t, err)
+	ics := string(out)
+	assert.Contains(t, ics, "X-WR-CALNAME:EventBooker: alice")
+	assert.Contains(t, ics, "UID:e1@eventbooker")
+	assert.Contains(t, ics, "UID:e2@eventbooker")
+	assert.Contains(t, ics, "LOCATION:Hall\\, Tverskaya 1")
+}
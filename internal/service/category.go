@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/service/ports"
+)
+
+type CategoryService struct {
+	repo ports.CategoryRepo
+}
+
+func NewCategoryService(repo ports.CategoryRepo) *CategoryService {
+	return &CategoryService{repo: repo}
+}
+
+func (s *CategoryService) Create(ctx context.Context, input domain.CreateCategoryInput) (*domain.Category, error) {
+	now := time.Now().UTC()
+	category := &domain.Category{
+		ID:          uuid.New().String(),
+		Slug:        input.Slug,
+		Name:        strings.TrimSpace(input.Name),
+		Description: input.Description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := validateCategory(category); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, category); err != nil {
+		return nil, fmt.Errorf("create category: %w", err)
+	}
+
+	return category, nil
+}
+
+func (s *CategoryService) Get(ctx context.Context, id string) (*domain.Category, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *CategoryService) List(ctx context.Context) ([]*domain.Category, error) {
+	return s.repo.List(ctx)
+}
+
+func (s *CategoryService) Update(ctx context.Context, id string, input domain.UpdateCategoryInput) (*domain.Category, error) {
+	category, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Slug != nil {
+		category.Slug = *input.Slug
+	}
+	if input.Name != nil {
+		category.Name = strings.TrimSpace(*input.Name)
+	}
+	if input.Description != nil {
+		category.Description = *input.Description
+	}
+	if err = validateCategory(category); err != nil {
+		return nil, err
+	}
+	category.UpdatedAt = time.Now().UTC()
+
+	if err = s.repo.Update(ctx, category); err != nil {
+		return nil, fmt.Errorf("update category: %w", err)
+	}
+
+	return category, nil
+}
+
+func (s *CategoryService) Delete(ctx context.Context, id string) error {
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *CategoryService) Follow(ctx context.Context, userID, categoryID string) error {
+	return s.repo.Follow(ctx, userID, categoryID)
+}
+
+func (s *CategoryService) Unfollow(ctx context.Context, userID, categoryID string) error {
+	return s.repo.Unfollow(ctx, userID, categoryID)
+}
+
+// ListFollowed возвращает рубрики, на которые подписан пользователь.
+func (s *CategoryService) ListFollowed(ctx context.Context, userID string) ([]*domain.Category, error) {
+	return s.repo.ListFollowed(ctx, userID)
+}
+
+func validateCategory(c *domain.Category) error {
+	if c.Name == "" {
+		return fmt.Errorf("%w: name is required", domain.ErrValidation)
+	}
+	return domain.ValidateSlug(c.Slug)
+}
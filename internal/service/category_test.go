@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/service/ports/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategoryService_Create(t *testing.T) {
+	repo := mocks.NewMockCategoryRepo(t)
+	svc := NewCategoryService(repo)
+
+	repo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+	category, err := svc.Create(context.Background(), domain.CreateCategoryInput{Slug: "live-music", Name: " Концерты "})
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, category.ID)
+	assert.Equal(t, "Концерты", category.Name)
+}
+
+func TestCategoryService_Create_Validation(t *testing.T) {
+	tests := []struct {
+		name  string
+		input domain.CreateCategoryInput
+	}{
+		{"empty name", domain.CreateCategoryInput{Slug: "music"}},
+		{"uppercase slug", domain.CreateCategoryInput{Slug: "Music", Name: "Music"}},
+		{"spaces in slug", domain.CreateCategoryInput{Slug: "live music", Name: "Music"}},
+		{"trailing dash", domain.CreateCategoryInput{Slug: "music-", Name: "Music"}},
+	}
+
+	svc := NewCategoryService(nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.Create(context.Background(), tt.input)
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+func TestCategoryService_Update_SlugTaken(t *testing.T) {
+	repo := mocks.NewMockCategoryRepo(t)
+	svc := NewCategoryService(repo)
+
+	repo.EXPECT().GetByID(mock.Anything, "c1").Return(&domain.Category{ID: "c1", Slug: "music", Name: "Music"}, nil)
+	repo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(c *domain.Category) bool {
+		return c.Slug == "theatre" && c.Name == "Music"
+	})).Return(domain.ErrCategoryExists)
+
+	slug := "theatre"
+	_, err := svc.Update(context.Background(), "c1", domain.UpdateCategoryInput{Slug: &slug})
+
+	assert.ErrorIs(t, err, domain.ErrCategoryExists)
+}
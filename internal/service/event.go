@@ -3,37 +3,233 @@ package service
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/stpnv0/EventBooker/internal/domain"
 	"github.com/stpnv0/EventBooker/internal/service/ports"
+	"github.com/wb-go/wbf/logger"
 )
 
-const defaultBookingTTL = 20 * time.Minute
+const (
+	defaultBookingTTL = 20 * time.Minute
+	// defaultEventDuration — сколько мероприятие занимает площадку, если длительность не указана.
+	defaultEventDuration = 2 * time.Hour
+)
 
 type EventService struct {
-	repo        ports.EventRepo
-	bookingRepo ports.BookingRepo
+	repo         ports.EventRepo
+	bookingRepo  ports.BookingRepo
+	venueRepo    ports.VenueRepo
+	categoryRepo ports.CategoryRepo
+	webhooks     ports.WebhookPublisher
+	notifier     ports.BookingNotifier
+	logger       logger.Logger
 }
 
-func NewEventService(repo ports.EventRepo, bookingRepo ports.BookingRepo) *EventService {
+func NewEventService(
+	repo ports.EventRepo,
+	bookingRepo ports.BookingRepo,
+	venueRepo ports.VenueRepo,
+	categoryRepo ports.CategoryRepo,
+	webhooks ports.WebhookPublisher,
+	notifier ports.BookingNotifier,
+	logger logger.Logger,
+) *EventService {
 	return &EventService{
-		repo:        repo,
-		bookingRepo: bookingRepo,
+		repo:         repo,
+		bookingRepo:  bookingRepo,
+		venueRepo:    venueRepo,
+		categoryRepo: categoryRepo,
+		webhooks:     webhooks,
+		notifier:     notifier,
+		logger:       logger,
 	}
 }
 
 func (s *EventService) CreateEvent(ctx context.Context, input domain.CreateEventInput) (*domain.Event, error) {
+	event, err := s.newEvent(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, event); err != nil {
+		return nil, fmt.Errorf("create event: %w", err)
+	}
+
+	if event.Status == domain.EventStatusPublished {
+		s.Announce(ctx, event)
+	}
+
+	return event, nil
+}
+
+// Publish публикует черновик: после этого мероприятие видно в списках и открыто для брони.
+func (s *EventService) Publish(ctx context.Context, id string) (*domain.Event, error) {
+	event, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if err = event.CheckPublishable(now); err != nil {
+		return nil, err
+	}
+	if err = s.repo.Publish(ctx, id, now); err != nil {
+		return nil, fmt.Errorf("publish event: %w", err)
+	}
+	event.Status = domain.EventStatusPublished
+	event.PublishedAt = &now
+	event.PublishAt = nil
+	event.UpdatedAt = now
+
+	s.Announce(ctx, event)
+
+	return event, nil
+}
+
+// PublishDue публикует черновики, время публикации которых наступило. Вызывается планировщиком.
+func (s *EventService) PublishDue(ctx context.Context) (int, error) {
+	events, err := s.repo.PublishDue(ctx, time.Now().UTC())
+	if err != nil {
+		return 0, fmt.Errorf("publish due events: %w", err)
+	}
+
+	for _, e := range events {
+		s.logger.LogAttrs(ctx, logger.InfoLevel, "scheduled event published",
+			logger.String("event_id", e.ID),
+		)
+		s.Announce(ctx, e)
+	}
+
+	return len(events), nil
+}
+
+// Archive снимает мероприятие с публикации. Уже сделанные брони сохраняются.
+func (s *EventService) Archive(ctx context.Context, id string) (*domain.Event, error) {
+	event, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if event.Status == domain.EventStatusArchived {
+		return nil, domain.ErrEventArchived
+	}
+
+	now := time.Now().UTC()
+	if err = s.repo.Archive(ctx, id, now); err != nil {
+		return nil, fmt.Errorf("archive event: %w", err)
+	}
+	event.Status = domain.EventStatusArchived
+	event.PublishAt = nil
+	event.UpdatedAt = now
+
+	return event, nil
+}
+
+// Announce сообщает о публикации партнёрам и подписчикам рубрик. О закрытом
+// мероприятии узнают только приглашённые, партнёрские вебхуки о нём не отправляются.
+// Опубликовать можно только черновик, поэтому для каждого мероприятия Announce
+// вызывается один раз: черновики партнёрам не видны, и event.created отправляется
+// вместе с event.published.
+func (s *EventService) Announce(ctx context.Context, event *domain.Event) {
+	if event.IsPrivate() {
+		s.notifyInvitees(ctx, event)
+		return
+	}
+	s.webhooks.PublishEvent(ctx, domain.WebhookEventCreated, event)
+	s.webhooks.PublishEvent(ctx, domain.WebhookEventPublished, event)
+	s.notifyFollowers(ctx, event)
+}
+
+// notifyInvitees рассылает приглашения всем приглашённым на мероприятие.
+func (s *EventService) notifyInvitees(ctx context.Context, event *domain.Event) {
+	invitees, err := s.repo.ListInvitees(ctx, event.ID)
+	if err != nil {
+		s.logger.LogAttrs(ctx, logger.ErrorLevel, "failed to list event invitees",
+			logger.String("event_id", event.ID),
+			logger.String("error", err.Error()),
+		)
+		return
+	}
+
+	for _, user := range invitees {
+		s.notifier.NotifyInvitation(ctx, user, event)
+	}
+}
+
+// notifyFollowers уведомляет подписчиков рубрик нового мероприятия. Подписанный
+// на несколько его рубрик получает одно уведомление. Ошибка не отменяет создание.
+func (s *EventService) notifyFollowers(ctx context.Context, event *domain.Event) {
+	if len(event.CategoryIDs) == 0 {
+		return
+	}
+
+	followers, err := s.categoryRepo.ListFollowers(ctx, event.CategoryIDs)
+	if err != nil {
+		s.logger.LogAttrs(ctx, logger.ErrorLevel, "failed to list category followers",
+			logger.String("event_id", event.ID),
+			logger.String("error", err.Error()),
+		)
+		return
+	}
+
+	for _, user := range followers {
+		s.notifier.NotifyNewEvent(ctx, user, event)
+	}
+}
+
+// newEvent проверяет входные данные и собирает мероприятие со значениями по умолчанию.
+func (s *EventService) newEvent(ctx context.Context, input domain.CreateEventInput) (*domain.Event, error) {
 	if input.Title == "" {
 		return nil, fmt.Errorf("%w: title is required", domain.ErrValidation)
 	}
-	if input.TotalSpots <= 0 {
-		return nil, fmt.Errorf("%w: total_spots must be positive", domain.ErrValidation)
-	}
 	if input.EventDate.Before(time.Now().UTC()) {
 		return nil, fmt.Errorf("%w: event_date must be in the future", domain.ErrValidation)
 	}
+
+	duration, err := eventDuration(input.EventDate, input.Duration, input.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	if duration == 0 {
+		duration = defaultEventDuration
+	}
+
+	totalSpots := input.TotalSpots
+	tz := input.Timezone
+	if input.VenueID != nil {
+		venue, err := s.venueRepo.GetByID(ctx, *input.VenueID)
+		if err != nil {
+			return nil, err
+		}
+		if totalSpots == 0 {
+			totalSpots = venue.Capacity
+		}
+		if tz == "" {
+			tz = venue.Timezone
+		}
+	}
+	if totalSpots <= 0 {
+		return nil, fmt.Errorf("%w: total_spots must be positive", domain.ErrValidation)
+	}
+	if tz == "" {
+		tz = "UTC"
+	}
+	if err := domain.ValidateTimezone(tz); err != nil {
+		return nil, err
+	}
+
+	categoryIDs, err := validateCategoryIDs(input.CategoryIDs)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := domain.NormalizeTags(input.Tags)
+	if err != nil {
+		return nil, err
+	}
+
 	requiresPayment := true
 	if input.RequiresPayment != nil {
 		requiresPayment = *input.RequiresPayment
@@ -43,18 +239,47 @@ func (s *EventService) CreateEvent(ctx context.Context, input domain.CreateEvent
 	if ttl == 0 {
 		ttl = defaultBookingTTL
 	}
+	now := time.Now().UTC()
 	event := &domain.Event{
 		ID:              uuid.New().String(),
 		Title:           input.Title,
 		Description:     input.Description,
 		EventDate:       input.EventDate,
 		RequiresPayment: requiresPayment,
-		TotalSpots:      input.TotalSpots,
+		TotalSpots:      totalSpots,
 		BookingTTL:      ttl,
+		Duration:        duration,
+		VenueID:         input.VenueID,
+		Timezone:        tz,
+		SalesOpenAt:     input.SalesOpenAt,
+		SalesCloseAt:    input.SalesCloseAt,
+		CategoryIDs:     categoryIDs,
+		Tags:            tags,
+		Status:          domain.EventStatusDraft,
+		Visibility:      input.Visibility,
+		AccessCode:      input.AccessCode,
 	}
-
-	if err := s.repo.Create(ctx, event); err != nil {
-		return nil, fmt.Errorf("create event: %w", err)
+	if event.Visibility == "" {
+		event.Visibility = domain.EventVisibilityPublic
+	}
+	if err = event.ValidateVisibility(); err != nil {
+		return nil, err
+	}
+	if input.PublishAt != nil {
+		event.PublishAt = nonZeroTime(*input.PublishAt)
+	}
+	if err = event.ValidateSalesWindow(); err != nil {
+		return nil, err
+	}
+	if input.Publish {
+		if event.PublishAt != nil {
+			return nil, fmt.Errorf("%w: specify either publish or publish_at", domain.ErrValidation)
+		}
+		event.Status = domain.EventStatusPublished
+		event.PublishedAt = &now
+	}
+	if err = event.ValidatePublishAt(now); err != nil {
+		return nil, err
 	}
 
 	return event, nil
@@ -83,6 +308,209 @@ func (s *EventService) GetDetails(ctx context.Context, id string) (*domain.Event
 	return details, nil
 }
 
+// StreamAttendees передаёт участников мероприятия в fn построчно. Без статусов
+// отдаются активные брони — список для входа не должен содержать отменённых.
+func (s *EventService) StreamAttendees(
+	ctx context.Context,
+	eventID string,
+	statuses []domain.BookingStatus,
+	fn func(*domain.Attendee) error,
+) error {
+	if len(statuses) == 0 {
+		statuses = domain.ActiveStatuses
+	}
+	return s.bookingRepo.StreamAttendees(ctx, eventID, statuses, fn)
+}
+
+// List возвращает опубликованные мероприятия.
 func (s *EventService) List(ctx context.Context) ([]*domain.Event, error) {
-	return s.repo.List(ctx)
+	return s.repo.List(ctx, domain.EventFilter{Statuses: []domain.EventStatus{domain.EventStatusPublished}})
+}
+
+// ListFiltered возвращает мероприятия, отобранные по рубрике, тегу или моменту проведения.
+// Без статусов в filter отдаются только опубликованные.
+func (s *EventService) ListFiltered(ctx context.Context, filter domain.EventFilter) ([]*domain.Event, error) {
+	filter.Tag = strings.ToLower(strings.TrimSpace(filter.Tag))
+	if len(filter.Statuses) == 0 {
+		filter.Statuses = []domain.EventStatus{domain.EventStatusPublished}
+	}
+	return s.repo.List(ctx, filter)
+}
+
+// UpdateEvent меняет мероприятие. Вхождение серии после этого отвязывается
+// от шаблона: последующие изменения серии его не затрагивают.
+func (s *EventService) UpdateEvent(ctx context.Context, id string, input domain.UpdateEventInput) (*domain.Event, error) {
+	event, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if event.CancelledAt != nil {
+		return nil, domain.ErrEventCancelled
+	}
+
+	if input.Title != nil {
+		if *input.Title == "" {
+			return nil, fmt.Errorf("%w: title is required", domain.ErrValidation)
+		}
+		event.Title = *input.Title
+	}
+	if input.Description != nil {
+		event.Description = *input.Description
+	}
+	if input.EventDate != nil {
+		if input.EventDate.Before(time.Now().UTC()) {
+			return nil, fmt.Errorf("%w: event_date must be in the future", domain.ErrValidation)
+		}
+		event.EventDate = *input.EventDate
+	}
+	if input.TotalSpots != nil {
+		if *input.TotalSpots <= 0 {
+			return nil, fmt.Errorf("%w: total_spots must be positive", domain.ErrValidation)
+		}
+		event.TotalSpots = *input.TotalSpots
+	}
+	if input.RequiresPayment != nil {
+		event.RequiresPayment = *input.RequiresPayment
+	}
+	if input.BookingTTL != nil {
+		if *input.BookingTTL <= 0 {
+			return nil, fmt.Errorf("%w: booking_ttl must be positive", domain.ErrValidation)
+		}
+		event.BookingTTL = *input.BookingTTL
+	}
+	// Без новой длительности мероприятие при переносе сдвигается целиком.
+	if input.Duration != nil || input.EndDate != nil {
+		var duration time.Duration
+		if input.Duration != nil {
+			if *input.Duration <= 0 {
+				return nil, fmt.Errorf("%w: duration must be positive", domain.ErrValidation)
+			}
+			duration = *input.Duration
+		}
+		if duration, err = eventDuration(event.EventDate, duration, input.EndDate); err != nil {
+			return nil, err
+		}
+		event.Duration = duration
+	}
+	if input.Timezone != nil {
+		if err = domain.ValidateTimezone(*input.Timezone); err != nil {
+			return nil, err
+		}
+		event.Timezone = *input.Timezone
+	}
+	if input.SalesOpenAt != nil {
+		event.SalesOpenAt = nonZeroTime(*input.SalesOpenAt)
+	}
+	if input.SalesCloseAt != nil {
+		event.SalesCloseAt = nonZeroTime(*input.SalesCloseAt)
+	}
+	if err = event.ValidateSalesWindow(); err != nil {
+		return nil, err
+	}
+	if input.PublishAt != nil {
+		if event.Status != domain.EventStatusDraft {
+			return nil, domain.ErrEventNotDraft
+		}
+		event.PublishAt = nonZeroTime(*input.PublishAt)
+	}
+	if input.PublishAt != nil || input.EventDate != nil {
+		if err = event.ValidatePublishAt(time.Now().UTC()); err != nil {
+			return nil, err
+		}
+	}
+	if input.Visibility != nil || input.AccessCode != nil {
+		if input.Visibility != nil {
+			event.Visibility = *input.Visibility
+			// Открытому мероприятию код не нужен — снимаем его, если новый не передан.
+			if event.Visibility == domain.EventVisibilityPublic && input.AccessCode == nil {
+				event.AccessCode = ""
+			}
+		}
+		if input.AccessCode != nil {
+			event.AccessCode = *input.AccessCode
+		}
+		if err = event.ValidateVisibility(); err != nil {
+			return nil, err
+		}
+	}
+	if input.CategoryIDs != nil {
+		if event.CategoryIDs, err = validateCategoryIDs(*input.CategoryIDs); err != nil {
+			return nil, err
+		}
+	}
+	if input.Tags != nil {
+		if event.Tags, err = domain.NormalizeTags(*input.Tags); err != nil {
+			return nil, err
+		}
+	}
+	if input.VenueID != nil {
+		// Пустая строка снимает привязку к площадке.
+		if *input.VenueID == "" {
+			event.VenueID = nil
+		} else {
+			event.VenueID = input.VenueID
+		}
+	}
+	event.Detached = event.SeriesID != nil
+	event.UpdatedAt = time.Now().UTC()
+
+	if err = s.repo.Update(ctx, event); err != nil {
+		return nil, fmt.Errorf("update event: %w", err)
+	}
+
+	return event, nil
+}
+
+// CancelEvent отменяет мероприятие (в том числе одно вхождение серии) и брони на него.
+// Владельцы отменённых броней получают уведомление, партнёры — вебхуки.
+func (s *EventService) CancelEvent(ctx context.Context, id string) error {
+	change := bookingChange(ctx, domain.BookingActorAdmin, "", domain.BookingReasonEventCancelled)
+	if _, err := s.repo.Cancel(ctx, id, change, cancelledBookingOutbox(s.webhooks, change)); err != nil {
+		return fmt.Errorf("cancel event: %w", err)
+	}
+
+	return nil
+}
+
+// eventDuration вычисляет длительность из duration или endDate (можно указать что-то одно).
+// Ноль означает, что длительность не задана.
+func eventDuration(start time.Time, duration time.Duration, endDate *time.Time) (time.Duration, error) {
+	if duration < 0 {
+		return 0, fmt.Errorf("%w: duration must be positive", domain.ErrValidation)
+	}
+	if endDate == nil {
+		return duration, nil
+	}
+	if duration != 0 {
+		return 0, fmt.Errorf("%w: specify either end_date or duration", domain.ErrValidation)
+	}
+	if !endDate.After(start) {
+		return 0, fmt.Errorf("%w: end_date must be after event_date", domain.ErrValidation)
+	}
+	return endDate.Sub(start), nil
+}
+
+// validateCategoryIDs убирает повторы и проверяет формат id. Существование рубрик
+// проверяет внешний ключ при сохранении.
+func validateCategoryIDs(ids []string) ([]string, error) {
+	res := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if err := uuid.Validate(id); err != nil {
+			return nil, fmt.Errorf("%w: invalid category id %q", domain.ErrValidation, id)
+		}
+		if !slices.Contains(res, id) {
+			res = append(res, id)
+		}
+	}
+	if len(res) > domain.MaxCategories {
+		return nil, fmt.Errorf("%w: an event can have at most %d categories", domain.ErrValidation, domain.MaxCategories)
+	}
+	return res, nil
+}
+
+func nonZeroTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
 }
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/eventimport"
+)
+
+// Import создаёт мероприятия из CSV или ICS. Каждая строка проверяется по тем же правилам,
+// что и CreateEvent; при любой ошибке не создаётся ничего, а в отчёте перечислены все
+// ошибочные строки. DryRun проверяет файл целиком, включая занятость площадок, но не сохраняет.
+func (s *EventService) Import(ctx context.Context, input domain.ImportInput) (*domain.ImportResult, error) {
+	rows, err := eventimport.Parse(input.Format, input.Data)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w: file has no events", domain.ErrValidation)
+	}
+
+	result := &domain.ImportResult{DryRun: input.DryRun, Total: len(rows)}
+	events := make([]*domain.Event, 0, len(rows))
+	// eventRows[i] — строка файла, из которой собрано events[i].
+	eventRows := make([]domain.ImportRow, 0, len(rows))
+	for _, row := range rows {
+		err := row.Err
+		if err == nil {
+			var event *domain.Event
+			if event, err = s.newEvent(ctx, withImportDefaults(row.Input, input)); err == nil {
+				events = append(events, event)
+				eventRows = append(eventRows, row)
+				continue
+			}
+		}
+		if !isRowError(err) {
+			return nil, fmt.Errorf("row %d: %w", row.Row, err)
+		}
+		result.Errors = append(result.Errors, importError(row, err))
+	}
+	if len(result.Errors) > 0 {
+		return result, nil
+	}
+
+	if err = s.repo.CreateBatch(ctx, events, input.DryRun); err != nil {
+		var batchErr *domain.EventBatchError
+		if errors.As(err, &batchErr) && isRowError(batchErr.Err) {
+			result.Errors = append(result.Errors, importError(eventRows[batchErr.Index], batchErr.Err))
+			return result, nil
+		}
+		return nil, fmt.Errorf("create events: %w", err)
+	}
+
+	result.Events = events
+	if !input.DryRun {
+		for _, e := range events {
+			if e.Status == domain.EventStatusPublished {
+				s.Announce(ctx, e)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// withImportDefaults подставляет площадку и число мест из запроса, если в строке их нет,
+// и переносит из запроса решение о публикации.
+func withImportDefaults(row domain.CreateEventInput, input domain.ImportInput) domain.CreateEventInput {
+	if row.VenueID == nil {
+		row.VenueID = input.VenueID
+	}
+	if row.TotalSpots == 0 {
+		row.TotalSpots = input.TotalSpots
+	}
+	row.Publish = input.Publish
+	return row
+}
+
+// isRowError отделяет ошибки данных строки от сбоев БД: последние прерывают импорт целиком.
+func isRowError(err error) bool {
+	return errors.Is(err, domain.ErrValidation) ||
+		errors.Is(err, domain.ErrVenueNotFound) ||
+		errors.Is(err, domain.ErrCategoryNotFound) ||
+		errors.Is(err, domain.ErrVenueBusy)
+}
+
+func importError(row domain.ImportRow, err error) domain.ImportError {
+	return domain.ImportError{Row: row.Row, Title: row.Input.Title, Message: err.Error()}
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/service/ports/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// importCSV собирает CSV с датами в будущем, чтобы тесты не устаревали.
+func importCSV(rows ...string) string {
+	date := time.Now().Add(48 * time.Hour).UTC().Format(time.RFC3339)
+	return "title,event_date,total_spots\n" + strings.ReplaceAll(strings.Join(rows, "\n"), "{date}", date)
+}
+
+func TestEventService_Import_CreatesAllRows(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	webhooks := mocks.NewMockWebhookPublisher(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, webhooks, nil, nil)
+
+	eventRepo.EXPECT().CreateBatch(mock.Anything, mock.MatchedBy(func(events []*domain.Event) bool {
+		return len(events) == 2 && events[0].Title == "Concert" && events[1].TotalSpots == 20
+	}), false).Return(nil)
+
+	result, err := svc.Import(context.Background(), domain.ImportInput{
+		Format: domain.ImportCSV,
+		Data:   strings.NewReader(importCSV("Concert,{date},10", "Lecture,{date},20")),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Total)
+	assert.Len(t, result.Events, 2)
+	assert.Empty(t, result.Errors)
+	webhooks.AssertNotCalled(t, "PublishEvent", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestEventService_Import_PublishAnnounces(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	webhooks := mocks.NewMockWebhookPublisher(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, webhooks, nil, nil)
+
+	eventRepo.EXPECT().CreateBatch(mock.Anything, mock.Anything, false).Return(nil)
+	webhooks.EXPECT().PublishEvent(mock.Anything, domain.WebhookEventCreated, mock.Anything).Return().Times(2)
+	webhooks.EXPECT().PublishEvent(mock.Anything, domain.WebhookEventPublished, mock.Anything).Return().Times(2)
+
+	// Публикуемым мероприятиям нужно описание, поэтому CSV собирается здесь.
+	date := time.Now().Add(48 * time.Hour).UTC().Format(time.RFC3339)
+	csv := "title,description,event_date,total_spots\n" +
+		"Concert,Live," + date + ",10\n" +
+		"Lecture,Talk," + date + ",20"
+
+	_, err := svc.Import(context.Background(), domain.ImportInput{
+		Format:  domain.ImportCSV,
+		Data:    strings.NewReader(csv),
+		Publish: true,
+	})
+
+	require.NoError(t, err)
+}
+
+func TestEventService_Import_ReportsEveryBadRow(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, nopWebhooks(t), nil, nil)
+
+	result, err := svc.Import(context.Background(), domain.ImportInput{
+		Format: domain.ImportCSV,
+		Data: strings.NewReader(importCSV(
+			"Concert,{date},10",
+			"Lecture,tomorrow,10",
+			",{date},10",
+			"Past,2000-01-01T10:00:00Z,10",
+		)),
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Events)
+	require.Len(t, result.Errors, 3)
+	assert.Equal(t, 3, result.Errors[0].Row)
+	assert.Equal(t, "Lecture", result.Errors[0].Title)
+	assert.Equal(t, 4, result.Errors[1].Row)
+	assert.Contains(t, result.Errors[1].Message, "title is required")
+	assert.Equal(t, 5, result.Errors[2].Row)
+	eventRepo.AssertNotCalled(t, "CreateBatch", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestEventService_Import_DryRun(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	webhooks := mocks.NewMockWebhookPublisher(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, webhooks, nil, nil)
+
+	eventRepo.EXPECT().CreateBatch(mock.Anything, mock.Anything, true).Return(nil)
+
+	result, err := svc.Import(context.Background(), domain.ImportInput{
+		Format: domain.ImportCSV,
+		Data:   strings.NewReader(importCSV("Concert,{date},10")),
+		DryRun: true,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.DryRun)
+	assert.Len(t, result.Events, 1)
+	webhooks.AssertNotCalled(t, "PublishEvent", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestEventService_Import_VenueBusyAttributedToRow(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	venueRepo := mocks.NewMockVenueRepo(t)
+	svc := NewEventService(eventRepo, nil, venueRepo, nil, nopWebhooks(t), nil, nil)
+
+	venueID := "2b1f6c8e-2d8c-4b8f-9a57-0c3f7a5d1e11"
+	venueRepo.EXPECT().GetByID(mock.Anything, venueID).
+		Return(&domain.Venue{ID: venueID, Capacity: 50, Timezone: "UTC"}, nil)
+	eventRepo.EXPECT().CreateBatch(mock.Anything, mock.Anything, false).
+		Return(&domain.EventBatchError{Index: 1, Err: domain.ErrVenueBusy})
+
+	result, err := svc.Import(context.Background(), domain.ImportInput{
+		Format:  domain.ImportCSV,
+		Data:    strings.NewReader(importCSV("Concert,{date},", "Lecture,{date},")),
+		VenueID: &venueID,
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Events)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, 3, result.Errors[0].Row)
+	assert.Equal(t, "Lecture", result.Errors[0].Title)
+}
+
+func TestEventService_Import_RepoError(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, nopWebhooks(t), nil, nil)
+
+	dbErr := errors.New("connection refused")
+	eventRepo.EXPECT().CreateBatch(mock.Anything, mock.Anything, false).
+		Return(&domain.EventBatchError{Index: 0, Err: dbErr})
+
+	_, err := svc.Import(context.Background(), domain.ImportInput{
+		Format: domain.ImportCSV,
+		Data:   strings.NewReader(importCSV("Concert,{date},10")),
+	})
+
+	assert.ErrorIs(t, err, dbErr)
+}
+
+func TestEventService_Import_ICSUsesDefaultSpots(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, nopWebhooks(t), nil, nil)
+
+	start := time.Now().Add(72 * time.Hour).UTC().Truncate(time.Second)
+	cal := "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:test\r\n" +
+		"BEGIN:VEVENT\r\nUID:1\r\nSUMMARY:Meetup\r\n" +
+		"DTSTART:" + start.Format("20060102T150405Z") + "\r\nDURATION:PT90M\r\n" +
+		"END:VEVENT\r\nEND:VCALENDAR\r\n"
+
+	eventRepo.EXPECT().CreateBatch(mock.Anything, mock.MatchedBy(func(events []*domain.Event) bool {
+		return len(events) == 1 && events[0].TotalSpots == 30 && events[0].Duration == 90*time.Minute &&
+			events[0].EventDate.Equal(start)
+	}), false).Return(nil)
+
+	result, err := svc.Import(context.Background(), domain.ImportInput{
+		Format:     domain.ImportICS,
+		Data:       strings.NewReader(cal),
+		TotalSpots: 30,
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, result.Events, 1)
+}
+
+func TestEventService_Import_EmptyFile(t *testing.T) {
+	svc := NewEventService(nil, nil, nil, nil, nopWebhooks(t), nil, nil)
+
+	_, err := svc.Import(context.Background(), domain.ImportInput{
+		Format: domain.ImportCSV,
+		Data:   strings.NewReader("title,event_date\n"),
+	})
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stpnv0/EventBooker/internal/domain"
+)
+
+// Invite приглашает пользователей на закрытое мероприятие и возвращает новые приглашения.
+// Уже приглашённые пропускаются. Уведомления уходят сразу, если мероприятие опубликовано,
+// иначе — при публикации.
+func (s *EventService) Invite(ctx context.Context, eventID string, userIDs []string) ([]*domain.EventInvitation, error) {
+	ids, err := validateInvitees(userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := s.repo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if !event.IsPrivate() {
+		return nil, fmt.Errorf("%w: invitations are only for private events", domain.ErrValidation)
+	}
+	if event.CancelledAt != nil {
+		return nil, domain.ErrEventCancelled
+	}
+
+	now := time.Now().UTC()
+	users, err := s.repo.Invite(ctx, eventID, ids, now)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations := make([]*domain.EventInvitation, len(users))
+	for i, user := range users {
+		invitations[i] = &domain.EventInvitation{
+			EventID:   eventID,
+			UserID:    user.ID,
+			Username:  user.Username,
+			CreatedAt: now,
+		}
+		if event.Status == domain.EventStatusPublished {
+			s.notifier.NotifyInvitation(ctx, user, event)
+		}
+	}
+
+	return invitations, nil
+}
+
+func (s *EventService) ListInvitations(ctx context.Context, eventID string) ([]*domain.EventInvitation, error) {
+	if _, err := s.repo.GetByID(ctx, eventID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListInvitations(ctx, eventID)
+}
+
+// RevokeInvitation отзывает приглашение. Сделанная по нему бронь остаётся.
+func (s *EventService) RevokeInvitation(ctx context.Context, eventID, userID string) error {
+	return s.repo.RevokeInvitation(ctx, eventID, userID)
+}
+
+func validateInvitees(userIDs []string) ([]string, error) {
+	if len(userIDs) == 0 {
+		return nil, fmt.Errorf("%w: user_ids is required", domain.ErrValidation)
+	}
+
+	res := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		if err := uuid.Validate(id); err != nil {
+			return nil, fmt.Errorf("%w: invalid user id %q", domain.ErrValidation, id)
+		}
+		if !slices.Contains(res, id) {
+			res = append(res, id)
+		}
+	}
+	if len(res) > domain.MaxInvitationsPerRequest {
+		return nil, fmt.Errorf("%w: at most %d users per request", domain.ErrValidation, domain.MaxInvitationsPerRequest)
+	}
+	return res, nil
+}
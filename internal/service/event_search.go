@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+	// maxSearchTerms ограничивает длину запроса: каждое слово — отдельный префиксный поиск по индексу.
+	maxSearchTerms = 10
+)
+
+// Search ищет мероприятия по словам в названии и описании.
+func (s *EventService) Search(ctx context.Context, input domain.EventSearchInput) ([]*domain.EventSearchResult, error) {
+	terms := searchTerms(input.Query)
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("%w: search query must contain at least one word", domain.ErrValidation)
+	}
+	if len(terms) > maxSearchTerms {
+		return nil, fmt.Errorf("%w: search query is limited to %d words", domain.ErrValidation, maxSearchTerms)
+	}
+	if input.From != nil && input.To != nil && !input.From.Before(*input.To) {
+		return nil, fmt.Errorf("%w: from must be before to", domain.ErrValidation)
+	}
+
+	limit := input.Limit
+	switch {
+	case limit == 0:
+		limit = defaultSearchLimit
+	case limit < 0 || limit > maxSearchLimit:
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", domain.ErrValidation, maxSearchLimit)
+	}
+	if input.Offset < 0 {
+		return nil, fmt.Errorf("%w: offset must not be negative", domain.ErrValidation)
+	}
+
+	return s.repo.Search(ctx, domain.EventSearch{
+		Terms:     terms,
+		From:      input.From,
+		To:        input.To,
+		Available: input.Available,
+		Limit:     limit,
+		Offset:    input.Offset,
+	})
+}
+
+// searchTerms разбивает запрос на слова из букв и цифр. Всё остальное — разделители,
+// так что операторы tsquery (&, |, !, :) в запрос не попадают.
+func searchTerms(query string) []string {
+	words := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make([]string, 0, len(words))
+	seen := make(map[string]bool, len(words))
+	for _, w := range words {
+		if !seen[w] {
+			seen[w] = true
+			terms = append(terms, w)
+		}
+	}
+	return terms
+}
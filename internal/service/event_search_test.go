@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/service/ports/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchTerms(t *testing.T) {
+	assert.Equal(t, []string{"рок", "концерт", "2030"}, searchTerms("  Рок-концерт 2030, рок!"))
+	// Операторы tsquery — разделители, а не часть запроса.
+	assert.Equal(t, []string{"jazz", "blues"}, searchTerms("jazz & !blues:*"))
+	assert.Empty(t, searchTerms(" & | ! "))
+}
+
+func TestEventService_Search_Defaults(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, nopWebhooks(t), nil, nil)
+
+	from := time.Date(2030, 5, 1, 0, 0, 0, 0, time.UTC)
+	found := []*domain.EventSearchResult{{Event: domain.Event{ID: "e1"}, Rank: 0.5, AvailableSpots: 3}}
+	eventRepo.EXPECT().Search(mock.Anything, domain.EventSearch{
+		Terms:     []string{"jazz", "вечер"},
+		From:      &from,
+		Available: true,
+		Limit:     defaultSearchLimit,
+	}).Return(found, nil)
+
+	res, err := svc.Search(context.Background(), domain.EventSearchInput{
+		Query:     "Jazz вечер",
+		From:      &from,
+		Available: true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, found, res)
+}
+
+func TestEventService_Search_Validation(t *testing.T) {
+	from := time.Date(2030, 5, 2, 0, 0, 0, 0, time.UTC)
+	to := from.Add(-time.Hour)
+
+	tests := []struct {
+		name  string
+		input domain.EventSearchInput
+	}{
+		{"no words", domain.EventSearchInput{Query: "!!!"}},
+		{"too many words", domain.EventSearchInput{Query: "a b c d e f g h i j k"}},
+		{"from after to", domain.EventSearchInput{Query: "jazz", From: &from, To: &to}},
+		{"limit too big", domain.EventSearchInput{Query: "jazz", Limit: maxSearchLimit + 1}},
+		{"negative offset", domain.EventSearchInput{Query: "jazz", Offset: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewEventService(mocks.NewMockEventRepo(t), nil, nil, nil, nopWebhooks(t), nil, nil)
+
+			_, err := svc.Search(context.Background(), tt.input)
+
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
@@ -16,7 +16,7 @@ import (
 func TestEventService_CreateEvent_Success(t *testing.T) {
 	eventRepo := mocks.NewMockEventRepo(t)
 	bookingRepo := mocks.NewMockBookingRepo(t)
-	svc := NewEventService(eventRepo, bookingRepo)
+	svc := NewEventService(eventRepo, bookingRepo, nil, nil, nopWebhooks(t), nil, nil)
 
 	eventRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
 
@@ -41,10 +41,28 @@ func TestEventService_CreateEvent_Success(t *testing.T) {
 	assert.NotEmpty(t, event.ID)
 }
 
+func TestEventService_CreateEvent_DraftIsNotAnnounced(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	webhooks := mocks.NewMockWebhookPublisher(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, webhooks, nil, nil)
+
+	eventRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+	event, err := svc.CreateEvent(context.Background(), domain.CreateEventInput{
+		Title:      "Concert",
+		EventDate:  time.Now().Add(24 * time.Hour),
+		TotalSpots: 10,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.EventStatusDraft, event.Status)
+	webhooks.AssertNotCalled(t, "PublishEvent", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestEventService_CreateEvent_DefaultTTL(t *testing.T) {
 	eventRepo := mocks.NewMockEventRepo(t)
 	bookingRepo := mocks.NewMockBookingRepo(t)
-	svc := NewEventService(eventRepo, bookingRepo)
+	svc := NewEventService(eventRepo, bookingRepo, nil, nil, nopWebhooks(t), nil, nil)
 
 	eventRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
 
@@ -63,7 +81,7 @@ func TestEventService_CreateEvent_DefaultTTL(t *testing.T) {
 func TestEventService_CreateEvent_DefaultRequiresPayment(t *testing.T) {
 	eventRepo := mocks.NewMockEventRepo(t)
 	bookingRepo := mocks.NewMockBookingRepo(t)
-	svc := NewEventService(eventRepo, bookingRepo)
+	svc := NewEventService(eventRepo, bookingRepo, nil, nil, nopWebhooks(t), nil, nil)
 
 	eventRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
 
@@ -82,7 +100,7 @@ func TestEventService_CreateEvent_DefaultRequiresPayment(t *testing.T) {
 func TestEventService_CreateEvent_NoPaymentRequired(t *testing.T) {
 	eventRepo := mocks.NewMockEventRepo(t)
 	bookingRepo := mocks.NewMockBookingRepo(t)
-	svc := NewEventService(eventRepo, bookingRepo)
+	svc := NewEventService(eventRepo, bookingRepo, nil, nil, nopWebhooks(t), nil, nil)
 
 	eventRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
 
@@ -101,7 +119,7 @@ func TestEventService_CreateEvent_NoPaymentRequired(t *testing.T) {
 }
 
 func TestEventService_CreateEvent_EmptyTitle(t *testing.T) {
-	svc := NewEventService(nil, nil)
+	svc := NewEventService(nil, nil, nil, nil, nopWebhooks(t), nil, nil)
 
 	input := domain.CreateEventInput{
 		EventDate:  time.Now().Add(time.Hour),
@@ -115,7 +133,7 @@ func TestEventService_CreateEvent_EmptyTitle(t *testing.T) {
 }
 
 func TestEventService_CreateEvent_ZeroSpots(t *testing.T) {
-	svc := NewEventService(nil, nil)
+	svc := NewEventService(nil, nil, nil, nil, nopWebhooks(t), nil, nil)
 
 	input := domain.CreateEventInput{
 		Title:      "Test",
@@ -130,7 +148,7 @@ func TestEventService_CreateEvent_ZeroSpots(t *testing.T) {
 }
 
 func TestEventService_CreateEvent_PastDate(t *testing.T) {
-	svc := NewEventService(nil, nil)
+	svc := NewEventService(nil, nil, nil, nil, nopWebhooks(t), nil, nil)
 
 	input := domain.CreateEventInput{
 		Title:      "Test",
@@ -147,7 +165,7 @@ func TestEventService_CreateEvent_PastDate(t *testing.T) {
 func TestEventService_CreateEvent_RepoError(t *testing.T) {
 	eventRepo := mocks.NewMockEventRepo(t)
 	bookingRepo := mocks.NewMockBookingRepo(t)
-	svc := NewEventService(eventRepo, bookingRepo)
+	svc := NewEventService(eventRepo, bookingRepo, nil, nil, nopWebhooks(t), nil, nil)
 
 	repoErr := errors.New("db error")
 	eventRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(repoErr)
@@ -167,7 +185,7 @@ func TestEventService_CreateEvent_RepoError(t *testing.T) {
 func TestEventService_GetDetails_Success(t *testing.T) {
 	eventRepo := mocks.NewMockEventRepo(t)
 	bookingRepo := mocks.NewMockBookingRepo(t)
-	svc := NewEventService(eventRepo, bookingRepo)
+	svc := NewEventService(eventRepo, bookingRepo, nil, nil, nopWebhooks(t), nil, nil)
 
 	eventID := "event-123"
 	details := &domain.EventDetails{
@@ -193,7 +211,7 @@ func TestEventService_GetDetails_Success(t *testing.T) {
 func TestEventService_GetDetails_NotFound(t *testing.T) {
 	eventRepo := mocks.NewMockEventRepo(t)
 	bookingRepo := mocks.NewMockBookingRepo(t)
-	svc := NewEventService(eventRepo, bookingRepo)
+	svc := NewEventService(eventRepo, bookingRepo, nil, nil, nopWebhooks(t), nil, nil)
 
 	eventRepo.EXPECT().GetDetails(mock.Anything, "missing").Return(nil, domain.ErrEventNotFound)
 
@@ -206,13 +224,13 @@ func TestEventService_GetDetails_NotFound(t *testing.T) {
 func TestEventService_List_Success(t *testing.T) {
 	eventRepo := mocks.NewMockEventRepo(t)
 	bookingRepo := mocks.NewMockBookingRepo(t)
-	svc := NewEventService(eventRepo, bookingRepo)
+	svc := NewEventService(eventRepo, bookingRepo, nil, nil, nopWebhooks(t), nil, nil)
 
 	events := []*domain.Event{
 		{ID: "e1", Title: "Event 1"},
 		{ID: "e2", Title: "Event 2"},
 	}
-	eventRepo.EXPECT().List(mock.Anything).Return(events, nil)
+	eventRepo.EXPECT().List(mock.Anything, domain.EventFilter{Statuses: []domain.EventStatus{domain.EventStatusPublished}}).Return(events, nil)
 
 	result, err := svc.List(context.Background())
 
@@ -223,11 +241,589 @@ func TestEventService_List_Success(t *testing.T) {
 func TestEventService_List_Error(t *testing.T) {
 	eventRepo := mocks.NewMockEventRepo(t)
 	bookingRepo := mocks.NewMockBookingRepo(t)
-	svc := NewEventService(eventRepo, bookingRepo)
+	svc := NewEventService(eventRepo, bookingRepo, nil, nil, nopWebhooks(t), nil, nil)
 
-	eventRepo.EXPECT().List(mock.Anything).Return(nil, errors.New("db error"))
+	eventRepo.EXPECT().List(mock.Anything, domain.EventFilter{Statuses: []domain.EventStatus{domain.EventStatusPublished}}).Return(nil, errors.New("db error"))
 
 	_, err := svc.List(context.Background())
 
 	require.Error(t, err)
 }
+
+func TestEventService_UpdateEvent_DetachesOccurrence(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, nopWebhooks(t), nil, nil)
+
+	seriesID := "s1"
+	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(&domain.Event{
+		ID: "e1", Title: "Workshop", TotalSpots: 10, SeriesID: &seriesID,
+	}, nil)
+	eventRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(e *domain.Event) bool {
+		return e.Detached && e.TotalSpots == 20 && e.Title == "Workshop"
+	})).Return(nil)
+
+	spots := 20
+	event, err := svc.UpdateEvent(context.Background(), "e1", domain.UpdateEventInput{TotalSpots: &spots})
+
+	require.NoError(t, err)
+	assert.True(t, event.Detached)
+}
+
+func TestEventService_UpdateEvent_SpotsBelowBooked(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, nopWebhooks(t), nil, nil)
+
+	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(&domain.Event{ID: "e1", Title: "Talk", TotalSpots: 10}, nil)
+	eventRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(domain.ErrSpotsBelowBooked)
+
+	spots := 1
+	_, err := svc.UpdateEvent(context.Background(), "e1", domain.UpdateEventInput{TotalSpots: &spots})
+
+	assert.ErrorIs(t, err, domain.ErrSpotsBelowBooked)
+}
+
+func TestEventService_UpdateEvent_Cancelled(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, nopWebhooks(t), nil, nil)
+
+	cancelledAt := time.Now()
+	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(&domain.Event{ID: "e1", CancelledAt: &cancelledAt}, nil)
+
+	title := "New"
+	_, err := svc.UpdateEvent(context.Background(), "e1", domain.UpdateEventInput{Title: &title})
+
+	assert.ErrorIs(t, err, domain.ErrEventCancelled)
+}
+
+func TestEventService_CancelEvent_NotifiesCancelledBookings(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, nopWebhooks(t), nil, nil)
+
+	var outbox domain.BookingOutbox
+	eventRepo.EXPECT().Cancel(mock.Anything, "e1", mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _ string, _ domain.BookingChange, o domain.BookingOutbox) { outbox = o }).
+		Return(nil, nil)
+
+	require.NoError(t, svc.CancelEvent(context.Background(), "e1"))
+
+	// Репозиторий передаёт бронь уже отменённой, в транзакции отмены мероприятия.
+	b := &domain.Booking{ID: "b1", EventID: "e1", UserID: "u1", Status: domain.BookingStatusCancelled}
+	out := runOutbox(t, outbox, b, &domain.Event{ID: "e1"})
+	kinds, types := outboxKinds(out)
+	assert.Equal(t, []domain.NotificationKind{domain.NotificationBookingCancelled}, kinds)
+	assert.Equal(t, "u1", out.Notifications[0].UserID)
+	assert.Equal(t, []domain.WebhookEventType{domain.WebhookBookingCancelled}, types)
+}
+
+func TestEventService_CancelEvent_PrivateEventSkipsWebhooks(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, mocks.NewMockWebhookPublisher(t), nil, nil)
+
+	var outbox domain.BookingOutbox
+	eventRepo.EXPECT().Cancel(mock.Anything, "e1", mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _ string, _ domain.BookingChange, o domain.BookingOutbox) { outbox = o }).
+		Return(nil, nil)
+
+	require.NoError(t, svc.CancelEvent(context.Background(), "e1"))
+
+	b := &domain.Booking{ID: "b1", EventID: "e1", UserID: "u1", Status: domain.BookingStatusCancelled}
+	private := &domain.Event{ID: "e1", Visibility: domain.EventVisibilityPrivate}
+	kinds, types := outboxKinds(runOutbox(t, outbox, b, private))
+	assert.Equal(t, []domain.NotificationKind{domain.NotificationBookingCancelled}, kinds)
+	assert.Empty(t, types)
+}
+
+func TestEventService_CreateEvent_SpotsFromVenue(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	venueRepo := mocks.NewMockVenueRepo(t)
+	svc := NewEventService(eventRepo, nil, venueRepo, nil, nopWebhooks(t), nil, nil)
+
+	venueID := "venue-1"
+	venueRepo.EXPECT().GetByID(mock.Anything, venueID).Return(&domain.Venue{ID: venueID, Capacity: 120}, nil)
+	eventRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+	event, err := svc.CreateEvent(context.Background(), domain.CreateEventInput{
+		Title:     "Talk",
+		EventDate: time.Now().Add(time.Hour),
+		VenueID:   &venueID,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 120, event.TotalSpots)
+	assert.Equal(t, defaultEventDuration, event.Duration)
+	assert.Equal(t, &venueID, event.VenueID)
+}
+
+func TestEventService_CreateEvent_ExplicitSpotsOverrideVenue(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	venueRepo := mocks.NewMockVenueRepo(t)
+	svc := NewEventService(eventRepo, nil, venueRepo, nil, nopWebhooks(t), nil, nil)
+
+	venueID := "venue-1"
+	venueRepo.EXPECT().GetByID(mock.Anything, venueID).Return(&domain.Venue{ID: venueID, Capacity: 120}, nil)
+	eventRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+	event, err := svc.CreateEvent(context.Background(), domain.CreateEventInput{
+		Title:      "Talk",
+		EventDate:  time.Now().Add(time.Hour),
+		TotalSpots: 30,
+		Duration:   45 * time.Minute,
+		VenueID:    &venueID,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 30, event.TotalSpots)
+	assert.Equal(t, 45*time.Minute, event.Duration)
+}
+
+func TestEventService_CreateEvent_VenueNotFound(t *testing.T) {
+	venueRepo := mocks.NewMockVenueRepo(t)
+	svc := NewEventService(nil, nil, venueRepo, nil, nopWebhooks(t), nil, nil)
+
+	venueID := "missing"
+	venueRepo.EXPECT().GetByID(mock.Anything, venueID).Return(nil, domain.ErrVenueNotFound)
+
+	_, err := svc.CreateEvent(context.Background(), domain.CreateEventInput{
+		Title:     "Talk",
+		EventDate: time.Now().Add(time.Hour),
+		VenueID:   &venueID,
+	})
+
+	assert.ErrorIs(t, err, domain.ErrVenueNotFound)
+}
+
+func TestEventService_CreateEvent_VenueBusy(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	venueRepo := mocks.NewMockVenueRepo(t)
+	svc := NewEventService(eventRepo, nil, venueRepo, nil, nopWebhooks(t), nil, nil)
+
+	venueID := "venue-1"
+	venueRepo.EXPECT().GetByID(mock.Anything, venueID).Return(&domain.Venue{ID: venueID, Capacity: 10}, nil)
+	eventRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(domain.ErrVenueBusy)
+
+	_, err := svc.CreateEvent(context.Background(), domain.CreateEventInput{
+		Title:     "Talk",
+		EventDate: time.Now().Add(time.Hour),
+		VenueID:   &venueID,
+	})
+
+	assert.ErrorIs(t, err, domain.ErrVenueBusy)
+}
+
+func TestEventService_UpdateEvent_DetachVenue(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, nopWebhooks(t), nil, nil)
+
+	venueID := "venue-1"
+	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(&domain.Event{ID: "e1", VenueID: &venueID}, nil)
+	eventRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(e *domain.Event) bool {
+		return e.VenueID == nil
+	})).Return(nil)
+
+	empty := ""
+	event, err := svc.UpdateEvent(context.Background(), "e1", domain.UpdateEventInput{VenueID: &empty})
+
+	require.NoError(t, err)
+	assert.Nil(t, event.VenueID)
+}
+
+func TestEventService_CreateEvent_EndDate(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, nopWebhooks(t), nil, nil)
+
+	eventRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Minute)
+	end := start.Add(3 * time.Hour)
+	event, err := svc.CreateEvent(context.Background(), domain.CreateEventInput{
+		Title:      "Conference",
+		EventDate:  start,
+		EndDate:    &end,
+		TotalSpots: 10,
+		Timezone:   "Europe/Moscow",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 3*time.Hour, event.Duration)
+	assert.Equal(t, end, event.EndDate())
+	assert.Equal(t, "Europe/Moscow", event.Timezone)
+}
+
+func TestEventService_CreateEvent_InvalidSchedule(t *testing.T) {
+	start := time.Now().Add(24 * time.Hour)
+	before := start.Add(-time.Hour)
+	after := start.Add(time.Hour)
+
+	tests := []struct {
+		name  string
+		input domain.CreateEventInput
+	}{
+		{"end before start", domain.CreateEventInput{EndDate: &before}},
+		{"end equals start", domain.CreateEventInput{EndDate: &start}},
+		{"end and duration", domain.CreateEventInput{EndDate: &after, Duration: time.Hour}},
+		{"negative duration", domain.CreateEventInput{Duration: -time.Hour}},
+		{"unknown timezone", domain.CreateEventInput{Timezone: "Mars/Olympus"}},
+	}
+
+	svc := NewEventService(nil, nil, nil, nil, nopWebhooks(t), nil, nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := tt.input
+			input.Title = "Conference"
+			input.EventDate = start
+			input.TotalSpots = 10
+
+			_, err := svc.CreateEvent(context.Background(), input)
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+func TestEventService_CreateEvent_TimezoneFromVenue(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	venueRepo := mocks.NewMockVenueRepo(t)
+	svc := NewEventService(eventRepo, nil, venueRepo, nil, nopWebhooks(t), nil, nil)
+
+	venueID := "venue-1"
+	venueRepo.EXPECT().GetByID(mock.Anything, venueID).
+		Return(&domain.Venue{ID: venueID, Capacity: 10, Timezone: "Asia/Tokyo"}, nil)
+	eventRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+	event, err := svc.CreateEvent(context.Background(), domain.CreateEventInput{
+		Title:     "Talk",
+		EventDate: time.Now().Add(time.Hour),
+		VenueID:   &venueID,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Asia/Tokyo", event.Timezone)
+}
+
+func TestEventService_UpdateEvent_MoveKeepsDuration(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, nopWebhooks(t), nil, nil)
+
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Minute)
+	eventRepo.EXPECT().GetByID(mock.Anything, "e1").
+		Return(&domain.Event{ID: "e1", EventDate: start, Duration: 90 * time.Minute}, nil)
+	eventRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil)
+
+	moved := start.Add(48 * time.Hour)
+	event, err := svc.UpdateEvent(context.Background(), "e1", domain.UpdateEventInput{EventDate: &moved})
+
+	require.NoError(t, err)
+	assert.Equal(t, moved.Add(90*time.Minute), event.EndDate())
+}
+
+func TestEventService_UpdateEvent_EndBeforeStart(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, nopWebhooks(t), nil, nil)
+
+	start := time.Now().Add(24 * time.Hour)
+	eventRepo.EXPECT().GetByID(mock.Anything, "e1").
+		Return(&domain.Event{ID: "e1", EventDate: start, Duration: time.Hour}, nil)
+
+	end := start.Add(-time.Minute)
+	_, err := svc.UpdateEvent(context.Background(), "e1", domain.UpdateEventInput{EndDate: &end})
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestEventService_CreateEvent_InvalidSalesWindow(t *testing.T) {
+	start := time.Now().Add(48 * time.Hour)
+	afterStart := start.Add(time.Hour)
+	opens := start.Add(-24 * time.Hour)
+	closesBeforeOpen := opens.Add(-time.Hour)
+
+	tests := []struct {
+		name          string
+		open, closeAt *time.Time
+	}{
+		{"opens after start", &afterStart, nil},
+		{"closes after start", nil, &afterStart},
+		{"closes before open", &opens, &closesBeforeOpen},
+	}
+
+	svc := NewEventService(nil, nil, nil, nil, nopWebhooks(t), nil, nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.CreateEvent(context.Background(), domain.CreateEventInput{
+				Title: "Concert", EventDate: start, TotalSpots: 10,
+				SalesOpenAt: tt.open, SalesCloseAt: tt.closeAt,
+			})
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+func TestEventService_UpdateEvent_ClearSalesWindow(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, nopWebhooks(t), nil, nil)
+
+	start := time.Now().Add(48 * time.Hour)
+	closes := start.Add(-time.Hour)
+	eventRepo.EXPECT().GetByID(mock.Anything, "e1").
+		Return(&domain.Event{ID: "e1", EventDate: start, SalesCloseAt: &closes}, nil)
+	eventRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil)
+
+	event, err := svc.UpdateEvent(context.Background(), "e1", domain.UpdateEventInput{SalesCloseAt: &time.Time{}})
+
+	require.NoError(t, err)
+	assert.Nil(t, event.SalesCloseAt)
+}
+
+func TestEventService_StreamAttendees_DefaultsToActive(t *testing.T) {
+	bookingRepo := mocks.NewMockBookingRepo(t)
+	svc := NewEventService(nil, bookingRepo, nil, nil, nopWebhooks(t), nil, nil)
+
+	bookingRepo.EXPECT().StreamAttendees(mock.Anything, "e1", domain.ActiveStatuses, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ string, _ []domain.BookingStatus, fn func(*domain.Attendee) error) error {
+			return fn(&domain.Attendee{Username: "alice"})
+		})
+
+	var names []string
+	err := svc.StreamAttendees(context.Background(), "e1", nil, func(a *domain.Attendee) error {
+		names = append(names, a.Username)
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice"}, names)
+}
+
+func TestEventService_CreateEvent_NotifiesFollowers(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	categoryRepo := mocks.NewMockCategoryRepo(t)
+	notifier := mocks.NewMockBookingNotifier(t)
+	svc := NewEventService(eventRepo, nil, nil, categoryRepo, nopWebhooks(t), notifier, newTestLogger(t))
+
+	music := "0b0c5d1e-2f3a-4b5c-8d6e-7f8091a2b3c4"
+	eventRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(e *domain.Event) bool {
+		return assert.ObjectsAreEqual([]string{music}, e.CategoryIDs) &&
+			assert.ObjectsAreEqual([]string{"jazz", "open air"}, e.Tags)
+	})).Return(nil)
+	followers := []*domain.User{{ID: "u1"}, {ID: "u2"}}
+	categoryRepo.EXPECT().ListFollowers(mock.Anything, []string{music}).Return(followers, nil)
+	notifier.EXPECT().NotifyNewEvent(mock.Anything, followers[0], mock.Anything).Return().Once()
+	notifier.EXPECT().NotifyNewEvent(mock.Anything, followers[1], mock.Anything).Return().Once()
+
+	_, err := svc.CreateEvent(context.Background(), domain.CreateEventInput{
+		Title:       "Jazz",
+		EventDate:   time.Now().Add(24 * time.Hour),
+		TotalSpots:  10,
+		CategoryIDs: []string{music, music},
+		Tags:        []string{" Jazz", "open air", "jazz", ""},
+		Publish:     true,
+	})
+
+	require.NoError(t, err)
+}
+
+func TestEventService_CreateEvent_InvalidLabels(t *testing.T) {
+	svc := NewEventService(nil, nil, nil, nil, nopWebhooks(t), nil, nil)
+
+	tags := make([]string, domain.MaxEventTags+1)
+	for i := range tags {
+		tags[i] = string(rune('a' + i))
+	}
+
+	for name, input := range map[string]domain.CreateEventInput{
+		"bad category id": {CategoryIDs: []string{"music"}},
+		"too many tags":   {Tags: tags},
+	} {
+		t.Run(name, func(t *testing.T) {
+			input.Title = "Event"
+			input.EventDate = time.Now().Add(time.Hour)
+			input.TotalSpots = 1
+			_, err := svc.CreateEvent(context.Background(), input)
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+func TestEventService_UpdateEvent_ReplacesTags(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, nopWebhooks(t), nil, nil)
+
+	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(&domain.Event{
+		ID: "e1", CategoryIDs: []string{"c1"}, Tags: []string{"jazz"},
+	}, nil)
+	eventRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil)
+
+	tags := []string{"Rock"}
+	event, err := svc.UpdateEvent(context.Background(), "e1", domain.UpdateEventInput{Tags: &tags})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rock"}, event.Tags)
+	assert.Equal(t, []string{"c1"}, event.CategoryIDs)
+}
+
+func TestEventService_CreateEvent_DraftByDefault(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, nopWebhooks(t), nil, nil)
+
+	eventRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(e *domain.Event) bool {
+		return e.Status == domain.EventStatusDraft && e.PublishedAt == nil
+	})).Return(nil)
+
+	publishAt := time.Now().Add(time.Hour)
+	event, err := svc.CreateEvent(context.Background(), domain.CreateEventInput{
+		Title:      "Draft",
+		EventDate:  time.Now().Add(24 * time.Hour),
+		TotalSpots: 10,
+		PublishAt:  &publishAt,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.EventStatusDraft, event.Status)
+	assert.True(t, publishAt.Equal(*event.PublishAt))
+}
+
+func TestEventService_CreateEvent_InvalidPublishAt(t *testing.T) {
+	svc := NewEventService(nil, nil, nil, nil, nopWebhooks(t), nil, nil)
+
+	eventDate := time.Now().Add(24 * time.Hour)
+	past := time.Now().Add(-time.Hour)
+	afterStart := eventDate.Add(time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	for name, input := range map[string]domain.CreateEventInput{
+		"in the past":            {PublishAt: &past},
+		"after event start":      {PublishAt: &afterStart},
+		"publish and publish_at": {Publish: true, PublishAt: &future},
+	} {
+		t.Run(name, func(t *testing.T) {
+			input.Title = "Event"
+			input.EventDate = eventDate
+			input.TotalSpots = 1
+			_, err := svc.CreateEvent(context.Background(), input)
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+func TestEventService_Publish_Success(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	webhooks := mocks.NewMockWebhookPublisher(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, webhooks, nil, nil)
+
+	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(&domain.Event{
+		ID: "e1", Title: "Concert", Description: "Live", TotalSpots: 10,
+		EventDate: time.Now().Add(24 * time.Hour), Status: domain.EventStatusDraft,
+	}, nil)
+	eventRepo.EXPECT().Publish(mock.Anything, "e1", mock.Anything).Return(nil)
+	webhooks.EXPECT().PublishEvent(mock.Anything, domain.WebhookEventCreated, mock.Anything).Return()
+	webhooks.EXPECT().PublishEvent(mock.Anything, domain.WebhookEventPublished, mock.Anything).Return()
+
+	event, err := svc.Publish(context.Background(), "e1")
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.EventStatusPublished, event.Status)
+	assert.NotNil(t, event.PublishedAt)
+}
+
+func TestEventService_Publish_Invalid(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, nopWebhooks(t), nil, nil)
+
+	eventDate := time.Now().Add(24 * time.Hour)
+	eventRepo.EXPECT().GetByID(mock.Anything, "published").Return(&domain.Event{
+		ID: "published", Title: "T", Description: "D", TotalSpots: 1,
+		EventDate: eventDate, Status: domain.EventStatusPublished,
+	}, nil)
+	eventRepo.EXPECT().GetByID(mock.Anything, "no-description").Return(&domain.Event{
+		ID: "no-description", Title: "T", TotalSpots: 1,
+		EventDate: eventDate, Status: domain.EventStatusDraft,
+	}, nil)
+
+	_, err := svc.Publish(context.Background(), "published")
+	assert.ErrorIs(t, err, domain.ErrEventNotDraft)
+
+	_, err = svc.Publish(context.Background(), "no-description")
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestEventService_PublishDue_Announces(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	webhooks := mocks.NewMockWebhookPublisher(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, webhooks, nil, newTestLogger(t))
+
+	due := []*domain.Event{{ID: "e1"}, {ID: "e2"}}
+	eventRepo.EXPECT().PublishDue(mock.Anything, mock.Anything).Return(due, nil)
+	webhooks.EXPECT().PublishEvent(mock.Anything, domain.WebhookEventCreated, mock.Anything).Return().Times(2)
+	webhooks.EXPECT().PublishEvent(mock.Anything, domain.WebhookEventPublished, mock.Anything).Return().Times(2)
+
+	n, err := svc.PublishDue(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+}
+
+func TestEventService_Invite_NotifiesPublished(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	notifier := mocks.NewMockBookingNotifier(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, nopWebhooks(t), notifier, nil)
+
+	event := &domain.Event{ID: "e1", Visibility: domain.EventVisibilityPrivate, Status: domain.EventStatusPublished}
+	alice := "0b0c5d1e-2f3a-4b5c-8d6e-7f8091a2b3c4"
+	bob := "1c1d6e2f-3a4b-4c5d-9e7f-8091a2b3c4d5"
+	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(event, nil)
+	// bob уже приглашён — репозиторий возвращает только новых.
+	users := []*domain.User{{ID: alice, Username: "alice"}}
+	eventRepo.EXPECT().Invite(mock.Anything, "e1", []string{alice, bob}, mock.Anything).Return(users, nil)
+	notifier.EXPECT().NotifyInvitation(mock.Anything, users[0], event).Return().Once()
+
+	invitations, err := svc.Invite(context.Background(), "e1", []string{alice, bob, alice})
+
+	require.NoError(t, err)
+	require.Len(t, invitations, 1)
+	assert.Equal(t, "alice", invitations[0].Username)
+}
+
+func TestEventService_Invite_PublicEvent(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	svc := NewEventService(eventRepo, nil, nil, nil, nopWebhooks(t), nil, nil)
+
+	eventRepo.EXPECT().GetByID(mock.Anything, "e1").
+		Return(&domain.Event{ID: "e1", Visibility: domain.EventVisibilityPublic}, nil)
+
+	_, err := svc.Invite(context.Background(), "e1", []string{"0b0c5d1e-2f3a-4b5c-8d6e-7f8091a2b3c4"})
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestEventService_Publish_PrivateNotifiesInvitees(t *testing.T) {
+	eventRepo := mocks.NewMockEventRepo(t)
+	notifier := mocks.NewMockBookingNotifier(t)
+	webhooks := mocks.NewMockWebhookPublisher(t)
+	// categoryRepo не задан: подписчики рубрик о закрытом мероприятии не узнают.
+	svc := NewEventService(eventRepo, nil, nil, nil, webhooks, notifier, newTestLogger(t))
+
+	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(&domain.Event{
+		ID: "e1", Title: "Team party", Description: "Closed", TotalSpots: 10,
+		EventDate: time.Now().Add(24 * time.Hour), Status: domain.EventStatusDraft,
+		Visibility: domain.EventVisibilityPrivate, CategoryIDs: []string{"c1"},
+	}, nil)
+	eventRepo.EXPECT().Publish(mock.Anything, "e1", mock.Anything).Return(nil)
+	invitee := &domain.User{ID: "u1"}
+	eventRepo.EXPECT().ListInvitees(mock.Anything, "e1").Return([]*domain.User{invitee}, nil)
+	notifier.EXPECT().NotifyInvitation(mock.Anything, invitee, mock.Anything).Return().Once()
+
+	_, err := svc.Publish(context.Background(), "e1")
+
+	require.NoError(t, err)
+	webhooks.AssertNotCalled(t, "PublishEvent", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestEventService_CreateEvent_AccessCodeOnPublic(t *testing.T) {
+	svc := NewEventService(nil, nil, nil, nil, nopWebhooks(t), nil, nil)
+
+	_, err := svc.CreateEvent(context.Background(), domain.CreateEventInput{
+		Title:      "Event",
+		EventDate:  time.Now().Add(time.Hour),
+		TotalSpots: 1,
+		AccessCode: "secret",
+	})
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
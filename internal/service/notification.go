@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/service/ports"
+	"github.com/wb-go/wbf/logger"
+)
+
+// notificationLease — время, на которое воркер резервирует уведомление для доставки.
+const notificationLease = time.Minute
+
+// NotificationService доставляет уведомления из outbox по каналам пользователя (router).
+type NotificationService struct {
+	repo      ports.NotificationRepo
+	userRepo  ports.UserRepo
+	eventRepo ports.EventRepo
+	router    ports.NotificationRouter
+	batchSize int
+	logger    logger.Logger
+}
+
+func NewNotificationService(
+	repo ports.NotificationRepo,
+	userRepo ports.UserRepo,
+	eventRepo ports.EventRepo,
+	router ports.NotificationRouter,
+	batchSize int,
+	logger logger.Logger,
+) *NotificationService {
+	return &NotificationService{
+		repo:      repo,
+		userRepo:  userRepo,
+		eventRepo: eventRepo,
+		router:    router,
+		batchSize: batchSize,
+		logger:    logger,
+	}
+}
+
+// Dispatch забирает пачку неотправленных уведомлений и доставляет их.
+// Недоставленное уведомление не помечается отправленным: после истечения аренды
+// его заберёт следующий Dispatch, но только для каналов, которые ещё не доставили.
+// Возвращает количество уведомлений, доставленных по всем каналам.
+func (s *NotificationService) Dispatch(ctx context.Context) (int, error) {
+	batch, err := s.repo.Claim(ctx, s.batchSize, notificationLease)
+	if err != nil {
+		return 0, fmt.Errorf("claim notifications: %w", err)
+	}
+
+	sent := 0
+	for _, n := range batch {
+		delivered, err := s.deliver(ctx, n)
+		if err != nil {
+			s.logger.LogAttrs(ctx, logger.ErrorLevel, "failed to deliver notification",
+				logger.String("notification_id", n.ID),
+				logger.Int("attempts", n.Attempts),
+				logger.String("error", err.Error()),
+			)
+			s.markDelivered(ctx, n, delivered)
+			continue
+		}
+
+		if err = s.repo.MarkSent(ctx, n.ID); err != nil {
+			s.logger.LogAttrs(ctx, logger.ErrorLevel, "failed to mark notification sent",
+				logger.String("notification_id", n.ID),
+				logger.String("error", err.Error()),
+			)
+			s.markDelivered(ctx, n, delivered)
+			continue
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+// deliver отправляет уведомление по каналам, которые его ещё не доставили,
+// и возвращает каналы, доставившие его сейчас.
+func (s *NotificationService) deliver(ctx context.Context, n *domain.Notification) ([]domain.NotificationChannel, error) {
+	user, err := s.userRepo.GetByID(ctx, n.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, n.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+
+	delivered, err := s.router.Deliver(ctx, n.Kind, user, event, n.Delivered)
+	if err != nil {
+		return delivered, fmt.Errorf("send %s: %w", n.Kind, err)
+	}
+
+	return delivered, nil
+}
+
+// markDelivered запоминает каналы, доставившие недоставленное целиком уведомление.
+func (s *NotificationService) markDelivered(ctx context.Context, n *domain.Notification, channels []domain.NotificationChannel) {
+	if len(channels) == 0 {
+		return
+	}
+	if err := s.repo.MarkDelivered(ctx, n.ID, channels); err != nil {
+		s.logger.LogAttrs(ctx, logger.ErrorLevel, "failed to mark notification channels delivered",
+			logger.String("notification_id", n.ID),
+			logger.String("error", err.Error()),
+		)
+	}
+}
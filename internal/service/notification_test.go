@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/service/ports/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationService_Dispatch_Success(t *testing.T) {
+	repo := mocks.NewMockNotificationRepo(t)
+	userRepo := mocks.NewMockUserRepo(t)
+	eventRepo := mocks.NewMockEventRepo(t)
+	router := mocks.NewMockNotificationRouter(t)
+	log := newTestLogger(t)
+
+	svc := NewNotificationService(repo, userRepo, eventRepo, router, 10, log)
+
+	user := &domain.User{ID: "u1"}
+	event := &domain.Event{ID: "e1"}
+	batch := []*domain.Notification{
+		{ID: "n1", Kind: domain.NotificationBookingCreated, UserID: "u1", EventID: "e1"},
+		{ID: "n2", Kind: domain.NotificationBookingCancelled, UserID: "u1", EventID: "e1"},
+	}
+
+	repo.EXPECT().Claim(mock.Anything, 10, notificationLease).Return(batch, nil)
+	userRepo.EXPECT().GetByID(mock.Anything, "u1").Return(user, nil)
+	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(event, nil)
+	router.EXPECT().Deliver(mock.Anything, domain.NotificationBookingCreated, user, event, []domain.NotificationChannel(nil)).
+		Return([]domain.NotificationChannel{domain.ChannelTelegram}, nil)
+	router.EXPECT().Deliver(mock.Anything, domain.NotificationBookingCancelled, user, event, []domain.NotificationChannel(nil)).
+		Return([]domain.NotificationChannel{domain.ChannelTelegram}, nil)
+	repo.EXPECT().MarkSent(mock.Anything, "n1").Return(nil)
+	repo.EXPECT().MarkSent(mock.Anything, "n2").Return(nil)
+
+	sent, err := svc.Dispatch(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, sent)
+}
+
+func TestNotificationService_Dispatch_SkipsUndeliverable(t *testing.T) {
+	repo := mocks.NewMockNotificationRepo(t)
+	userRepo := mocks.NewMockUserRepo(t)
+	eventRepo := mocks.NewMockEventRepo(t)
+	router := mocks.NewMockNotificationRouter(t)
+	log := newTestLogger(t)
+
+	svc := NewNotificationService(repo, userRepo, eventRepo, router, 10, log)
+
+	batch := []*domain.Notification{
+		{ID: "n1", Kind: domain.NotificationBookingConfirmed, UserID: "missing", EventID: "e1"},
+	}
+
+	repo.EXPECT().Claim(mock.Anything, 10, notificationLease).Return(batch, nil)
+	userRepo.EXPECT().GetByID(mock.Anything, "missing").Return(nil, domain.ErrUserNotFound)
+
+	sent, err := svc.Dispatch(context.Background())
+
+	require.NoError(t, err)
+	assert.Zero(t, sent)
+}
+
+func TestNotificationService_Dispatch_NotifierFails(t *testing.T) {
+	repo := mocks.NewMockNotificationRepo(t)
+	userRepo := mocks.NewMockUserRepo(t)
+	eventRepo := mocks.NewMockEventRepo(t)
+	router := mocks.NewMockNotificationRouter(t)
+
+	svc := NewNotificationService(repo, userRepo, eventRepo, router, 10, newTestLogger(t))
+
+	user := &domain.User{ID: "u1"}
+	event := &domain.Event{ID: "e1"}
+	batch := []*domain.Notification{
+		{ID: "n1", Kind: domain.NotificationBookingConfirmed, UserID: "u1", EventID: "e1"},
+		{ID: "n2", Kind: domain.NotificationNewEvent, UserID: "u1", EventID: "e1"},
+	}
+
+	repo.EXPECT().Claim(mock.Anything, 10, notificationLease).Return(batch, nil)
+	userRepo.EXPECT().GetByID(mock.Anything, "u1").Return(user, nil)
+	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(event, nil)
+	router.EXPECT().Deliver(mock.Anything, domain.NotificationBookingConfirmed, user, event, []domain.NotificationChannel(nil)).
+		Return(nil, errors.New("telegram: too many requests"))
+	router.EXPECT().Deliver(mock.Anything, domain.NotificationNewEvent, user, event, []domain.NotificationChannel(nil)).
+		Return([]domain.NotificationChannel{domain.ChannelTelegram}, nil)
+	// n1 не помечается отправленным — его заберёт следующий Dispatch.
+	repo.EXPECT().MarkSent(mock.Anything, "n2").Return(nil)
+
+	sent, err := svc.Dispatch(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+}
+
+func TestNotificationService_Dispatch_RemembersDeliveredChannels(t *testing.T) {
+	repo := mocks.NewMockNotificationRepo(t)
+	userRepo := mocks.NewMockUserRepo(t)
+	eventRepo := mocks.NewMockEventRepo(t)
+	router := mocks.NewMockNotificationRouter(t)
+
+	svc := NewNotificationService(repo, userRepo, eventRepo, router, 10, newTestLogger(t))
+
+	user := &domain.User{ID: "u1"}
+	event := &domain.Event{ID: "e1"}
+	done := []domain.NotificationChannel{domain.ChannelWebhook}
+	batch := []*domain.Notification{
+		{ID: "n1", Kind: domain.NotificationBookingConfirmed, UserID: "u1", EventID: "e1", Delivered: done},
+	}
+
+	repo.EXPECT().Claim(mock.Anything, 10, notificationLease).Return(batch, nil)
+	userRepo.EXPECT().GetByID(mock.Anything, "u1").Return(user, nil)
+	eventRepo.EXPECT().GetByID(mock.Anything, "e1").Return(event, nil)
+	// Email упал, Telegram доставил: при повторе Telegram будет пропущен.
+	router.EXPECT().Deliver(mock.Anything, domain.NotificationBookingConfirmed, user, event, done).
+		Return([]domain.NotificationChannel{domain.ChannelTelegram}, errors.New("email: smtp: connection refused"))
+	repo.EXPECT().MarkDelivered(mock.Anything, "n1", []domain.NotificationChannel{domain.ChannelTelegram}).Return(nil)
+
+	sent, err := svc.Dispatch(context.Background())
+
+	require.NoError(t, err)
+	assert.Zero(t, sent)
+}
+
+func TestNotificationService_Dispatch_ClaimError(t *testing.T) {
+	repo := mocks.NewMockNotificationRepo(t)
+	log := newTestLogger(t)
+
+	svc := NewNotificationService(repo, nil, nil, nil, 10, log)
+
+	repo.EXPECT().Claim(mock.Anything, 10, notificationLease).Return(nil, errors.New("db error"))
+
+	_, err := svc.Dispatch(context.Background())
+
+	require.Error(t, err)
+}
@@ -7,10 +7,17 @@ import (
 )
 
 type BookingRepo interface {
-	Create(ctx context.Context, b *domain.Booking) error
+	// Create проверяет quota в той же транзакции, что и вставку; nil quota не ограничивает.
+	// Сообщения out записываются в той же транзакции.
+	Create(ctx context.Context, b *domain.Booking, change domain.BookingChange, quota *domain.BookingQuota, out *domain.Outbox) error
 	GetByEventAndUser(ctx context.Context, eventID, userID string) (*domain.Booking, error)
-	Confirm(ctx context.Context, eventID, userID string) error
-	CancelExpired(ctx context.Context) ([]*domain.Booking, error)
+	// Confirm, Cancel и CancelExpired записывают сообщения outbox об изменённых бронях
+	// в транзакции смены статуса.
+	Confirm(ctx context.Context, eventID, userID string, change domain.BookingChange, outbox domain.BookingOutbox) (*domain.Booking, error)
+	Cancel(ctx context.Context, eventID, userID string, change domain.BookingChange, outbox domain.BookingOutbox) (*domain.Booking, error)
+	CancelExpired(ctx context.Context, change domain.BookingChange, outbox domain.BookingOutbox) ([]*domain.Booking, error)
+	History(ctx context.Context, bookingID string) ([]*domain.BookingHistoryEntry, error)
 	ListByEvent(ctx context.Context, eventID string) ([]*domain.Booking, error)
 	ListByUser(ctx context.Context, userID string) ([]*domain.Booking, error)
+	StreamAttendees(ctx context.Context, eventID string, statuses []domain.BookingStatus, fn func(*domain.Attendee) error) error
 }
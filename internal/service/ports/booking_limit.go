@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+)
+
+type BookingLimitRepo interface {
+	// Usage считает брони пользователя: неоплаченные, созданные с dayStart и истёкшие с expiredSince.
+	Usage(ctx context.Context, userID string, dayStart, expiredSince time.Time) (*domain.BookingUsage, error)
+	GetOverride(ctx context.Context, userID string) (*domain.BookingLimitOverride, error)
+	SaveOverride(ctx context.Context, o *domain.BookingLimitOverride) error
+	DeleteOverride(ctx context.Context, userID string) error
+	ClearCooldown(ctx context.Context, userID string, at time.Time) error
+}
+
+// BookingLimiter возвращает ограничения пользователя для новой брони; nil — ограничений нет.
+type BookingLimiter interface {
+	Quota(ctx context.Context, userID string) (*domain.BookingQuota, error)
+}
@@ -0,0 +1,8 @@
+package ports
+
+import "context"
+
+type CalendarTokenRepo interface {
+	Save(ctx context.Context, userID, tokenHash string) error
+	GetHash(ctx context.Context, userID string) (string, error)
+}
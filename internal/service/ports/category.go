@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+)
+
+type CategoryRepo interface {
+	Create(ctx context.Context, c *domain.Category) error
+	GetByID(ctx context.Context, id string) (*domain.Category, error)
+	List(ctx context.Context) ([]*domain.Category, error)
+	Update(ctx context.Context, c *domain.Category) error
+	Delete(ctx context.Context, id string) error
+	Follow(ctx context.Context, userID, categoryID string) error
+	Unfollow(ctx context.Context, userID, categoryID string) error
+	ListFollowed(ctx context.Context, userID string) ([]*domain.Category, error)
+	ListFollowers(ctx context.Context, categoryIDs []string) ([]*domain.User, error)
+}
@@ -2,13 +2,29 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/stpnv0/EventBooker/internal/domain"
 )
 
 type EventRepo interface {
 	Create(ctx context.Context, e *domain.Event) error
+	CreateBatch(ctx context.Context, events []*domain.Event, dryRun bool) error
 	GetByID(ctx context.Context, id string) (*domain.Event, error)
-	List(ctx context.Context) ([]*domain.Event, error)
+	List(ctx context.Context, filter domain.EventFilter) ([]*domain.Event, error)
+	Search(ctx context.Context, s domain.EventSearch) ([]*domain.EventSearchResult, error)
+	ListBookedByUser(ctx context.Context, userID string, statuses []domain.BookingStatus) ([]*domain.Event, error)
 	GetDetails(ctx context.Context, eventID string) (*domain.EventDetails, error)
+	Update(ctx context.Context, e *domain.Event) error
+	Publish(ctx context.Context, id string, at time.Time) error
+	PublishDue(ctx context.Context, now time.Time) ([]*domain.Event, error)
+	Archive(ctx context.Context, id string, at time.Time) error
+	// Cancel записывает сообщения outbox об отменённых бронях в своей транзакции.
+	Cancel(ctx context.Context, id string, change domain.BookingChange, outbox domain.BookingOutbox) ([]*domain.Booking, error)
+
+	Invite(ctx context.Context, eventID string, userIDs []string, at time.Time) ([]*domain.User, error)
+	ListInvitations(ctx context.Context, eventID string) ([]*domain.EventInvitation, error)
+	ListInvitees(ctx context.Context, eventID string) ([]*domain.User, error)
+	RevokeInvitation(ctx context.Context, eventID, userID string) error
+	IsInvited(ctx context.Context, eventID, userID string) (bool, error)
 }
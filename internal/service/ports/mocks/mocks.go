@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/stpnv0/EventBooker/internal/domain"
 	mock "github.com/stretchr/testify/mock"
@@ -38,9 +39,95 @@ func (_m *MockBookingRepo) EXPECT() *MockBookingRepo_Expecter {
 	return &MockBookingRepo_Expecter{mock: &_m.Mock}
 }
 
+// Cancel provides a mock function for the type MockBookingRepo
+func (_mock *MockBookingRepo) Cancel(ctx context.Context, eventID string, userID string, change domain.BookingChange, outbox domain.BookingOutbox) (*domain.Booking, error) {
+	ret := _mock.Called(ctx, eventID, userID, change, outbox)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cancel")
+	}
+
+	var r0 *domain.Booking
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.BookingChange, domain.BookingOutbox) (*domain.Booking, error)); ok {
+		return returnFunc(ctx, eventID, userID, change, outbox)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.BookingChange, domain.BookingOutbox) *domain.Booking); ok {
+		r0 = returnFunc(ctx, eventID, userID, change, outbox)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Booking)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, domain.BookingChange, domain.BookingOutbox) error); ok {
+		r1 = returnFunc(ctx, eventID, userID, change, outbox)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBookingRepo_Cancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cancel'
+type MockBookingRepo_Cancel_Call struct {
+	*mock.Call
+}
+
+// Cancel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+//   - userID string
+//   - change domain.BookingChange
+//   - outbox domain.BookingOutbox
+func (_e *MockBookingRepo_Expecter) Cancel(ctx interface{}, eventID interface{}, userID interface{}, change interface{}, outbox interface{}) *MockBookingRepo_Cancel_Call {
+	return &MockBookingRepo_Cancel_Call{Call: _e.mock.On("Cancel", ctx, eventID, userID, change, outbox)}
+}
+
+func (_c *MockBookingRepo_Cancel_Call) Run(run func(ctx context.Context, eventID string, userID string, change domain.BookingChange, outbox domain.BookingOutbox)) *MockBookingRepo_Cancel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 domain.BookingChange
+		if args[3] != nil {
+			arg3 = args[3].(domain.BookingChange)
+		}
+		var arg4 domain.BookingOutbox
+		if args[4] != nil {
+			arg4 = args[4].(domain.BookingOutbox)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookingRepo_Cancel_Call) Return(booking *domain.Booking, err error) *MockBookingRepo_Cancel_Call {
+	_c.Call.Return(booking, err)
+	return _c
+}
+
+func (_c *MockBookingRepo_Cancel_Call) RunAndReturn(run func(ctx context.Context, eventID string, userID string, change domain.BookingChange, outbox domain.BookingOutbox) (*domain.Booking, error)) *MockBookingRepo_Cancel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CancelExpired provides a mock function for the type MockBookingRepo
-func (_mock *MockBookingRepo) CancelExpired(ctx context.Context) ([]*domain.Booking, error) {
-	ret := _mock.Called(ctx)
+func (_mock *MockBookingRepo) CancelExpired(ctx context.Context, change domain.BookingChange, outbox domain.BookingOutbox) ([]*domain.Booking, error) {
+	ret := _mock.Called(ctx, change, outbox)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CancelExpired")
@@ -48,18 +135,18 @@ func (_mock *MockBookingRepo) CancelExpired(ctx context.Context) ([]*domain.Book
 
 	var r0 []*domain.Booking
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.Booking, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.BookingChange, domain.BookingOutbox) ([]*domain.Booking, error)); ok {
+		return returnFunc(ctx, change, outbox)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.Booking); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.BookingChange, domain.BookingOutbox) []*domain.Booking); ok {
+		r0 = returnFunc(ctx, change, outbox)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*domain.Booking)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.BookingChange, domain.BookingOutbox) error); ok {
+		r1 = returnFunc(ctx, change, outbox)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,18 +160,30 @@ type MockBookingRepo_CancelExpired_Call struct {
 
 // CancelExpired is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockBookingRepo_Expecter) CancelExpired(ctx interface{}) *MockBookingRepo_CancelExpired_Call {
-	return &MockBookingRepo_CancelExpired_Call{Call: _e.mock.On("CancelExpired", ctx)}
+//   - change domain.BookingChange
+//   - outbox domain.BookingOutbox
+func (_e *MockBookingRepo_Expecter) CancelExpired(ctx interface{}, change interface{}, outbox interface{}) *MockBookingRepo_CancelExpired_Call {
+	return &MockBookingRepo_CancelExpired_Call{Call: _e.mock.On("CancelExpired", ctx, change, outbox)}
 }
 
-func (_c *MockBookingRepo_CancelExpired_Call) Run(run func(ctx context.Context)) *MockBookingRepo_CancelExpired_Call {
+func (_c *MockBookingRepo_CancelExpired_Call) Run(run func(ctx context.Context, change domain.BookingChange, outbox domain.BookingOutbox)) *MockBookingRepo_CancelExpired_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 domain.BookingChange
+		if args[1] != nil {
+			arg1 = args[1].(domain.BookingChange)
+		}
+		var arg2 domain.BookingOutbox
+		if args[2] != nil {
+			arg2 = args[2].(domain.BookingOutbox)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -95,26 +194,37 @@ func (_c *MockBookingRepo_CancelExpired_Call) Return(bookings []*domain.Booking,
 	return _c
 }
 
-func (_c *MockBookingRepo_CancelExpired_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.Booking, error)) *MockBookingRepo_CancelExpired_Call {
+func (_c *MockBookingRepo_CancelExpired_Call) RunAndReturn(run func(ctx context.Context, change domain.BookingChange, outbox domain.BookingOutbox) ([]*domain.Booking, error)) *MockBookingRepo_CancelExpired_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // Confirm provides a mock function for the type MockBookingRepo
-func (_mock *MockBookingRepo) Confirm(ctx context.Context, eventID string, userID string) error {
-	ret := _mock.Called(ctx, eventID, userID)
+func (_mock *MockBookingRepo) Confirm(ctx context.Context, eventID string, userID string, change domain.BookingChange, outbox domain.BookingOutbox) (*domain.Booking, error) {
+	ret := _mock.Called(ctx, eventID, userID, change, outbox)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Confirm")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = returnFunc(ctx, eventID, userID)
+	var r0 *domain.Booking
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.BookingChange, domain.BookingOutbox) (*domain.Booking, error)); ok {
+		return returnFunc(ctx, eventID, userID, change, outbox)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.BookingChange, domain.BookingOutbox) *domain.Booking); ok {
+		r0 = returnFunc(ctx, eventID, userID, change, outbox)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Booking)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, domain.BookingChange, domain.BookingOutbox) error); ok {
+		r1 = returnFunc(ctx, eventID, userID, change, outbox)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // MockBookingRepo_Confirm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Confirm'
@@ -126,11 +236,13 @@ type MockBookingRepo_Confirm_Call struct {
 //   - ctx context.Context
 //   - eventID string
 //   - userID string
-func (_e *MockBookingRepo_Expecter) Confirm(ctx interface{}, eventID interface{}, userID interface{}) *MockBookingRepo_Confirm_Call {
-	return &MockBookingRepo_Confirm_Call{Call: _e.mock.On("Confirm", ctx, eventID, userID)}
+//   - change domain.BookingChange
+//   - outbox domain.BookingOutbox
+func (_e *MockBookingRepo_Expecter) Confirm(ctx interface{}, eventID interface{}, userID interface{}, change interface{}, outbox interface{}) *MockBookingRepo_Confirm_Call {
+	return &MockBookingRepo_Confirm_Call{Call: _e.mock.On("Confirm", ctx, eventID, userID, change, outbox)}
 }
 
-func (_c *MockBookingRepo_Confirm_Call) Run(run func(ctx context.Context, eventID string, userID string)) *MockBookingRepo_Confirm_Call {
+func (_c *MockBookingRepo_Confirm_Call) Run(run func(ctx context.Context, eventID string, userID string, change domain.BookingChange, outbox domain.BookingOutbox)) *MockBookingRepo_Confirm_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -144,36 +256,46 @@ func (_c *MockBookingRepo_Confirm_Call) Run(run func(ctx context.Context, eventI
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
+		var arg3 domain.BookingChange
+		if args[3] != nil {
+			arg3 = args[3].(domain.BookingChange)
+		}
+		var arg4 domain.BookingOutbox
+		if args[4] != nil {
+			arg4 = args[4].(domain.BookingOutbox)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
+			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *MockBookingRepo_Confirm_Call) Return(err error) *MockBookingRepo_Confirm_Call {
-	_c.Call.Return(err)
+func (_c *MockBookingRepo_Confirm_Call) Return(booking *domain.Booking, err error) *MockBookingRepo_Confirm_Call {
+	_c.Call.Return(booking, err)
 	return _c
 }
 
-func (_c *MockBookingRepo_Confirm_Call) RunAndReturn(run func(ctx context.Context, eventID string, userID string) error) *MockBookingRepo_Confirm_Call {
+func (_c *MockBookingRepo_Confirm_Call) RunAndReturn(run func(ctx context.Context, eventID string, userID string, change domain.BookingChange, outbox domain.BookingOutbox) (*domain.Booking, error)) *MockBookingRepo_Confirm_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // Create provides a mock function for the type MockBookingRepo
-func (_mock *MockBookingRepo) Create(ctx context.Context, b *domain.Booking) error {
-	ret := _mock.Called(ctx, b)
+func (_mock *MockBookingRepo) Create(ctx context.Context, b *domain.Booking, change domain.BookingChange, quota *domain.BookingQuota, out *domain.Outbox) error {
+	ret := _mock.Called(ctx, b, change, quota, out)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Booking) error); ok {
-		r0 = returnFunc(ctx, b)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Booking, domain.BookingChange, *domain.BookingQuota, *domain.Outbox) error); ok {
+		r0 = returnFunc(ctx, b, change, quota, out)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -188,11 +310,14 @@ type MockBookingRepo_Create_Call struct {
 // Create is a helper method to define mock.On call
 //   - ctx context.Context
 //   - b *domain.Booking
-func (_e *MockBookingRepo_Expecter) Create(ctx interface{}, b interface{}) *MockBookingRepo_Create_Call {
-	return &MockBookingRepo_Create_Call{Call: _e.mock.On("Create", ctx, b)}
+//   - change domain.BookingChange
+//   - quota *domain.BookingQuota
+//   - out *domain.Outbox
+func (_e *MockBookingRepo_Expecter) Create(ctx interface{}, b interface{}, change interface{}, quota interface{}, out interface{}) *MockBookingRepo_Create_Call {
+	return &MockBookingRepo_Create_Call{Call: _e.mock.On("Create", ctx, b, change, quota, out)}
 }
 
-func (_c *MockBookingRepo_Create_Call) Run(run func(ctx context.Context, b *domain.Booking)) *MockBookingRepo_Create_Call {
+func (_c *MockBookingRepo_Create_Call) Run(run func(ctx context.Context, b *domain.Booking, change domain.BookingChange, quota *domain.BookingQuota, out *domain.Outbox)) *MockBookingRepo_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -202,9 +327,24 @@ func (_c *MockBookingRepo_Create_Call) Run(run func(ctx context.Context, b *doma
 		if args[1] != nil {
 			arg1 = args[1].(*domain.Booking)
 		}
+		var arg2 domain.BookingChange
+		if args[2] != nil {
+			arg2 = args[2].(domain.BookingChange)
+		}
+		var arg3 *domain.BookingQuota
+		if args[3] != nil {
+			arg3 = args[3].(*domain.BookingQuota)
+		}
+		var arg4 *domain.Outbox
+		if args[4] != nil {
+			arg4 = args[4].(*domain.Outbox)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
+			arg4,
 		)
 	})
 	return _c
@@ -215,7 +355,7 @@ func (_c *MockBookingRepo_Create_Call) Return(err error) *MockBookingRepo_Create
 	return _c
 }
 
-func (_c *MockBookingRepo_Create_Call) RunAndReturn(run func(ctx context.Context, b *domain.Booking) error) *MockBookingRepo_Create_Call {
+func (_c *MockBookingRepo_Create_Call) RunAndReturn(run func(ctx context.Context, b *domain.Booking, change domain.BookingChange, quota *domain.BookingQuota, out *domain.Outbox) error) *MockBookingRepo_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -294,6 +434,74 @@ func (_c *MockBookingRepo_GetByEventAndUser_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// History provides a mock function for the type MockBookingRepo
+func (_mock *MockBookingRepo) History(ctx context.Context, bookingID string) ([]*domain.BookingHistoryEntry, error) {
+	ret := _mock.Called(ctx, bookingID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for History")
+	}
+
+	var r0 []*domain.BookingHistoryEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*domain.BookingHistoryEntry, error)); ok {
+		return returnFunc(ctx, bookingID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*domain.BookingHistoryEntry); ok {
+		r0 = returnFunc(ctx, bookingID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.BookingHistoryEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, bookingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBookingRepo_History_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'History'
+type MockBookingRepo_History_Call struct {
+	*mock.Call
+}
+
+// History is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bookingID string
+func (_e *MockBookingRepo_Expecter) History(ctx interface{}, bookingID interface{}) *MockBookingRepo_History_Call {
+	return &MockBookingRepo_History_Call{Call: _e.mock.On("History", ctx, bookingID)}
+}
+
+func (_c *MockBookingRepo_History_Call) Run(run func(ctx context.Context, bookingID string)) *MockBookingRepo_History_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookingRepo_History_Call) Return(bookingHistoryEntrys []*domain.BookingHistoryEntry, err error) *MockBookingRepo_History_Call {
+	_c.Call.Return(bookingHistoryEntrys, err)
+	return _c
+}
+
+func (_c *MockBookingRepo_History_Call) RunAndReturn(run func(ctx context.Context, bookingID string) ([]*domain.BookingHistoryEntry, error)) *MockBookingRepo_History_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListByEvent provides a mock function for the type MockBookingRepo
 func (_mock *MockBookingRepo) ListByEvent(ctx context.Context, eventID string) ([]*domain.Booking, error) {
 	ret := _mock.Called(ctx, eventID)
@@ -430,13 +638,82 @@ func (_c *MockBookingRepo_ListByUser_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
-// NewMockEventRepo creates a new instance of MockEventRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// StreamAttendees provides a mock function for the type MockBookingRepo
+func (_mock *MockBookingRepo) StreamAttendees(ctx context.Context, eventID string, statuses []domain.BookingStatus, fn func(*domain.Attendee) error) error {
+	ret := _mock.Called(ctx, eventID, statuses, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamAttendees")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []domain.BookingStatus, func(*domain.Attendee) error) error); ok {
+		r0 = returnFunc(ctx, eventID, statuses, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBookingRepo_StreamAttendees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamAttendees'
+type MockBookingRepo_StreamAttendees_Call struct {
+	*mock.Call
+}
+
+// StreamAttendees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+//   - statuses []domain.BookingStatus
+//   - fn func(*domain.Attendee) error
+func (_e *MockBookingRepo_Expecter) StreamAttendees(ctx interface{}, eventID interface{}, statuses interface{}, fn interface{}) *MockBookingRepo_StreamAttendees_Call {
+	return &MockBookingRepo_StreamAttendees_Call{Call: _e.mock.On("StreamAttendees", ctx, eventID, statuses, fn)}
+}
+
+func (_c *MockBookingRepo_StreamAttendees_Call) Run(run func(ctx context.Context, eventID string, statuses []domain.BookingStatus, fn func(*domain.Attendee) error)) *MockBookingRepo_StreamAttendees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []domain.BookingStatus
+		if args[2] != nil {
+			arg2 = args[2].([]domain.BookingStatus)
+		}
+		var arg3 func(*domain.Attendee) error
+		if args[3] != nil {
+			arg3 = args[3].(func(*domain.Attendee) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookingRepo_StreamAttendees_Call) Return(err error) *MockBookingRepo_StreamAttendees_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBookingRepo_StreamAttendees_Call) RunAndReturn(run func(ctx context.Context, eventID string, statuses []domain.BookingStatus, fn func(*domain.Attendee) error) error) *MockBookingRepo_StreamAttendees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBookingLimitRepo creates a new instance of MockBookingLimitRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockEventRepo(t interface {
+func NewMockBookingLimitRepo(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockEventRepo {
-	mock := &MockEventRepo{}
+}) *MockBookingLimitRepo {
+	mock := &MockBookingLimitRepo{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -444,117 +721,112 @@ func NewMockEventRepo(t interface {
 	return mock
 }
 
-// MockEventRepo is an autogenerated mock type for the EventRepo type
-type MockEventRepo struct {
+// MockBookingLimitRepo is an autogenerated mock type for the BookingLimitRepo type
+type MockBookingLimitRepo struct {
 	mock.Mock
 }
 
-type MockEventRepo_Expecter struct {
+type MockBookingLimitRepo_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockEventRepo) EXPECT() *MockEventRepo_Expecter {
-	return &MockEventRepo_Expecter{mock: &_m.Mock}
+func (_m *MockBookingLimitRepo) EXPECT() *MockBookingLimitRepo_Expecter {
+	return &MockBookingLimitRepo_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function for the type MockEventRepo
-func (_mock *MockEventRepo) Create(ctx context.Context, e *domain.Event) error {
-	ret := _mock.Called(ctx, e)
+// ClearCooldown provides a mock function for the type MockBookingLimitRepo
+func (_mock *MockBookingLimitRepo) ClearCooldown(ctx context.Context, userID string, at time.Time) error {
+	ret := _mock.Called(ctx, userID, at)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for ClearCooldown")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Event) error); ok {
-		r0 = returnFunc(ctx, e)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, userID, at)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockEventRepo_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockEventRepo_Create_Call struct {
+// MockBookingLimitRepo_ClearCooldown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearCooldown'
+type MockBookingLimitRepo_ClearCooldown_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// ClearCooldown is a helper method to define mock.On call
 //   - ctx context.Context
-//   - e *domain.Event
-func (_e *MockEventRepo_Expecter) Create(ctx interface{}, e interface{}) *MockEventRepo_Create_Call {
-	return &MockEventRepo_Create_Call{Call: _e.mock.On("Create", ctx, e)}
+//   - userID string
+//   - at time.Time
+func (_e *MockBookingLimitRepo_Expecter) ClearCooldown(ctx interface{}, userID interface{}, at interface{}) *MockBookingLimitRepo_ClearCooldown_Call {
+	return &MockBookingLimitRepo_ClearCooldown_Call{Call: _e.mock.On("ClearCooldown", ctx, userID, at)}
 }
 
-func (_c *MockEventRepo_Create_Call) Run(run func(ctx context.Context, e *domain.Event)) *MockEventRepo_Create_Call {
+func (_c *MockBookingLimitRepo_ClearCooldown_Call) Run(run func(ctx context.Context, userID string, at time.Time)) *MockBookingLimitRepo_ClearCooldown_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.Event
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*domain.Event)
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockEventRepo_Create_Call) Return(err error) *MockEventRepo_Create_Call {
+func (_c *MockBookingLimitRepo_ClearCooldown_Call) Return(err error) *MockBookingLimitRepo_ClearCooldown_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockEventRepo_Create_Call) RunAndReturn(run func(ctx context.Context, e *domain.Event) error) *MockEventRepo_Create_Call {
+func (_c *MockBookingLimitRepo_ClearCooldown_Call) RunAndReturn(run func(ctx context.Context, userID string, at time.Time) error) *MockBookingLimitRepo_ClearCooldown_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function for the type MockEventRepo
-func (_mock *MockEventRepo) GetByID(ctx context.Context, id string) (*domain.Event, error) {
-	ret := _mock.Called(ctx, id)
+// DeleteOverride provides a mock function for the type MockBookingLimitRepo
+func (_mock *MockBookingLimitRepo) DeleteOverride(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for DeleteOverride")
 	}
 
-	var r0 *domain.Event
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Event, error)); ok {
-		return returnFunc(ctx, id)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Event); ok {
-		r0 = returnFunc(ctx, id)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Event)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, id)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockEventRepo_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockEventRepo_GetByID_Call struct {
+// MockBookingLimitRepo_DeleteOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOverride'
+type MockBookingLimitRepo_DeleteOverride_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
+// DeleteOverride is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *MockEventRepo_Expecter) GetByID(ctx interface{}, id interface{}) *MockEventRepo_GetByID_Call {
-	return &MockEventRepo_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+//   - userID string
+func (_e *MockBookingLimitRepo_Expecter) DeleteOverride(ctx interface{}, userID interface{}) *MockBookingLimitRepo_DeleteOverride_Call {
+	return &MockBookingLimitRepo_DeleteOverride_Call{Call: _e.mock.On("DeleteOverride", ctx, userID)}
 }
 
-func (_c *MockEventRepo_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockEventRepo_GetByID_Call {
+func (_c *MockBookingLimitRepo_DeleteOverride_Call) Run(run func(ctx context.Context, userID string)) *MockBookingLimitRepo_DeleteOverride_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -572,57 +844,57 @@ func (_c *MockEventRepo_GetByID_Call) Run(run func(ctx context.Context, id strin
 	return _c
 }
 
-func (_c *MockEventRepo_GetByID_Call) Return(event *domain.Event, err error) *MockEventRepo_GetByID_Call {
-	_c.Call.Return(event, err)
+func (_c *MockBookingLimitRepo_DeleteOverride_Call) Return(err error) *MockBookingLimitRepo_DeleteOverride_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockEventRepo_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.Event, error)) *MockEventRepo_GetByID_Call {
+func (_c *MockBookingLimitRepo_DeleteOverride_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *MockBookingLimitRepo_DeleteOverride_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDetails provides a mock function for the type MockEventRepo
-func (_mock *MockEventRepo) GetDetails(ctx context.Context, eventID string) (*domain.EventDetails, error) {
-	ret := _mock.Called(ctx, eventID)
+// GetOverride provides a mock function for the type MockBookingLimitRepo
+func (_mock *MockBookingLimitRepo) GetOverride(ctx context.Context, userID string) (*domain.BookingLimitOverride, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDetails")
+		panic("no return value specified for GetOverride")
 	}
 
-	var r0 *domain.EventDetails
+	var r0 *domain.BookingLimitOverride
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.EventDetails, error)); ok {
-		return returnFunc(ctx, eventID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.BookingLimitOverride, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.EventDetails); ok {
-		r0 = returnFunc(ctx, eventID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.BookingLimitOverride); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.EventDetails)
+			r0 = ret.Get(0).(*domain.BookingLimitOverride)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, eventID)
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockEventRepo_GetDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDetails'
-type MockEventRepo_GetDetails_Call struct {
+// MockBookingLimitRepo_GetOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOverride'
+type MockBookingLimitRepo_GetOverride_Call struct {
 	*mock.Call
 }
 
-// GetDetails is a helper method to define mock.On call
+// GetOverride is a helper method to define mock.On call
 //   - ctx context.Context
-//   - eventID string
-func (_e *MockEventRepo_Expecter) GetDetails(ctx interface{}, eventID interface{}) *MockEventRepo_GetDetails_Call {
-	return &MockEventRepo_GetDetails_Call{Call: _e.mock.On("GetDetails", ctx, eventID)}
+//   - userID string
+func (_e *MockBookingLimitRepo_Expecter) GetOverride(ctx interface{}, userID interface{}) *MockBookingLimitRepo_GetOverride_Call {
+	return &MockBookingLimitRepo_GetOverride_Call{Call: _e.mock.On("GetOverride", ctx, userID)}
 }
 
-func (_c *MockEventRepo_GetDetails_Call) Run(run func(ctx context.Context, eventID string)) *MockEventRepo_GetDetails_Call {
+func (_c *MockBookingLimitRepo_GetOverride_Call) Run(run func(ctx context.Context, userID string)) *MockBookingLimitRepo_GetOverride_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -640,85 +912,160 @@ func (_c *MockEventRepo_GetDetails_Call) Run(run func(ctx context.Context, event
 	return _c
 }
 
-func (_c *MockEventRepo_GetDetails_Call) Return(eventDetails *domain.EventDetails, err error) *MockEventRepo_GetDetails_Call {
-	_c.Call.Return(eventDetails, err)
+func (_c *MockBookingLimitRepo_GetOverride_Call) Return(bookingLimitOverride *domain.BookingLimitOverride, err error) *MockBookingLimitRepo_GetOverride_Call {
+	_c.Call.Return(bookingLimitOverride, err)
 	return _c
 }
 
-func (_c *MockEventRepo_GetDetails_Call) RunAndReturn(run func(ctx context.Context, eventID string) (*domain.EventDetails, error)) *MockEventRepo_GetDetails_Call {
+func (_c *MockBookingLimitRepo_GetOverride_Call) RunAndReturn(run func(ctx context.Context, userID string) (*domain.BookingLimitOverride, error)) *MockBookingLimitRepo_GetOverride_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// List provides a mock function for the type MockEventRepo
-func (_mock *MockEventRepo) List(ctx context.Context) ([]*domain.Event, error) {
-	ret := _mock.Called(ctx)
+// SaveOverride provides a mock function for the type MockBookingLimitRepo
+func (_mock *MockBookingLimitRepo) SaveOverride(ctx context.Context, o *domain.BookingLimitOverride) error {
+	ret := _mock.Called(ctx, o)
 
 	if len(ret) == 0 {
-		panic("no return value specified for List")
+		panic("no return value specified for SaveOverride")
 	}
 
-	var r0 []*domain.Event
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.BookingLimitOverride) error); ok {
+		r0 = returnFunc(ctx, o)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBookingLimitRepo_SaveOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveOverride'
+type MockBookingLimitRepo_SaveOverride_Call struct {
+	*mock.Call
+}
+
+// SaveOverride is a helper method to define mock.On call
+//   - ctx context.Context
+//   - o *domain.BookingLimitOverride
+func (_e *MockBookingLimitRepo_Expecter) SaveOverride(ctx interface{}, o interface{}) *MockBookingLimitRepo_SaveOverride_Call {
+	return &MockBookingLimitRepo_SaveOverride_Call{Call: _e.mock.On("SaveOverride", ctx, o)}
+}
+
+func (_c *MockBookingLimitRepo_SaveOverride_Call) Run(run func(ctx context.Context, o *domain.BookingLimitOverride)) *MockBookingLimitRepo_SaveOverride_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.BookingLimitOverride
+		if args[1] != nil {
+			arg1 = args[1].(*domain.BookingLimitOverride)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookingLimitRepo_SaveOverride_Call) Return(err error) *MockBookingLimitRepo_SaveOverride_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBookingLimitRepo_SaveOverride_Call) RunAndReturn(run func(ctx context.Context, o *domain.BookingLimitOverride) error) *MockBookingLimitRepo_SaveOverride_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Usage provides a mock function for the type MockBookingLimitRepo
+func (_mock *MockBookingLimitRepo) Usage(ctx context.Context, userID string, dayStart time.Time, expiredSince time.Time) (*domain.BookingUsage, error) {
+	ret := _mock.Called(ctx, userID, dayStart, expiredSince)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Usage")
+	}
+
+	var r0 *domain.BookingUsage
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.Event, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) (*domain.BookingUsage, error)); ok {
+		return returnFunc(ctx, userID, dayStart, expiredSince)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.Event); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) *domain.BookingUsage); ok {
+		r0 = returnFunc(ctx, userID, dayStart, expiredSince)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.Event)
+			r0 = ret.Get(0).(*domain.BookingUsage)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, dayStart, expiredSince)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockEventRepo_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
-type MockEventRepo_List_Call struct {
+// MockBookingLimitRepo_Usage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Usage'
+type MockBookingLimitRepo_Usage_Call struct {
 	*mock.Call
 }
 
-// List is a helper method to define mock.On call
+// Usage is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockEventRepo_Expecter) List(ctx interface{}) *MockEventRepo_List_Call {
-	return &MockEventRepo_List_Call{Call: _e.mock.On("List", ctx)}
+//   - userID string
+//   - dayStart time.Time
+//   - expiredSince time.Time
+func (_e *MockBookingLimitRepo_Expecter) Usage(ctx interface{}, userID interface{}, dayStart interface{}, expiredSince interface{}) *MockBookingLimitRepo_Usage_Call {
+	return &MockBookingLimitRepo_Usage_Call{Call: _e.mock.On("Usage", ctx, userID, dayStart, expiredSince)}
 }
 
-func (_c *MockEventRepo_List_Call) Run(run func(ctx context.Context)) *MockEventRepo_List_Call {
+func (_c *MockBookingLimitRepo_Usage_Call) Run(run func(ctx context.Context, userID string, dayStart time.Time, expiredSince time.Time)) *MockBookingLimitRepo_Usage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockEventRepo_List_Call) Return(events []*domain.Event, err error) *MockEventRepo_List_Call {
-	_c.Call.Return(events, err)
+func (_c *MockBookingLimitRepo_Usage_Call) Return(bookingUsage *domain.BookingUsage, err error) *MockBookingLimitRepo_Usage_Call {
+	_c.Call.Return(bookingUsage, err)
 	return _c
 }
 
-func (_c *MockEventRepo_List_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.Event, error)) *MockEventRepo_List_Call {
+func (_c *MockBookingLimitRepo_Usage_Call) RunAndReturn(run func(ctx context.Context, userID string, dayStart time.Time, expiredSince time.Time) (*domain.BookingUsage, error)) *MockBookingLimitRepo_Usage_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockBookingNotifier creates a new instance of MockBookingNotifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockBookingLimiter creates a new instance of MockBookingLimiter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockBookingNotifier(t interface {
+func NewMockBookingLimiter(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockBookingNotifier {
-	mock := &MockBookingNotifier{}
+}) *MockBookingLimiter {
+	mock := &MockBookingLimiter{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -726,155 +1073,223 @@ func NewMockBookingNotifier(t interface {
 	return mock
 }
 
-// MockBookingNotifier is an autogenerated mock type for the BookingNotifier type
-type MockBookingNotifier struct {
+// MockBookingLimiter is an autogenerated mock type for the BookingLimiter type
+type MockBookingLimiter struct {
 	mock.Mock
 }
 
-type MockBookingNotifier_Expecter struct {
+type MockBookingLimiter_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockBookingNotifier) EXPECT() *MockBookingNotifier_Expecter {
-	return &MockBookingNotifier_Expecter{mock: &_m.Mock}
+func (_m *MockBookingLimiter) EXPECT() *MockBookingLimiter_Expecter {
+	return &MockBookingLimiter_Expecter{mock: &_m.Mock}
 }
 
-// NotifyBookingCancelled provides a mock function for the type MockBookingNotifier
-func (_mock *MockBookingNotifier) NotifyBookingCancelled(ctx context.Context, user *domain.User, event *domain.Event) {
-	_mock.Called(ctx, user, event)
-	return
+// Quota provides a mock function for the type MockBookingLimiter
+func (_mock *MockBookingLimiter) Quota(ctx context.Context, userID string) (*domain.BookingQuota, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Quota")
+	}
+
+	var r0 *domain.BookingQuota
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.BookingQuota, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.BookingQuota); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.BookingQuota)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockBookingNotifier_NotifyBookingCancelled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyBookingCancelled'
-type MockBookingNotifier_NotifyBookingCancelled_Call struct {
+// MockBookingLimiter_Quota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Quota'
+type MockBookingLimiter_Quota_Call struct {
 	*mock.Call
 }
 
-// NotifyBookingCancelled is a helper method to define mock.On call
+// Quota is a helper method to define mock.On call
 //   - ctx context.Context
-//   - user *domain.User
-//   - event *domain.Event
-func (_e *MockBookingNotifier_Expecter) NotifyBookingCancelled(ctx interface{}, user interface{}, event interface{}) *MockBookingNotifier_NotifyBookingCancelled_Call {
-	return &MockBookingNotifier_NotifyBookingCancelled_Call{Call: _e.mock.On("NotifyBookingCancelled", ctx, user, event)}
+//   - userID string
+func (_e *MockBookingLimiter_Expecter) Quota(ctx interface{}, userID interface{}) *MockBookingLimiter_Quota_Call {
+	return &MockBookingLimiter_Quota_Call{Call: _e.mock.On("Quota", ctx, userID)}
 }
 
-func (_c *MockBookingNotifier_NotifyBookingCancelled_Call) Run(run func(ctx context.Context, user *domain.User, event *domain.Event)) *MockBookingNotifier_NotifyBookingCancelled_Call {
+func (_c *MockBookingLimiter_Quota_Call) Run(run func(ctx context.Context, userID string)) *MockBookingLimiter_Quota_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.User
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*domain.User)
-		}
-		var arg2 *domain.Event
-		if args[2] != nil {
-			arg2 = args[2].(*domain.Event)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockBookingNotifier_NotifyBookingCancelled_Call) Return() *MockBookingNotifier_NotifyBookingCancelled_Call {
-	_c.Call.Return()
+func (_c *MockBookingLimiter_Quota_Call) Return(bookingQuota *domain.BookingQuota, err error) *MockBookingLimiter_Quota_Call {
+	_c.Call.Return(bookingQuota, err)
 	return _c
 }
 
-func (_c *MockBookingNotifier_NotifyBookingCancelled_Call) RunAndReturn(run func(ctx context.Context, user *domain.User, event *domain.Event)) *MockBookingNotifier_NotifyBookingCancelled_Call {
-	_c.Run(run)
+func (_c *MockBookingLimiter_Quota_Call) RunAndReturn(run func(ctx context.Context, userID string) (*domain.BookingQuota, error)) *MockBookingLimiter_Quota_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// NotifyBookingConfirmed provides a mock function for the type MockBookingNotifier
-func (_mock *MockBookingNotifier) NotifyBookingConfirmed(ctx context.Context, user *domain.User, event *domain.Event) {
-	_mock.Called(ctx, user, event)
-	return
+// NewMockCalendarTokenRepo creates a new instance of MockCalendarTokenRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCalendarTokenRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCalendarTokenRepo {
+	mock := &MockCalendarTokenRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
 }
 
-// MockBookingNotifier_NotifyBookingConfirmed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyBookingConfirmed'
-type MockBookingNotifier_NotifyBookingConfirmed_Call struct {
+// MockCalendarTokenRepo is an autogenerated mock type for the CalendarTokenRepo type
+type MockCalendarTokenRepo struct {
+	mock.Mock
+}
+
+type MockCalendarTokenRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCalendarTokenRepo) EXPECT() *MockCalendarTokenRepo_Expecter {
+	return &MockCalendarTokenRepo_Expecter{mock: &_m.Mock}
+}
+
+// GetHash provides a mock function for the type MockCalendarTokenRepo
+func (_mock *MockCalendarTokenRepo) GetHash(ctx context.Context, userID string) (string, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHash")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCalendarTokenRepo_GetHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHash'
+type MockCalendarTokenRepo_GetHash_Call struct {
 	*mock.Call
 }
 
-// NotifyBookingConfirmed is a helper method to define mock.On call
+// GetHash is a helper method to define mock.On call
 //   - ctx context.Context
-//   - user *domain.User
-//   - event *domain.Event
-func (_e *MockBookingNotifier_Expecter) NotifyBookingConfirmed(ctx interface{}, user interface{}, event interface{}) *MockBookingNotifier_NotifyBookingConfirmed_Call {
-	return &MockBookingNotifier_NotifyBookingConfirmed_Call{Call: _e.mock.On("NotifyBookingConfirmed", ctx, user, event)}
+//   - userID string
+func (_e *MockCalendarTokenRepo_Expecter) GetHash(ctx interface{}, userID interface{}) *MockCalendarTokenRepo_GetHash_Call {
+	return &MockCalendarTokenRepo_GetHash_Call{Call: _e.mock.On("GetHash", ctx, userID)}
 }
 
-func (_c *MockBookingNotifier_NotifyBookingConfirmed_Call) Run(run func(ctx context.Context, user *domain.User, event *domain.Event)) *MockBookingNotifier_NotifyBookingConfirmed_Call {
+func (_c *MockCalendarTokenRepo_GetHash_Call) Run(run func(ctx context.Context, userID string)) *MockCalendarTokenRepo_GetHash_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.User
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*domain.User)
-		}
-		var arg2 *domain.Event
-		if args[2] != nil {
-			arg2 = args[2].(*domain.Event)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockBookingNotifier_NotifyBookingConfirmed_Call) Return() *MockBookingNotifier_NotifyBookingConfirmed_Call {
-	_c.Call.Return()
+func (_c *MockCalendarTokenRepo_GetHash_Call) Return(s string, err error) *MockCalendarTokenRepo_GetHash_Call {
+	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *MockBookingNotifier_NotifyBookingConfirmed_Call) RunAndReturn(run func(ctx context.Context, user *domain.User, event *domain.Event)) *MockBookingNotifier_NotifyBookingConfirmed_Call {
-	_c.Run(run)
+func (_c *MockCalendarTokenRepo_GetHash_Call) RunAndReturn(run func(ctx context.Context, userID string) (string, error)) *MockCalendarTokenRepo_GetHash_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// NotifyBookingCreated provides a mock function for the type MockBookingNotifier
-func (_mock *MockBookingNotifier) NotifyBookingCreated(ctx context.Context, user *domain.User, event *domain.Event) {
-	_mock.Called(ctx, user, event)
-	return
+// Save provides a mock function for the type MockCalendarTokenRepo
+func (_mock *MockCalendarTokenRepo) Save(ctx context.Context, userID string, tokenHash string) error {
+	ret := _mock.Called(ctx, userID, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, tokenHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
 }
 
-// MockBookingNotifier_NotifyBookingCreated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyBookingCreated'
-type MockBookingNotifier_NotifyBookingCreated_Call struct {
+// MockCalendarTokenRepo_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MockCalendarTokenRepo_Save_Call struct {
 	*mock.Call
 }
 
-// NotifyBookingCreated is a helper method to define mock.On call
+// Save is a helper method to define mock.On call
 //   - ctx context.Context
-//   - user *domain.User
-//   - event *domain.Event
-func (_e *MockBookingNotifier_Expecter) NotifyBookingCreated(ctx interface{}, user interface{}, event interface{}) *MockBookingNotifier_NotifyBookingCreated_Call {
-	return &MockBookingNotifier_NotifyBookingCreated_Call{Call: _e.mock.On("NotifyBookingCreated", ctx, user, event)}
+//   - userID string
+//   - tokenHash string
+func (_e *MockCalendarTokenRepo_Expecter) Save(ctx interface{}, userID interface{}, tokenHash interface{}) *MockCalendarTokenRepo_Save_Call {
+	return &MockCalendarTokenRepo_Save_Call{Call: _e.mock.On("Save", ctx, userID, tokenHash)}
 }
 
-func (_c *MockBookingNotifier_NotifyBookingCreated_Call) Run(run func(ctx context.Context, user *domain.User, event *domain.Event)) *MockBookingNotifier_NotifyBookingCreated_Call {
+func (_c *MockCalendarTokenRepo_Save_Call) Run(run func(ctx context.Context, userID string, tokenHash string)) *MockCalendarTokenRepo_Save_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.User
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*domain.User)
+			arg1 = args[1].(string)
 		}
-		var arg2 *domain.Event
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].(*domain.Event)
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
@@ -885,23 +1300,23 @@ func (_c *MockBookingNotifier_NotifyBookingCreated_Call) Run(run func(ctx contex
 	return _c
 }
 
-func (_c *MockBookingNotifier_NotifyBookingCreated_Call) Return() *MockBookingNotifier_NotifyBookingCreated_Call {
-	_c.Call.Return()
+func (_c *MockCalendarTokenRepo_Save_Call) Return(err error) *MockCalendarTokenRepo_Save_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockBookingNotifier_NotifyBookingCreated_Call) RunAndReturn(run func(ctx context.Context, user *domain.User, event *domain.Event)) *MockBookingNotifier_NotifyBookingCreated_Call {
-	_c.Run(run)
+func (_c *MockCalendarTokenRepo_Save_Call) RunAndReturn(run func(ctx context.Context, userID string, tokenHash string) error) *MockCalendarTokenRepo_Save_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockUserRepo creates a new instance of MockUserRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCategoryRepo creates a new instance of MockCategoryRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockUserRepo(t interface {
+func NewMockCategoryRepo(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockUserRepo {
-	mock := &MockUserRepo{}
+}) *MockCategoryRepo {
+	mock := &MockCategoryRepo{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -909,57 +1324,57 @@ func NewMockUserRepo(t interface {
 	return mock
 }
 
-// MockUserRepo is an autogenerated mock type for the UserRepo type
-type MockUserRepo struct {
+// MockCategoryRepo is an autogenerated mock type for the CategoryRepo type
+type MockCategoryRepo struct {
 	mock.Mock
 }
 
-type MockUserRepo_Expecter struct {
+type MockCategoryRepo_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockUserRepo) EXPECT() *MockUserRepo_Expecter {
-	return &MockUserRepo_Expecter{mock: &_m.Mock}
+func (_m *MockCategoryRepo) EXPECT() *MockCategoryRepo_Expecter {
+	return &MockCategoryRepo_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function for the type MockUserRepo
-func (_mock *MockUserRepo) Create(ctx context.Context, user *domain.User) error {
-	ret := _mock.Called(ctx, user)
+// Create provides a mock function for the type MockCategoryRepo
+func (_mock *MockCategoryRepo) Create(ctx context.Context, c *domain.Category) error {
+	ret := _mock.Called(ctx, c)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User) error); ok {
-		r0 = returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Category) error); ok {
+		r0 = returnFunc(ctx, c)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockUserRepo_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockUserRepo_Create_Call struct {
+// MockCategoryRepo_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockCategoryRepo_Create_Call struct {
 	*mock.Call
 }
 
 // Create is a helper method to define mock.On call
 //   - ctx context.Context
-//   - user *domain.User
-func (_e *MockUserRepo_Expecter) Create(ctx interface{}, user interface{}) *MockUserRepo_Create_Call {
-	return &MockUserRepo_Create_Call{Call: _e.mock.On("Create", ctx, user)}
+//   - c *domain.Category
+func (_e *MockCategoryRepo_Expecter) Create(ctx interface{}, c interface{}) *MockCategoryRepo_Create_Call {
+	return &MockCategoryRepo_Create_Call{Call: _e.mock.On("Create", ctx, c)}
 }
 
-func (_c *MockUserRepo_Create_Call) Run(run func(ctx context.Context, user *domain.User)) *MockUserRepo_Create_Call {
+func (_c *MockCategoryRepo_Create_Call) Run(run func(ctx context.Context, c *domain.Category)) *MockCategoryRepo_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.User
+		var arg1 *domain.Category
 		if args[1] != nil {
-			arg1 = args[1].(*domain.User)
+			arg1 = args[1].(*domain.Category)
 		}
 		run(
 			arg0,
@@ -969,34 +1384,154 @@ func (_c *MockUserRepo_Create_Call) Run(run func(ctx context.Context, user *doma
 	return _c
 }
 
-func (_c *MockUserRepo_Create_Call) Return(err error) *MockUserRepo_Create_Call {
+func (_c *MockCategoryRepo_Create_Call) Return(err error) *MockCategoryRepo_Create_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockUserRepo_Create_Call) RunAndReturn(run func(ctx context.Context, user *domain.User) error) *MockUserRepo_Create_Call {
+func (_c *MockCategoryRepo_Create_Call) RunAndReturn(run func(ctx context.Context, c *domain.Category) error) *MockCategoryRepo_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function for the type MockUserRepo
-func (_mock *MockUserRepo) GetByID(ctx context.Context, id string) (*domain.User, error) {
+// Delete provides a mock function for the type MockCategoryRepo
+func (_mock *MockCategoryRepo) Delete(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCategoryRepo_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockCategoryRepo_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockCategoryRepo_Expecter) Delete(ctx interface{}, id interface{}) *MockCategoryRepo_Delete_Call {
+	return &MockCategoryRepo_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockCategoryRepo_Delete_Call) Run(run func(ctx context.Context, id string)) *MockCategoryRepo_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepo_Delete_Call) Return(err error) *MockCategoryRepo_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCategoryRepo_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockCategoryRepo_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Follow provides a mock function for the type MockCategoryRepo
+func (_mock *MockCategoryRepo) Follow(ctx context.Context, userID string, categoryID string) error {
+	ret := _mock.Called(ctx, userID, categoryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Follow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, categoryID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCategoryRepo_Follow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Follow'
+type MockCategoryRepo_Follow_Call struct {
+	*mock.Call
+}
+
+// Follow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - categoryID string
+func (_e *MockCategoryRepo_Expecter) Follow(ctx interface{}, userID interface{}, categoryID interface{}) *MockCategoryRepo_Follow_Call {
+	return &MockCategoryRepo_Follow_Call{Call: _e.mock.On("Follow", ctx, userID, categoryID)}
+}
+
+func (_c *MockCategoryRepo_Follow_Call) Run(run func(ctx context.Context, userID string, categoryID string)) *MockCategoryRepo_Follow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepo_Follow_Call) Return(err error) *MockCategoryRepo_Follow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCategoryRepo_Follow_Call) RunAndReturn(run func(ctx context.Context, userID string, categoryID string) error) *MockCategoryRepo_Follow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockCategoryRepo
+func (_mock *MockCategoryRepo) GetByID(ctx context.Context, id string) (*domain.Category, error) {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
 	}
 
-	var r0 *domain.User
+	var r0 *domain.Category
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Category, error)); ok {
 		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Category); ok {
 		r0 = returnFunc(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.User)
+			r0 = ret.Get(0).(*domain.Category)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
@@ -1007,19 +1542,19 @@ func (_mock *MockUserRepo) GetByID(ctx context.Context, id string) (*domain.User
 	return r0, r1
 }
 
-// MockUserRepo_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockUserRepo_GetByID_Call struct {
+// MockCategoryRepo_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockCategoryRepo_GetByID_Call struct {
 	*mock.Call
 }
 
 // GetByID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *MockUserRepo_Expecter) GetByID(ctx interface{}, id interface{}) *MockUserRepo_GetByID_Call {
-	return &MockUserRepo_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+func (_e *MockCategoryRepo_Expecter) GetByID(ctx interface{}, id interface{}) *MockCategoryRepo_GetByID_Call {
+	return &MockCategoryRepo_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
 }
 
-func (_c *MockUserRepo_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockUserRepo_GetByID_Call {
+func (_c *MockCategoryRepo_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockCategoryRepo_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1037,57 +1572,119 @@ func (_c *MockUserRepo_GetByID_Call) Run(run func(ctx context.Context, id string
 	return _c
 }
 
-func (_c *MockUserRepo_GetByID_Call) Return(user *domain.User, err error) *MockUserRepo_GetByID_Call {
-	_c.Call.Return(user, err)
+func (_c *MockCategoryRepo_GetByID_Call) Return(category *domain.Category, err error) *MockCategoryRepo_GetByID_Call {
+	_c.Call.Return(category, err)
 	return _c
 }
 
-func (_c *MockUserRepo_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.User, error)) *MockUserRepo_GetByID_Call {
+func (_c *MockCategoryRepo_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.Category, error)) *MockCategoryRepo_GetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByUsername provides a mock function for the type MockUserRepo
-func (_mock *MockUserRepo) GetByUsername(ctx context.Context, username string) (*domain.User, error) {
-	ret := _mock.Called(ctx, username)
+// List provides a mock function for the type MockCategoryRepo
+func (_mock *MockCategoryRepo) List(ctx context.Context) ([]*domain.Category, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByUsername")
+		panic("no return value specified for List")
 	}
 
-	var r0 *domain.User
+	var r0 []*domain.Category
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
-		return returnFunc(ctx, username)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.Category, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
-		r0 = returnFunc(ctx, username)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.Category); ok {
+		r0 = returnFunc(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.User)
+			r0 = ret.Get(0).([]*domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryRepo_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockCategoryRepo_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCategoryRepo_Expecter) List(ctx interface{}) *MockCategoryRepo_List_Call {
+	return &MockCategoryRepo_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockCategoryRepo_List_Call) Run(run func(ctx context.Context)) *MockCategoryRepo_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepo_List_Call) Return(categorys []*domain.Category, err error) *MockCategoryRepo_List_Call {
+	_c.Call.Return(categorys, err)
+	return _c
+}
+
+func (_c *MockCategoryRepo_List_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.Category, error)) *MockCategoryRepo_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFollowed provides a mock function for the type MockCategoryRepo
+func (_mock *MockCategoryRepo) ListFollowed(ctx context.Context, userID string) ([]*domain.Category, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFollowed")
+	}
+
+	var r0 []*domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*domain.Category, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*domain.Category); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Category)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, username)
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserRepo_GetByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUsername'
-type MockUserRepo_GetByUsername_Call struct {
+// MockCategoryRepo_ListFollowed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFollowed'
+type MockCategoryRepo_ListFollowed_Call struct {
 	*mock.Call
 }
 
-// GetByUsername is a helper method to define mock.On call
+// ListFollowed is a helper method to define mock.On call
 //   - ctx context.Context
-//   - username string
-func (_e *MockUserRepo_Expecter) GetByUsername(ctx interface{}, username interface{}) *MockUserRepo_GetByUsername_Call {
-	return &MockUserRepo_GetByUsername_Call{Call: _e.mock.On("GetByUsername", ctx, username)}
+//   - userID string
+func (_e *MockCategoryRepo_Expecter) ListFollowed(ctx interface{}, userID interface{}) *MockCategoryRepo_ListFollowed_Call {
+	return &MockCategoryRepo_ListFollowed_Call{Call: _e.mock.On("ListFollowed", ctx, userID)}
 }
 
-func (_c *MockUserRepo_GetByUsername_Call) Run(run func(ctx context.Context, username string)) *MockUserRepo_GetByUsername_Call {
+func (_c *MockCategoryRepo_ListFollowed_Call) Run(run func(ctx context.Context, userID string)) *MockCategoryRepo_ListFollowed_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1105,74 +1702,5384 @@ func (_c *MockUserRepo_GetByUsername_Call) Run(run func(ctx context.Context, use
 	return _c
 }
 
-func (_c *MockUserRepo_GetByUsername_Call) Return(user *domain.User, err error) *MockUserRepo_GetByUsername_Call {
-	_c.Call.Return(user, err)
+func (_c *MockCategoryRepo_ListFollowed_Call) Return(categorys []*domain.Category, err error) *MockCategoryRepo_ListFollowed_Call {
+	_c.Call.Return(categorys, err)
 	return _c
 }
 
-func (_c *MockUserRepo_GetByUsername_Call) RunAndReturn(run func(ctx context.Context, username string) (*domain.User, error)) *MockUserRepo_GetByUsername_Call {
+func (_c *MockCategoryRepo_ListFollowed_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]*domain.Category, error)) *MockCategoryRepo_ListFollowed_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// List provides a mock function for the type MockUserRepo
-func (_mock *MockUserRepo) List(ctx context.Context) ([]*domain.User, error) {
-	ret := _mock.Called(ctx)
+// ListFollowers provides a mock function for the type MockCategoryRepo
+func (_mock *MockCategoryRepo) ListFollowers(ctx context.Context, categoryIDs []string) ([]*domain.User, error) {
+	ret := _mock.Called(ctx, categoryIDs)
 
 	if len(ret) == 0 {
-		panic("no return value specified for List")
+		panic("no return value specified for ListFollowers")
 	}
 
 	var r0 []*domain.User
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.User, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]*domain.User, error)); ok {
+		return returnFunc(ctx, categoryIDs)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.User); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []*domain.User); ok {
+		r0 = returnFunc(ctx, categoryIDs)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*domain.User)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, categoryIDs)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserRepo_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
-type MockUserRepo_List_Call struct {
+// MockCategoryRepo_ListFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFollowers'
+type MockCategoryRepo_ListFollowers_Call struct {
 	*mock.Call
 }
 
-// List is a helper method to define mock.On call
+// ListFollowers is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockUserRepo_Expecter) List(ctx interface{}) *MockUserRepo_List_Call {
-	return &MockUserRepo_List_Call{Call: _e.mock.On("List", ctx)}
+//   - categoryIDs []string
+func (_e *MockCategoryRepo_Expecter) ListFollowers(ctx interface{}, categoryIDs interface{}) *MockCategoryRepo_ListFollowers_Call {
+	return &MockCategoryRepo_ListFollowers_Call{Call: _e.mock.On("ListFollowers", ctx, categoryIDs)}
 }
 
-func (_c *MockUserRepo_List_Call) Run(run func(ctx context.Context)) *MockUserRepo_List_Call {
+func (_c *MockCategoryRepo_ListFollowers_Call) Run(run func(ctx context.Context, categoryIDs []string)) *MockCategoryRepo_ListFollowers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockUserRepo_List_Call) Return(users []*domain.User, err error) *MockUserRepo_List_Call {
+func (_c *MockCategoryRepo_ListFollowers_Call) Return(users []*domain.User, err error) *MockCategoryRepo_ListFollowers_Call {
 	_c.Call.Return(users, err)
 	return _c
 }
 
-func (_c *MockUserRepo_List_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.User, error)) *MockUserRepo_List_Call {
+func (_c *MockCategoryRepo_ListFollowers_Call) RunAndReturn(run func(ctx context.Context, categoryIDs []string) ([]*domain.User, error)) *MockCategoryRepo_ListFollowers_Call {
 	_c.Call.Return(run)
 	return _c
 }
+
+// Unfollow provides a mock function for the type MockCategoryRepo
+func (_mock *MockCategoryRepo) Unfollow(ctx context.Context, userID string, categoryID string) error {
+	ret := _mock.Called(ctx, userID, categoryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unfollow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, categoryID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCategoryRepo_Unfollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unfollow'
+type MockCategoryRepo_Unfollow_Call struct {
+	*mock.Call
+}
+
+// Unfollow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - categoryID string
+func (_e *MockCategoryRepo_Expecter) Unfollow(ctx interface{}, userID interface{}, categoryID interface{}) *MockCategoryRepo_Unfollow_Call {
+	return &MockCategoryRepo_Unfollow_Call{Call: _e.mock.On("Unfollow", ctx, userID, categoryID)}
+}
+
+func (_c *MockCategoryRepo_Unfollow_Call) Run(run func(ctx context.Context, userID string, categoryID string)) *MockCategoryRepo_Unfollow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepo_Unfollow_Call) Return(err error) *MockCategoryRepo_Unfollow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCategoryRepo_Unfollow_Call) RunAndReturn(run func(ctx context.Context, userID string, categoryID string) error) *MockCategoryRepo_Unfollow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockCategoryRepo
+func (_mock *MockCategoryRepo) Update(ctx context.Context, c *domain.Category) error {
+	ret := _mock.Called(ctx, c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Category) error); ok {
+		r0 = returnFunc(ctx, c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCategoryRepo_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockCategoryRepo_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - c *domain.Category
+func (_e *MockCategoryRepo_Expecter) Update(ctx interface{}, c interface{}) *MockCategoryRepo_Update_Call {
+	return &MockCategoryRepo_Update_Call{Call: _e.mock.On("Update", ctx, c)}
+}
+
+func (_c *MockCategoryRepo_Update_Call) Run(run func(ctx context.Context, c *domain.Category)) *MockCategoryRepo_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Category
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Category)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepo_Update_Call) Return(err error) *MockCategoryRepo_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCategoryRepo_Update_Call) RunAndReturn(run func(ctx context.Context, c *domain.Category) error) *MockCategoryRepo_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEventRepo creates a new instance of MockEventRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEventRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEventRepo {
+	mock := &MockEventRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockEventRepo is an autogenerated mock type for the EventRepo type
+type MockEventRepo struct {
+	mock.Mock
+}
+
+type MockEventRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEventRepo) EXPECT() *MockEventRepo_Expecter {
+	return &MockEventRepo_Expecter{mock: &_m.Mock}
+}
+
+// Archive provides a mock function for the type MockEventRepo
+func (_mock *MockEventRepo) Archive(ctx context.Context, id string, at time.Time) error {
+	ret := _mock.Called(ctx, id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Archive")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEventRepo_Archive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Archive'
+type MockEventRepo_Archive_Call struct {
+	*mock.Call
+}
+
+// Archive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - at time.Time
+func (_e *MockEventRepo_Expecter) Archive(ctx interface{}, id interface{}, at interface{}) *MockEventRepo_Archive_Call {
+	return &MockEventRepo_Archive_Call{Call: _e.mock.On("Archive", ctx, id, at)}
+}
+
+func (_c *MockEventRepo_Archive_Call) Run(run func(ctx context.Context, id string, at time.Time)) *MockEventRepo_Archive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventRepo_Archive_Call) Return(err error) *MockEventRepo_Archive_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEventRepo_Archive_Call) RunAndReturn(run func(ctx context.Context, id string, at time.Time) error) *MockEventRepo_Archive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Cancel provides a mock function for the type MockEventRepo
+func (_mock *MockEventRepo) Cancel(ctx context.Context, id string, change domain.BookingChange, outbox domain.BookingOutbox) ([]*domain.Booking, error) {
+	ret := _mock.Called(ctx, id, change, outbox)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cancel")
+	}
+
+	var r0 []*domain.Booking
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.BookingChange, domain.BookingOutbox) ([]*domain.Booking, error)); ok {
+		return returnFunc(ctx, id, change, outbox)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.BookingChange, domain.BookingOutbox) []*domain.Booking); ok {
+		r0 = returnFunc(ctx, id, change, outbox)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Booking)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.BookingChange, domain.BookingOutbox) error); ok {
+		r1 = returnFunc(ctx, id, change, outbox)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventRepo_Cancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cancel'
+type MockEventRepo_Cancel_Call struct {
+	*mock.Call
+}
+
+// Cancel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - change domain.BookingChange
+//   - outbox domain.BookingOutbox
+func (_e *MockEventRepo_Expecter) Cancel(ctx interface{}, id interface{}, change interface{}, outbox interface{}) *MockEventRepo_Cancel_Call {
+	return &MockEventRepo_Cancel_Call{Call: _e.mock.On("Cancel", ctx, id, change, outbox)}
+}
+
+func (_c *MockEventRepo_Cancel_Call) Run(run func(ctx context.Context, id string, change domain.BookingChange, outbox domain.BookingOutbox)) *MockEventRepo_Cancel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.BookingChange
+		if args[2] != nil {
+			arg2 = args[2].(domain.BookingChange)
+		}
+		var arg3 domain.BookingOutbox
+		if args[3] != nil {
+			arg3 = args[3].(domain.BookingOutbox)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventRepo_Cancel_Call) Return(bookings []*domain.Booking, err error) *MockEventRepo_Cancel_Call {
+	_c.Call.Return(bookings, err)
+	return _c
+}
+
+func (_c *MockEventRepo_Cancel_Call) RunAndReturn(run func(ctx context.Context, id string, change domain.BookingChange, outbox domain.BookingOutbox) ([]*domain.Booking, error)) *MockEventRepo_Cancel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockEventRepo
+func (_mock *MockEventRepo) Create(ctx context.Context, e *domain.Event) error {
+	ret := _mock.Called(ctx, e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Event) error); ok {
+		r0 = returnFunc(ctx, e)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEventRepo_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockEventRepo_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - e *domain.Event
+func (_e *MockEventRepo_Expecter) Create(ctx interface{}, e interface{}) *MockEventRepo_Create_Call {
+	return &MockEventRepo_Create_Call{Call: _e.mock.On("Create", ctx, e)}
+}
+
+func (_c *MockEventRepo_Create_Call) Run(run func(ctx context.Context, e *domain.Event)) *MockEventRepo_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Event
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Event)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventRepo_Create_Call) Return(err error) *MockEventRepo_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEventRepo_Create_Call) RunAndReturn(run func(ctx context.Context, e *domain.Event) error) *MockEventRepo_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateBatch provides a mock function for the type MockEventRepo
+func (_mock *MockEventRepo) CreateBatch(ctx context.Context, events []*domain.Event, dryRun bool) error {
+	ret := _mock.Called(ctx, events, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBatch")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*domain.Event, bool) error); ok {
+		r0 = returnFunc(ctx, events, dryRun)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEventRepo_CreateBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBatch'
+type MockEventRepo_CreateBatch_Call struct {
+	*mock.Call
+}
+
+// CreateBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - events []*domain.Event
+//   - dryRun bool
+func (_e *MockEventRepo_Expecter) CreateBatch(ctx interface{}, events interface{}, dryRun interface{}) *MockEventRepo_CreateBatch_Call {
+	return &MockEventRepo_CreateBatch_Call{Call: _e.mock.On("CreateBatch", ctx, events, dryRun)}
+}
+
+func (_c *MockEventRepo_CreateBatch_Call) Run(run func(ctx context.Context, events []*domain.Event, dryRun bool)) *MockEventRepo_CreateBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*domain.Event
+		if args[1] != nil {
+			arg1 = args[1].([]*domain.Event)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventRepo_CreateBatch_Call) Return(err error) *MockEventRepo_CreateBatch_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEventRepo_CreateBatch_Call) RunAndReturn(run func(ctx context.Context, events []*domain.Event, dryRun bool) error) *MockEventRepo_CreateBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockEventRepo
+func (_mock *MockEventRepo) GetByID(ctx context.Context, id string) (*domain.Event, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.Event
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Event, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Event); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Event)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventRepo_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockEventRepo_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockEventRepo_Expecter) GetByID(ctx interface{}, id interface{}) *MockEventRepo_GetByID_Call {
+	return &MockEventRepo_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockEventRepo_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockEventRepo_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventRepo_GetByID_Call) Return(event *domain.Event, err error) *MockEventRepo_GetByID_Call {
+	_c.Call.Return(event, err)
+	return _c
+}
+
+func (_c *MockEventRepo_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.Event, error)) *MockEventRepo_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDetails provides a mock function for the type MockEventRepo
+func (_mock *MockEventRepo) GetDetails(ctx context.Context, eventID string) (*domain.EventDetails, error) {
+	ret := _mock.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDetails")
+	}
+
+	var r0 *domain.EventDetails
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.EventDetails, error)); ok {
+		return returnFunc(ctx, eventID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.EventDetails); ok {
+		r0 = returnFunc(ctx, eventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.EventDetails)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventRepo_GetDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDetails'
+type MockEventRepo_GetDetails_Call struct {
+	*mock.Call
+}
+
+// GetDetails is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+func (_e *MockEventRepo_Expecter) GetDetails(ctx interface{}, eventID interface{}) *MockEventRepo_GetDetails_Call {
+	return &MockEventRepo_GetDetails_Call{Call: _e.mock.On("GetDetails", ctx, eventID)}
+}
+
+func (_c *MockEventRepo_GetDetails_Call) Run(run func(ctx context.Context, eventID string)) *MockEventRepo_GetDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventRepo_GetDetails_Call) Return(eventDetails *domain.EventDetails, err error) *MockEventRepo_GetDetails_Call {
+	_c.Call.Return(eventDetails, err)
+	return _c
+}
+
+func (_c *MockEventRepo_GetDetails_Call) RunAndReturn(run func(ctx context.Context, eventID string) (*domain.EventDetails, error)) *MockEventRepo_GetDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Invite provides a mock function for the type MockEventRepo
+func (_mock *MockEventRepo) Invite(ctx context.Context, eventID string, userIDs []string, at time.Time) ([]*domain.User, error) {
+	ret := _mock.Called(ctx, eventID, userIDs, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Invite")
+	}
+
+	var r0 []*domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, time.Time) ([]*domain.User, error)); ok {
+		return returnFunc(ctx, eventID, userIDs, at)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, time.Time) []*domain.User); ok {
+		r0 = returnFunc(ctx, eventID, userIDs, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string, time.Time) error); ok {
+		r1 = returnFunc(ctx, eventID, userIDs, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventRepo_Invite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Invite'
+type MockEventRepo_Invite_Call struct {
+	*mock.Call
+}
+
+// Invite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+//   - userIDs []string
+//   - at time.Time
+func (_e *MockEventRepo_Expecter) Invite(ctx interface{}, eventID interface{}, userIDs interface{}, at interface{}) *MockEventRepo_Invite_Call {
+	return &MockEventRepo_Invite_Call{Call: _e.mock.On("Invite", ctx, eventID, userIDs, at)}
+}
+
+func (_c *MockEventRepo_Invite_Call) Run(run func(ctx context.Context, eventID string, userIDs []string, at time.Time)) *MockEventRepo_Invite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventRepo_Invite_Call) Return(users []*domain.User, err error) *MockEventRepo_Invite_Call {
+	_c.Call.Return(users, err)
+	return _c
+}
+
+func (_c *MockEventRepo_Invite_Call) RunAndReturn(run func(ctx context.Context, eventID string, userIDs []string, at time.Time) ([]*domain.User, error)) *MockEventRepo_Invite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsInvited provides a mock function for the type MockEventRepo
+func (_mock *MockEventRepo) IsInvited(ctx context.Context, eventID string, userID string) (bool, error) {
+	ret := _mock.Called(ctx, eventID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsInvited")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, eventID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, eventID, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, eventID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventRepo_IsInvited_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsInvited'
+type MockEventRepo_IsInvited_Call struct {
+	*mock.Call
+}
+
+// IsInvited is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+//   - userID string
+func (_e *MockEventRepo_Expecter) IsInvited(ctx interface{}, eventID interface{}, userID interface{}) *MockEventRepo_IsInvited_Call {
+	return &MockEventRepo_IsInvited_Call{Call: _e.mock.On("IsInvited", ctx, eventID, userID)}
+}
+
+func (_c *MockEventRepo_IsInvited_Call) Run(run func(ctx context.Context, eventID string, userID string)) *MockEventRepo_IsInvited_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventRepo_IsInvited_Call) Return(b bool, err error) *MockEventRepo_IsInvited_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockEventRepo_IsInvited_Call) RunAndReturn(run func(ctx context.Context, eventID string, userID string) (bool, error)) *MockEventRepo_IsInvited_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockEventRepo
+func (_mock *MockEventRepo) List(ctx context.Context, filter domain.EventFilter) ([]*domain.Event, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*domain.Event
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.EventFilter) ([]*domain.Event, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.EventFilter) []*domain.Event); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Event)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.EventFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventRepo_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockEventRepo_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.EventFilter
+func (_e *MockEventRepo_Expecter) List(ctx interface{}, filter interface{}) *MockEventRepo_List_Call {
+	return &MockEventRepo_List_Call{Call: _e.mock.On("List", ctx, filter)}
+}
+
+func (_c *MockEventRepo_List_Call) Run(run func(ctx context.Context, filter domain.EventFilter)) *MockEventRepo_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.EventFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.EventFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventRepo_List_Call) Return(events []*domain.Event, err error) *MockEventRepo_List_Call {
+	_c.Call.Return(events, err)
+	return _c
+}
+
+func (_c *MockEventRepo_List_Call) RunAndReturn(run func(ctx context.Context, filter domain.EventFilter) ([]*domain.Event, error)) *MockEventRepo_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBookedByUser provides a mock function for the type MockEventRepo
+func (_mock *MockEventRepo) ListBookedByUser(ctx context.Context, userID string, statuses []domain.BookingStatus) ([]*domain.Event, error) {
+	ret := _mock.Called(ctx, userID, statuses)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBookedByUser")
+	}
+
+	var r0 []*domain.Event
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []domain.BookingStatus) ([]*domain.Event, error)); ok {
+		return returnFunc(ctx, userID, statuses)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []domain.BookingStatus) []*domain.Event); ok {
+		r0 = returnFunc(ctx, userID, statuses)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Event)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []domain.BookingStatus) error); ok {
+		r1 = returnFunc(ctx, userID, statuses)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventRepo_ListBookedByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBookedByUser'
+type MockEventRepo_ListBookedByUser_Call struct {
+	*mock.Call
+}
+
+// ListBookedByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - statuses []domain.BookingStatus
+func (_e *MockEventRepo_Expecter) ListBookedByUser(ctx interface{}, userID interface{}, statuses interface{}) *MockEventRepo_ListBookedByUser_Call {
+	return &MockEventRepo_ListBookedByUser_Call{Call: _e.mock.On("ListBookedByUser", ctx, userID, statuses)}
+}
+
+func (_c *MockEventRepo_ListBookedByUser_Call) Run(run func(ctx context.Context, userID string, statuses []domain.BookingStatus)) *MockEventRepo_ListBookedByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []domain.BookingStatus
+		if args[2] != nil {
+			arg2 = args[2].([]domain.BookingStatus)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventRepo_ListBookedByUser_Call) Return(events []*domain.Event, err error) *MockEventRepo_ListBookedByUser_Call {
+	_c.Call.Return(events, err)
+	return _c
+}
+
+func (_c *MockEventRepo_ListBookedByUser_Call) RunAndReturn(run func(ctx context.Context, userID string, statuses []domain.BookingStatus) ([]*domain.Event, error)) *MockEventRepo_ListBookedByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListInvitations provides a mock function for the type MockEventRepo
+func (_mock *MockEventRepo) ListInvitations(ctx context.Context, eventID string) ([]*domain.EventInvitation, error) {
+	ret := _mock.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInvitations")
+	}
+
+	var r0 []*domain.EventInvitation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*domain.EventInvitation, error)); ok {
+		return returnFunc(ctx, eventID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*domain.EventInvitation); ok {
+		r0 = returnFunc(ctx, eventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.EventInvitation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventRepo_ListInvitations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInvitations'
+type MockEventRepo_ListInvitations_Call struct {
+	*mock.Call
+}
+
+// ListInvitations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+func (_e *MockEventRepo_Expecter) ListInvitations(ctx interface{}, eventID interface{}) *MockEventRepo_ListInvitations_Call {
+	return &MockEventRepo_ListInvitations_Call{Call: _e.mock.On("ListInvitations", ctx, eventID)}
+}
+
+func (_c *MockEventRepo_ListInvitations_Call) Run(run func(ctx context.Context, eventID string)) *MockEventRepo_ListInvitations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventRepo_ListInvitations_Call) Return(eventInvitations []*domain.EventInvitation, err error) *MockEventRepo_ListInvitations_Call {
+	_c.Call.Return(eventInvitations, err)
+	return _c
+}
+
+func (_c *MockEventRepo_ListInvitations_Call) RunAndReturn(run func(ctx context.Context, eventID string) ([]*domain.EventInvitation, error)) *MockEventRepo_ListInvitations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListInvitees provides a mock function for the type MockEventRepo
+func (_mock *MockEventRepo) ListInvitees(ctx context.Context, eventID string) ([]*domain.User, error) {
+	ret := _mock.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInvitees")
+	}
+
+	var r0 []*domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*domain.User, error)); ok {
+		return returnFunc(ctx, eventID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*domain.User); ok {
+		r0 = returnFunc(ctx, eventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventRepo_ListInvitees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInvitees'
+type MockEventRepo_ListInvitees_Call struct {
+	*mock.Call
+}
+
+// ListInvitees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+func (_e *MockEventRepo_Expecter) ListInvitees(ctx interface{}, eventID interface{}) *MockEventRepo_ListInvitees_Call {
+	return &MockEventRepo_ListInvitees_Call{Call: _e.mock.On("ListInvitees", ctx, eventID)}
+}
+
+func (_c *MockEventRepo_ListInvitees_Call) Run(run func(ctx context.Context, eventID string)) *MockEventRepo_ListInvitees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventRepo_ListInvitees_Call) Return(users []*domain.User, err error) *MockEventRepo_ListInvitees_Call {
+	_c.Call.Return(users, err)
+	return _c
+}
+
+func (_c *MockEventRepo_ListInvitees_Call) RunAndReturn(run func(ctx context.Context, eventID string) ([]*domain.User, error)) *MockEventRepo_ListInvitees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Publish provides a mock function for the type MockEventRepo
+func (_mock *MockEventRepo) Publish(ctx context.Context, id string, at time.Time) error {
+	ret := _mock.Called(ctx, id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEventRepo_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type MockEventRepo_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - at time.Time
+func (_e *MockEventRepo_Expecter) Publish(ctx interface{}, id interface{}, at interface{}) *MockEventRepo_Publish_Call {
+	return &MockEventRepo_Publish_Call{Call: _e.mock.On("Publish", ctx, id, at)}
+}
+
+func (_c *MockEventRepo_Publish_Call) Run(run func(ctx context.Context, id string, at time.Time)) *MockEventRepo_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventRepo_Publish_Call) Return(err error) *MockEventRepo_Publish_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEventRepo_Publish_Call) RunAndReturn(run func(ctx context.Context, id string, at time.Time) error) *MockEventRepo_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishDue provides a mock function for the type MockEventRepo
+func (_mock *MockEventRepo) PublishDue(ctx context.Context, now time.Time) ([]*domain.Event, error) {
+	ret := _mock.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishDue")
+	}
+
+	var r0 []*domain.Event
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) ([]*domain.Event, error)); ok {
+		return returnFunc(ctx, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) []*domain.Event); ok {
+		r0 = returnFunc(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Event)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventRepo_PublishDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishDue'
+type MockEventRepo_PublishDue_Call struct {
+	*mock.Call
+}
+
+// PublishDue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockEventRepo_Expecter) PublishDue(ctx interface{}, now interface{}) *MockEventRepo_PublishDue_Call {
+	return &MockEventRepo_PublishDue_Call{Call: _e.mock.On("PublishDue", ctx, now)}
+}
+
+func (_c *MockEventRepo_PublishDue_Call) Run(run func(ctx context.Context, now time.Time)) *MockEventRepo_PublishDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventRepo_PublishDue_Call) Return(events []*domain.Event, err error) *MockEventRepo_PublishDue_Call {
+	_c.Call.Return(events, err)
+	return _c
+}
+
+func (_c *MockEventRepo_PublishDue_Call) RunAndReturn(run func(ctx context.Context, now time.Time) ([]*domain.Event, error)) *MockEventRepo_PublishDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeInvitation provides a mock function for the type MockEventRepo
+func (_mock *MockEventRepo) RevokeInvitation(ctx context.Context, eventID string, userID string) error {
+	ret := _mock.Called(ctx, eventID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeInvitation")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, eventID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEventRepo_RevokeInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeInvitation'
+type MockEventRepo_RevokeInvitation_Call struct {
+	*mock.Call
+}
+
+// RevokeInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+//   - userID string
+func (_e *MockEventRepo_Expecter) RevokeInvitation(ctx interface{}, eventID interface{}, userID interface{}) *MockEventRepo_RevokeInvitation_Call {
+	return &MockEventRepo_RevokeInvitation_Call{Call: _e.mock.On("RevokeInvitation", ctx, eventID, userID)}
+}
+
+func (_c *MockEventRepo_RevokeInvitation_Call) Run(run func(ctx context.Context, eventID string, userID string)) *MockEventRepo_RevokeInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventRepo_RevokeInvitation_Call) Return(err error) *MockEventRepo_RevokeInvitation_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEventRepo_RevokeInvitation_Call) RunAndReturn(run func(ctx context.Context, eventID string, userID string) error) *MockEventRepo_RevokeInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function for the type MockEventRepo
+func (_mock *MockEventRepo) Search(ctx context.Context, s domain.EventSearch) ([]*domain.EventSearchResult, error) {
+	ret := _mock.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []*domain.EventSearchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.EventSearch) ([]*domain.EventSearchResult, error)); ok {
+		return returnFunc(ctx, s)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.EventSearch) []*domain.EventSearchResult); ok {
+		r0 = returnFunc(ctx, s)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.EventSearchResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.EventSearch) error); ok {
+		r1 = returnFunc(ctx, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventRepo_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockEventRepo_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - s domain.EventSearch
+func (_e *MockEventRepo_Expecter) Search(ctx interface{}, s interface{}) *MockEventRepo_Search_Call {
+	return &MockEventRepo_Search_Call{Call: _e.mock.On("Search", ctx, s)}
+}
+
+func (_c *MockEventRepo_Search_Call) Run(run func(ctx context.Context, s domain.EventSearch)) *MockEventRepo_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.EventSearch
+		if args[1] != nil {
+			arg1 = args[1].(domain.EventSearch)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventRepo_Search_Call) Return(eventSearchResults []*domain.EventSearchResult, err error) *MockEventRepo_Search_Call {
+	_c.Call.Return(eventSearchResults, err)
+	return _c
+}
+
+func (_c *MockEventRepo_Search_Call) RunAndReturn(run func(ctx context.Context, s domain.EventSearch) ([]*domain.EventSearchResult, error)) *MockEventRepo_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockEventRepo
+func (_mock *MockEventRepo) Update(ctx context.Context, e *domain.Event) error {
+	ret := _mock.Called(ctx, e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Event) error); ok {
+		r0 = returnFunc(ctx, e)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEventRepo_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockEventRepo_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - e *domain.Event
+func (_e *MockEventRepo_Expecter) Update(ctx interface{}, e interface{}) *MockEventRepo_Update_Call {
+	return &MockEventRepo_Update_Call{Call: _e.mock.On("Update", ctx, e)}
+}
+
+func (_c *MockEventRepo_Update_Call) Run(run func(ctx context.Context, e *domain.Event)) *MockEventRepo_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Event
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Event)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventRepo_Update_Call) Return(err error) *MockEventRepo_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEventRepo_Update_Call) RunAndReturn(run func(ctx context.Context, e *domain.Event) error) *MockEventRepo_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotificationRepo creates a new instance of MockNotificationRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationRepo {
+	mock := &MockNotificationRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockNotificationRepo is an autogenerated mock type for the NotificationRepo type
+type MockNotificationRepo struct {
+	mock.Mock
+}
+
+type MockNotificationRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationRepo) EXPECT() *MockNotificationRepo_Expecter {
+	return &MockNotificationRepo_Expecter{mock: &_m.Mock}
+}
+
+// Claim provides a mock function for the type MockNotificationRepo
+func (_mock *MockNotificationRepo) Claim(ctx context.Context, limit int, lease time.Duration) ([]*domain.Notification, error) {
+	ret := _mock.Called(ctx, limit, lease)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Claim")
+	}
+
+	var r0 []*domain.Notification
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, time.Duration) ([]*domain.Notification, error)); ok {
+		return returnFunc(ctx, limit, lease)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, time.Duration) []*domain.Notification); ok {
+		r0 = returnFunc(ctx, limit, lease)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Notification)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, time.Duration) error); ok {
+		r1 = returnFunc(ctx, limit, lease)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationRepo_Claim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Claim'
+type MockNotificationRepo_Claim_Call struct {
+	*mock.Call
+}
+
+// Claim is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - lease time.Duration
+func (_e *MockNotificationRepo_Expecter) Claim(ctx interface{}, limit interface{}, lease interface{}) *MockNotificationRepo_Claim_Call {
+	return &MockNotificationRepo_Claim_Call{Call: _e.mock.On("Claim", ctx, limit, lease)}
+}
+
+func (_c *MockNotificationRepo_Claim_Call) Run(run func(ctx context.Context, limit int, lease time.Duration)) *MockNotificationRepo_Claim_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 time.Duration
+		if args[2] != nil {
+			arg2 = args[2].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepo_Claim_Call) Return(notifications []*domain.Notification, err error) *MockNotificationRepo_Claim_Call {
+	_c.Call.Return(notifications, err)
+	return _c
+}
+
+func (_c *MockNotificationRepo_Claim_Call) RunAndReturn(run func(ctx context.Context, limit int, lease time.Duration) ([]*domain.Notification, error)) *MockNotificationRepo_Claim_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Enqueue provides a mock function for the type MockNotificationRepo
+func (_mock *MockNotificationRepo) Enqueue(ctx context.Context, n *domain.Notification) error {
+	ret := _mock.Called(ctx, n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enqueue")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Notification) error); ok {
+		r0 = returnFunc(ctx, n)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationRepo_Enqueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enqueue'
+type MockNotificationRepo_Enqueue_Call struct {
+	*mock.Call
+}
+
+// Enqueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - n *domain.Notification
+func (_e *MockNotificationRepo_Expecter) Enqueue(ctx interface{}, n interface{}) *MockNotificationRepo_Enqueue_Call {
+	return &MockNotificationRepo_Enqueue_Call{Call: _e.mock.On("Enqueue", ctx, n)}
+}
+
+func (_c *MockNotificationRepo_Enqueue_Call) Run(run func(ctx context.Context, n *domain.Notification)) *MockNotificationRepo_Enqueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Notification
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Notification)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepo_Enqueue_Call) Return(err error) *MockNotificationRepo_Enqueue_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationRepo_Enqueue_Call) RunAndReturn(run func(ctx context.Context, n *domain.Notification) error) *MockNotificationRepo_Enqueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkDelivered provides a mock function for the type MockNotificationRepo
+func (_mock *MockNotificationRepo) MarkDelivered(ctx context.Context, id string, channels []domain.NotificationChannel) error {
+	ret := _mock.Called(ctx, id, channels)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkDelivered")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []domain.NotificationChannel) error); ok {
+		r0 = returnFunc(ctx, id, channels)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationRepo_MarkDelivered_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkDelivered'
+type MockNotificationRepo_MarkDelivered_Call struct {
+	*mock.Call
+}
+
+// MarkDelivered is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - channels []domain.NotificationChannel
+func (_e *MockNotificationRepo_Expecter) MarkDelivered(ctx interface{}, id interface{}, channels interface{}) *MockNotificationRepo_MarkDelivered_Call {
+	return &MockNotificationRepo_MarkDelivered_Call{Call: _e.mock.On("MarkDelivered", ctx, id, channels)}
+}
+
+func (_c *MockNotificationRepo_MarkDelivered_Call) Run(run func(ctx context.Context, id string, channels []domain.NotificationChannel)) *MockNotificationRepo_MarkDelivered_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []domain.NotificationChannel
+		if args[2] != nil {
+			arg2 = args[2].([]domain.NotificationChannel)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepo_MarkDelivered_Call) Return(err error) *MockNotificationRepo_MarkDelivered_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationRepo_MarkDelivered_Call) RunAndReturn(run func(ctx context.Context, id string, channels []domain.NotificationChannel) error) *MockNotificationRepo_MarkDelivered_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkSent provides a mock function for the type MockNotificationRepo
+func (_mock *MockNotificationRepo) MarkSent(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkSent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationRepo_MarkSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSent'
+type MockNotificationRepo_MarkSent_Call struct {
+	*mock.Call
+}
+
+// MarkSent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockNotificationRepo_Expecter) MarkSent(ctx interface{}, id interface{}) *MockNotificationRepo_MarkSent_Call {
+	return &MockNotificationRepo_MarkSent_Call{Call: _e.mock.On("MarkSent", ctx, id)}
+}
+
+func (_c *MockNotificationRepo_MarkSent_Call) Run(run func(ctx context.Context, id string)) *MockNotificationRepo_MarkSent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepo_MarkSent_Call) Return(err error) *MockNotificationRepo_MarkSent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationRepo_MarkSent_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockNotificationRepo_MarkSent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotificationPreferenceRepo creates a new instance of MockNotificationPreferenceRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationPreferenceRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationPreferenceRepo {
+	mock := &MockNotificationPreferenceRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockNotificationPreferenceRepo is an autogenerated mock type for the NotificationPreferenceRepo type
+type MockNotificationPreferenceRepo struct {
+	mock.Mock
+}
+
+type MockNotificationPreferenceRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationPreferenceRepo) EXPECT() *MockNotificationPreferenceRepo_Expecter {
+	return &MockNotificationPreferenceRepo_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockNotificationPreferenceRepo
+func (_mock *MockNotificationPreferenceRepo) Get(ctx context.Context, userID string) (*domain.NotificationPreferences, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *domain.NotificationPreferences
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.NotificationPreferences, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.NotificationPreferences); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationPreferences)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationPreferenceRepo_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockNotificationPreferenceRepo_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockNotificationPreferenceRepo_Expecter) Get(ctx interface{}, userID interface{}) *MockNotificationPreferenceRepo_Get_Call {
+	return &MockNotificationPreferenceRepo_Get_Call{Call: _e.mock.On("Get", ctx, userID)}
+}
+
+func (_c *MockNotificationPreferenceRepo_Get_Call) Run(run func(ctx context.Context, userID string)) *MockNotificationPreferenceRepo_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationPreferenceRepo_Get_Call) Return(notificationPreferences *domain.NotificationPreferences, err error) *MockNotificationPreferenceRepo_Get_Call {
+	_c.Call.Return(notificationPreferences, err)
+	return _c
+}
+
+func (_c *MockNotificationPreferenceRepo_Get_Call) RunAndReturn(run func(ctx context.Context, userID string) (*domain.NotificationPreferences, error)) *MockNotificationPreferenceRepo_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function for the type MockNotificationPreferenceRepo
+func (_mock *MockNotificationPreferenceRepo) Save(ctx context.Context, prefs *domain.NotificationPreferences) error {
+	ret := _mock.Called(ctx, prefs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationPreferences) error); ok {
+		r0 = returnFunc(ctx, prefs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationPreferenceRepo_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MockNotificationPreferenceRepo_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefs *domain.NotificationPreferences
+func (_e *MockNotificationPreferenceRepo_Expecter) Save(ctx interface{}, prefs interface{}) *MockNotificationPreferenceRepo_Save_Call {
+	return &MockNotificationPreferenceRepo_Save_Call{Call: _e.mock.On("Save", ctx, prefs)}
+}
+
+func (_c *MockNotificationPreferenceRepo_Save_Call) Run(run func(ctx context.Context, prefs *domain.NotificationPreferences)) *MockNotificationPreferenceRepo_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.NotificationPreferences
+		if args[1] != nil {
+			arg1 = args[1].(*domain.NotificationPreferences)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationPreferenceRepo_Save_Call) Return(err error) *MockNotificationPreferenceRepo_Save_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationPreferenceRepo_Save_Call) RunAndReturn(run func(ctx context.Context, prefs *domain.NotificationPreferences) error) *MockNotificationPreferenceRepo_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBookingNotifier creates a new instance of MockBookingNotifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBookingNotifier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBookingNotifier {
+	mock := &MockBookingNotifier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBookingNotifier is an autogenerated mock type for the BookingNotifier type
+type MockBookingNotifier struct {
+	mock.Mock
+}
+
+type MockBookingNotifier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBookingNotifier) EXPECT() *MockBookingNotifier_Expecter {
+	return &MockBookingNotifier_Expecter{mock: &_m.Mock}
+}
+
+// NotifyBookingCancelled provides a mock function for the type MockBookingNotifier
+func (_mock *MockBookingNotifier) NotifyBookingCancelled(ctx context.Context, user *domain.User, event *domain.Event) {
+	_mock.Called(ctx, user, event)
+	return
+}
+
+// MockBookingNotifier_NotifyBookingCancelled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyBookingCancelled'
+type MockBookingNotifier_NotifyBookingCancelled_Call struct {
+	*mock.Call
+}
+
+// NotifyBookingCancelled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *domain.User
+//   - event *domain.Event
+func (_e *MockBookingNotifier_Expecter) NotifyBookingCancelled(ctx interface{}, user interface{}, event interface{}) *MockBookingNotifier_NotifyBookingCancelled_Call {
+	return &MockBookingNotifier_NotifyBookingCancelled_Call{Call: _e.mock.On("NotifyBookingCancelled", ctx, user, event)}
+}
+
+func (_c *MockBookingNotifier_NotifyBookingCancelled_Call) Run(run func(ctx context.Context, user *domain.User, event *domain.Event)) *MockBookingNotifier_NotifyBookingCancelled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.User
+		if args[1] != nil {
+			arg1 = args[1].(*domain.User)
+		}
+		var arg2 *domain.Event
+		if args[2] != nil {
+			arg2 = args[2].(*domain.Event)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookingNotifier_NotifyBookingCancelled_Call) Return() *MockBookingNotifier_NotifyBookingCancelled_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockBookingNotifier_NotifyBookingCancelled_Call) RunAndReturn(run func(ctx context.Context, user *domain.User, event *domain.Event)) *MockBookingNotifier_NotifyBookingCancelled_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NotifyBookingConfirmed provides a mock function for the type MockBookingNotifier
+func (_mock *MockBookingNotifier) NotifyBookingConfirmed(ctx context.Context, user *domain.User, event *domain.Event) {
+	_mock.Called(ctx, user, event)
+	return
+}
+
+// MockBookingNotifier_NotifyBookingConfirmed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyBookingConfirmed'
+type MockBookingNotifier_NotifyBookingConfirmed_Call struct {
+	*mock.Call
+}
+
+// NotifyBookingConfirmed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *domain.User
+//   - event *domain.Event
+func (_e *MockBookingNotifier_Expecter) NotifyBookingConfirmed(ctx interface{}, user interface{}, event interface{}) *MockBookingNotifier_NotifyBookingConfirmed_Call {
+	return &MockBookingNotifier_NotifyBookingConfirmed_Call{Call: _e.mock.On("NotifyBookingConfirmed", ctx, user, event)}
+}
+
+func (_c *MockBookingNotifier_NotifyBookingConfirmed_Call) Run(run func(ctx context.Context, user *domain.User, event *domain.Event)) *MockBookingNotifier_NotifyBookingConfirmed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.User
+		if args[1] != nil {
+			arg1 = args[1].(*domain.User)
+		}
+		var arg2 *domain.Event
+		if args[2] != nil {
+			arg2 = args[2].(*domain.Event)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookingNotifier_NotifyBookingConfirmed_Call) Return() *MockBookingNotifier_NotifyBookingConfirmed_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockBookingNotifier_NotifyBookingConfirmed_Call) RunAndReturn(run func(ctx context.Context, user *domain.User, event *domain.Event)) *MockBookingNotifier_NotifyBookingConfirmed_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NotifyBookingCreated provides a mock function for the type MockBookingNotifier
+func (_mock *MockBookingNotifier) NotifyBookingCreated(ctx context.Context, user *domain.User, event *domain.Event) {
+	_mock.Called(ctx, user, event)
+	return
+}
+
+// MockBookingNotifier_NotifyBookingCreated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyBookingCreated'
+type MockBookingNotifier_NotifyBookingCreated_Call struct {
+	*mock.Call
+}
+
+// NotifyBookingCreated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *domain.User
+//   - event *domain.Event
+func (_e *MockBookingNotifier_Expecter) NotifyBookingCreated(ctx interface{}, user interface{}, event interface{}) *MockBookingNotifier_NotifyBookingCreated_Call {
+	return &MockBookingNotifier_NotifyBookingCreated_Call{Call: _e.mock.On("NotifyBookingCreated", ctx, user, event)}
+}
+
+func (_c *MockBookingNotifier_NotifyBookingCreated_Call) Run(run func(ctx context.Context, user *domain.User, event *domain.Event)) *MockBookingNotifier_NotifyBookingCreated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.User
+		if args[1] != nil {
+			arg1 = args[1].(*domain.User)
+		}
+		var arg2 *domain.Event
+		if args[2] != nil {
+			arg2 = args[2].(*domain.Event)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookingNotifier_NotifyBookingCreated_Call) Return() *MockBookingNotifier_NotifyBookingCreated_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockBookingNotifier_NotifyBookingCreated_Call) RunAndReturn(run func(ctx context.Context, user *domain.User, event *domain.Event)) *MockBookingNotifier_NotifyBookingCreated_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NotifyInvitation provides a mock function for the type MockBookingNotifier
+func (_mock *MockBookingNotifier) NotifyInvitation(ctx context.Context, user *domain.User, event *domain.Event) {
+	_mock.Called(ctx, user, event)
+	return
+}
+
+// MockBookingNotifier_NotifyInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyInvitation'
+type MockBookingNotifier_NotifyInvitation_Call struct {
+	*mock.Call
+}
+
+// NotifyInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *domain.User
+//   - event *domain.Event
+func (_e *MockBookingNotifier_Expecter) NotifyInvitation(ctx interface{}, user interface{}, event interface{}) *MockBookingNotifier_NotifyInvitation_Call {
+	return &MockBookingNotifier_NotifyInvitation_Call{Call: _e.mock.On("NotifyInvitation", ctx, user, event)}
+}
+
+func (_c *MockBookingNotifier_NotifyInvitation_Call) Run(run func(ctx context.Context, user *domain.User, event *domain.Event)) *MockBookingNotifier_NotifyInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.User
+		if args[1] != nil {
+			arg1 = args[1].(*domain.User)
+		}
+		var arg2 *domain.Event
+		if args[2] != nil {
+			arg2 = args[2].(*domain.Event)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookingNotifier_NotifyInvitation_Call) Return() *MockBookingNotifier_NotifyInvitation_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockBookingNotifier_NotifyInvitation_Call) RunAndReturn(run func(ctx context.Context, user *domain.User, event *domain.Event)) *MockBookingNotifier_NotifyInvitation_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NotifyNewEvent provides a mock function for the type MockBookingNotifier
+func (_mock *MockBookingNotifier) NotifyNewEvent(ctx context.Context, user *domain.User, event *domain.Event) {
+	_mock.Called(ctx, user, event)
+	return
+}
+
+// MockBookingNotifier_NotifyNewEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyNewEvent'
+type MockBookingNotifier_NotifyNewEvent_Call struct {
+	*mock.Call
+}
+
+// NotifyNewEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *domain.User
+//   - event *domain.Event
+func (_e *MockBookingNotifier_Expecter) NotifyNewEvent(ctx interface{}, user interface{}, event interface{}) *MockBookingNotifier_NotifyNewEvent_Call {
+	return &MockBookingNotifier_NotifyNewEvent_Call{Call: _e.mock.On("NotifyNewEvent", ctx, user, event)}
+}
+
+func (_c *MockBookingNotifier_NotifyNewEvent_Call) Run(run func(ctx context.Context, user *domain.User, event *domain.Event)) *MockBookingNotifier_NotifyNewEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.User
+		if args[1] != nil {
+			arg1 = args[1].(*domain.User)
+		}
+		var arg2 *domain.Event
+		if args[2] != nil {
+			arg2 = args[2].(*domain.Event)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookingNotifier_NotifyNewEvent_Call) Return() *MockBookingNotifier_NotifyNewEvent_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockBookingNotifier_NotifyNewEvent_Call) RunAndReturn(run func(ctx context.Context, user *domain.User, event *domain.Event)) *MockBookingNotifier_NotifyNewEvent_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockChannelNotifier creates a new instance of MockChannelNotifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockChannelNotifier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockChannelNotifier {
+	mock := &MockChannelNotifier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockChannelNotifier is an autogenerated mock type for the ChannelNotifier type
+type MockChannelNotifier struct {
+	mock.Mock
+}
+
+type MockChannelNotifier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockChannelNotifier) EXPECT() *MockChannelNotifier_Expecter {
+	return &MockChannelNotifier_Expecter{mock: &_m.Mock}
+}
+
+// NotifyBookingCancelled provides a mock function for the type MockChannelNotifier
+func (_mock *MockChannelNotifier) NotifyBookingCancelled(ctx context.Context, user *domain.User, event *domain.Event) error {
+	ret := _mock.Called(ctx, user, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyBookingCancelled")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User, *domain.Event) error); ok {
+		r0 = returnFunc(ctx, user, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockChannelNotifier_NotifyBookingCancelled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyBookingCancelled'
+type MockChannelNotifier_NotifyBookingCancelled_Call struct {
+	*mock.Call
+}
+
+// NotifyBookingCancelled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *domain.User
+//   - event *domain.Event
+func (_e *MockChannelNotifier_Expecter) NotifyBookingCancelled(ctx interface{}, user interface{}, event interface{}) *MockChannelNotifier_NotifyBookingCancelled_Call {
+	return &MockChannelNotifier_NotifyBookingCancelled_Call{Call: _e.mock.On("NotifyBookingCancelled", ctx, user, event)}
+}
+
+func (_c *MockChannelNotifier_NotifyBookingCancelled_Call) Run(run func(ctx context.Context, user *domain.User, event *domain.Event)) *MockChannelNotifier_NotifyBookingCancelled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.User
+		if args[1] != nil {
+			arg1 = args[1].(*domain.User)
+		}
+		var arg2 *domain.Event
+		if args[2] != nil {
+			arg2 = args[2].(*domain.Event)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChannelNotifier_NotifyBookingCancelled_Call) Return(err error) *MockChannelNotifier_NotifyBookingCancelled_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockChannelNotifier_NotifyBookingCancelled_Call) RunAndReturn(run func(ctx context.Context, user *domain.User, event *domain.Event) error) *MockChannelNotifier_NotifyBookingCancelled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotifyBookingConfirmed provides a mock function for the type MockChannelNotifier
+func (_mock *MockChannelNotifier) NotifyBookingConfirmed(ctx context.Context, user *domain.User, event *domain.Event) error {
+	ret := _mock.Called(ctx, user, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyBookingConfirmed")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User, *domain.Event) error); ok {
+		r0 = returnFunc(ctx, user, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockChannelNotifier_NotifyBookingConfirmed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyBookingConfirmed'
+type MockChannelNotifier_NotifyBookingConfirmed_Call struct {
+	*mock.Call
+}
+
+// NotifyBookingConfirmed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *domain.User
+//   - event *domain.Event
+func (_e *MockChannelNotifier_Expecter) NotifyBookingConfirmed(ctx interface{}, user interface{}, event interface{}) *MockChannelNotifier_NotifyBookingConfirmed_Call {
+	return &MockChannelNotifier_NotifyBookingConfirmed_Call{Call: _e.mock.On("NotifyBookingConfirmed", ctx, user, event)}
+}
+
+func (_c *MockChannelNotifier_NotifyBookingConfirmed_Call) Run(run func(ctx context.Context, user *domain.User, event *domain.Event)) *MockChannelNotifier_NotifyBookingConfirmed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.User
+		if args[1] != nil {
+			arg1 = args[1].(*domain.User)
+		}
+		var arg2 *domain.Event
+		if args[2] != nil {
+			arg2 = args[2].(*domain.Event)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChannelNotifier_NotifyBookingConfirmed_Call) Return(err error) *MockChannelNotifier_NotifyBookingConfirmed_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockChannelNotifier_NotifyBookingConfirmed_Call) RunAndReturn(run func(ctx context.Context, user *domain.User, event *domain.Event) error) *MockChannelNotifier_NotifyBookingConfirmed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotifyBookingCreated provides a mock function for the type MockChannelNotifier
+func (_mock *MockChannelNotifier) NotifyBookingCreated(ctx context.Context, user *domain.User, event *domain.Event) error {
+	ret := _mock.Called(ctx, user, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyBookingCreated")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User, *domain.Event) error); ok {
+		r0 = returnFunc(ctx, user, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockChannelNotifier_NotifyBookingCreated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyBookingCreated'
+type MockChannelNotifier_NotifyBookingCreated_Call struct {
+	*mock.Call
+}
+
+// NotifyBookingCreated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *domain.User
+//   - event *domain.Event
+func (_e *MockChannelNotifier_Expecter) NotifyBookingCreated(ctx interface{}, user interface{}, event interface{}) *MockChannelNotifier_NotifyBookingCreated_Call {
+	return &MockChannelNotifier_NotifyBookingCreated_Call{Call: _e.mock.On("NotifyBookingCreated", ctx, user, event)}
+}
+
+func (_c *MockChannelNotifier_NotifyBookingCreated_Call) Run(run func(ctx context.Context, user *domain.User, event *domain.Event)) *MockChannelNotifier_NotifyBookingCreated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.User
+		if args[1] != nil {
+			arg1 = args[1].(*domain.User)
+		}
+		var arg2 *domain.Event
+		if args[2] != nil {
+			arg2 = args[2].(*domain.Event)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChannelNotifier_NotifyBookingCreated_Call) Return(err error) *MockChannelNotifier_NotifyBookingCreated_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockChannelNotifier_NotifyBookingCreated_Call) RunAndReturn(run func(ctx context.Context, user *domain.User, event *domain.Event) error) *MockChannelNotifier_NotifyBookingCreated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotifyInvitation provides a mock function for the type MockChannelNotifier
+func (_mock *MockChannelNotifier) NotifyInvitation(ctx context.Context, user *domain.User, event *domain.Event) error {
+	ret := _mock.Called(ctx, user, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyInvitation")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User, *domain.Event) error); ok {
+		r0 = returnFunc(ctx, user, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockChannelNotifier_NotifyInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyInvitation'
+type MockChannelNotifier_NotifyInvitation_Call struct {
+	*mock.Call
+}
+
+// NotifyInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *domain.User
+//   - event *domain.Event
+func (_e *MockChannelNotifier_Expecter) NotifyInvitation(ctx interface{}, user interface{}, event interface{}) *MockChannelNotifier_NotifyInvitation_Call {
+	return &MockChannelNotifier_NotifyInvitation_Call{Call: _e.mock.On("NotifyInvitation", ctx, user, event)}
+}
+
+func (_c *MockChannelNotifier_NotifyInvitation_Call) Run(run func(ctx context.Context, user *domain.User, event *domain.Event)) *MockChannelNotifier_NotifyInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.User
+		if args[1] != nil {
+			arg1 = args[1].(*domain.User)
+		}
+		var arg2 *domain.Event
+		if args[2] != nil {
+			arg2 = args[2].(*domain.Event)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChannelNotifier_NotifyInvitation_Call) Return(err error) *MockChannelNotifier_NotifyInvitation_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockChannelNotifier_NotifyInvitation_Call) RunAndReturn(run func(ctx context.Context, user *domain.User, event *domain.Event) error) *MockChannelNotifier_NotifyInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotifyNewEvent provides a mock function for the type MockChannelNotifier
+func (_mock *MockChannelNotifier) NotifyNewEvent(ctx context.Context, user *domain.User, event *domain.Event) error {
+	ret := _mock.Called(ctx, user, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyNewEvent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User, *domain.Event) error); ok {
+		r0 = returnFunc(ctx, user, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockChannelNotifier_NotifyNewEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyNewEvent'
+type MockChannelNotifier_NotifyNewEvent_Call struct {
+	*mock.Call
+}
+
+// NotifyNewEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *domain.User
+//   - event *domain.Event
+func (_e *MockChannelNotifier_Expecter) NotifyNewEvent(ctx interface{}, user interface{}, event interface{}) *MockChannelNotifier_NotifyNewEvent_Call {
+	return &MockChannelNotifier_NotifyNewEvent_Call{Call: _e.mock.On("NotifyNewEvent", ctx, user, event)}
+}
+
+func (_c *MockChannelNotifier_NotifyNewEvent_Call) Run(run func(ctx context.Context, user *domain.User, event *domain.Event)) *MockChannelNotifier_NotifyNewEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.User
+		if args[1] != nil {
+			arg1 = args[1].(*domain.User)
+		}
+		var arg2 *domain.Event
+		if args[2] != nil {
+			arg2 = args[2].(*domain.Event)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChannelNotifier_NotifyNewEvent_Call) Return(err error) *MockChannelNotifier_NotifyNewEvent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockChannelNotifier_NotifyNewEvent_Call) RunAndReturn(run func(ctx context.Context, user *domain.User, event *domain.Event) error) *MockChannelNotifier_NotifyNewEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotificationRouter creates a new instance of MockNotificationRouter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationRouter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationRouter {
+	mock := &MockNotificationRouter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockNotificationRouter is an autogenerated mock type for the NotificationRouter type
+type MockNotificationRouter struct {
+	mock.Mock
+}
+
+type MockNotificationRouter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationRouter) EXPECT() *MockNotificationRouter_Expecter {
+	return &MockNotificationRouter_Expecter{mock: &_m.Mock}
+}
+
+// Deliver provides a mock function for the type MockNotificationRouter
+func (_mock *MockNotificationRouter) Deliver(ctx context.Context, kind domain.NotificationKind, user *domain.User, event *domain.Event, done []domain.NotificationChannel) ([]domain.NotificationChannel, error) {
+	ret := _mock.Called(ctx, kind, user, event, done)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Deliver")
+	}
+
+	var r0 []domain.NotificationChannel
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.NotificationKind, *domain.User, *domain.Event, []domain.NotificationChannel) ([]domain.NotificationChannel, error)); ok {
+		return returnFunc(ctx, kind, user, event, done)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.NotificationKind, *domain.User, *domain.Event, []domain.NotificationChannel) []domain.NotificationChannel); ok {
+		r0 = returnFunc(ctx, kind, user, event, done)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.NotificationChannel)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.NotificationKind, *domain.User, *domain.Event, []domain.NotificationChannel) error); ok {
+		r1 = returnFunc(ctx, kind, user, event, done)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationRouter_Deliver_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Deliver'
+type MockNotificationRouter_Deliver_Call struct {
+	*mock.Call
+}
+
+// Deliver is a helper method to define mock.On call
+//   - ctx context.Context
+//   - kind domain.NotificationKind
+//   - user *domain.User
+//   - event *domain.Event
+//   - done []domain.NotificationChannel
+func (_e *MockNotificationRouter_Expecter) Deliver(ctx interface{}, kind interface{}, user interface{}, event interface{}, done interface{}) *MockNotificationRouter_Deliver_Call {
+	return &MockNotificationRouter_Deliver_Call{Call: _e.mock.On("Deliver", ctx, kind, user, event, done)}
+}
+
+func (_c *MockNotificationRouter_Deliver_Call) Run(run func(ctx context.Context, kind domain.NotificationKind, user *domain.User, event *domain.Event, done []domain.NotificationChannel)) *MockNotificationRouter_Deliver_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.NotificationKind
+		if args[1] != nil {
+			arg1 = args[1].(domain.NotificationKind)
+		}
+		var arg2 *domain.User
+		if args[2] != nil {
+			arg2 = args[2].(*domain.User)
+		}
+		var arg3 *domain.Event
+		if args[3] != nil {
+			arg3 = args[3].(*domain.Event)
+		}
+		var arg4 []domain.NotificationChannel
+		if args[4] != nil {
+			arg4 = args[4].([]domain.NotificationChannel)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationRouter_Deliver_Call) Return(notificationChannels []domain.NotificationChannel, err error) *MockNotificationRouter_Deliver_Call {
+	_c.Call.Return(notificationChannels, err)
+	return _c
+}
+
+func (_c *MockNotificationRouter_Deliver_Call) RunAndReturn(run func(ctx context.Context, kind domain.NotificationKind, user *domain.User, event *domain.Event, done []domain.NotificationChannel) ([]domain.NotificationChannel, error)) *MockNotificationRouter_Deliver_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReportingRepo creates a new instance of MockReportingRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReportingRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReportingRepo {
+	mock := &MockReportingRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReportingRepo is an autogenerated mock type for the ReportingRepo type
+type MockReportingRepo struct {
+	mock.Mock
+}
+
+type MockReportingRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReportingRepo) EXPECT() *MockReportingRepo_Expecter {
+	return &MockReportingRepo_Expecter{mock: &_m.Mock}
+}
+
+// Conversion provides a mock function for the type MockReportingRepo
+func (_mock *MockReportingRepo) Conversion(ctx context.Context, rng domain.StatsRange) (*domain.ConversionStats, error) {
+	ret := _mock.Called(ctx, rng)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Conversion")
+	}
+
+	var r0 *domain.ConversionStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StatsRange) (*domain.ConversionStats, error)); ok {
+		return returnFunc(ctx, rng)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StatsRange) *domain.ConversionStats); ok {
+		r0 = returnFunc(ctx, rng)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ConversionStats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.StatsRange) error); ok {
+		r1 = returnFunc(ctx, rng)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReportingRepo_Conversion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Conversion'
+type MockReportingRepo_Conversion_Call struct {
+	*mock.Call
+}
+
+// Conversion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rng domain.StatsRange
+func (_e *MockReportingRepo_Expecter) Conversion(ctx interface{}, rng interface{}) *MockReportingRepo_Conversion_Call {
+	return &MockReportingRepo_Conversion_Call{Call: _e.mock.On("Conversion", ctx, rng)}
+}
+
+func (_c *MockReportingRepo_Conversion_Call) Run(run func(ctx context.Context, rng domain.StatsRange)) *MockReportingRepo_Conversion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.StatsRange
+		if args[1] != nil {
+			arg1 = args[1].(domain.StatsRange)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReportingRepo_Conversion_Call) Return(conversionStats *domain.ConversionStats, err error) *MockReportingRepo_Conversion_Call {
+	_c.Call.Return(conversionStats, err)
+	return _c
+}
+
+func (_c *MockReportingRepo_Conversion_Call) RunAndReturn(run func(ctx context.Context, rng domain.StatsRange) (*domain.ConversionStats, error)) *MockReportingRepo_Conversion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DailyBookings provides a mock function for the type MockReportingRepo
+func (_mock *MockReportingRepo) DailyBookings(ctx context.Context, rng domain.StatsRange) ([]domain.DailyBookingStats, error) {
+	ret := _mock.Called(ctx, rng)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DailyBookings")
+	}
+
+	var r0 []domain.DailyBookingStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StatsRange) ([]domain.DailyBookingStats, error)); ok {
+		return returnFunc(ctx, rng)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StatsRange) []domain.DailyBookingStats); ok {
+		r0 = returnFunc(ctx, rng)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.DailyBookingStats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.StatsRange) error); ok {
+		r1 = returnFunc(ctx, rng)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReportingRepo_DailyBookings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DailyBookings'
+type MockReportingRepo_DailyBookings_Call struct {
+	*mock.Call
+}
+
+// DailyBookings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rng domain.StatsRange
+func (_e *MockReportingRepo_Expecter) DailyBookings(ctx interface{}, rng interface{}) *MockReportingRepo_DailyBookings_Call {
+	return &MockReportingRepo_DailyBookings_Call{Call: _e.mock.On("DailyBookings", ctx, rng)}
+}
+
+func (_c *MockReportingRepo_DailyBookings_Call) Run(run func(ctx context.Context, rng domain.StatsRange)) *MockReportingRepo_DailyBookings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.StatsRange
+		if args[1] != nil {
+			arg1 = args[1].(domain.StatsRange)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReportingRepo_DailyBookings_Call) Return(dailyBookingStatss []domain.DailyBookingStats, err error) *MockReportingRepo_DailyBookings_Call {
+	_c.Call.Return(dailyBookingStatss, err)
+	return _c
+}
+
+func (_c *MockReportingRepo_DailyBookings_Call) RunAndReturn(run func(ctx context.Context, rng domain.StatsRange) ([]domain.DailyBookingStats, error)) *MockReportingRepo_DailyBookings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Occupancy provides a mock function for the type MockReportingRepo
+func (_mock *MockReportingRepo) Occupancy(ctx context.Context, rng domain.StatsRange) ([]domain.EventOccupancy, error) {
+	ret := _mock.Called(ctx, rng)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Occupancy")
+	}
+
+	var r0 []domain.EventOccupancy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StatsRange) ([]domain.EventOccupancy, error)); ok {
+		return returnFunc(ctx, rng)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StatsRange) []domain.EventOccupancy); ok {
+		r0 = returnFunc(ctx, rng)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.EventOccupancy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.StatsRange) error); ok {
+		r1 = returnFunc(ctx, rng)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReportingRepo_Occupancy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Occupancy'
+type MockReportingRepo_Occupancy_Call struct {
+	*mock.Call
+}
+
+// Occupancy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rng domain.StatsRange
+func (_e *MockReportingRepo_Expecter) Occupancy(ctx interface{}, rng interface{}) *MockReportingRepo_Occupancy_Call {
+	return &MockReportingRepo_Occupancy_Call{Call: _e.mock.On("Occupancy", ctx, rng)}
+}
+
+func (_c *MockReportingRepo_Occupancy_Call) Run(run func(ctx context.Context, rng domain.StatsRange)) *MockReportingRepo_Occupancy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.StatsRange
+		if args[1] != nil {
+			arg1 = args[1].(domain.StatsRange)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReportingRepo_Occupancy_Call) Return(eventOccupancys []domain.EventOccupancy, err error) *MockReportingRepo_Occupancy_Call {
+	_c.Call.Return(eventOccupancys, err)
+	return _c
+}
+
+func (_c *MockReportingRepo_Occupancy_Call) RunAndReturn(run func(ctx context.Context, rng domain.StatsRange) ([]domain.EventOccupancy, error)) *MockReportingRepo_Occupancy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TopEvents provides a mock function for the type MockReportingRepo
+func (_mock *MockReportingRepo) TopEvents(ctx context.Context, rng domain.StatsRange, limit int) ([]domain.EventOccupancy, error) {
+	ret := _mock.Called(ctx, rng, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TopEvents")
+	}
+
+	var r0 []domain.EventOccupancy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StatsRange, int) ([]domain.EventOccupancy, error)); ok {
+		return returnFunc(ctx, rng, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StatsRange, int) []domain.EventOccupancy); ok {
+		r0 = returnFunc(ctx, rng, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.EventOccupancy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.StatsRange, int) error); ok {
+		r1 = returnFunc(ctx, rng, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReportingRepo_TopEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TopEvents'
+type MockReportingRepo_TopEvents_Call struct {
+	*mock.Call
+}
+
+// TopEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rng domain.StatsRange
+//   - limit int
+func (_e *MockReportingRepo_Expecter) TopEvents(ctx interface{}, rng interface{}, limit interface{}) *MockReportingRepo_TopEvents_Call {
+	return &MockReportingRepo_TopEvents_Call{Call: _e.mock.On("TopEvents", ctx, rng, limit)}
+}
+
+func (_c *MockReportingRepo_TopEvents_Call) Run(run func(ctx context.Context, rng domain.StatsRange, limit int)) *MockReportingRepo_TopEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.StatsRange
+		if args[1] != nil {
+			arg1 = args[1].(domain.StatsRange)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReportingRepo_TopEvents_Call) Return(eventOccupancys []domain.EventOccupancy, err error) *MockReportingRepo_TopEvents_Call {
+	_c.Call.Return(eventOccupancys, err)
+	return _c
+}
+
+func (_c *MockReportingRepo_TopEvents_Call) RunAndReturn(run func(ctx context.Context, rng domain.StatsRange, limit int) ([]domain.EventOccupancy, error)) *MockReportingRepo_TopEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSeriesRepo creates a new instance of MockSeriesRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSeriesRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSeriesRepo {
+	mock := &MockSeriesRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSeriesRepo is an autogenerated mock type for the SeriesRepo type
+type MockSeriesRepo struct {
+	mock.Mock
+}
+
+type MockSeriesRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSeriesRepo) EXPECT() *MockSeriesRepo_Expecter {
+	return &MockSeriesRepo_Expecter{mock: &_m.Mock}
+}
+
+// AddOccurrences provides a mock function for the type MockSeriesRepo
+func (_mock *MockSeriesRepo) AddOccurrences(ctx context.Context, seriesID string, events []*domain.Event, until time.Time) ([]*domain.Event, error) {
+	ret := _mock.Called(ctx, seriesID, events, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddOccurrences")
+	}
+
+	var r0 []*domain.Event
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []*domain.Event, time.Time) ([]*domain.Event, error)); ok {
+		return returnFunc(ctx, seriesID, events, until)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []*domain.Event, time.Time) []*domain.Event); ok {
+		r0 = returnFunc(ctx, seriesID, events, until)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Event)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []*domain.Event, time.Time) error); ok {
+		r1 = returnFunc(ctx, seriesID, events, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSeriesRepo_AddOccurrences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddOccurrences'
+type MockSeriesRepo_AddOccurrences_Call struct {
+	*mock.Call
+}
+
+// AddOccurrences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - seriesID string
+//   - events []*domain.Event
+//   - until time.Time
+func (_e *MockSeriesRepo_Expecter) AddOccurrences(ctx interface{}, seriesID interface{}, events interface{}, until interface{}) *MockSeriesRepo_AddOccurrences_Call {
+	return &MockSeriesRepo_AddOccurrences_Call{Call: _e.mock.On("AddOccurrences", ctx, seriesID, events, until)}
+}
+
+func (_c *MockSeriesRepo_AddOccurrences_Call) Run(run func(ctx context.Context, seriesID string, events []*domain.Event, until time.Time)) *MockSeriesRepo_AddOccurrences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []*domain.Event
+		if args[2] != nil {
+			arg2 = args[2].([]*domain.Event)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepo_AddOccurrences_Call) Return(events1 []*domain.Event, err error) *MockSeriesRepo_AddOccurrences_Call {
+	_c.Call.Return(events1, err)
+	return _c
+}
+
+func (_c *MockSeriesRepo_AddOccurrences_Call) RunAndReturn(run func(ctx context.Context, seriesID string, events []*domain.Event, until time.Time) ([]*domain.Event, error)) *MockSeriesRepo_AddOccurrences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Cancel provides a mock function for the type MockSeriesRepo
+func (_mock *MockSeriesRepo) Cancel(ctx context.Context, id string, change domain.BookingChange, outbox domain.BookingOutbox) ([]*domain.Booking, error) {
+	ret := _mock.Called(ctx, id, change, outbox)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cancel")
+	}
+
+	var r0 []*domain.Booking
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.BookingChange, domain.BookingOutbox) ([]*domain.Booking, error)); ok {
+		return returnFunc(ctx, id, change, outbox)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.BookingChange, domain.BookingOutbox) []*domain.Booking); ok {
+		r0 = returnFunc(ctx, id, change, outbox)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Booking)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.BookingChange, domain.BookingOutbox) error); ok {
+		r1 = returnFunc(ctx, id, change, outbox)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSeriesRepo_Cancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cancel'
+type MockSeriesRepo_Cancel_Call struct {
+	*mock.Call
+}
+
+// Cancel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - change domain.BookingChange
+//   - outbox domain.BookingOutbox
+func (_e *MockSeriesRepo_Expecter) Cancel(ctx interface{}, id interface{}, change interface{}, outbox interface{}) *MockSeriesRepo_Cancel_Call {
+	return &MockSeriesRepo_Cancel_Call{Call: _e.mock.On("Cancel", ctx, id, change, outbox)}
+}
+
+func (_c *MockSeriesRepo_Cancel_Call) Run(run func(ctx context.Context, id string, change domain.BookingChange, outbox domain.BookingOutbox)) *MockSeriesRepo_Cancel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.BookingChange
+		if args[2] != nil {
+			arg2 = args[2].(domain.BookingChange)
+		}
+		var arg3 domain.BookingOutbox
+		if args[3] != nil {
+			arg3 = args[3].(domain.BookingOutbox)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepo_Cancel_Call) Return(bookings []*domain.Booking, err error) *MockSeriesRepo_Cancel_Call {
+	_c.Call.Return(bookings, err)
+	return _c
+}
+
+func (_c *MockSeriesRepo_Cancel_Call) RunAndReturn(run func(ctx context.Context, id string, change domain.BookingChange, outbox domain.BookingOutbox) ([]*domain.Booking, error)) *MockSeriesRepo_Cancel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockSeriesRepo
+func (_mock *MockSeriesRepo) Create(ctx context.Context, s *domain.EventSeries) error {
+	ret := _mock.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.EventSeries) error); ok {
+		r0 = returnFunc(ctx, s)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSeriesRepo_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockSeriesRepo_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - s *domain.EventSeries
+func (_e *MockSeriesRepo_Expecter) Create(ctx interface{}, s interface{}) *MockSeriesRepo_Create_Call {
+	return &MockSeriesRepo_Create_Call{Call: _e.mock.On("Create", ctx, s)}
+}
+
+func (_c *MockSeriesRepo_Create_Call) Run(run func(ctx context.Context, s *domain.EventSeries)) *MockSeriesRepo_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.EventSeries
+		if args[1] != nil {
+			arg1 = args[1].(*domain.EventSeries)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepo_Create_Call) Return(err error) *MockSeriesRepo_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSeriesRepo_Create_Call) RunAndReturn(run func(ctx context.Context, s *domain.EventSeries) error) *MockSeriesRepo_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockSeriesRepo
+func (_mock *MockSeriesRepo) GetByID(ctx context.Context, id string) (*domain.EventSeries, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.EventSeries
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.EventSeries, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.EventSeries); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.EventSeries)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSeriesRepo_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockSeriesRepo_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockSeriesRepo_Expecter) GetByID(ctx interface{}, id interface{}) *MockSeriesRepo_GetByID_Call {
+	return &MockSeriesRepo_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockSeriesRepo_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockSeriesRepo_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepo_GetByID_Call) Return(eventSeries *domain.EventSeries, err error) *MockSeriesRepo_GetByID_Call {
+	_c.Call.Return(eventSeries, err)
+	return _c
+}
+
+func (_c *MockSeriesRepo_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.EventSeries, error)) *MockSeriesRepo_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockSeriesRepo
+func (_mock *MockSeriesRepo) List(ctx context.Context) ([]*domain.EventSeries, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*domain.EventSeries
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.EventSeries, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.EventSeries); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.EventSeries)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSeriesRepo_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockSeriesRepo_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSeriesRepo_Expecter) List(ctx interface{}) *MockSeriesRepo_List_Call {
+	return &MockSeriesRepo_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockSeriesRepo_List_Call) Run(run func(ctx context.Context)) *MockSeriesRepo_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepo_List_Call) Return(eventSeriess []*domain.EventSeries, err error) *MockSeriesRepo_List_Call {
+	_c.Call.Return(eventSeriess, err)
+	return _c
+}
+
+func (_c *MockSeriesRepo_List_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.EventSeries, error)) *MockSeriesRepo_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDue provides a mock function for the type MockSeriesRepo
+func (_mock *MockSeriesRepo) ListDue(ctx context.Context, horizon time.Time) ([]*domain.EventSeries, error) {
+	ret := _mock.Called(ctx, horizon)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDue")
+	}
+
+	var r0 []*domain.EventSeries
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) ([]*domain.EventSeries, error)); ok {
+		return returnFunc(ctx, horizon)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) []*domain.EventSeries); ok {
+		r0 = returnFunc(ctx, horizon)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.EventSeries)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, horizon)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSeriesRepo_ListDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDue'
+type MockSeriesRepo_ListDue_Call struct {
+	*mock.Call
+}
+
+// ListDue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - horizon time.Time
+func (_e *MockSeriesRepo_Expecter) ListDue(ctx interface{}, horizon interface{}) *MockSeriesRepo_ListDue_Call {
+	return &MockSeriesRepo_ListDue_Call{Call: _e.mock.On("ListDue", ctx, horizon)}
+}
+
+func (_c *MockSeriesRepo_ListDue_Call) Run(run func(ctx context.Context, horizon time.Time)) *MockSeriesRepo_ListDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepo_ListDue_Call) Return(eventSeriess []*domain.EventSeries, err error) *MockSeriesRepo_ListDue_Call {
+	_c.Call.Return(eventSeriess, err)
+	return _c
+}
+
+func (_c *MockSeriesRepo_ListDue_Call) RunAndReturn(run func(ctx context.Context, horizon time.Time) ([]*domain.EventSeries, error)) *MockSeriesRepo_ListDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOccurrences provides a mock function for the type MockSeriesRepo
+func (_mock *MockSeriesRepo) ListOccurrences(ctx context.Context, seriesID string) ([]*domain.Event, error) {
+	ret := _mock.Called(ctx, seriesID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOccurrences")
+	}
+
+	var r0 []*domain.Event
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*domain.Event, error)); ok {
+		return returnFunc(ctx, seriesID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*domain.Event); ok {
+		r0 = returnFunc(ctx, seriesID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Event)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, seriesID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSeriesRepo_ListOccurrences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOccurrences'
+type MockSeriesRepo_ListOccurrences_Call struct {
+	*mock.Call
+}
+
+// ListOccurrences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - seriesID string
+func (_e *MockSeriesRepo_Expecter) ListOccurrences(ctx interface{}, seriesID interface{}) *MockSeriesRepo_ListOccurrences_Call {
+	return &MockSeriesRepo_ListOccurrences_Call{Call: _e.mock.On("ListOccurrences", ctx, seriesID)}
+}
+
+func (_c *MockSeriesRepo_ListOccurrences_Call) Run(run func(ctx context.Context, seriesID string)) *MockSeriesRepo_ListOccurrences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepo_ListOccurrences_Call) Return(events []*domain.Event, err error) *MockSeriesRepo_ListOccurrences_Call {
+	_c.Call.Return(events, err)
+	return _c
+}
+
+func (_c *MockSeriesRepo_ListOccurrences_Call) RunAndReturn(run func(ctx context.Context, seriesID string) ([]*domain.Event, error)) *MockSeriesRepo_ListOccurrences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockSeriesRepo
+func (_mock *MockSeriesRepo) Update(ctx context.Context, s *domain.EventSeries) error {
+	ret := _mock.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.EventSeries) error); ok {
+		r0 = returnFunc(ctx, s)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSeriesRepo_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockSeriesRepo_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - s *domain.EventSeries
+func (_e *MockSeriesRepo_Expecter) Update(ctx interface{}, s interface{}) *MockSeriesRepo_Update_Call {
+	return &MockSeriesRepo_Update_Call{Call: _e.mock.On("Update", ctx, s)}
+}
+
+func (_c *MockSeriesRepo_Update_Call) Run(run func(ctx context.Context, s *domain.EventSeries)) *MockSeriesRepo_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.EventSeries
+		if args[1] != nil {
+			arg1 = args[1].(*domain.EventSeries)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepo_Update_Call) Return(err error) *MockSeriesRepo_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSeriesRepo_Update_Call) RunAndReturn(run func(ctx context.Context, s *domain.EventSeries) error) *MockSeriesRepo_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEventAnnouncer creates a new instance of MockEventAnnouncer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEventAnnouncer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEventAnnouncer {
+	mock := &MockEventAnnouncer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockEventAnnouncer is an autogenerated mock type for the EventAnnouncer type
+type MockEventAnnouncer struct {
+	mock.Mock
+}
+
+type MockEventAnnouncer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEventAnnouncer) EXPECT() *MockEventAnnouncer_Expecter {
+	return &MockEventAnnouncer_Expecter{mock: &_m.Mock}
+}
+
+// Announce provides a mock function for the type MockEventAnnouncer
+func (_mock *MockEventAnnouncer) Announce(ctx context.Context, event *domain.Event) {
+	_mock.Called(ctx, event)
+	return
+}
+
+// MockEventAnnouncer_Announce_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Announce'
+type MockEventAnnouncer_Announce_Call struct {
+	*mock.Call
+}
+
+// Announce is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *domain.Event
+func (_e *MockEventAnnouncer_Expecter) Announce(ctx interface{}, event interface{}) *MockEventAnnouncer_Announce_Call {
+	return &MockEventAnnouncer_Announce_Call{Call: _e.mock.On("Announce", ctx, event)}
+}
+
+func (_c *MockEventAnnouncer_Announce_Call) Run(run func(ctx context.Context, event *domain.Event)) *MockEventAnnouncer_Announce_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Event
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Event)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventAnnouncer_Announce_Call) Return() *MockEventAnnouncer_Announce_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockEventAnnouncer_Announce_Call) RunAndReturn(run func(ctx context.Context, event *domain.Event)) *MockEventAnnouncer_Announce_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockTelegramLinkRepo creates a new instance of MockTelegramLinkRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTelegramLinkRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTelegramLinkRepo {
+	mock := &MockTelegramLinkRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTelegramLinkRepo is an autogenerated mock type for the TelegramLinkRepo type
+type MockTelegramLinkRepo struct {
+	mock.Mock
+}
+
+type MockTelegramLinkRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTelegramLinkRepo) EXPECT() *MockTelegramLinkRepo_Expecter {
+	return &MockTelegramLinkRepo_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockTelegramLinkRepo
+func (_mock *MockTelegramLinkRepo) Create(ctx context.Context, tokenHash string, userID string, expiresAt time.Time) error {
+	ret := _mock.Called(ctx, tokenHash, userID, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, tokenHash, userID, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTelegramLinkRepo_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockTelegramLinkRepo_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+//   - userID string
+//   - expiresAt time.Time
+func (_e *MockTelegramLinkRepo_Expecter) Create(ctx interface{}, tokenHash interface{}, userID interface{}, expiresAt interface{}) *MockTelegramLinkRepo_Create_Call {
+	return &MockTelegramLinkRepo_Create_Call{Call: _e.mock.On("Create", ctx, tokenHash, userID, expiresAt)}
+}
+
+func (_c *MockTelegramLinkRepo_Create_Call) Run(run func(ctx context.Context, tokenHash string, userID string, expiresAt time.Time)) *MockTelegramLinkRepo_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTelegramLinkRepo_Create_Call) Return(err error) *MockTelegramLinkRepo_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTelegramLinkRepo_Create_Call) RunAndReturn(run func(ctx context.Context, tokenHash string, userID string, expiresAt time.Time) error) *MockTelegramLinkRepo_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Link provides a mock function for the type MockTelegramLinkRepo
+func (_mock *MockTelegramLinkRepo) Link(ctx context.Context, tokenHash string, chatID int64) (string, error) {
+	ret := _mock.Called(ctx, tokenHash, chatID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Link")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64) (string, error)); ok {
+		return returnFunc(ctx, tokenHash, chatID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64) string); ok {
+		r0 = returnFunc(ctx, tokenHash, chatID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = returnFunc(ctx, tokenHash, chatID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTelegramLinkRepo_Link_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Link'
+type MockTelegramLinkRepo_Link_Call struct {
+	*mock.Call
+}
+
+// Link is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+//   - chatID int64
+func (_e *MockTelegramLinkRepo_Expecter) Link(ctx interface{}, tokenHash interface{}, chatID interface{}) *MockTelegramLinkRepo_Link_Call {
+	return &MockTelegramLinkRepo_Link_Call{Call: _e.mock.On("Link", ctx, tokenHash, chatID)}
+}
+
+func (_c *MockTelegramLinkRepo_Link_Call) Run(run func(ctx context.Context, tokenHash string, chatID int64)) *MockTelegramLinkRepo_Link_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTelegramLinkRepo_Link_Call) Return(userID string, err error) *MockTelegramLinkRepo_Link_Call {
+	_c.Call.Return(userID, err)
+	return _c
+}
+
+func (_c *MockTelegramLinkRepo_Link_Call) RunAndReturn(run func(ctx context.Context, tokenHash string, chatID int64) (string, error)) *MockTelegramLinkRepo_Link_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserRepo creates a new instance of MockUserRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserRepo {
+	mock := &MockUserRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUserRepo is an autogenerated mock type for the UserRepo type
+type MockUserRepo struct {
+	mock.Mock
+}
+
+type MockUserRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserRepo) EXPECT() *MockUserRepo_Expecter {
+	return &MockUserRepo_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) Create(ctx context.Context, user *domain.User) error {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User) error); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepo_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockUserRepo_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *domain.User
+func (_e *MockUserRepo_Expecter) Create(ctx interface{}, user interface{}) *MockUserRepo_Create_Call {
+	return &MockUserRepo_Create_Call{Call: _e.mock.On("Create", ctx, user)}
+}
+
+func (_c *MockUserRepo_Create_Call) Run(run func(ctx context.Context, user *domain.User)) *MockUserRepo_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.User
+		if args[1] != nil {
+			arg1 = args[1].(*domain.User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_Create_Call) Return(err error) *MockUserRepo_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepo_Create_Call) RunAndReturn(run func(ctx context.Context, user *domain.User) error) *MockUserRepo_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) GetByID(ctx context.Context, id string) (*domain.User, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepo_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockUserRepo_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockUserRepo_Expecter) GetByID(ctx interface{}, id interface{}) *MockUserRepo_GetByID_Call {
+	return &MockUserRepo_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockUserRepo_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockUserRepo_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_GetByID_Call) Return(user *domain.User, err error) *MockUserRepo_GetByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepo_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.User, error)) *MockUserRepo_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTelegramChatID provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) GetByTelegramChatID(ctx context.Context, chatID int64) (*domain.User, error) {
+	ret := _mock.Called(ctx, chatID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTelegramChatID")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.User, error)); ok {
+		return returnFunc(ctx, chatID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.User); ok {
+		r0 = returnFunc(ctx, chatID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, chatID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepo_GetByTelegramChatID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTelegramChatID'
+type MockUserRepo_GetByTelegramChatID_Call struct {
+	*mock.Call
+}
+
+// GetByTelegramChatID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatID int64
+func (_e *MockUserRepo_Expecter) GetByTelegramChatID(ctx interface{}, chatID interface{}) *MockUserRepo_GetByTelegramChatID_Call {
+	return &MockUserRepo_GetByTelegramChatID_Call{Call: _e.mock.On("GetByTelegramChatID", ctx, chatID)}
+}
+
+func (_c *MockUserRepo_GetByTelegramChatID_Call) Run(run func(ctx context.Context, chatID int64)) *MockUserRepo_GetByTelegramChatID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_GetByTelegramChatID_Call) Return(user *domain.User, err error) *MockUserRepo_GetByTelegramChatID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepo_GetByTelegramChatID_Call) RunAndReturn(run func(ctx context.Context, chatID int64) (*domain.User, error)) *MockUserRepo_GetByTelegramChatID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUsername provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) GetByUsername(ctx context.Context, username string) (*domain.User, error) {
+	ret := _mock.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUsername")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, username)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+		r0 = returnFunc(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepo_GetByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUsername'
+type MockUserRepo_GetByUsername_Call struct {
+	*mock.Call
+}
+
+// GetByUsername is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *MockUserRepo_Expecter) GetByUsername(ctx interface{}, username interface{}) *MockUserRepo_GetByUsername_Call {
+	return &MockUserRepo_GetByUsername_Call{Call: _e.mock.On("GetByUsername", ctx, username)}
+}
+
+func (_c *MockUserRepo_GetByUsername_Call) Run(run func(ctx context.Context, username string)) *MockUserRepo_GetByUsername_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_GetByUsername_Call) Return(user *domain.User, err error) *MockUserRepo_GetByUsername_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepo_GetByUsername_Call) RunAndReturn(run func(ctx context.Context, username string) (*domain.User, error)) *MockUserRepo_GetByUsername_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) List(ctx context.Context) ([]*domain.User, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.User, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.User); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepo_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockUserRepo_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockUserRepo_Expecter) List(ctx interface{}) *MockUserRepo_List_Call {
+	return &MockUserRepo_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockUserRepo_List_Call) Run(run func(ctx context.Context)) *MockUserRepo_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_List_Call) Return(users []*domain.User, err error) *MockUserRepo_List_Call {
+	_c.Call.Return(users, err)
+	return _c
+}
+
+func (_c *MockUserRepo_List_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.User, error)) *MockUserRepo_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockVenueRepo creates a new instance of MockVenueRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockVenueRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockVenueRepo {
+	mock := &MockVenueRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockVenueRepo is an autogenerated mock type for the VenueRepo type
+type MockVenueRepo struct {
+	mock.Mock
+}
+
+type MockVenueRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockVenueRepo) EXPECT() *MockVenueRepo_Expecter {
+	return &MockVenueRepo_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockVenueRepo
+func (_mock *MockVenueRepo) Create(ctx context.Context, v *domain.Venue) error {
+	ret := _mock.Called(ctx, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Venue) error); ok {
+		r0 = returnFunc(ctx, v)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockVenueRepo_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockVenueRepo_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - v *domain.Venue
+func (_e *MockVenueRepo_Expecter) Create(ctx interface{}, v interface{}) *MockVenueRepo_Create_Call {
+	return &MockVenueRepo_Create_Call{Call: _e.mock.On("Create", ctx, v)}
+}
+
+func (_c *MockVenueRepo_Create_Call) Run(run func(ctx context.Context, v *domain.Venue)) *MockVenueRepo_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Venue
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Venue)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVenueRepo_Create_Call) Return(err error) *MockVenueRepo_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockVenueRepo_Create_Call) RunAndReturn(run func(ctx context.Context, v *domain.Venue) error) *MockVenueRepo_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockVenueRepo
+func (_mock *MockVenueRepo) Delete(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockVenueRepo_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockVenueRepo_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockVenueRepo_Expecter) Delete(ctx interface{}, id interface{}) *MockVenueRepo_Delete_Call {
+	return &MockVenueRepo_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockVenueRepo_Delete_Call) Run(run func(ctx context.Context, id string)) *MockVenueRepo_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVenueRepo_Delete_Call) Return(err error) *MockVenueRepo_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockVenueRepo_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockVenueRepo_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockVenueRepo
+func (_mock *MockVenueRepo) GetByID(ctx context.Context, id string) (*domain.Venue, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.Venue
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Venue, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Venue); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Venue)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVenueRepo_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockVenueRepo_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockVenueRepo_Expecter) GetByID(ctx interface{}, id interface{}) *MockVenueRepo_GetByID_Call {
+	return &MockVenueRepo_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockVenueRepo_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockVenueRepo_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVenueRepo_GetByID_Call) Return(venue *domain.Venue, err error) *MockVenueRepo_GetByID_Call {
+	_c.Call.Return(venue, err)
+	return _c
+}
+
+func (_c *MockVenueRepo_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.Venue, error)) *MockVenueRepo_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockVenueRepo
+func (_mock *MockVenueRepo) List(ctx context.Context) ([]*domain.Venue, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*domain.Venue
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.Venue, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.Venue); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Venue)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVenueRepo_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockVenueRepo_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockVenueRepo_Expecter) List(ctx interface{}) *MockVenueRepo_List_Call {
+	return &MockVenueRepo_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockVenueRepo_List_Call) Run(run func(ctx context.Context)) *MockVenueRepo_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVenueRepo_List_Call) Return(venues []*domain.Venue, err error) *MockVenueRepo_List_Call {
+	_c.Call.Return(venues, err)
+	return _c
+}
+
+func (_c *MockVenueRepo_List_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.Venue, error)) *MockVenueRepo_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByIDs provides a mock function for the type MockVenueRepo
+func (_mock *MockVenueRepo) ListByIDs(ctx context.Context, ids []string) ([]*domain.Venue, error) {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByIDs")
+	}
+
+	var r0 []*domain.Venue
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]*domain.Venue, error)); ok {
+		return returnFunc(ctx, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []*domain.Venue); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Venue)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVenueRepo_ListByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByIDs'
+type MockVenueRepo_ListByIDs_Call struct {
+	*mock.Call
+}
+
+// ListByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []string
+func (_e *MockVenueRepo_Expecter) ListByIDs(ctx interface{}, ids interface{}) *MockVenueRepo_ListByIDs_Call {
+	return &MockVenueRepo_ListByIDs_Call{Call: _e.mock.On("ListByIDs", ctx, ids)}
+}
+
+func (_c *MockVenueRepo_ListByIDs_Call) Run(run func(ctx context.Context, ids []string)) *MockVenueRepo_ListByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVenueRepo_ListByIDs_Call) Return(venues []*domain.Venue, err error) *MockVenueRepo_ListByIDs_Call {
+	_c.Call.Return(venues, err)
+	return _c
+}
+
+func (_c *MockVenueRepo_ListByIDs_Call) RunAndReturn(run func(ctx context.Context, ids []string) ([]*domain.Venue, error)) *MockVenueRepo_ListByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockVenueRepo
+func (_mock *MockVenueRepo) Update(ctx context.Context, v *domain.Venue) error {
+	ret := _mock.Called(ctx, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Venue) error); ok {
+		r0 = returnFunc(ctx, v)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockVenueRepo_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockVenueRepo_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - v *domain.Venue
+func (_e *MockVenueRepo_Expecter) Update(ctx interface{}, v interface{}) *MockVenueRepo_Update_Call {
+	return &MockVenueRepo_Update_Call{Call: _e.mock.On("Update", ctx, v)}
+}
+
+func (_c *MockVenueRepo_Update_Call) Run(run func(ctx context.Context, v *domain.Venue)) *MockVenueRepo_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Venue
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Venue)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVenueRepo_Update_Call) Return(err error) *MockVenueRepo_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockVenueRepo_Update_Call) RunAndReturn(run func(ctx context.Context, v *domain.Venue) error) *MockVenueRepo_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWebhookRepo creates a new instance of MockWebhookRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookRepo {
+	mock := &MockWebhookRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebhookRepo is an autogenerated mock type for the WebhookRepo type
+type MockWebhookRepo struct {
+	mock.Mock
+}
+
+type MockWebhookRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookRepo) EXPECT() *MockWebhookRepo_Expecter {
+	return &MockWebhookRepo_Expecter{mock: &_m.Mock}
+}
+
+// ClaimDeliveries provides a mock function for the type MockWebhookRepo
+func (_mock *MockWebhookRepo) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*domain.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, limit, lease)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDeliveries")
+	}
+
+	var r0 []*domain.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, time.Duration) ([]*domain.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, limit, lease)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, time.Duration) []*domain.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, limit, lease)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, time.Duration) error); ok {
+		r1 = returnFunc(ctx, limit, lease)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookRepo_ClaimDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDeliveries'
+type MockWebhookRepo_ClaimDeliveries_Call struct {
+	*mock.Call
+}
+
+// ClaimDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - lease time.Duration
+func (_e *MockWebhookRepo_Expecter) ClaimDeliveries(ctx interface{}, limit interface{}, lease interface{}) *MockWebhookRepo_ClaimDeliveries_Call {
+	return &MockWebhookRepo_ClaimDeliveries_Call{Call: _e.mock.On("ClaimDeliveries", ctx, limit, lease)}
+}
+
+func (_c *MockWebhookRepo_ClaimDeliveries_Call) Run(run func(ctx context.Context, limit int, lease time.Duration)) *MockWebhookRepo_ClaimDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 time.Duration
+		if args[2] != nil {
+			arg2 = args[2].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepo_ClaimDeliveries_Call) Return(webhookDeliverys []*domain.WebhookDelivery, err error) *MockWebhookRepo_ClaimDeliveries_Call {
+	_c.Call.Return(webhookDeliverys, err)
+	return _c
+}
+
+func (_c *MockWebhookRepo_ClaimDeliveries_Call) RunAndReturn(run func(ctx context.Context, limit int, lease time.Duration) ([]*domain.WebhookDelivery, error)) *MockWebhookRepo_ClaimDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSubscription provides a mock function for the type MockWebhookRepo
+func (_mock *MockWebhookRepo) CreateSubscription(ctx context.Context, s *domain.WebhookSubscription) error {
+	ret := _mock.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSubscription")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.WebhookSubscription) error); ok {
+		r0 = returnFunc(ctx, s)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookRepo_CreateSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSubscription'
+type MockWebhookRepo_CreateSubscription_Call struct {
+	*mock.Call
+}
+
+// CreateSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - s *domain.WebhookSubscription
+func (_e *MockWebhookRepo_Expecter) CreateSubscription(ctx interface{}, s interface{}) *MockWebhookRepo_CreateSubscription_Call {
+	return &MockWebhookRepo_CreateSubscription_Call{Call: _e.mock.On("CreateSubscription", ctx, s)}
+}
+
+func (_c *MockWebhookRepo_CreateSubscription_Call) Run(run func(ctx context.Context, s *domain.WebhookSubscription)) *MockWebhookRepo_CreateSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.WebhookSubscription
+		if args[1] != nil {
+			arg1 = args[1].(*domain.WebhookSubscription)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepo_CreateSubscription_Call) Return(err error) *MockWebhookRepo_CreateSubscription_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookRepo_CreateSubscription_Call) RunAndReturn(run func(ctx context.Context, s *domain.WebhookSubscription) error) *MockWebhookRepo_CreateSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSubscription provides a mock function for the type MockWebhookRepo
+func (_mock *MockWebhookRepo) DeleteSubscription(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSubscription")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookRepo_DeleteSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSubscription'
+type MockWebhookRepo_DeleteSubscription_Call struct {
+	*mock.Call
+}
+
+// DeleteSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockWebhookRepo_Expecter) DeleteSubscription(ctx interface{}, id interface{}) *MockWebhookRepo_DeleteSubscription_Call {
+	return &MockWebhookRepo_DeleteSubscription_Call{Call: _e.mock.On("DeleteSubscription", ctx, id)}
+}
+
+func (_c *MockWebhookRepo_DeleteSubscription_Call) Run(run func(ctx context.Context, id string)) *MockWebhookRepo_DeleteSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepo_DeleteSubscription_Call) Return(err error) *MockWebhookRepo_DeleteSubscription_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookRepo_DeleteSubscription_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockWebhookRepo_DeleteSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueueDeliveries provides a mock function for the type MockWebhookRepo
+func (_mock *MockWebhookRepo) EnqueueDeliveries(ctx context.Context, deliveries []*domain.WebhookDelivery) error {
+	ret := _mock.Called(ctx, deliveries)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueDeliveries")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*domain.WebhookDelivery) error); ok {
+		r0 = returnFunc(ctx, deliveries)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookRepo_EnqueueDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueDeliveries'
+type MockWebhookRepo_EnqueueDeliveries_Call struct {
+	*mock.Call
+}
+
+// EnqueueDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deliveries []*domain.WebhookDelivery
+func (_e *MockWebhookRepo_Expecter) EnqueueDeliveries(ctx interface{}, deliveries interface{}) *MockWebhookRepo_EnqueueDeliveries_Call {
+	return &MockWebhookRepo_EnqueueDeliveries_Call{Call: _e.mock.On("EnqueueDeliveries", ctx, deliveries)}
+}
+
+func (_c *MockWebhookRepo_EnqueueDeliveries_Call) Run(run func(ctx context.Context, deliveries []*domain.WebhookDelivery)) *MockWebhookRepo_EnqueueDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*domain.WebhookDelivery
+		if args[1] != nil {
+			arg1 = args[1].([]*domain.WebhookDelivery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepo_EnqueueDeliveries_Call) Return(err error) *MockWebhookRepo_EnqueueDeliveries_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookRepo_EnqueueDeliveries_Call) RunAndReturn(run func(ctx context.Context, deliveries []*domain.WebhookDelivery) error) *MockWebhookRepo_EnqueueDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDelivery provides a mock function for the type MockWebhookRepo
+func (_mock *MockWebhookRepo) GetDelivery(ctx context.Context, id string) (*domain.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDelivery")
+	}
+
+	var r0 *domain.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookRepo_GetDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDelivery'
+type MockWebhookRepo_GetDelivery_Call struct {
+	*mock.Call
+}
+
+// GetDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockWebhookRepo_Expecter) GetDelivery(ctx interface{}, id interface{}) *MockWebhookRepo_GetDelivery_Call {
+	return &MockWebhookRepo_GetDelivery_Call{Call: _e.mock.On("GetDelivery", ctx, id)}
+}
+
+func (_c *MockWebhookRepo_GetDelivery_Call) Run(run func(ctx context.Context, id string)) *MockWebhookRepo_GetDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepo_GetDelivery_Call) Return(webhookDelivery *domain.WebhookDelivery, err error) *MockWebhookRepo_GetDelivery_Call {
+	_c.Call.Return(webhookDelivery, err)
+	return _c
+}
+
+func (_c *MockWebhookRepo_GetDelivery_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.WebhookDelivery, error)) *MockWebhookRepo_GetDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubscription provides a mock function for the type MockWebhookRepo
+func (_mock *MockWebhookRepo) GetSubscription(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubscription")
+	}
+
+	var r0 *domain.WebhookSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.WebhookSubscription, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.WebhookSubscription); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WebhookSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookRepo_GetSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubscription'
+type MockWebhookRepo_GetSubscription_Call struct {
+	*mock.Call
+}
+
+// GetSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockWebhookRepo_Expecter) GetSubscription(ctx interface{}, id interface{}) *MockWebhookRepo_GetSubscription_Call {
+	return &MockWebhookRepo_GetSubscription_Call{Call: _e.mock.On("GetSubscription", ctx, id)}
+}
+
+func (_c *MockWebhookRepo_GetSubscription_Call) Run(run func(ctx context.Context, id string)) *MockWebhookRepo_GetSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepo_GetSubscription_Call) Return(webhookSubscription *domain.WebhookSubscription, err error) *MockWebhookRepo_GetSubscription_Call {
+	_c.Call.Return(webhookSubscription, err)
+	return _c
+}
+
+func (_c *MockWebhookRepo_GetSubscription_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.WebhookSubscription, error)) *MockWebhookRepo_GetSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDeliveries provides a mock function for the type MockWebhookRepo
+func (_mock *MockWebhookRepo) ListDeliveries(ctx context.Context, subscriptionID string, limit int) ([]*domain.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, subscriptionID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeliveries")
+	}
+
+	var r0 []*domain.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]*domain.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, subscriptionID, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []*domain.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, subscriptionID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, subscriptionID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookRepo_ListDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeliveries'
+type MockWebhookRepo_ListDeliveries_Call struct {
+	*mock.Call
+}
+
+// ListDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscriptionID string
+//   - limit int
+func (_e *MockWebhookRepo_Expecter) ListDeliveries(ctx interface{}, subscriptionID interface{}, limit interface{}) *MockWebhookRepo_ListDeliveries_Call {
+	return &MockWebhookRepo_ListDeliveries_Call{Call: _e.mock.On("ListDeliveries", ctx, subscriptionID, limit)}
+}
+
+func (_c *MockWebhookRepo_ListDeliveries_Call) Run(run func(ctx context.Context, subscriptionID string, limit int)) *MockWebhookRepo_ListDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepo_ListDeliveries_Call) Return(webhookDeliverys []*domain.WebhookDelivery, err error) *MockWebhookRepo_ListDeliveries_Call {
+	_c.Call.Return(webhookDeliverys, err)
+	return _c
+}
+
+func (_c *MockWebhookRepo_ListDeliveries_Call) RunAndReturn(run func(ctx context.Context, subscriptionID string, limit int) ([]*domain.WebhookDelivery, error)) *MockWebhookRepo_ListDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSubscribers provides a mock function for the type MockWebhookRepo
+func (_mock *MockWebhookRepo) ListSubscribers(ctx context.Context, eventType domain.WebhookEventType) ([]*domain.WebhookSubscription, error) {
+	ret := _mock.Called(ctx, eventType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSubscribers")
+	}
+
+	var r0 []*domain.WebhookSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.WebhookEventType) ([]*domain.WebhookSubscription, error)); ok {
+		return returnFunc(ctx, eventType)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.WebhookEventType) []*domain.WebhookSubscription); ok {
+		r0 = returnFunc(ctx, eventType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.WebhookSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.WebhookEventType) error); ok {
+		r1 = returnFunc(ctx, eventType)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookRepo_ListSubscribers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSubscribers'
+type MockWebhookRepo_ListSubscribers_Call struct {
+	*mock.Call
+}
+
+// ListSubscribers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventType domain.WebhookEventType
+func (_e *MockWebhookRepo_Expecter) ListSubscribers(ctx interface{}, eventType interface{}) *MockWebhookRepo_ListSubscribers_Call {
+	return &MockWebhookRepo_ListSubscribers_Call{Call: _e.mock.On("ListSubscribers", ctx, eventType)}
+}
+
+func (_c *MockWebhookRepo_ListSubscribers_Call) Run(run func(ctx context.Context, eventType domain.WebhookEventType)) *MockWebhookRepo_ListSubscribers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.WebhookEventType
+		if args[1] != nil {
+			arg1 = args[1].(domain.WebhookEventType)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepo_ListSubscribers_Call) Return(webhookSubscriptions []*domain.WebhookSubscription, err error) *MockWebhookRepo_ListSubscribers_Call {
+	_c.Call.Return(webhookSubscriptions, err)
+	return _c
+}
+
+func (_c *MockWebhookRepo_ListSubscribers_Call) RunAndReturn(run func(ctx context.Context, eventType domain.WebhookEventType) ([]*domain.WebhookSubscription, error)) *MockWebhookRepo_ListSubscribers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSubscriptions provides a mock function for the type MockWebhookRepo
+func (_mock *MockWebhookRepo) ListSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSubscriptions")
+	}
+
+	var r0 []*domain.WebhookSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.WebhookSubscription, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.WebhookSubscription); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.WebhookSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookRepo_ListSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSubscriptions'
+type MockWebhookRepo_ListSubscriptions_Call struct {
+	*mock.Call
+}
+
+// ListSubscriptions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockWebhookRepo_Expecter) ListSubscriptions(ctx interface{}) *MockWebhookRepo_ListSubscriptions_Call {
+	return &MockWebhookRepo_ListSubscriptions_Call{Call: _e.mock.On("ListSubscriptions", ctx)}
+}
+
+func (_c *MockWebhookRepo_ListSubscriptions_Call) Run(run func(ctx context.Context)) *MockWebhookRepo_ListSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepo_ListSubscriptions_Call) Return(webhookSubscriptions []*domain.WebhookSubscription, err error) *MockWebhookRepo_ListSubscriptions_Call {
+	_c.Call.Return(webhookSubscriptions, err)
+	return _c
+}
+
+func (_c *MockWebhookRepo_ListSubscriptions_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.WebhookSubscription, error)) *MockWebhookRepo_ListSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordAttempt provides a mock function for the type MockWebhookRepo
+func (_mock *MockWebhookRepo) RecordAttempt(ctx context.Context, d *domain.WebhookDelivery, attempt domain.WebhookDeliveryAttempt) error {
+	ret := _mock.Called(ctx, d, attempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordAttempt")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.WebhookDelivery, domain.WebhookDeliveryAttempt) error); ok {
+		r0 = returnFunc(ctx, d, attempt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookRepo_RecordAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordAttempt'
+type MockWebhookRepo_RecordAttempt_Call struct {
+	*mock.Call
+}
+
+// RecordAttempt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - d *domain.WebhookDelivery
+//   - attempt domain.WebhookDeliveryAttempt
+func (_e *MockWebhookRepo_Expecter) RecordAttempt(ctx interface{}, d interface{}, attempt interface{}) *MockWebhookRepo_RecordAttempt_Call {
+	return &MockWebhookRepo_RecordAttempt_Call{Call: _e.mock.On("RecordAttempt", ctx, d, attempt)}
+}
+
+func (_c *MockWebhookRepo_RecordAttempt_Call) Run(run func(ctx context.Context, d *domain.WebhookDelivery, attempt domain.WebhookDeliveryAttempt)) *MockWebhookRepo_RecordAttempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.WebhookDelivery
+		if args[1] != nil {
+			arg1 = args[1].(*domain.WebhookDelivery)
+		}
+		var arg2 domain.WebhookDeliveryAttempt
+		if args[2] != nil {
+			arg2 = args[2].(domain.WebhookDeliveryAttempt)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepo_RecordAttempt_Call) Return(err error) *MockWebhookRepo_RecordAttempt_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookRepo_RecordAttempt_Call) RunAndReturn(run func(ctx context.Context, d *domain.WebhookDelivery, attempt domain.WebhookDeliveryAttempt) error) *MockWebhookRepo_RecordAttempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSubscription provides a mock function for the type MockWebhookRepo
+func (_mock *MockWebhookRepo) UpdateSubscription(ctx context.Context, s *domain.WebhookSubscription) error {
+	ret := _mock.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSubscription")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.WebhookSubscription) error); ok {
+		r0 = returnFunc(ctx, s)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookRepo_UpdateSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSubscription'
+type MockWebhookRepo_UpdateSubscription_Call struct {
+	*mock.Call
+}
+
+// UpdateSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - s *domain.WebhookSubscription
+func (_e *MockWebhookRepo_Expecter) UpdateSubscription(ctx interface{}, s interface{}) *MockWebhookRepo_UpdateSubscription_Call {
+	return &MockWebhookRepo_UpdateSubscription_Call{Call: _e.mock.On("UpdateSubscription", ctx, s)}
+}
+
+func (_c *MockWebhookRepo_UpdateSubscription_Call) Run(run func(ctx context.Context, s *domain.WebhookSubscription)) *MockWebhookRepo_UpdateSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.WebhookSubscription
+		if args[1] != nil {
+			arg1 = args[1].(*domain.WebhookSubscription)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepo_UpdateSubscription_Call) Return(err error) *MockWebhookRepo_UpdateSubscription_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookRepo_UpdateSubscription_Call) RunAndReturn(run func(ctx context.Context, s *domain.WebhookSubscription) error) *MockWebhookRepo_UpdateSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWebhookSender creates a new instance of MockWebhookSender. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookSender(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookSender {
+	mock := &MockWebhookSender{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebhookSender is an autogenerated mock type for the WebhookSender type
+type MockWebhookSender struct {
+	mock.Mock
+}
+
+type MockWebhookSender_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookSender) EXPECT() *MockWebhookSender_Expecter {
+	return &MockWebhookSender_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function for the type MockWebhookSender
+func (_mock *MockWebhookSender) Send(ctx context.Context, sub *domain.WebhookSubscription, d *domain.WebhookDelivery) (int, error) {
+	ret := _mock.Called(ctx, sub, d)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.WebhookSubscription, *domain.WebhookDelivery) (int, error)); ok {
+		return returnFunc(ctx, sub, d)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.WebhookSubscription, *domain.WebhookDelivery) int); ok {
+		r0 = returnFunc(ctx, sub, d)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.WebhookSubscription, *domain.WebhookDelivery) error); ok {
+		r1 = returnFunc(ctx, sub, d)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookSender_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type MockWebhookSender_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sub *domain.WebhookSubscription
+//   - d *domain.WebhookDelivery
+func (_e *MockWebhookSender_Expecter) Send(ctx interface{}, sub interface{}, d interface{}) *MockWebhookSender_Send_Call {
+	return &MockWebhookSender_Send_Call{Call: _e.mock.On("Send", ctx, sub, d)}
+}
+
+func (_c *MockWebhookSender_Send_Call) Run(run func(ctx context.Context, sub *domain.WebhookSubscription, d *domain.WebhookDelivery)) *MockWebhookSender_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.WebhookSubscription
+		if args[1] != nil {
+			arg1 = args[1].(*domain.WebhookSubscription)
+		}
+		var arg2 *domain.WebhookDelivery
+		if args[2] != nil {
+			arg2 = args[2].(*domain.WebhookDelivery)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookSender_Send_Call) Return(n int, err error) *MockWebhookSender_Send_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockWebhookSender_Send_Call) RunAndReturn(run func(ctx context.Context, sub *domain.WebhookSubscription, d *domain.WebhookDelivery) (int, error)) *MockWebhookSender_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWebhookPublisher creates a new instance of MockWebhookPublisher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookPublisher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookPublisher {
+	mock := &MockWebhookPublisher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebhookPublisher is an autogenerated mock type for the WebhookPublisher type
+type MockWebhookPublisher struct {
+	mock.Mock
+}
+
+type MockWebhookPublisher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookPublisher) EXPECT() *MockWebhookPublisher_Expecter {
+	return &MockWebhookPublisher_Expecter{mock: &_m.Mock}
+}
+
+// BookingMessage provides a mock function for the type MockWebhookPublisher
+func (_mock *MockWebhookPublisher) BookingMessage(eventType domain.WebhookEventType, b *domain.Booking) (*domain.WebhookMessage, error) {
+	ret := _mock.Called(eventType, b)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BookingMessage")
+	}
+
+	var r0 *domain.WebhookMessage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(domain.WebhookEventType, *domain.Booking) (*domain.WebhookMessage, error)); ok {
+		return returnFunc(eventType, b)
+	}
+	if returnFunc, ok := ret.Get(0).(func(domain.WebhookEventType, *domain.Booking) *domain.WebhookMessage); ok {
+		r0 = returnFunc(eventType, b)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WebhookMessage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(domain.WebhookEventType, *domain.Booking) error); ok {
+		r1 = returnFunc(eventType, b)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookPublisher_BookingMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BookingMessage'
+type MockWebhookPublisher_BookingMessage_Call struct {
+	*mock.Call
+}
+
+// BookingMessage is a helper method to define mock.On call
+//   - eventType domain.WebhookEventType
+//   - b *domain.Booking
+func (_e *MockWebhookPublisher_Expecter) BookingMessage(eventType interface{}, b interface{}) *MockWebhookPublisher_BookingMessage_Call {
+	return &MockWebhookPublisher_BookingMessage_Call{Call: _e.mock.On("BookingMessage", eventType, b)}
+}
+
+func (_c *MockWebhookPublisher_BookingMessage_Call) Run(run func(eventType domain.WebhookEventType, b *domain.Booking)) *MockWebhookPublisher_BookingMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 domain.WebhookEventType
+		if args[0] != nil {
+			arg0 = args[0].(domain.WebhookEventType)
+		}
+		var arg1 *domain.Booking
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Booking)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookPublisher_BookingMessage_Call) Return(webhookMessage *domain.WebhookMessage, err error) *MockWebhookPublisher_BookingMessage_Call {
+	_c.Call.Return(webhookMessage, err)
+	return _c
+}
+
+func (_c *MockWebhookPublisher_BookingMessage_Call) RunAndReturn(run func(eventType domain.WebhookEventType, b *domain.Booking) (*domain.WebhookMessage, error)) *MockWebhookPublisher_BookingMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishEvent provides a mock function for the type MockWebhookPublisher
+func (_mock *MockWebhookPublisher) PublishEvent(ctx context.Context, eventType domain.WebhookEventType, e *domain.Event) {
+	_mock.Called(ctx, eventType, e)
+	return
+}
+
+// MockWebhookPublisher_PublishEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishEvent'
+type MockWebhookPublisher_PublishEvent_Call struct {
+	*mock.Call
+}
+
+// PublishEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventType domain.WebhookEventType
+//   - e *domain.Event
+func (_e *MockWebhookPublisher_Expecter) PublishEvent(ctx interface{}, eventType interface{}, e interface{}) *MockWebhookPublisher_PublishEvent_Call {
+	return &MockWebhookPublisher_PublishEvent_Call{Call: _e.mock.On("PublishEvent", ctx, eventType, e)}
+}
+
+func (_c *MockWebhookPublisher_PublishEvent_Call) Run(run func(ctx context.Context, eventType domain.WebhookEventType, e *domain.Event)) *MockWebhookPublisher_PublishEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.WebhookEventType
+		if args[1] != nil {
+			arg1 = args[1].(domain.WebhookEventType)
+		}
+		var arg2 *domain.Event
+		if args[2] != nil {
+			arg2 = args[2].(*domain.Event)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookPublisher_PublishEvent_Call) Return() *MockWebhookPublisher_PublishEvent_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockWebhookPublisher_PublishEvent_Call) RunAndReturn(run func(ctx context.Context, eventType domain.WebhookEventType, e *domain.Event)) *MockWebhookPublisher_PublishEvent_Call {
+	_c.Run(run)
+	return _c
+}
@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+)
+
+type NotificationRepo interface {
+	Enqueue(ctx context.Context, n *domain.Notification) error
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]*domain.Notification, error)
+	MarkSent(ctx context.Context, id string) error
+	// MarkDelivered запоминает каналы, доставившие уведомление, чтобы повтор их пропустил.
+	MarkDelivered(ctx context.Context, id string, channels []domain.NotificationChannel) error
+}
@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+)
+
+type NotificationPreferenceRepo interface {
+	Get(ctx context.Context, userID string) (*domain.NotificationPreferences, error)
+	Save(ctx context.Context, prefs *domain.NotificationPreferences) error
+}
@@ -10,4 +10,30 @@ type BookingNotifier interface {
 	NotifyBookingCreated(ctx context.Context, user *domain.User, event *domain.Event)
 	NotifyBookingConfirmed(ctx context.Context, user *domain.User, event *domain.Event)
 	NotifyBookingCancelled(ctx context.Context, user *domain.User, event *domain.Event)
+	NotifyNewEvent(ctx context.Context, user *domain.User, event *domain.Event)
+	NotifyInvitation(ctx context.Context, user *domain.User, event *domain.Event)
+}
+
+// ChannelNotifier доставляет уведомление по реальному каналу. В отличие от BookingNotifier,
+// которым сервисы ставят уведомления в outbox, сообщает об ошибке — тогда воркер повторит доставку.
+// Если канал не настроен или у пользователя нет адреса, это не ошибка: доставлять некуда.
+type ChannelNotifier interface {
+	NotifyBookingCreated(ctx context.Context, user *domain.User, event *domain.Event) error
+	NotifyBookingConfirmed(ctx context.Context, user *domain.User, event *domain.Event) error
+	NotifyBookingCancelled(ctx context.Context, user *domain.User, event *domain.Event) error
+	NotifyNewEvent(ctx context.Context, user *domain.User, event *domain.Event) error
+	NotifyInvitation(ctx context.Context, user *domain.User, event *domain.Event) error
+}
+
+// NotificationRouter доставляет уведомление kind по каналам, которые включил пользователь,
+// кроме уже доставивших (done). Возвращает каналы, доставившие его в этот раз, — в том
+// числе вместе с ошибкой остальных, чтобы повтор не отправил его им снова.
+type NotificationRouter interface {
+	Deliver(
+		ctx context.Context,
+		kind domain.NotificationKind,
+		user *domain.User,
+		event *domain.Event,
+		done []domain.NotificationChannel,
+	) ([]domain.NotificationChannel, error)
 }
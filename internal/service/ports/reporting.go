@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+)
+
+type ReportingRepo interface {
+	DailyBookings(ctx context.Context, rng domain.StatsRange) ([]domain.DailyBookingStats, error)
+	Conversion(ctx context.Context, rng domain.StatsRange) (*domain.ConversionStats, error)
+	Occupancy(ctx context.Context, rng domain.StatsRange) ([]domain.EventOccupancy, error)
+	TopEvents(ctx context.Context, rng domain.StatsRange, limit int) ([]domain.EventOccupancy, error)
+}
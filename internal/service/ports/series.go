@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+)
+
+type SeriesRepo interface {
+	Create(ctx context.Context, s *domain.EventSeries) error
+	GetByID(ctx context.Context, id string) (*domain.EventSeries, error)
+	List(ctx context.Context) ([]*domain.EventSeries, error)
+	ListDue(ctx context.Context, horizon time.Time) ([]*domain.EventSeries, error)
+	ListOccurrences(ctx context.Context, seriesID string) ([]*domain.Event, error)
+	Update(ctx context.Context, s *domain.EventSeries) error
+	// Cancel записывает сообщения outbox об отменённых бронях в своей транзакции.
+	Cancel(ctx context.Context, id string, change domain.BookingChange, outbox domain.BookingOutbox) ([]*domain.Booking, error)
+	AddOccurrences(ctx context.Context, seriesID string, events []*domain.Event, until time.Time) ([]*domain.Event, error)
+}
+
+// EventAnnouncer сообщает партнёрам и подписчикам о публикации вхождения серии.
+type EventAnnouncer interface {
+	Announce(ctx context.Context, event *domain.Event)
+}
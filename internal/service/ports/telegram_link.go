@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+	"time"
+)
+
+type TelegramLinkRepo interface {
+	Create(ctx context.Context, tokenHash, userID string, expiresAt time.Time) error
+	// Link использует токен и привязывает chatID к его владельцу в одной транзакции.
+	Link(ctx context.Context, tokenHash string, chatID int64) (userID string, err error)
+}
@@ -0,0 +1,36 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+)
+
+type WebhookRepo interface {
+	CreateSubscription(ctx context.Context, s *domain.WebhookSubscription) error
+	GetSubscription(ctx context.Context, id string) (*domain.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error)
+	ListSubscribers(ctx context.Context, eventType domain.WebhookEventType) ([]*domain.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, s *domain.WebhookSubscription) error
+	DeleteSubscription(ctx context.Context, id string) error
+
+	EnqueueDeliveries(ctx context.Context, deliveries []*domain.WebhookDelivery) error
+	ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*domain.WebhookDelivery, error)
+	RecordAttempt(ctx context.Context, d *domain.WebhookDelivery, attempt domain.WebhookDeliveryAttempt) error
+	ListDeliveries(ctx context.Context, subscriptionID string, limit int) ([]*domain.WebhookDelivery, error)
+	GetDelivery(ctx context.Context, id string) (*domain.WebhookDelivery, error)
+}
+
+// WebhookSender отправляет подписанный запрос и возвращает HTTP-статус ответа.
+type WebhookSender interface {
+	Send(ctx context.Context, sub *domain.WebhookSubscription, d *domain.WebhookDelivery) (int, error)
+}
+
+// WebhookPublisher ставит события в очередь доставки подписчикам. Ошибки Publish*
+// логируются реализацией и не влияют на основную операцию. BookingMessage только
+// собирает событие — его записывают в outbox вместе с изменением брони.
+type WebhookPublisher interface {
+	BookingMessage(eventType domain.WebhookEventType, b *domain.Booking) (*domain.WebhookMessage, error)
+	PublishEvent(ctx context.Context, eventType domain.WebhookEventType, e *domain.Event)
+}
@@ -0,0 +1,352 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/service/ports"
+	"github.com/teambition/rrule-go"
+	"github.com/wb-go/wbf/logger"
+)
+
+var rruleFreqs = map[domain.RecurrenceFreq]rrule.Frequency{
+	domain.FreqDaily:   rrule.DAILY,
+	domain.FreqWeekly:  rrule.WEEKLY,
+	domain.FreqMonthly: rrule.MONTHLY,
+}
+
+// rruleWeekdays индексируется time.Weekday (воскресенье — 0).
+var rruleWeekdays = []rrule.Weekday{rrule.SU, rrule.MO, rrule.TU, rrule.WE, rrule.TH, rrule.FR, rrule.SA}
+
+// SeriesService управляет повторяющимися мероприятиями и заранее создаёт их вхождения.
+type SeriesService struct {
+	repo      ports.SeriesRepo
+	webhooks  ports.WebhookPublisher
+	announcer ports.EventAnnouncer
+	horizon   time.Duration
+	logger    logger.Logger
+}
+
+// NewSeriesService: horizon — на сколько вперёд материализуются вхождения.
+func NewSeriesService(
+	repo ports.SeriesRepo,
+	webhooks ports.WebhookPublisher,
+	announcer ports.EventAnnouncer,
+	horizon time.Duration,
+	logger logger.Logger,
+) *SeriesService {
+	return &SeriesService{
+		repo:      repo,
+		webhooks:  webhooks,
+		announcer: announcer,
+		horizon:   horizon,
+		logger:    logger,
+	}
+}
+
+// Create сохраняет серию и сразу создаёт вхождения в пределах горизонта.
+func (s *SeriesService) Create(ctx context.Context, input domain.CreateSeriesInput) (*domain.SeriesDetails, error) {
+	if input.Title == "" {
+		return nil, fmt.Errorf("%w: title is required", domain.ErrValidation)
+	}
+	if input.TotalSpots <= 0 {
+		return nil, fmt.Errorf("%w: total_spots must be positive", domain.ErrValidation)
+	}
+	if input.Start.Before(time.Now().UTC()) {
+		return nil, fmt.Errorf("%w: start must be in the future", domain.ErrValidation)
+	}
+
+	tz := input.Timezone
+	if tz == "" {
+		tz = "UTC"
+	}
+	if err := domain.ValidateTimezone(tz); err != nil {
+		return nil, err
+	}
+
+	rec := input.Recurrence
+	if rec.Interval == 0 {
+		rec.Interval = 1
+	}
+	if err := validateRecurrence(rec, input.Start); err != nil {
+		return nil, err
+	}
+
+	requiresPayment := true
+	if input.RequiresPayment != nil {
+		requiresPayment = *input.RequiresPayment
+	}
+	ttl := input.BookingTTL
+	if ttl == 0 {
+		ttl = defaultBookingTTL
+	}
+
+	now := time.Now().UTC()
+	series := &domain.EventSeries{
+		ID:              uuid.New().String(),
+		Title:           input.Title,
+		Description:     input.Description,
+		TotalSpots:      input.TotalSpots,
+		RequiresPayment: requiresPayment,
+		BookingTTL:      ttl,
+		Start:           input.Start,
+		Timezone:        tz,
+		Recurrence:      rec,
+		Exceptions:      input.Exceptions,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	if err := s.repo.Create(ctx, series); err != nil {
+		return nil, fmt.Errorf("create series: %w", err)
+	}
+
+	if _, err := s.materialize(ctx, series, now); err != nil {
+		// Серия уже сохранена — вхождения догонит планировщик.
+		s.logger.LogAttrs(ctx, logger.ErrorLevel, "failed to materialize new series",
+			logger.String("series_id", series.ID),
+			logger.String("error", err.Error()),
+		)
+	}
+
+	return s.Get(ctx, series.ID)
+}
+
+func (s *SeriesService) Get(ctx context.Context, id string) (*domain.SeriesDetails, error) {
+	series, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences, err := s.repo.ListOccurrences(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("list occurrences: %w", err)
+	}
+
+	return &domain.SeriesDetails{Series: *series, Occurrences: occurrences}, nil
+}
+
+func (s *SeriesService) List(ctx context.Context) ([]*domain.EventSeries, error) {
+	return s.repo.List(ctx)
+}
+
+// Update меняет шаблон серии и её будущие вхождения.
+func (s *SeriesService) Update(ctx context.Context, id string, input domain.UpdateSeriesInput) (*domain.EventSeries, error) {
+	series, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if series.CancelledAt != nil {
+		return nil, domain.ErrSeriesCancelled
+	}
+
+	if input.Title != nil {
+		if *input.Title == "" {
+			return nil, fmt.Errorf("%w: title is required", domain.ErrValidation)
+		}
+		series.Title = *input.Title
+	}
+	if input.Description != nil {
+		series.Description = *input.Description
+	}
+	if input.TotalSpots != nil {
+		if *input.TotalSpots <= 0 {
+			return nil, fmt.Errorf("%w: total_spots must be positive", domain.ErrValidation)
+		}
+		series.TotalSpots = *input.TotalSpots
+	}
+	if input.RequiresPayment != nil {
+		series.RequiresPayment = *input.RequiresPayment
+	}
+	if input.BookingTTL != nil {
+		if *input.BookingTTL <= 0 {
+			return nil, fmt.Errorf("%w: booking_ttl must be positive", domain.ErrValidation)
+		}
+		series.BookingTTL = *input.BookingTTL
+	}
+	series.UpdatedAt = time.Now().UTC()
+
+	if err = s.repo.Update(ctx, series); err != nil {
+		return nil, fmt.Errorf("update series: %w", err)
+	}
+
+	return series, nil
+}
+
+// Cancel останавливает серию: будущие вхождения и брони на них отменяются,
+// владельцы броней получают уведомление.
+func (s *SeriesService) Cancel(ctx context.Context, id string) error {
+	change := bookingChange(ctx, domain.BookingActorAdmin, "", domain.BookingReasonSeriesCancelled)
+	bookings, err := s.repo.Cancel(ctx, id, change, cancelledBookingOutbox(s.webhooks, change))
+	if err != nil {
+		return fmt.Errorf("cancel series: %w", err)
+	}
+
+	s.logger.LogAttrs(ctx, logger.InfoLevel, "event series cancelled",
+		logger.String("series_id", id),
+		logger.Int("bookings_cancelled", len(bookings)),
+	)
+
+	return nil
+}
+
+// Materialize создаёт недостающие вхождения активных серий на горизонт вперёд.
+// Возвращает количество созданных мероприятий.
+func (s *SeriesService) Materialize(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	due, err := s.repo.ListDue(ctx, now.Add(s.horizon))
+	if err != nil {
+		return 0, fmt.Errorf("list due series: %w", err)
+	}
+
+	total := 0
+	for _, series := range due {
+		n, err := s.materialize(ctx, series, now)
+		if err != nil {
+			s.logger.LogAttrs(ctx, logger.ErrorLevel, "failed to materialize series",
+				logger.String("series_id", series.ID),
+				logger.String("error", err.Error()),
+			)
+			continue
+		}
+		total += n
+	}
+
+	return total, nil
+}
+
+func (s *SeriesService) materialize(ctx context.Context, series *domain.EventSeries, now time.Time) (int, error) {
+	until := now.Add(s.horizon)
+	dates, err := occurrencesBetween(series, now, until)
+	if err != nil {
+		return 0, err
+	}
+
+	events := make([]*domain.Event, len(dates))
+	for i, date := range dates {
+		events[i] = &domain.Event{
+			ID:              uuid.New().String(),
+			Title:           series.Title,
+			Description:     series.Description,
+			EventDate:       date,
+			TotalSpots:      series.TotalSpots,
+			RequiresPayment: series.RequiresPayment,
+			BookingTTL:      series.BookingTTL,
+			Duration:        defaultEventDuration,
+			Timezone:        series.Timezone,
+			SeriesID:        &series.ID,
+			Status:          domain.EventStatusPublished,
+			PublishedAt:     &now,
+			Visibility:      domain.EventVisibilityPublic,
+			CreatedAt:       now,
+			UpdatedAt:       now,
+		}
+	}
+
+	created, err := s.repo.AddOccurrences(ctx, series.ID, events, until)
+	if err != nil {
+		return 0, fmt.Errorf("add occurrences: %w", err)
+	}
+
+	// Вхождения создаются опубликованными, поэтому о них сообщается как о публикации.
+	for _, e := range created {
+		s.announcer.Announce(ctx, e)
+	}
+
+	return len(created), nil
+}
+
+// occurrencesBetween возвращает вхождения после уже материализованных и после now,
+// но не позже until, без исключений. Время считается в часовом поясе серии,
+// поэтому «каждый вторник в 19:00» не сдвигается при переходе на летнее время.
+func occurrencesBetween(series *domain.EventSeries, now, until time.Time) ([]time.Time, error) {
+	r, err := newRRule(series)
+	if err != nil {
+		return nil, err
+	}
+
+	from := now
+	if series.MaterializedUntil != nil && series.MaterializedUntil.After(from) {
+		from = *series.MaterializedUntil
+	}
+
+	var res []time.Time
+	for _, t := range r.Between(from, until, true) {
+		if !t.After(from) {
+			continue
+		}
+		if slices.ContainsFunc(series.Exceptions, t.Equal) {
+			continue
+		}
+		res = append(res, t.UTC())
+	}
+
+	return res, nil
+}
+
+func newRRule(series *domain.EventSeries) (*rrule.RRule, error) {
+	loc, err := time.LoadLocation(series.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("load timezone: %w", err)
+	}
+
+	rec := series.Recurrence
+	opt := rrule.ROption{
+		Freq:       rruleFreqs[rec.Freq],
+		Interval:   rec.Interval,
+		Count:      rec.Count,
+		Dtstart:    series.Start.In(loc),
+		Bymonthday: rec.ByMonthDay,
+	}
+	for _, d := range rec.ByWeekday {
+		opt.Byweekday = append(opt.Byweekday, rruleWeekdays[d])
+	}
+	if rec.Until != nil {
+		opt.Until = rec.Until.In(loc)
+	}
+
+	return rrule.NewRRule(opt)
+}
+
+func validateRecurrence(rec domain.Recurrence, start time.Time) error {
+	if _, ok := rruleFreqs[rec.Freq]; !ok {
+		return fmt.Errorf("%w: freq must be daily, weekly or monthly", domain.ErrValidation)
+	}
+	if rec.Interval < 0 {
+		return fmt.Errorf("%w: interval must be positive", domain.ErrValidation)
+	}
+	if rec.Count < 0 {
+		return fmt.Errorf("%w: count must be positive", domain.ErrValidation)
+	}
+	// RFC 5545 запрещает COUNT и UNTIL в одном правиле.
+	if rec.Count > 0 && rec.Until != nil {
+		return fmt.Errorf("%w: count and until are mutually exclusive", domain.ErrValidation)
+	}
+	if rec.Until != nil && rec.Until.Before(start) {
+		return fmt.Errorf("%w: until must be after start", domain.ErrValidation)
+	}
+
+	if len(rec.ByWeekday) > 0 && rec.Freq != domain.FreqWeekly {
+		return fmt.Errorf("%w: by_weekday is only supported for weekly series", domain.ErrValidation)
+	}
+	for _, d := range rec.ByWeekday {
+		if d < time.Sunday || d > time.Saturday {
+			return fmt.Errorf("%w: invalid weekday %d", domain.ErrValidation, d)
+		}
+	}
+
+	if len(rec.ByMonthDay) > 0 && rec.Freq != domain.FreqMonthly {
+		return fmt.Errorf("%w: by_month_day is only supported for monthly series", domain.ErrValidation)
+	}
+	for _, d := range rec.ByMonthDay {
+		// Отрицательные дни считаются с конца месяца: -1 — последний день.
+		if d == 0 || d < -31 || d > 31 {
+			return fmt.Errorf("%w: invalid month day %d", domain.ErrValidation, d)
+		}
+	}
+
+	return nil
+}
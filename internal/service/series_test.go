@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/service/ports/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func weeklySeries(t *testing.T) *domain.EventSeries {
+	t.Helper()
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	return &domain.EventSeries{
+		ID:         "s1",
+		Title:      "Workshop",
+		TotalSpots: 10,
+		// Вторник, 19:00 по Берлину; 31 марта 2030 — переход на летнее время.
+		Start:    time.Date(2030, 3, 19, 19, 0, 0, 0, berlin),
+		Timezone: "Europe/Berlin",
+		Recurrence: domain.Recurrence{
+			Freq:      domain.FreqWeekly,
+			Interval:  1,
+			ByWeekday: []time.Weekday{time.Tuesday},
+			Count:     4,
+		},
+	}
+}
+
+func TestOccurrencesBetween_KeepsLocalTimeAcrossDST(t *testing.T) {
+	series := weeklySeries(t)
+	now := series.Start.Add(-time.Hour)
+
+	dates, err := occurrencesBetween(series, now, now.Add(60*24*time.Hour))
+
+	require.NoError(t, err)
+	require.Len(t, dates, 4)
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	for _, d := range dates {
+		assert.Equal(t, 19, d.In(berlin).Hour())
+		assert.Equal(t, time.Tuesday, d.In(berlin).Weekday())
+	}
+	assert.Equal(t, 18, dates[0].Hour())
+	assert.Equal(t, 17, dates[3].Hour())
+}
+
+func TestOccurrencesBetween_SkipsExceptionsAndMaterialized(t *testing.T) {
+	series := weeklySeries(t)
+	second := series.Start.AddDate(0, 0, 7)
+	series.Exceptions = []time.Time{series.Start.AddDate(0, 0, 14).UTC()}
+	series.MaterializedUntil = &second
+
+	dates, err := occurrencesBetween(series, series.Start.Add(-time.Hour), series.Start.AddDate(0, 2, 0))
+
+	require.NoError(t, err)
+	require.Len(t, dates, 1)
+	assert.True(t, dates[0].Equal(series.Start.AddDate(0, 0, 21)))
+}
+
+func TestOccurrencesBetween_MonthlyLastDay(t *testing.T) {
+	series := &domain.EventSeries{
+		Start:    time.Date(2030, 1, 31, 10, 0, 0, 0, time.UTC),
+		Timezone: "UTC",
+		Recurrence: domain.Recurrence{
+			Freq:       domain.FreqMonthly,
+			Interval:   1,
+			ByMonthDay: []int{-1},
+		},
+	}
+
+	dates, err := occurrencesBetween(series, series.Start.Add(-time.Hour), time.Date(2030, 4, 1, 0, 0, 0, 0, time.UTC))
+
+	require.NoError(t, err)
+	require.Len(t, dates, 3)
+	assert.Equal(t, 28, dates[1].Day())
+	assert.Equal(t, 31, dates[2].Day())
+}
+
+func TestSeriesService_Create_Validation(t *testing.T) {
+	svc := NewSeriesService(nil, nil, nil, 30*24*time.Hour, newTestLogger(t))
+	until := time.Now().Add(48 * time.Hour)
+
+	base := func() domain.CreateSeriesInput {
+		return domain.CreateSeriesInput{
+			Title:      "Workshop",
+			Start:      time.Now().Add(24 * time.Hour),
+			TotalSpots: 10,
+			Recurrence: domain.Recurrence{Freq: domain.FreqDaily},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(in *domain.CreateSeriesInput)
+	}{
+		{"unknown freq", func(in *domain.CreateSeriesInput) { in.Recurrence.Freq = "yearly" }},
+		{"count with until", func(in *domain.CreateSeriesInput) {
+			in.Recurrence.Count = 3
+			in.Recurrence.Until = &until
+		}},
+		{"weekday for daily", func(in *domain.CreateSeriesInput) {
+			in.Recurrence.ByWeekday = []time.Weekday{time.Monday}
+		}},
+		{"month day zero", func(in *domain.CreateSeriesInput) {
+			in.Recurrence.Freq = domain.FreqMonthly
+			in.Recurrence.ByMonthDay = []int{0}
+		}},
+		{"unknown timezone", func(in *domain.CreateSeriesInput) { in.Timezone = "Mars/Olympus" }},
+		{"start in past", func(in *domain.CreateSeriesInput) { in.Start = time.Now().Add(-time.Hour) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := base()
+			tt.modify(&in)
+			_, err := svc.Create(context.Background(), in)
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+func TestSeriesService_Create_MaterializesOccurrences(t *testing.T) {
+	repo := mocks.NewMockSeriesRepo(t)
+	announcer := mocks.NewMockEventAnnouncer(t)
+	svc := NewSeriesService(repo, nil, announcer, 7*24*time.Hour, newTestLogger(t))
+
+	start := time.Now().Add(time.Hour).Truncate(time.Second)
+	var saved *domain.EventSeries
+	repo.EXPECT().Create(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, s *domain.EventSeries) { saved = s }).Return(nil)
+
+	var added []*domain.Event
+	repo.EXPECT().AddOccurrences(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ string, events []*domain.Event, _ time.Time) ([]*domain.Event, error) {
+			added = events
+			return events, nil
+		})
+	announcer.EXPECT().Announce(mock.Anything, mock.MatchedBy(func(e *domain.Event) bool {
+		return e.Status == domain.EventStatusPublished
+	})).Return().Times(3)
+	repo.EXPECT().GetByID(mock.Anything, mock.Anything).RunAndReturn(
+		func(context.Context, string) (*domain.EventSeries, error) { return saved, nil })
+	repo.EXPECT().ListOccurrences(mock.Anything, mock.Anything).RunAndReturn(
+		func(context.Context, string) ([]*domain.Event, error) { return added, nil })
+
+	details, err := svc.Create(context.Background(), domain.CreateSeriesInput{
+		Title:      "Standup",
+		Start:      start,
+		TotalSpots: 5,
+		Recurrence: domain.Recurrence{Freq: domain.FreqDaily, Count: 3},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, details.Series.Recurrence.Interval)
+	assert.Equal(t, "UTC", details.Series.Timezone)
+	require.Len(t, details.Occurrences, 3)
+	assert.Equal(t, saved.ID, *details.Occurrences[0].SeriesID)
+	assert.True(t, details.Occurrences[2].EventDate.Equal(start.AddDate(0, 0, 2)))
+}
+
+func TestSeriesService_Materialize_ContinuesAfterFailure(t *testing.T) {
+	repo := mocks.NewMockSeriesRepo(t)
+	announcer := mocks.NewMockEventAnnouncer(t)
+	svc := NewSeriesService(repo, nil, announcer, 7*24*time.Hour, newTestLogger(t))
+
+	start := time.Now().Add(time.Hour)
+	broken := &domain.EventSeries{ID: "broken", Start: start, Timezone: "Nowhere/Invalid",
+		Recurrence: domain.Recurrence{Freq: domain.FreqDaily, Interval: 1}}
+	ok := &domain.EventSeries{ID: "ok", Start: start, Timezone: "UTC",
+		Recurrence: domain.Recurrence{Freq: domain.FreqDaily, Interval: 1, Count: 2}}
+
+	repo.EXPECT().ListDue(mock.Anything, mock.Anything).Return([]*domain.EventSeries{broken, ok}, nil)
+	repo.EXPECT().AddOccurrences(mock.Anything, "ok", mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ string, events []*domain.Event, _ time.Time) ([]*domain.Event, error) {
+			return events[:1], nil
+		})
+	announcer.EXPECT().Announce(mock.Anything, mock.Anything).Return().Once()
+
+	n, err := svc.Materialize(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
+
+func TestSeriesService_Update_Cancelled(t *testing.T) {
+	repo := mocks.NewMockSeriesRepo(t)
+	svc := NewSeriesService(repo, nil, nil, time.Hour, newTestLogger(t))
+
+	cancelledAt := time.Now()
+	repo.EXPECT().GetByID(mock.Anything, "s1").Return(&domain.EventSeries{ID: "s1", CancelledAt: &cancelledAt}, nil)
+
+	title := "New"
+	_, err := svc.Update(context.Background(), "s1", domain.UpdateSeriesInput{Title: &title})
+
+	assert.ErrorIs(t, err, domain.ErrSeriesCancelled)
+}
+
+func TestSeriesService_Cancel_NotifiesCancelledBookings(t *testing.T) {
+	repo := mocks.NewMockSeriesRepo(t)
+	svc := NewSeriesService(repo, nopWebhooks(t), nil, time.Hour, newTestLogger(t))
+
+	b := &domain.Booking{ID: "b1", EventID: "e1", UserID: "u1", Status: domain.BookingStatusCancelled}
+	var outbox domain.BookingOutbox
+	repo.EXPECT().Cancel(mock.Anything, "s1", mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _ string, _ domain.BookingChange, o domain.BookingOutbox) { outbox = o }).
+		Return([]*domain.Booking{b}, nil)
+
+	require.NoError(t, svc.Cancel(context.Background(), "s1"))
+
+	kinds, types := outboxKinds(runOutbox(t, outbox, b, &domain.Event{ID: "e1"}))
+	assert.Equal(t, []domain.NotificationKind{domain.NotificationBookingCancelled}, kinds)
+	assert.Equal(t, []domain.WebhookEventType{domain.WebhookBookingCancelled}, types)
+}
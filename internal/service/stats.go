@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/service/ports"
+)
+
+const (
+	defaultStatsDays = 30
+	// maxStatsDays ограничивает период: дневной ряд и список мероприятий растут вместе с ним.
+	maxStatsDays     = 366
+	defaultTopEvents = 10
+	maxTopEvents     = 100
+)
+
+// StatsService собирает сводку по броням и заполненности для панели администратора.
+type StatsService struct {
+	repo ports.ReportingRepo
+}
+
+func NewStatsService(repo ports.ReportingRepo) *StatsService {
+	return &StatsService{repo: repo}
+}
+
+func (s *StatsService) BookingStats(ctx context.Context, input domain.StatsInput) (*domain.BookingStats, error) {
+	rng, err := statsRange(input, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	top := input.Top
+	if top == 0 {
+		top = defaultTopEvents
+	}
+	if top < 0 || top > maxTopEvents {
+		return nil, fmt.Errorf("%w: top must be between 1 and %d", domain.ErrValidation, maxTopEvents)
+	}
+
+	stats := &domain.BookingStats{Range: rng}
+	if stats.Daily, err = s.repo.DailyBookings(ctx, rng); err != nil {
+		return nil, err
+	}
+	conversion, err := s.repo.Conversion(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	stats.Conversion = *conversion
+	if stats.Occupancy, err = s.repo.Occupancy(ctx, rng); err != nil {
+		return nil, err
+	}
+	if stats.TopEvents, err = s.repo.TopEvents(ctx, rng, top); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// statsRange переводит календарные дни в полуинтервал [полночь From, полночь после To)
+// в часовом поясе отчёта.
+func statsRange(input domain.StatsInput, now time.Time) (domain.StatsRange, error) {
+	tz := input.Timezone
+	if tz == "" {
+		tz = "UTC"
+	}
+	loc, err := domain.LoadTimezone(tz)
+	if err != nil {
+		return domain.StatsRange{}, err
+	}
+
+	to := input.To
+	if to.IsZero() {
+		to = now.In(loc)
+	}
+	to = midnight(to, loc)
+	from := input.From
+	if from.IsZero() {
+		from = to.AddDate(0, 0, -(defaultStatsDays - 1))
+	}
+	from = midnight(from, loc)
+
+	if from.After(to) {
+		return domain.StatsRange{}, fmt.Errorf("%w: from must not be after to", domain.ErrValidation)
+	}
+	end := to.AddDate(0, 0, 1)
+	if from.AddDate(0, 0, maxStatsDays).Before(end) {
+		return domain.StatsRange{}, fmt.Errorf("%w: period must not exceed %d days", domain.ErrValidation, maxStatsDays)
+	}
+
+	return domain.StatsRange{From: from, To: end, Location: loc}, nil
+}
+
+// midnight — начало календарного дня t в поясе loc; берётся дата t как есть, без пересчёта.
+func midnight(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
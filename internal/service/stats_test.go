@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/service/ports/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsRange_DefaultsToLast30Days(t *testing.T) {
+	now := time.Date(2030, 5, 31, 23, 30, 0, 0, time.UTC)
+
+	rng, err := statsRange(domain.StatsInput{Timezone: "Europe/Moscow"}, now)
+
+	require.NoError(t, err)
+	msk, _ := time.LoadLocation("Europe/Moscow")
+	// В Москве уже 1 июня: период заканчивается этим днём.
+	assert.Equal(t, time.Date(2030, 5, 3, 0, 0, 0, 0, msk), rng.From)
+	assert.Equal(t, time.Date(2030, 6, 2, 0, 0, 0, 0, msk), rng.To)
+	assert.Equal(t, msk, rng.Location)
+}
+
+func TestStatsRange_Validation(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2030, 1, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name  string
+		input domain.StatsInput
+	}{
+		{"from after to", domain.StatsInput{From: day(10), To: day(9)}},
+		{"too long", domain.StatsInput{From: day(1), To: day(1).AddDate(1, 0, 1)}},
+		{"unknown timezone", domain.StatsInput{Timezone: "Mars/Olympus"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := statsRange(tt.input, time.Now())
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+func TestStatsService_BookingStats(t *testing.T) {
+	repo := mocks.NewMockReportingRepo(t)
+	svc := NewStatsService(repo)
+
+	from := time.Date(2030, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2030, 5, 2, 0, 0, 0, 0, time.UTC)
+	rng := domain.StatsRange{From: from, To: to.AddDate(0, 0, 1), Location: time.UTC}
+
+	repo.EXPECT().DailyBookings(mock.Anything, rng).Return([]domain.DailyBookingStats{
+		{Day: from, Created: 3}, {Day: to, Confirmed: 2},
+	}, nil)
+	repo.EXPECT().Conversion(mock.Anything, rng).Return(&domain.ConversionStats{Resolved: 4, Confirmed: 3, Rate: 0.75}, nil)
+	repo.EXPECT().Occupancy(mock.Anything, rng).Return([]domain.EventOccupancy{{EventID: "e1", FillRate: 0.5}}, nil)
+	repo.EXPECT().TopEvents(mock.Anything, rng, defaultTopEvents).Return([]domain.EventOccupancy{{EventID: "e1"}}, nil)
+
+	stats, err := svc.BookingStats(context.Background(), domain.StatsInput{From: from, To: to})
+
+	require.NoError(t, err)
+	assert.Len(t, stats.Daily, 2)
+	assert.InDelta(t, 0.75, stats.Conversion.Rate, 1e-9)
+	assert.Len(t, stats.Occupancy, 1)
+	assert.Len(t, stats.TopEvents, 1)
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/service/ports"
+)
+
+// linkTokenBytes — длина токена до кодирования; base64url даёт 43 символа,
+// что укладывается в лимит 64 символа параметра start.
+const linkTokenBytes = 32
+
+// TelegramLinkService выдаёт одноразовые ссылки t.me/<bot>?start=<token>
+// и привязывает чат, из которого пришёл /start, к владельцу токена.
+type TelegramLinkService struct {
+	linkRepo    ports.TelegramLinkRepo
+	userRepo    ports.UserRepo
+	botUsername string
+	ttl         time.Duration
+}
+
+func NewTelegramLinkService(
+	linkRepo ports.TelegramLinkRepo,
+	userRepo ports.UserRepo,
+	botUsername string,
+	ttl time.Duration,
+) *TelegramLinkService {
+	return &TelegramLinkService{
+		linkRepo:    linkRepo,
+		userRepo:    userRepo,
+		botUsername: botUsername,
+		ttl:         ttl,
+	}
+}
+
+func (s *TelegramLinkService) CreateLink(ctx context.Context, userID string) (*domain.TelegramLink, error) {
+	if s.botUsername == "" {
+		return nil, domain.ErrTelegramDisabled
+	}
+
+	if _, err := s.userRepo.GetByID(ctx, userID); err != nil {
+		return nil, fmt.Errorf("check user: %w", err)
+	}
+
+	token, err := newToken(linkTokenBytes)
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := time.Now().UTC().Add(s.ttl)
+
+	if err = s.linkRepo.Create(ctx, hashToken(token), userID, expiresAt); err != nil {
+		return nil, fmt.Errorf("save token: %w", err)
+	}
+
+	return &domain.TelegramLink{
+		Token:     token,
+		URL:       fmt.Sprintf("https://t.me/%s?start=%s", s.botUsername, token),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// Link использует токен и сохраняет chatID у его владельца. Токен тратится, только
+// если чат привязан: оба изменения делаются в одной транзакции репозитория.
+func (s *TelegramLinkService) Link(ctx context.Context, token string, chatID int64) (*domain.User, error) {
+	if token == "" {
+		return nil, domain.ErrTelegramLinkInvalid
+	}
+
+	userID, err := s.linkRepo.Link(ctx, hashToken(token), chatID)
+	if err != nil {
+		return nil, fmt.Errorf("link chat: %w", err)
+	}
+
+	return s.userRepo.GetByID(ctx, userID)
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/service/ports/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTelegramLinkService_CreateLink_Success(t *testing.T) {
+	linkRepo := mocks.NewMockTelegramLinkRepo(t)
+	userRepo := mocks.NewMockUserRepo(t)
+	svc := NewTelegramLinkService(linkRepo, userRepo, "event_booker_bot", 15*time.Minute)
+
+	userRepo.EXPECT().GetByID(mock.Anything, "u1").Return(&domain.User{ID: "u1"}, nil)
+
+	var storedHash string
+	linkRepo.EXPECT().Create(mock.Anything, mock.Anything, "u1", mock.Anything).
+		Run(func(_ context.Context, tokenHash, _ string, _ time.Time) { storedHash = tokenHash }).
+		Return(nil)
+
+	link, err := svc.CreateLink(context.Background(), "u1")
+
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(link.URL, "https://t.me/event_booker_bot?start="))
+	assert.LessOrEqual(t, len(link.Token), 64)
+	assert.Equal(t, hashToken(link.Token), storedHash)
+	assert.NotEqual(t, link.Token, storedHash)
+	assert.WithinDuration(t, time.Now().Add(15*time.Minute), link.ExpiresAt, time.Minute)
+}
+
+func TestTelegramLinkService_CreateLink_BotNotConfigured(t *testing.T) {
+	svc := NewTelegramLinkService(nil, nil, "", 15*time.Minute)
+
+	_, err := svc.CreateLink(context.Background(), "u1")
+
+	assert.ErrorIs(t, err, domain.ErrTelegramDisabled)
+}
+
+func TestTelegramLinkService_CreateLink_UserNotFound(t *testing.T) {
+	userRepo := mocks.NewMockUserRepo(t)
+	svc := NewTelegramLinkService(nil, userRepo, "event_booker_bot", 15*time.Minute)
+
+	userRepo.EXPECT().GetByID(mock.Anything, "u1").Return(nil, domain.ErrUserNotFound)
+
+	_, err := svc.CreateLink(context.Background(), "u1")
+
+	assert.ErrorIs(t, err, domain.ErrUserNotFound)
+}
+
+func TestTelegramLinkService_Link_Success(t *testing.T) {
+	linkRepo := mocks.NewMockTelegramLinkRepo(t)
+	userRepo := mocks.NewMockUserRepo(t)
+	svc := NewTelegramLinkService(linkRepo, userRepo, "event_booker_bot", 15*time.Minute)
+
+	chatID := int64(42)
+	linkRepo.EXPECT().Link(mock.Anything, hashToken("tok"), chatID).Return("u1", nil)
+	userRepo.EXPECT().GetByID(mock.Anything, "u1").Return(&domain.User{ID: "u1", TelegramChatID: &chatID}, nil)
+
+	user, err := svc.Link(context.Background(), "tok", chatID)
+
+	require.NoError(t, err)
+	assert.Equal(t, &chatID, user.TelegramChatID)
+}
+
+func TestTelegramLinkService_Link_InvalidToken(t *testing.T) {
+	linkRepo := mocks.NewMockTelegramLinkRepo(t)
+	userRepo := mocks.NewMockUserRepo(t)
+	svc := NewTelegramLinkService(linkRepo, userRepo, "event_booker_bot", 15*time.Minute)
+
+	linkRepo.EXPECT().Link(mock.Anything, hashToken("tok"), int64(42)).Return("", domain.ErrTelegramLinkInvalid)
+
+	_, err := svc.Link(context.Background(), "tok", 42)
+
+	assert.ErrorIs(t, err, domain.ErrTelegramLinkInvalid)
+}
+
+func TestTelegramLinkService_Link_EmptyToken(t *testing.T) {
+	svc := NewTelegramLinkService(nil, nil, "event_booker_bot", 15*time.Minute)
+
+	_, err := svc.Link(context.Background(), "", 42)
+
+	assert.ErrorIs(t, err, domain.ErrTelegramLinkInvalid)
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"net/mail"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,22 +12,53 @@ import (
 )
 
 type UserService struct {
-	repo ports.UserRepo
+	repo      ports.UserRepo
+	prefsRepo ports.NotificationPreferenceRepo
 }
 
-func NewUserService(repo ports.UserRepo) *UserService {
-	return &UserService{repo: repo}
+func NewUserService(repo ports.UserRepo, prefsRepo ports.NotificationPreferenceRepo) *UserService {
+	return &UserService{repo: repo, prefsRepo: prefsRepo}
 }
 
 func (s *UserService) Create(ctx context.Context, input domain.CreateUserInput) (*domain.User, error) {
 	if input.Username == "" {
 		return nil, fmt.Errorf("%w: username is required", domain.ErrValidation)
 	}
+	if input.Email != nil {
+		if _, err := mail.ParseAddress(*input.Email); err != nil {
+			return nil, fmt.Errorf("%w: invalid email", domain.ErrValidation)
+		}
+	}
+	if input.WebhookURL != nil {
+		if err := validateWebhookURL(ctx, "webhook_url", *input.WebhookURL); err != nil {
+			return nil, err
+		}
+	}
+
+	locale := input.Locale
+	if locale == "" {
+		locale = domain.DefaultLocale
+	}
+	if !domain.IsSupportedLocale(locale) {
+		return nil, fmt.Errorf("%w: unsupported locale %q", domain.ErrValidation, locale)
+	}
+
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = domain.DefaultTimezone
+	}
+	if err := domain.ValidateTimezone(timezone); err != nil {
+		return nil, err
+	}
 
 	user := &domain.User{
 		ID:             uuid.New().String(),
 		Username:       input.Username,
 		TelegramChatID: input.TelegramChatID,
+		Email:          input.Email,
+		WebhookURL:     input.WebhookURL,
+		Locale:         locale,
+		Timezone:       timezone,
 		CreatedAt:      time.Now().UTC(),
 	}
 
@@ -41,6 +73,54 @@ func (s *UserService) GetByID(ctx context.Context, id string) (*domain.User, err
 	return s.repo.GetByID(ctx, id)
 }
 
+// GetByTelegramChatID находит пользователя, привязанного к чату Telegram.
+func (s *UserService) GetByTelegramChatID(ctx context.Context, chatID int64) (*domain.User, error) {
+	return s.repo.GetByTelegramChatID(ctx, chatID)
+}
+
 func (s *UserService) List(ctx context.Context) ([]*domain.User, error) {
 	return s.repo.List(ctx)
 }
+
+func (s *UserService) GetNotificationPreferences(ctx context.Context, userID string) (*domain.NotificationPreferences, error) {
+	if _, err := s.repo.GetByID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return s.prefsRepo.Get(ctx, userID)
+}
+
+// UpdateNotificationPreferences применяет частичные изменения поверх текущих настроек.
+func (s *UserService) UpdateNotificationPreferences(
+	ctx context.Context,
+	userID string,
+	changes map[domain.NotificationChannel]map[domain.NotificationKind]bool,
+) (*domain.NotificationPreferences, error) {
+	for ch, kinds := range changes {
+		if !domain.IsValidNotificationChannel(ch) {
+			return nil, fmt.Errorf("%w: unknown channel %q", domain.ErrValidation, ch)
+		}
+		for kind := range kinds {
+			if !domain.IsValidNotificationKind(kind) {
+				return nil, fmt.Errorf("%w: unknown notification type %q", domain.ErrValidation, kind)
+			}
+		}
+	}
+
+	prefs, err := s.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for ch, kinds := range changes {
+		for kind, enabled := range kinds {
+			prefs.Set(ch, kind, enabled)
+		}
+	}
+
+	if err = s.prefsRepo.Save(ctx, prefs); err != nil {
+		return nil, fmt.Errorf("save notification preferences: %w", err)
+	}
+
+	return prefs, nil
+}
@@ -14,7 +14,7 @@ import (
 
 func TestUserService_Create_Success(t *testing.T) {
 	repo := mocks.NewMockUserRepo(t)
-	svc := NewUserService(repo)
+	svc := NewUserService(repo, nil)
 
 	repo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
 
@@ -29,11 +29,50 @@ func TestUserService_Create_Success(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "testuser", user.Username)
 	assert.Equal(t, &chatID, user.TelegramChatID)
+	assert.Equal(t, domain.DefaultLocale, user.Locale)
+	assert.Equal(t, domain.DefaultTimezone, user.Timezone)
 	assert.NotEmpty(t, user.ID)
 }
 
+func TestUserService_Create_UnsupportedLocale(t *testing.T) {
+	svc := NewUserService(nil, nil)
+
+	_, err := svc.Create(context.Background(), domain.CreateUserInput{Username: "user", Locale: "de"})
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestUserService_Create_UnknownTimezone(t *testing.T) {
+	svc := NewUserService(nil, nil)
+
+	_, err := svc.Create(context.Background(), domain.CreateUserInput{Username: "user", Timezone: "Mars/Olympus"})
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestUserService_Create_PrivateWebhookURL(t *testing.T) {
+	svc := NewUserService(nil, nil)
+
+	hook := "http://169.254.169.254/latest/meta-data"
+	_, err := svc.Create(context.Background(), domain.CreateUserInput{Username: "user", WebhookURL: &hook})
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestUserService_Create_LocalTimezone(t *testing.T) {
+	svc := NewUserService(nil, nil)
+
+	_, err := svc.Create(context.Background(), domain.CreateUserInput{Username: "user", Timezone: "Local"})
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
 func TestUserService_Create_EmptyUsername(t *testing.T) {
-	svc := NewUserService(nil)
+	svc := NewUserService(nil, nil)
 
 	_, err := svc.Create(context.Background(), domain.CreateUserInput{Username: ""})
 
@@ -43,7 +82,7 @@ func TestUserService_Create_EmptyUsername(t *testing.T) {
 
 func TestUserService_Create_RepoError(t *testing.T) {
 	repo := mocks.NewMockUserRepo(t)
-	svc := NewUserService(repo)
+	svc := NewUserService(repo, nil)
 
 	repoErr := errors.New("db error")
 	repo.EXPECT().Create(mock.Anything, mock.Anything).Return(repoErr)
@@ -56,7 +95,7 @@ func TestUserService_Create_RepoError(t *testing.T) {
 
 func TestUserService_Create_UsernameTaken(t *testing.T) {
 	repo := mocks.NewMockUserRepo(t)
-	svc := NewUserService(repo)
+	svc := NewUserService(repo, nil)
 
 	repo.EXPECT().Create(mock.Anything, mock.Anything).Return(domain.ErrUsernameTaken)
 
@@ -68,7 +107,7 @@ func TestUserService_Create_UsernameTaken(t *testing.T) {
 
 func TestUserService_GetByID_Success(t *testing.T) {
 	repo := mocks.NewMockUserRepo(t)
-	svc := NewUserService(repo)
+	svc := NewUserService(repo, nil)
 
 	expected := &domain.User{ID: "u1", Username: "alice"}
 	repo.EXPECT().GetByID(mock.Anything, "u1").Return(expected, nil)
@@ -81,7 +120,7 @@ func TestUserService_GetByID_Success(t *testing.T) {
 
 func TestUserService_GetByID_NotFound(t *testing.T) {
 	repo := mocks.NewMockUserRepo(t)
-	svc := NewUserService(repo)
+	svc := NewUserService(repo, nil)
 
 	repo.EXPECT().GetByID(mock.Anything, "missing").Return(nil, domain.ErrUserNotFound)
 
@@ -93,7 +132,7 @@ func TestUserService_GetByID_NotFound(t *testing.T) {
 
 func TestUserService_List_Success(t *testing.T) {
 	repo := mocks.NewMockUserRepo(t)
-	svc := NewUserService(repo)
+	svc := NewUserService(repo, nil)
 
 	users := []*domain.User{{ID: "u1"}, {ID: "u2"}}
 	repo.EXPECT().List(mock.Anything).Return(users, nil)
@@ -106,7 +145,7 @@ func TestUserService_List_Success(t *testing.T) {
 
 func TestUserService_List_Error(t *testing.T) {
 	repo := mocks.NewMockUserRepo(t)
-	svc := NewUserService(repo)
+	svc := NewUserService(repo, nil)
 
 	repo.EXPECT().List(mock.Anything).Return(nil, errors.New("db error"))
 
@@ -114,3 +153,59 @@ func TestUserService_List_Error(t *testing.T) {
 
 	require.Error(t, err)
 }
+
+func TestUserService_Create_InvalidEmail(t *testing.T) {
+	svc := NewUserService(nil, nil)
+
+	email := "not-an-email"
+	_, err := svc.Create(context.Background(), domain.CreateUserInput{Username: "user", Email: &email})
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestUserService_GetNotificationPreferences_UserNotFound(t *testing.T) {
+	repo := mocks.NewMockUserRepo(t)
+	prefsRepo := mocks.NewMockNotificationPreferenceRepo(t)
+	svc := NewUserService(repo, prefsRepo)
+
+	repo.EXPECT().GetByID(mock.Anything, "missing").Return(nil, domain.ErrUserNotFound)
+
+	_, err := svc.GetNotificationPreferences(context.Background(), "missing")
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrUserNotFound)
+}
+
+func TestUserService_UpdateNotificationPreferences_Success(t *testing.T) {
+	repo := mocks.NewMockUserRepo(t)
+	prefsRepo := mocks.NewMockNotificationPreferenceRepo(t)
+	svc := NewUserService(repo, prefsRepo)
+
+	repo.EXPECT().GetByID(mock.Anything, "u1").Return(&domain.User{ID: "u1"}, nil)
+	prefsRepo.EXPECT().Get(mock.Anything, "u1").Return(domain.NewNotificationPreferences("u1"), nil)
+	prefsRepo.EXPECT().Save(mock.Anything, mock.Anything).Return(nil)
+
+	prefs, err := svc.UpdateNotificationPreferences(context.Background(), "u1",
+		map[domain.NotificationChannel]map[domain.NotificationKind]bool{
+			domain.ChannelWebhook:  {domain.NotificationBookingConfirmed: true},
+			domain.ChannelTelegram: {domain.NotificationBookingCreated: false},
+		})
+
+	require.NoError(t, err)
+	assert.True(t, prefs.Enabled(domain.ChannelWebhook, domain.NotificationBookingConfirmed))
+	assert.False(t, prefs.Enabled(domain.ChannelTelegram, domain.NotificationBookingCreated))
+	assert.True(t, prefs.Enabled(domain.ChannelEmail, domain.NotificationBookingCreated))
+}
+
+func TestUserService_UpdateNotificationPreferences_UnknownChannel(t *testing.T) {
+	svc := NewUserService(nil, nil)
+
+	_, err := svc.UpdateNotificationPreferences(context.Background(), "u1",
+		map[domain.NotificationChannel]map[domain.NotificationKind]bool{
+			"sms": {domain.NotificationBookingCreated: true},
+		})
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/service/ports"
+)
+
+type VenueService struct {
+	repo ports.VenueRepo
+}
+
+func NewVenueService(repo ports.VenueRepo) *VenueService {
+	return &VenueService{repo: repo}
+}
+
+func (s *VenueService) Create(ctx context.Context, input domain.CreateVenueInput) (*domain.Venue, error) {
+	tz := input.Timezone
+	if tz == "" {
+		tz = "UTC"
+	}
+
+	now := time.Now().UTC()
+	venue := &domain.Venue{
+		ID:        uuid.New().String(),
+		Name:      input.Name,
+		Address:   input.Address,
+		Latitude:  input.Latitude,
+		Longitude: input.Longitude,
+		Capacity:  input.Capacity,
+		Timezone:  tz,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := validateVenue(venue); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, venue); err != nil {
+		return nil, fmt.Errorf("create venue: %w", err)
+	}
+
+	return venue, nil
+}
+
+func (s *VenueService) Get(ctx context.Context, id string) (*domain.Venue, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *VenueService) List(ctx context.Context) ([]*domain.Venue, error) {
+	return s.repo.List(ctx)
+}
+
+// Update меняет площадку. Вместимость влияет только на новые мероприятия:
+// у существующих TotalSpots уже зафиксирован.
+func (s *VenueService) Update(ctx context.Context, id string, input domain.UpdateVenueInput) (*domain.Venue, error) {
+	venue, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Name != nil {
+		venue.Name = *input.Name
+	}
+	if input.Address != nil {
+		venue.Address = *input.Address
+	}
+	if input.Latitude != nil || input.Longitude != nil {
+		venue.Latitude, venue.Longitude = input.Latitude, input.Longitude
+	}
+	if input.Capacity != nil {
+		venue.Capacity = *input.Capacity
+	}
+	if input.Timezone != nil {
+		venue.Timezone = *input.Timezone
+	}
+	if err = validateVenue(venue); err != nil {
+		return nil, err
+	}
+	venue.UpdatedAt = time.Now().UTC()
+
+	if err = s.repo.Update(ctx, venue); err != nil {
+		return nil, fmt.Errorf("update venue: %w", err)
+	}
+
+	return venue, nil
+}
+
+func (s *VenueService) Delete(ctx context.Context, id string) error {
+	return s.repo.Delete(ctx, id)
+}
+
+func validateVenue(v *domain.Venue) error {
+	if v.Name == "" {
+		return fmt.Errorf("%w: name is required", domain.ErrValidation)
+	}
+	if v.Address == "" {
+		return fmt.Errorf("%w: address is required", domain.ErrValidation)
+	}
+	if v.Capacity <= 0 {
+		return fmt.Errorf("%w: capacity must be positive", domain.ErrValidation)
+	}
+	if err := domain.ValidateTimezone(v.Timezone); err != nil {
+		return err
+	}
+
+	// Координаты задаются парой: одна широта без долготы бессмысленна.
+	if (v.Latitude == nil) != (v.Longitude == nil) {
+		return fmt.Errorf("%w: latitude and longitude must be set together", domain.ErrValidation)
+	}
+	if v.Latitude != nil && (*v.Latitude < -90 || *v.Latitude > 90) {
+		return fmt.Errorf("%w: latitude must be between -90 and 90", domain.ErrValidation)
+	}
+	if v.Longitude != nil && (*v.Longitude < -180 || *v.Longitude > 180) {
+		return fmt.Errorf("%w: longitude must be between -180 and 180", domain.ErrValidation)
+	}
+
+	return nil
+}
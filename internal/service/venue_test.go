@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/service/ports/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVenueService_Create_DefaultTimezone(t *testing.T) {
+	repo := mocks.NewMockVenueRepo(t)
+	svc := NewVenueService(repo)
+
+	repo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+	venue, err := svc.Create(context.Background(), domain.CreateVenueInput{
+		Name: "Hall A", Address: "Tverskaya 1", Capacity: 100,
+	})
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, venue.ID)
+	assert.Equal(t, "UTC", venue.Timezone)
+}
+
+func TestVenueService_Create_Validation(t *testing.T) {
+	lat, lon, bad := 55.75, 37.61, 200.0
+
+	tests := []struct {
+		name  string
+		input domain.CreateVenueInput
+	}{
+		{"empty name", domain.CreateVenueInput{Address: "A", Capacity: 1}},
+		{"empty address", domain.CreateVenueInput{Name: "N", Capacity: 1}},
+		{"zero capacity", domain.CreateVenueInput{Name: "N", Address: "A"}},
+		{"unknown timezone", domain.CreateVenueInput{Name: "N", Address: "A", Capacity: 1, Timezone: "Mars/Olympus"}},
+		{"latitude only", domain.CreateVenueInput{Name: "N", Address: "A", Capacity: 1, Latitude: &lat}},
+		{"longitude out of range", domain.CreateVenueInput{Name: "N", Address: "A", Capacity: 1, Latitude: &lat, Longitude: &bad}},
+		{"latitude out of range", domain.CreateVenueInput{Name: "N", Address: "A", Capacity: 1, Latitude: &bad, Longitude: &lon}},
+	}
+
+	svc := NewVenueService(nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.Create(context.Background(), tt.input)
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+func TestVenueService_Update_ClearsCoordinatesTogether(t *testing.T) {
+	repo := mocks.NewMockVenueRepo(t)
+	svc := NewVenueService(repo)
+
+	lat, lon := 55.75, 37.61
+	repo.EXPECT().GetByID(mock.Anything, "v1").Return(&domain.Venue{
+		ID: "v1", Name: "Hall", Address: "A", Capacity: 10, Timezone: "UTC", Latitude: &lat, Longitude: &lon,
+	}, nil)
+
+	newLat := 59.93
+	_, err := svc.Update(context.Background(), "v1", domain.UpdateVenueInput{Latitude: &newLat})
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestVenueService_Update_Success(t *testing.T) {
+	repo := mocks.NewMockVenueRepo(t)
+	svc := NewVenueService(repo)
+
+	repo.EXPECT().GetByID(mock.Anything, "v1").Return(&domain.Venue{
+		ID: "v1", Name: "Hall", Address: "A", Capacity: 10, Timezone: "UTC",
+	}, nil)
+	repo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(v *domain.Venue) bool {
+		return v.Capacity == 50 && v.Name == "Hall"
+	})).Return(nil)
+
+	capacity := 50
+	venue, err := svc.Update(context.Background(), "v1", domain.UpdateVenueInput{Capacity: &capacity})
+
+	require.NoError(t, err)
+	assert.Equal(t, 50, venue.Capacity)
+}
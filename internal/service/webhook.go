@@ -0,0 +1,414 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/netguard"
+	"github.com/stpnv0/EventBooker/internal/service/ports"
+	"github.com/wb-go/wbf/logger"
+)
+
+const (
+	// webhookLease — время, на которое воркер резервирует доставку.
+	webhookLease = time.Minute
+	// webhookSecretBytes — длина сгенерированного секрета подписки.
+	webhookSecretBytes = 32
+	// webhookDeliveriesLimit — сколько последних доставок отдаёт журнал.
+	webhookDeliveriesLimit = 100
+)
+
+// WebhookConfig — параметры доставки вебхуков.
+type WebhookConfig struct {
+	BatchSize   int
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+}
+
+// WebhookService управляет подписками партнёров и доставляет им события с повторами.
+type WebhookService struct {
+	repo   ports.WebhookRepo
+	sender ports.WebhookSender
+	cfg    WebhookConfig
+	logger logger.Logger
+}
+
+func NewWebhookService(repo ports.WebhookRepo, sender ports.WebhookSender, cfg WebhookConfig, logger logger.Logger) *WebhookService {
+	return &WebhookService{
+		repo:   repo,
+		sender: sender,
+		cfg:    cfg,
+		logger: logger,
+	}
+}
+
+// webhookPayload — тело запроса, одинаковое для всех подписчиков события.
+type webhookPayload struct {
+	ID        string                  `json:"id"`
+	Type      domain.WebhookEventType `json:"type"`
+	CreatedAt time.Time               `json:"created_at"`
+	Data      any                     `json:"data"`
+}
+
+type bookingPayload struct {
+	ID        string               `json:"id"`
+	EventID   string               `json:"event_id"`
+	UserID    string               `json:"user_id"`
+	Status    domain.BookingStatus `json:"status"`
+	CreatedAt time.Time            `json:"created_at"`
+	UpdatedAt time.Time            `json:"updated_at"`
+}
+
+type eventPayload struct {
+	ID                string    `json:"id"`
+	Title             string    `json:"title"`
+	Description       string    `json:"description"`
+	EventDate         time.Time `json:"event_date"`
+	EndDate           time.Time `json:"end_date"`
+	Timezone          string    `json:"timezone"`
+	TotalSpots        int       `json:"total_spots"`
+	RequiresPayment   bool      `json:"requires_payment"`
+	BookingTTLMinutes int       `json:"booking_ttl_minutes"`
+	DurationMinutes   int       `json:"duration_minutes"`
+	VenueID           *string   `json:"venue_id,omitempty"`
+}
+
+func (s *WebhookService) CreateSubscription(ctx context.Context, input domain.CreateWebhookInput) (*domain.WebhookSubscription, error) {
+	if err := validateWebhookURL(ctx, "url", input.URL); err != nil {
+		return nil, err
+	}
+	if err := validateWebhookEventTypes(input.EventTypes); err != nil {
+		return nil, err
+	}
+
+	secret := input.Secret
+	if secret == "" {
+		buf := make([]byte, webhookSecretBytes)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, fmt.Errorf("generate secret: %w", err)
+		}
+		secret = hex.EncodeToString(buf)
+	}
+
+	now := time.Now().UTC()
+	sub := &domain.WebhookSubscription{
+		ID:         uuid.New().String(),
+		URL:        input.URL,
+		Secret:     secret,
+		EventTypes: input.EventTypes,
+		Active:     true,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := s.repo.CreateSubscription(ctx, sub); err != nil {
+		return nil, fmt.Errorf("create subscription: %w", err)
+	}
+
+	return sub, nil
+}
+
+func (s *WebhookService) GetSubscription(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	return s.repo.GetSubscription(ctx, id)
+}
+
+func (s *WebhookService) ListSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error) {
+	return s.repo.ListSubscriptions(ctx)
+}
+
+func (s *WebhookService) UpdateSubscription(
+	ctx context.Context,
+	id string,
+	input domain.UpdateWebhookInput,
+) (*domain.WebhookSubscription, error) {
+	sub, err := s.repo.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.URL != nil {
+		if err = validateWebhookURL(ctx, "url", *input.URL); err != nil {
+			return nil, err
+		}
+		sub.URL = *input.URL
+	}
+	if input.EventTypes != nil {
+		if err = validateWebhookEventTypes(input.EventTypes); err != nil {
+			return nil, err
+		}
+		sub.EventTypes = input.EventTypes
+	}
+	if input.Active != nil {
+		sub.Active = *input.Active
+	}
+	sub.UpdatedAt = time.Now().UTC()
+
+	if err = s.repo.UpdateSubscription(ctx, sub); err != nil {
+		return nil, fmt.Errorf("update subscription: %w", err)
+	}
+
+	return sub, nil
+}
+
+func (s *WebhookService) DeleteSubscription(ctx context.Context, id string) error {
+	return s.repo.DeleteSubscription(ctx, id)
+}
+
+func (s *WebhookService) ListDeliveries(ctx context.Context, subscriptionID string) ([]*domain.WebhookDelivery, error) {
+	if _, err := s.repo.GetSubscription(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListDeliveries(ctx, subscriptionID, webhookDeliveriesLimit)
+}
+
+// GetDelivery возвращает доставку с журналом попыток, если она относится к подписке.
+func (s *WebhookService) GetDelivery(ctx context.Context, subscriptionID, deliveryID string) (*domain.WebhookDelivery, error) {
+	d, err := s.repo.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if d.SubscriptionID != subscriptionID {
+		return nil, domain.ErrWebhookDeliveryNotFound
+	}
+
+	return d, nil
+}
+
+// Replay ставит в очередь новую доставку с тем же payload. Исходная запись
+// и её журнал попыток сохраняются.
+func (s *WebhookService) Replay(ctx context.Context, subscriptionID, deliveryID string) (*domain.WebhookDelivery, error) {
+	orig, err := s.GetDelivery(ctx, subscriptionID, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	d := newWebhookDelivery(subscriptionID, orig.EventType, orig.Payload)
+	if err = s.repo.EnqueueDeliveries(ctx, []*domain.WebhookDelivery{d}); err != nil {
+		return nil, fmt.Errorf("enqueue replay: %w", err)
+	}
+
+	return d, nil
+}
+
+// BookingMessage собирает событие о брони для outbox: доставки создаёт репозиторий
+// в транзакции, изменившей бронь.
+func (s *WebhookService) BookingMessage(eventType domain.WebhookEventType, b *domain.Booking) (*domain.WebhookMessage, error) {
+	payload, err := marshalWebhookPayload(eventType, newBookingPayload(b))
+	if err != nil {
+		return nil, err
+	}
+	return &domain.WebhookMessage{Type: eventType, Payload: payload}, nil
+}
+
+func newBookingPayload(b *domain.Booking) bookingPayload {
+	return bookingPayload{
+		ID:        b.ID,
+		EventID:   b.EventID,
+		UserID:    b.UserID,
+		Status:    b.Status,
+		CreatedAt: b.CreatedAt,
+		UpdatedAt: b.UpdatedAt,
+	}
+}
+
+func (s *WebhookService) PublishEvent(ctx context.Context, eventType domain.WebhookEventType, e *domain.Event) {
+	s.publish(ctx, eventType, eventPayload{
+		ID:                e.ID,
+		Title:             e.Title,
+		Description:       e.Description,
+		EventDate:         e.EventDate,
+		EndDate:           e.EndDate(),
+		Timezone:          e.Timezone,
+		TotalSpots:        e.TotalSpots,
+		RequiresPayment:   e.RequiresPayment,
+		BookingTTLMinutes: int(e.BookingTTL.Minutes()),
+		DurationMinutes:   int(e.Duration.Minutes()),
+		VenueID:           e.VenueID,
+	})
+}
+
+// publish создаёт по доставке на каждую активную подписку на eventType.
+func (s *WebhookService) publish(ctx context.Context, eventType domain.WebhookEventType, data any) {
+	if err := s.enqueue(ctx, eventType, data); err != nil {
+		s.logger.LogAttrs(ctx, logger.ErrorLevel, "failed to publish webhook event",
+			logger.String("type", string(eventType)),
+			logger.String("error", err.Error()),
+		)
+	}
+}
+
+func (s *WebhookService) enqueue(ctx context.Context, eventType domain.WebhookEventType, data any) error {
+	subs, err := s.repo.ListSubscribers(ctx, eventType)
+	if err != nil {
+		return fmt.Errorf("list subscribers: %w", err)
+	}
+	if len(subs) == 0 {
+		return nil
+	}
+
+	payload, err := marshalWebhookPayload(eventType, data)
+	if err != nil {
+		return err
+	}
+
+	deliveries := make([]*domain.WebhookDelivery, len(subs))
+	for i, sub := range subs {
+		deliveries[i] = newWebhookDelivery(sub.ID, eventType, payload)
+	}
+
+	return s.repo.EnqueueDeliveries(ctx, deliveries)
+}
+
+func marshalWebhookPayload(eventType domain.WebhookEventType, data any) (json.RawMessage, error) {
+	payload, err := json.Marshal(webhookPayload{
+		ID:        uuid.New().String(),
+		Type:      eventType,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal payload: %w", err)
+	}
+	return payload, nil
+}
+
+// Dispatch отправляет пачку доставок, время которых подошло. Неудачные попытки
+// откладываются с экспоненциальной задержкой, после MaxAttempts доставка считается проваленной.
+// Возвращает количество успешно доставленных.
+func (s *WebhookService) Dispatch(ctx context.Context) (int, error) {
+	batch, err := s.repo.ClaimDeliveries(ctx, s.cfg.BatchSize, webhookLease)
+	if err != nil {
+		return 0, fmt.Errorf("claim deliveries: %w", err)
+	}
+
+	subs := make(map[string]*domain.WebhookSubscription)
+	delivered := 0
+	for _, d := range batch {
+		sub, ok := subs[d.SubscriptionID]
+		if !ok {
+			if sub, err = s.repo.GetSubscription(ctx, d.SubscriptionID); err != nil {
+				s.logger.LogAttrs(ctx, logger.ErrorLevel, "failed to get webhook subscription",
+					logger.String("subscription_id", d.SubscriptionID),
+					logger.String("error", err.Error()),
+				)
+				continue
+			}
+			subs[d.SubscriptionID] = sub
+		}
+
+		if s.attempt(ctx, sub, d) {
+			delivered++
+		}
+	}
+
+	return delivered, nil
+}
+
+// attempt выполняет одну попытку доставки и записывает её результат.
+func (s *WebhookService) attempt(ctx context.Context, sub *domain.WebhookSubscription, d *domain.WebhookDelivery) bool {
+	start := time.Now()
+	var status int
+	var err error
+	if sub.Active {
+		status, err = s.sender.Send(ctx, sub, d)
+	} else {
+		err = fmt.Errorf("subscription is disabled")
+	}
+	now := time.Now().UTC()
+
+	d.Attempts++
+	a := domain.WebhookDeliveryAttempt{
+		Attempt:   d.Attempts,
+		Duration:  now.Sub(start),
+		CreatedAt: now,
+	}
+	if status != 0 {
+		a.ResponseStatus = &status
+	}
+	d.ResponseStatus = a.ResponseStatus
+
+	switch {
+	case err == nil:
+		d.Status = domain.WebhookDeliveryDelivered
+		d.DeliveredAt = &now
+		d.LastError = nil
+	case !sub.Active || d.Attempts >= s.cfg.MaxAttempts:
+		msg := err.Error()
+		a.Error, d.LastError = &msg, &msg
+		d.Status = domain.WebhookDeliveryFailed
+	default:
+		msg := err.Error()
+		a.Error, d.LastError = &msg, &msg
+		d.NextAttemptAt = now.Add(s.backoff(d.Attempts))
+	}
+
+	if recErr := s.repo.RecordAttempt(ctx, d, a); recErr != nil {
+		s.logger.LogAttrs(ctx, logger.ErrorLevel, "failed to record webhook attempt",
+			logger.String("delivery_id", d.ID),
+			logger.String("error", recErr.Error()),
+		)
+	}
+
+	if err != nil {
+		s.logger.LogAttrs(ctx, logger.WarnLevel, "webhook delivery failed",
+			logger.String("delivery_id", d.ID),
+			logger.String("subscription_id", sub.ID),
+			logger.Int("attempts", d.Attempts),
+			logger.String("status", string(d.Status)),
+			logger.String("error", err.Error()),
+		)
+		return false
+	}
+
+	return true
+}
+
+// backoff возвращает задержку перед следующей попыткой: Backoff * 2^(attempts-1), не больше MaxBackoff.
+func (s *WebhookService) backoff(attempts int) time.Duration {
+	d := s.cfg.Backoff
+	for i := 1; i < attempts && d < s.cfg.MaxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, s.cfg.MaxBackoff)
+}
+
+func newWebhookDelivery(subscriptionID string, eventType domain.WebhookEventType, payload json.RawMessage) *domain.WebhookDelivery {
+	now := time.Now().UTC()
+	return &domain.WebhookDelivery{
+		ID:             uuid.New().String(),
+		SubscriptionID: subscriptionID,
+		EventType:      eventType,
+		Payload:        payload,
+		Status:         domain.WebhookDeliveryPending,
+		NextAttemptAt:  now,
+		CreatedAt:      now,
+	}
+}
+
+// validateWebhookURL проверяет адрес, на который сервис будет отправлять запросы:
+// вебхук партнёра или webhook_url пользователя.
+func validateWebhookURL(ctx context.Context, field, raw string) error {
+	if err := netguard.ValidateURL(ctx, raw); err != nil {
+		return fmt.Errorf("%w: %s: %v", domain.ErrValidation, field, err)
+	}
+	return nil
+}
+
+func validateWebhookEventTypes(types []domain.WebhookEventType) error {
+	if len(types) == 0 {
+		return fmt.Errorf("%w: at least one event type is required", domain.ErrValidation)
+	}
+	for _, t := range types {
+		if !domain.IsValidWebhookEventType(t) {
+			return fmt.Errorf("%w: unknown event type %q", domain.ErrValidation, t)
+		}
+	}
+	return nil
+}
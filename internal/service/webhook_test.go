@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/service/ports/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testWebhookConfig = WebhookConfig{
+	BatchSize:   10,
+	MaxAttempts: 3,
+	Backoff:     time.Minute,
+	MaxBackoff:  90 * time.Second,
+}
+
+func TestWebhookService_CreateSubscription_GeneratesSecret(t *testing.T) {
+	repo := mocks.NewMockWebhookRepo(t)
+	svc := NewWebhookService(repo, nil, testWebhookConfig, newTestLogger(t))
+
+	repo.EXPECT().CreateSubscription(mock.Anything, mock.Anything).Return(nil)
+
+	sub, err := svc.CreateSubscription(context.Background(), domain.CreateWebhookInput{
+		URL:        "https://partner.example/hook",
+		EventTypes: []domain.WebhookEventType{domain.WebhookBookingCreated},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, sub.Secret, 64)
+	assert.True(t, sub.Active)
+}
+
+func TestWebhookService_CreateSubscription_Validation(t *testing.T) {
+	svc := NewWebhookService(nil, nil, testWebhookConfig, newTestLogger(t))
+
+	tests := []struct {
+		name  string
+		input domain.CreateWebhookInput
+	}{
+		{"relative url", domain.CreateWebhookInput{
+			URL:        "/hook",
+			EventTypes: []domain.WebhookEventType{domain.WebhookBookingCreated},
+		}},
+		{"loopback url", domain.CreateWebhookInput{
+			URL:        "http://127.0.0.1:8080/hook",
+			EventTypes: []domain.WebhookEventType{domain.WebhookBookingCreated},
+		}},
+		{"no event types", domain.CreateWebhookInput{URL: "https://partner.example/hook"}},
+		{"unknown event type", domain.CreateWebhookInput{
+			URL:        "https://partner.example/hook",
+			EventTypes: []domain.WebhookEventType{"booking.exploded"},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.CreateSubscription(context.Background(), tt.input)
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+func TestWebhookService_PublishEvent_EnqueuesPerSubscriber(t *testing.T) {
+	repo := mocks.NewMockWebhookRepo(t)
+	svc := NewWebhookService(repo, nil, testWebhookConfig, newTestLogger(t))
+
+	repo.EXPECT().ListSubscribers(mock.Anything, domain.WebhookEventPublished).
+		Return([]*domain.WebhookSubscription{{ID: "s1"}, {ID: "s2"}}, nil)
+
+	var enqueued []*domain.WebhookDelivery
+	repo.EXPECT().EnqueueDeliveries(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, d []*domain.WebhookDelivery) { enqueued = d }).
+		Return(nil)
+
+	svc.PublishEvent(context.Background(), domain.WebhookEventPublished, &domain.Event{ID: "e1", Title: "Go meetup"})
+
+	require.Len(t, enqueued, 2)
+	assert.Equal(t, "s1", enqueued[0].SubscriptionID)
+	assert.Equal(t, domain.WebhookDeliveryPending, enqueued[0].Status)
+	// Все подписчики получают одно и то же тело с одинаковым id события.
+	assert.Equal(t, enqueued[0].Payload, enqueued[1].Payload)
+
+	var body struct {
+		Type string `json:"type"`
+		Data struct {
+			ID    string `json:"id"`
+			Title string `json:"title"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(enqueued[0].Payload, &body))
+	assert.Equal(t, "event.published", body.Type)
+	assert.Equal(t, "e1", body.Data.ID)
+	assert.Equal(t, "Go meetup", body.Data.Title)
+}
+
+func TestWebhookService_BookingMessage(t *testing.T) {
+	// Сообщение только собирается: подписчиков подбирает репозиторий при записи outbox.
+	svc := NewWebhookService(mocks.NewMockWebhookRepo(t), nil, testWebhookConfig, newTestLogger(t))
+
+	msg, err := svc.BookingMessage(domain.WebhookBookingCancelled, &domain.Booking{
+		ID: "b1", EventID: "e1", UserID: "u1", Status: domain.BookingStatusCancelled,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.WebhookBookingCancelled, msg.Type)
+
+	var body struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+		Data struct {
+			ID     string `json:"id"`
+			Status string `json:"status"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(msg.Payload, &body))
+	assert.NotEmpty(t, body.ID)
+	assert.Equal(t, "booking.cancelled", body.Type)
+	assert.Equal(t, "b1", body.Data.ID)
+	assert.Equal(t, "cancelled", body.Data.Status)
+}
+
+func TestWebhookService_PublishEvent_NoSubscribers(t *testing.T) {
+	repo := mocks.NewMockWebhookRepo(t)
+	svc := NewWebhookService(repo, nil, testWebhookConfig, newTestLogger(t))
+
+	repo.EXPECT().ListSubscribers(mock.Anything, domain.WebhookEventCreated).Return(nil, nil)
+
+	svc.PublishEvent(context.Background(), domain.WebhookEventCreated, &domain.Event{ID: "e1"})
+}
+
+func TestWebhookService_Dispatch_Delivered(t *testing.T) {
+	repo := mocks.NewMockWebhookRepo(t)
+	sender := mocks.NewMockWebhookSender(t)
+	svc := NewWebhookService(repo, sender, testWebhookConfig, newTestLogger(t))
+
+	sub := &domain.WebhookSubscription{ID: "s1", Active: true}
+	d := &domain.WebhookDelivery{ID: "d1", SubscriptionID: "s1", Status: domain.WebhookDeliveryPending}
+
+	repo.EXPECT().ClaimDeliveries(mock.Anything, 10, webhookLease).Return([]*domain.WebhookDelivery{d}, nil)
+	repo.EXPECT().GetSubscription(mock.Anything, "s1").Return(sub, nil)
+	sender.EXPECT().Send(mock.Anything, sub, d).Return(204, nil)
+	repo.EXPECT().RecordAttempt(mock.Anything, d, mock.MatchedBy(func(a domain.WebhookDeliveryAttempt) bool {
+		return a.Attempt == 1 && *a.ResponseStatus == 204 && a.Error == nil
+	})).Return(nil)
+
+	n, err := svc.Dispatch(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, domain.WebhookDeliveryDelivered, d.Status)
+	assert.NotNil(t, d.DeliveredAt)
+}
+
+func TestWebhookService_Dispatch_RetryWithBackoff(t *testing.T) {
+	repo := mocks.NewMockWebhookRepo(t)
+	sender := mocks.NewMockWebhookSender(t)
+	svc := NewWebhookService(repo, sender, testWebhookConfig, newTestLogger(t))
+
+	sub := &domain.WebhookSubscription{ID: "s1", Active: true}
+	d := &domain.WebhookDelivery{ID: "d1", SubscriptionID: "s1", Status: domain.WebhookDeliveryPending}
+
+	repo.EXPECT().ClaimDeliveries(mock.Anything, 10, webhookLease).Return([]*domain.WebhookDelivery{d}, nil)
+	repo.EXPECT().GetSubscription(mock.Anything, "s1").Return(sub, nil)
+	sender.EXPECT().Send(mock.Anything, sub, d).Return(503, errors.New("unexpected status 503"))
+	repo.EXPECT().RecordAttempt(mock.Anything, d, mock.Anything).Return(nil)
+
+	before := time.Now()
+	n, err := svc.Dispatch(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+	assert.Equal(t, domain.WebhookDeliveryPending, d.Status)
+	assert.Equal(t, 1, d.Attempts)
+	assert.WithinDuration(t, before.Add(time.Minute), d.NextAttemptAt, 5*time.Second)
+	require.NotNil(t, d.LastError)
+}
+
+func TestWebhookService_Dispatch_FailsAfterMaxAttempts(t *testing.T) {
+	repo := mocks.NewMockWebhookRepo(t)
+	sender := mocks.NewMockWebhookSender(t)
+	svc := NewWebhookService(repo, sender, testWebhookConfig, newTestLogger(t))
+
+	sub := &domain.WebhookSubscription{ID: "s1", Active: true}
+	d := &domain.WebhookDelivery{ID: "d1", SubscriptionID: "s1", Attempts: 2, Status: domain.WebhookDeliveryPending}
+
+	repo.EXPECT().ClaimDeliveries(mock.Anything, 10, webhookLease).Return([]*domain.WebhookDelivery{d}, nil)
+	repo.EXPECT().GetSubscription(mock.Anything, "s1").Return(sub, nil)
+	sender.EXPECT().Send(mock.Anything, sub, d).Return(0, errors.New("connection refused"))
+	repo.EXPECT().RecordAttempt(mock.Anything, d, mock.Anything).Return(nil)
+
+	_, err := svc.Dispatch(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.WebhookDeliveryFailed, d.Status)
+	assert.Equal(t, 3, d.Attempts)
+}
+
+func TestWebhookService_Dispatch_InactiveSubscriptionFails(t *testing.T) {
+	repo := mocks.NewMockWebhookRepo(t)
+	sender := mocks.NewMockWebhookSender(t)
+	svc := NewWebhookService(repo, sender, testWebhookConfig, newTestLogger(t))
+
+	d := &domain.WebhookDelivery{ID: "d1", SubscriptionID: "s1", Status: domain.WebhookDeliveryPending}
+
+	repo.EXPECT().ClaimDeliveries(mock.Anything, 10, webhookLease).Return([]*domain.WebhookDelivery{d}, nil)
+	repo.EXPECT().GetSubscription(mock.Anything, "s1").Return(&domain.WebhookSubscription{ID: "s1"}, nil)
+	repo.EXPECT().RecordAttempt(mock.Anything, d, mock.Anything).Return(nil)
+
+	_, err := svc.Dispatch(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.WebhookDeliveryFailed, d.Status)
+}
+
+func TestWebhookService_Backoff(t *testing.T) {
+	svc := NewWebhookService(nil, nil, testWebhookConfig, newTestLogger(t))
+
+	assert.Equal(t, time.Minute, svc.backoff(1))
+	assert.Equal(t, 90*time.Second, svc.backoff(2))
+	assert.Equal(t, 90*time.Second, svc.backoff(10))
+}
+
+func TestWebhookService_Replay(t *testing.T) {
+	repo := mocks.NewMockWebhookRepo(t)
+	svc := NewWebhookService(repo, nil, testWebhookConfig, newTestLogger(t))
+
+	orig := &domain.WebhookDelivery{
+		ID:             "d1",
+		SubscriptionID: "s1",
+		EventType:      domain.WebhookBookingCreated,
+		Payload:        []byte(`{"id":"evt"}`),
+		Status:         domain.WebhookDeliveryFailed,
+	}
+	repo.EXPECT().GetDelivery(mock.Anything, "d1").Return(orig, nil)
+	repo.EXPECT().EnqueueDeliveries(mock.Anything, mock.MatchedBy(func(d []*domain.WebhookDelivery) bool {
+		return len(d) == 1 && d[0].ID != "d1" && string(d[0].Payload) == `{"id":"evt"}`
+	})).Return(nil)
+
+	d, err := svc.Replay(context.Background(), "s1", "d1")
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.WebhookDeliveryPending, d.Status)
+}
+
+func TestWebhookService_Replay_OtherSubscription(t *testing.T) {
+	repo := mocks.NewMockWebhookRepo(t)
+	svc := NewWebhookService(repo, nil, testWebhookConfig, newTestLogger(t))
+
+	repo.EXPECT().GetDelivery(mock.Anything, "d1").Return(&domain.WebhookDelivery{ID: "d1", SubscriptionID: "s2"}, nil)
+
+	_, err := svc.Replay(context.Background(), "s1", "d1")
+
+	assert.ErrorIs(t, err, domain.ErrWebhookDeliveryNotFound)
+}
@@ -0,0 +1,423 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/wb-go/wbf/logger"
+)
+
+// maxListed — сколько мероприятий и броней показывается в одном сообщении.
+const maxListed = 20
+
+type Sender interface {
+	Send(c tgbotapi.Chattable) (tgbotapi.Message, error)
+	Request(c tgbotapi.Chattable) (*tgbotapi.APIResponse, error)
+}
+
+type EventSvc interface {
+	List(ctx context.Context) ([]*domain.Event, error)
+	GetByID(ctx context.Context, id string) (*domain.Event, error)
+}
+
+type BookingSvc interface {
+	Book(ctx context.Context, eventID, userID, accessCode string) (*domain.Booking, error)
+	Confirm(ctx context.Context, eventID, userID string) error
+	Cancel(ctx context.Context, eventID, userID string) error
+	ListByUser(ctx context.Context, userID string) ([]*domain.Booking, error)
+}
+
+type UserSvc interface {
+	GetByTelegramChatID(ctx context.Context, chatID int64) (*domain.User, error)
+}
+
+type LinkSvc interface {
+	Link(ctx context.Context, token string, chatID int64) (*domain.User, error)
+}
+
+// Bot принимает команды через long polling и выполняет их от имени пользователя,
+// к которому привязан чат.
+type Bot struct {
+	api         *tgbotapi.BotAPI
+	sender      Sender
+	pollTimeout time.Duration
+
+	eventService   EventSvc
+	bookingService BookingSvc
+	userService    UserSvc
+	linkService    LinkSvc
+	logger         logger.Logger
+}
+
+func NewBot(
+	token string,
+	pollTimeout time.Duration,
+	eventService EventSvc,
+	bookingService BookingSvc,
+	userService UserSvc,
+	linkService LinkSvc,
+	logger logger.Logger,
+) (*Bot, error) {
+	api, err := tgbotapi.NewBotAPI(token)
+	if err != nil {
+		return nil, fmt.Errorf("create telegram bot: %w", err)
+	}
+
+	b := newBot(api, eventService, bookingService, userService, linkService, logger)
+	b.api = api
+	b.pollTimeout = pollTimeout
+	return b, nil
+}
+
+func newBot(
+	sender Sender,
+	eventService EventSvc,
+	bookingService BookingSvc,
+	userService UserSvc,
+	linkService LinkSvc,
+	logger logger.Logger,
+) *Bot {
+	return &Bot{
+		sender:         sender,
+		eventService:   eventService,
+		bookingService: bookingService,
+		userService:    userService,
+		linkService:    linkService,
+		logger:         logger,
+	}
+}
+
+// Run блокируется до отмены ctx, обрабатывая обновления по одному.
+func (b *Bot) Run(ctx context.Context) {
+	b.setCommands()
+
+	cfg := tgbotapi.NewUpdate(0)
+	cfg.Timeout = int(b.pollTimeout.Seconds())
+	cfg.AllowedUpdates = []string{"message", "callback_query"}
+	updates := b.api.GetUpdatesChan(cfg)
+
+	b.logger.Info("telegram bot started", logger.String("username", b.api.Self.UserName))
+
+	for {
+		select {
+		case <-ctx.Done():
+			b.api.StopReceivingUpdates()
+			b.logger.Info("telegram bot stopped")
+			return
+		case update := <-updates:
+			b.handleUpdate(ctx, update)
+		}
+	}
+}
+
+// setCommands регистрирует меню команд: язык по умолчанию — для всех клиентов,
+// остальные — для клиентов Telegram с соответствующим языком.
+func (b *Bot) setCommands() {
+	for _, locale := range domain.SupportedLocales {
+		cfg := tgbotapi.NewSetMyCommands(commandLists[locale]...)
+		if locale != domain.DefaultLocale {
+			cfg = tgbotapi.NewSetMyCommandsWithScopeAndLanguage(tgbotapi.NewBotCommandScopeDefault(), locale, commandLists[locale]...)
+		}
+		if _, err := b.sender.Request(cfg); err != nil {
+			b.logger.Warn("failed to set bot commands",
+				logger.String("locale", locale),
+				logger.String("error", err.Error()),
+			)
+		}
+	}
+}
+
+// chat — чат, из которого пришло обновление: привязанный к нему пользователь и язык ответов.
+type chat struct {
+	id      int64
+	user    *domain.User
+	userErr error
+	locale  string
+}
+
+func (b *Bot) chat(ctx context.Context, chatID int64, from *tgbotapi.User) *chat {
+	user, err := b.userService.GetByTelegramChatID(ctx, chatID)
+	return &chat{id: chatID, user: user, userErr: err, locale: chatLocale(user, from)}
+}
+
+func (b *Bot) handleUpdate(ctx context.Context, update tgbotapi.Update) {
+	switch {
+	case update.CallbackQuery != nil:
+		b.handleCallback(ctx, update.CallbackQuery)
+	case update.Message != nil && update.Message.IsCommand():
+		b.handleCommand(ctx, update.Message)
+	case update.Message != nil:
+		c := b.chat(ctx, update.Message.Chat.ID, update.Message.From)
+		b.reply(c.id, text(c.locale, msgHelp), nil)
+	}
+}
+
+func (b *Bot) handleCommand(ctx context.Context, msg *tgbotapi.Message) {
+	c := b.chat(ctx, msg.Chat.ID, msg.From)
+	arg := strings.TrimSpace(msg.CommandArguments())
+
+	switch msg.Command() {
+	case "start":
+		if arg == "" {
+			b.reply(c.id, text(c.locale, msgHelp), nil)
+			return
+		}
+		b.reply(c.id, b.link(ctx, c, msg.From, arg), nil)
+	case "help":
+		b.reply(c.id, text(c.locale, msgHelp), nil)
+	case "events":
+		b.listEvents(ctx, c)
+	case "mybookings":
+		b.listBookings(ctx, c)
+	case ActionBook, ActionConfirm, ActionCancel:
+		if arg == "" {
+			b.reply(c.id, text(c.locale, msgMissingEventID, msg.Command()), nil)
+			return
+		}
+		b.reply(c.id, b.execute(ctx, c, msg.Command(), arg), nil)
+	default:
+		b.reply(c.id, text(c.locale, msgUnknownCommand)+text(c.locale, msgHelp), nil)
+	}
+}
+
+func (b *Bot) handleCallback(ctx context.Context, q *tgbotapi.CallbackQuery) {
+	action, eventID, ok := parseCallbackData(q.Data)
+	if !ok || q.Message == nil {
+		b.answer(q.ID, text(chatLocale(nil, q.From), msgUnknownAction))
+		return
+	}
+
+	c := b.chat(ctx, q.Message.Chat.ID, q.From)
+	b.answer(q.ID, "")
+
+	reply := b.execute(ctx, c, action, eventID)
+
+	// После подтверждения или отмены кнопки под уведомлением больше не нужны.
+	if action != ActionBook {
+		edit := tgbotapi.NewEditMessageReplyMarkup(c.id, q.Message.MessageID, tgbotapi.InlineKeyboardMarkup{
+			InlineKeyboard: [][]tgbotapi.InlineKeyboardButton{},
+		})
+		if _, err := b.sender.Request(edit); err != nil {
+			b.logger.Debug("failed to remove inline keyboard", logger.String("error", err.Error()))
+		}
+	}
+
+	b.reply(c.id, reply, nil)
+}
+
+// link привязывает чат к аккаунту по токену из deep-link ссылки.
+func (b *Bot) link(ctx context.Context, c *chat, from *tgbotapi.User, token string) string {
+	user, err := b.linkService.Link(ctx, token, c.id)
+	if err != nil {
+		if errors.Is(err, domain.ErrTelegramLinkInvalid) {
+			return text(c.locale, msgLinkInvalid)
+		}
+		return b.errorText(ctx, c.locale, "start", err)
+	}
+
+	locale := chatLocale(user, from)
+	return text(locale, msgLinked, html.EscapeString(user.Username)) + text(locale, msgHelp)
+}
+
+// execute выполняет действие с бронью от имени привязанного пользователя и возвращает текст ответа.
+func (b *Bot) execute(ctx context.Context, c *chat, action, eventID string) string {
+	if c.user == nil {
+		return b.notLinkedText(ctx, c)
+	}
+
+	var err error
+	switch action {
+	case ActionBook:
+		var booking *domain.Booking
+		booking, err = b.bookingService.Book(ctx, eventID, c.user.ID, "")
+		if err == nil {
+			if booking.Status == domain.BookingStatusPending {
+				return text(c.locale, msgBooked, eventID)
+			}
+			return text(c.locale, msgBookedConfirmed)
+		}
+	case ActionConfirm:
+		if err = b.bookingService.Confirm(ctx, eventID, c.user.ID); err == nil {
+			return text(c.locale, msgConfirmed)
+		}
+	case ActionCancel:
+		if err = b.bookingService.Cancel(ctx, eventID, c.user.ID); err == nil {
+			return text(c.locale, msgCancelled)
+		}
+	}
+
+	return b.errorText(ctx, c.locale, action, err)
+}
+
+func (b *Bot) listEvents(ctx context.Context, c *chat) {
+	events, err := b.eventService.List(ctx)
+	if err != nil {
+		b.reply(c.id, b.errorText(ctx, c.locale, "events", err), nil)
+		return
+	}
+
+	loc := time.UTC
+	if c.user != nil {
+		loc = userLocation(c.user)
+	}
+
+	now := time.Now()
+	var sb strings.Builder
+	var rows [][]tgbotapi.InlineKeyboardButton
+	for _, e := range events {
+		if e.EventDate.Before(now) {
+			continue
+		}
+		if len(rows) == maxListed {
+			break
+		}
+
+		fmt.Fprintf(&sb, "<b>%s</b>\n%s (%s)\nID: <code>%s</code>\n\n",
+			html.EscapeString(e.Title), e.EventDate.In(loc).Format(text(c.locale, msgDateLayout)), loc, e.ID)
+		rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(text(c.locale, msgBookButton, truncate(e.Title, 40)), callbackData(ActionBook, e.ID)),
+		))
+	}
+
+	if len(rows) == 0 {
+		b.reply(c.id, text(c.locale, msgNoEvents), nil)
+		return
+	}
+
+	b.reply(c.id, sb.String(), tgbotapi.NewInlineKeyboardMarkup(rows...))
+}
+
+func (b *Bot) listBookings(ctx context.Context, c *chat) {
+	if c.user == nil {
+		b.reply(c.id, b.notLinkedText(ctx, c), nil)
+		return
+	}
+
+	bookings, err := b.bookingService.ListByUser(ctx, c.user.ID)
+	if err != nil {
+		b.reply(c.id, b.errorText(ctx, c.locale, "mybookings", err), nil)
+		return
+	}
+	if len(bookings) == 0 {
+		b.reply(c.id, text(c.locale, msgNoBookings), nil)
+		return
+	}
+
+	loc := userLocation(c.user)
+	var sb strings.Builder
+	for i, bk := range bookings {
+		if i == maxListed {
+			break
+		}
+
+		// Мероприятие берётся по ID: бронь может быть на закрытое, снятое или прошедшее,
+		// которых нет в публичном списке.
+		title, date := bk.EventID, ""
+		e, err := b.eventService.GetByID(ctx, bk.EventID)
+		switch {
+		case err == nil:
+			title, date = e.Title, e.EventDate.In(loc).Format(text(c.locale, msgDateLayout))
+		case !errors.Is(err, domain.ErrEventNotFound):
+			b.reply(c.id, b.errorText(ctx, c.locale, "mybookings", err), nil)
+			return
+		}
+		fmt.Fprintf(&sb, "<b>%s</b> — %s\n%s\nID: <code>%s</code>\n\n",
+			html.EscapeString(title), statusText(c.locale, bk.Status), date, bk.EventID)
+	}
+
+	b.reply(c.id, sb.String(), nil)
+}
+
+// notLinkedText — ответ чату, к которому не удалось найти пользователя.
+func (b *Bot) notLinkedText(ctx context.Context, c *chat) string {
+	if errors.Is(c.userErr, domain.ErrUserNotFound) {
+		return text(c.locale, msgNotLinked)
+	}
+	return b.errorText(ctx, c.locale, "user", c.userErr)
+}
+
+func (b *Bot) errorText(ctx context.Context, locale, action string, err error) string {
+	switch {
+	case errors.Is(err, domain.ErrEventNotFound), errors.Is(err, domain.ErrEventNotPublished):
+		return text(locale, msgEventNotFound)
+	case errors.Is(err, domain.ErrEventArchived):
+		return text(locale, msgEventArchived)
+	case errors.Is(err, domain.ErrEventAccessDenied):
+		return text(locale, msgEventPrivate)
+	case errors.Is(err, domain.ErrBookingNotFound):
+		return text(locale, msgBookingNotFound)
+	case errors.Is(err, domain.ErrEventCancelled):
+		return text(locale, msgEventCancelled)
+	case errors.Is(err, domain.ErrEventStarted):
+		return text(locale, msgEventStarted)
+	case errors.Is(err, domain.ErrSalesNotOpen):
+		return text(locale, msgSalesNotOpen)
+	case errors.Is(err, domain.ErrSalesClosed):
+		return text(locale, msgSalesClosed)
+	case errors.Is(err, domain.ErrTooManyPendingBookings):
+		return text(locale, msgTooManyPending)
+	case errors.Is(err, domain.ErrDailyBookingLimit):
+		return text(locale, msgDailyLimit)
+	case errors.Is(err, domain.ErrBookingCooldown):
+		return text(locale, msgCooldown)
+	case errors.Is(err, domain.ErrNoAvailableSpots):
+		return text(locale, msgNoSpots)
+	case errors.Is(err, domain.ErrAlreadyBooked):
+		return text(locale, msgAlreadyBooked)
+	case errors.Is(err, domain.ErrBookingNotPending):
+		return text(locale, msgNotPending)
+	case errors.Is(err, domain.ErrBookingExpired):
+		return text(locale, msgBookingExpired)
+	case errors.Is(err, domain.ErrValidation) && action == ActionConfirm:
+		return text(locale, msgNoConfirmation)
+	}
+
+	b.logger.LogAttrs(ctx, logger.ErrorLevel, "telegram bot action failed",
+		logger.String("action", action),
+		logger.String("error", err.Error()),
+	)
+	return text(locale, msgInternalError)
+}
+
+func (b *Bot) reply(chatID int64, text string, markup any) {
+	msg := tgbotapi.NewMessage(chatID, text)
+	msg.ParseMode = tgbotapi.ModeHTML
+	if markup != nil {
+		msg.ReplyMarkup = markup
+	}
+
+	if _, err := b.sender.Send(msg); err != nil {
+		b.logger.Error("failed to send telegram reply",
+			logger.Int64("chat_id", chatID),
+			logger.String("error", err.Error()),
+		)
+	}
+}
+
+func (b *Bot) answer(callbackID, text string) {
+	if _, err := b.sender.Request(tgbotapi.NewCallback(callbackID, text)); err != nil {
+		b.logger.Debug("failed to answer callback", logger.String("error", err.Error()))
+	}
+}
+
+func userLocation(user *domain.User) *time.Location {
+	loc, err := time.LoadLocation(user.Timezone)
+	if err != nil || user.Timezone == "" {
+		return time.UTC
+	}
+	return loc
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "…"
+}
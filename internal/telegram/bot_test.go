@@ -0,0 +1,280 @@
+package telegram
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/telegram/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/wb-go/wbf/logger"
+)
+
+const chatID int64 = 42
+
+type testBot struct {
+	*Bot
+	sender   *mocks.MockSender
+	events   *mocks.MockEventSvc
+	bookings *mocks.MockBookingSvc
+	users    *mocks.MockUserSvc
+	links    *mocks.MockLinkSvc
+}
+
+func newTestBot(t *testing.T) *testBot {
+	t.Helper()
+	log, err := logger.InitLogger("slog", "test", "test", logger.WithLevel(logger.ErrorLevel))
+	require.NoError(t, err)
+
+	tb := &testBot{
+		sender:   mocks.NewMockSender(t),
+		events:   mocks.NewMockEventSvc(t),
+		bookings: mocks.NewMockBookingSvc(t),
+		users:    mocks.NewMockUserSvc(t),
+		links:    mocks.NewMockLinkSvc(t),
+	}
+	tb.Bot = newBot(tb.sender, tb.events, tb.bookings, tb.users, tb.links, log)
+	return tb
+}
+
+// expectReply перехватывает отправленное сообщение.
+func (tb *testBot) expectReply() *tgbotapi.MessageConfig {
+	var sent tgbotapi.MessageConfig
+	tb.sender.EXPECT().Send(mock.Anything).
+		Run(func(c tgbotapi.Chattable) { sent = c.(tgbotapi.MessageConfig) }).
+		Return(tgbotapi.Message{}, nil).Once()
+	return &sent
+}
+
+func command(text string) tgbotapi.Update {
+	cmd, _, _ := strings.Cut(text, " ")
+	return tgbotapi.Update{Message: &tgbotapi.Message{
+		Text:     text,
+		Chat:     &tgbotapi.Chat{ID: chatID},
+		Entities: []tgbotapi.MessageEntity{{Type: "bot_command", Offset: 0, Length: len(cmd)}},
+	}}
+}
+
+func TestBot_Book_Success(t *testing.T) {
+	tb := newTestBot(t)
+	user := &domain.User{ID: "u1"}
+
+	tb.users.EXPECT().GetByTelegramChatID(mock.Anything, chatID).Return(user, nil)
+	tb.bookings.EXPECT().Book(mock.Anything, "e1", "u1", "").
+		Return(&domain.Booking{Status: domain.BookingStatusPending}, nil)
+	sent := tb.expectReply()
+
+	tb.handleUpdate(context.Background(), command("/book e1"))
+
+	assert.Equal(t, chatID, sent.ChatID)
+	assert.Contains(t, sent.Text, "/confirm e1")
+}
+
+func TestBot_Book_MissingArgument(t *testing.T) {
+	tb := newTestBot(t)
+	tb.users.EXPECT().GetByTelegramChatID(mock.Anything, chatID).Return(nil, domain.ErrUserNotFound)
+	sent := tb.expectReply()
+
+	tb.handleUpdate(context.Background(), command("/book"))
+
+	assert.Contains(t, sent.Text, "Укажите ID мероприятия")
+}
+
+func TestBot_Book_NoAvailableSpots(t *testing.T) {
+	tb := newTestBot(t)
+
+	tb.users.EXPECT().GetByTelegramChatID(mock.Anything, chatID).Return(&domain.User{ID: "u1"}, nil)
+	tb.bookings.EXPECT().Book(mock.Anything, "e1", "u1", "").Return(nil, domain.ErrNoAvailableSpots)
+	sent := tb.expectReply()
+
+	tb.handleUpdate(context.Background(), command("/book e1"))
+
+	assert.Equal(t, "Свободных мест нет.", sent.Text)
+}
+
+func TestBot_UnlinkedChat(t *testing.T) {
+	tb := newTestBot(t)
+
+	tb.users.EXPECT().GetByTelegramChatID(mock.Anything, chatID).Return(nil, domain.ErrUserNotFound)
+	sent := tb.expectReply()
+
+	tb.handleUpdate(context.Background(), command("/confirm e1"))
+
+	assert.Contains(t, sent.Text, "не привязан")
+}
+
+func TestBot_StartWithToken_LinksChat(t *testing.T) {
+	tb := newTestBot(t)
+
+	tb.users.EXPECT().GetByTelegramChatID(mock.Anything, chatID).Return(nil, domain.ErrUserNotFound)
+	tb.links.EXPECT().Link(mock.Anything, "tok", chatID).Return(&domain.User{ID: "u1", Username: "alice"}, nil)
+	sent := tb.expectReply()
+
+	tb.handleUpdate(context.Background(), command("/start tok"))
+
+	assert.Contains(t, sent.Text, "Чат привязан к аккаунту <b>alice</b>")
+}
+
+func TestBot_StartWithToken_Invalid(t *testing.T) {
+	tb := newTestBot(t)
+
+	tb.users.EXPECT().GetByTelegramChatID(mock.Anything, chatID).Return(nil, domain.ErrUserNotFound)
+	tb.links.EXPECT().Link(mock.Anything, "tok", chatID).Return(nil, domain.ErrTelegramLinkInvalid)
+	sent := tb.expectReply()
+
+	tb.handleUpdate(context.Background(), command("/start tok"))
+
+	assert.Contains(t, sent.Text, "недействительна")
+}
+
+func TestBot_RepliesInUserLocale(t *testing.T) {
+	tb := newTestBot(t)
+
+	tb.users.EXPECT().GetByTelegramChatID(mock.Anything, chatID).Return(&domain.User{ID: "u1", Locale: "en"}, nil)
+	tb.bookings.EXPECT().Book(mock.Anything, "e1", "u1", "").Return(nil, domain.ErrNoAvailableSpots)
+	sent := tb.expectReply()
+
+	tb.handleUpdate(context.Background(), command("/book e1"))
+
+	assert.Equal(t, "No spots left.", sent.Text)
+}
+
+func TestBot_UnlinkedChat_UsesClientLanguage(t *testing.T) {
+	tb := newTestBot(t)
+
+	tb.users.EXPECT().GetByTelegramChatID(mock.Anything, chatID).Return(nil, domain.ErrUserNotFound)
+	sent := tb.expectReply()
+
+	update := command("/mybookings")
+	update.Message.From = &tgbotapi.User{LanguageCode: "en-GB"}
+	tb.handleUpdate(context.Background(), update)
+
+	assert.Contains(t, sent.Text, "not linked")
+}
+
+func TestMessages_AllLocalesComplete(t *testing.T) {
+	for _, locale := range domain.SupportedLocales {
+		require.Contains(t, messages, locale)
+		require.Contains(t, commandLists, locale)
+		assert.Len(t, messages[locale], len(messages[domain.DefaultLocale]), locale)
+	}
+}
+
+func TestBot_Cancel_Success(t *testing.T) {
+	tb := newTestBot(t)
+
+	tb.users.EXPECT().GetByTelegramChatID(mock.Anything, chatID).Return(&domain.User{ID: "u1"}, nil)
+	tb.bookings.EXPECT().Cancel(mock.Anything, "e1", "u1").Return(nil)
+	sent := tb.expectReply()
+
+	tb.handleUpdate(context.Background(), command("/cancel e1"))
+
+	assert.Equal(t, "Бронь отменена.", sent.Text)
+}
+
+func TestBot_Events_ListsUpcomingWithButtons(t *testing.T) {
+	tb := newTestBot(t)
+
+	events := []*domain.Event{
+		{ID: "past", Title: "Old", EventDate: time.Now().Add(-time.Hour)},
+		{ID: "e1", Title: "Rock & Roll", EventDate: time.Now().Add(24 * time.Hour)},
+	}
+	tb.events.EXPECT().List(mock.Anything).Return(events, nil)
+	tb.users.EXPECT().GetByTelegramChatID(mock.Anything, chatID).Return(nil, domain.ErrUserNotFound)
+	sent := tb.expectReply()
+
+	tb.handleUpdate(context.Background(), command("/events"))
+
+	assert.Contains(t, sent.Text, "Rock &amp; Roll")
+	assert.NotContains(t, sent.Text, "Old")
+
+	markup, ok := sent.ReplyMarkup.(tgbotapi.InlineKeyboardMarkup)
+	require.True(t, ok)
+	require.Len(t, markup.InlineKeyboard, 1)
+	assert.Equal(t, "book:e1", *markup.InlineKeyboard[0][0].CallbackData)
+}
+
+func TestBot_MyBookings(t *testing.T) {
+	tb := newTestBot(t)
+
+	tb.users.EXPECT().GetByTelegramChatID(mock.Anything, chatID).
+		Return(&domain.User{ID: "u1", Timezone: "Europe/Moscow"}, nil)
+	tb.bookings.EXPECT().ListByUser(mock.Anything, "u1").Return([]*domain.Booking{
+		{EventID: "e1", Status: domain.BookingStatusConfirmed},
+	}, nil)
+	tb.events.EXPECT().GetByID(mock.Anything, "e1").Return(&domain.Event{
+		ID: "e1", Title: "Concert", EventDate: time.Date(2030, 5, 1, 16, 0, 0, 0, time.UTC),
+	}, nil)
+	sent := tb.expectReply()
+
+	tb.handleUpdate(context.Background(), command("/mybookings"))
+
+	assert.Contains(t, sent.Text, "Concert")
+	assert.Contains(t, sent.Text, "подтверждена")
+	assert.Contains(t, sent.Text, "01.05.2030 19:00")
+}
+
+func TestBot_MyBookings_PrivateEvent(t *testing.T) {
+	tb := newTestBot(t)
+
+	tb.users.EXPECT().GetByTelegramChatID(mock.Anything, chatID).Return(&domain.User{ID: "u1"}, nil)
+	tb.bookings.EXPECT().ListByUser(mock.Anything, "u1").Return([]*domain.Booking{
+		{EventID: "e1", Status: domain.BookingStatusPending},
+	}, nil)
+	tb.events.EXPECT().GetByID(mock.Anything, "e1").Return(&domain.Event{
+		ID: "e1", Title: "Private party", Visibility: domain.EventVisibilityPrivate,
+		EventDate: time.Date(2030, 5, 1, 16, 0, 0, 0, time.UTC),
+	}, nil)
+	sent := tb.expectReply()
+
+	tb.handleUpdate(context.Background(), command("/mybookings"))
+
+	assert.Contains(t, sent.Text, "Private party")
+}
+
+func TestBot_CallbackConfirm(t *testing.T) {
+	tb := newTestBot(t)
+
+	tb.sender.EXPECT().Request(mock.AnythingOfType("tgbotapi.CallbackConfig")).Return(&tgbotapi.APIResponse{Ok: true}, nil)
+	tb.users.EXPECT().GetByTelegramChatID(mock.Anything, chatID).Return(&domain.User{ID: "u1"}, nil)
+	tb.bookings.EXPECT().Confirm(mock.Anything, "e1", "u1").Return(nil)
+	tb.sender.EXPECT().Request(mock.AnythingOfType("tgbotapi.EditMessageReplyMarkupConfig")).
+		Return(&tgbotapi.APIResponse{Ok: true}, nil)
+	sent := tb.expectReply()
+
+	tb.handleUpdate(context.Background(), tgbotapi.Update{CallbackQuery: &tgbotapi.CallbackQuery{
+		ID:      "q1",
+		Data:    "confirm:e1",
+		Message: &tgbotapi.Message{MessageID: 7, Chat: &tgbotapi.Chat{ID: chatID}},
+	}})
+
+	assert.Equal(t, "Бронь подтверждена.", sent.Text)
+}
+
+func TestBot_CallbackUnknownAction(t *testing.T) {
+	tb := newTestBot(t)
+
+	tb.sender.EXPECT().Request(mock.AnythingOfType("tgbotapi.CallbackConfig")).Return(&tgbotapi.APIResponse{Ok: true}, nil)
+
+	tb.handleUpdate(context.Background(), tgbotapi.Update{CallbackQuery: &tgbotapi.CallbackQuery{
+		ID:      "q1",
+		Data:    "drop:e1",
+		Message: &tgbotapi.Message{MessageID: 7, Chat: &tgbotapi.Chat{ID: chatID}},
+	}})
+}
+
+func TestBookingKeyboard(t *testing.T) {
+	markup := BookingKeyboard("e1", "en")
+
+	require.Len(t, markup.InlineKeyboard, 1)
+	row := markup.InlineKeyboard[0]
+	require.Len(t, row, 2)
+	assert.Equal(t, "confirm:e1", *row[0].CallbackData)
+	assert.Equal(t, "cancel:e1", *row[1].CallbackData)
+	assert.Contains(t, row[0].Text, "Confirm")
+}
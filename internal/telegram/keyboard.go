@@ -0,0 +1,63 @@
+package telegram
+
+import (
+	"strings"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// Действия inline-кнопок. callback_data имеет вид "<action>:<event_id>".
+const (
+	ActionBook    = "book"
+	ActionConfirm = "confirm"
+	ActionCancel  = "cancel"
+)
+
+// buttonLabels — подписи кнопок под уведомлениями о брони и приглашениями.
+var buttonLabels = map[string]map[string]string{
+	"ru": {ActionBook: "🎟 Забронировать", ActionConfirm: "✅ Подтвердить", ActionCancel: "❌ Отменить"},
+	"en": {ActionBook: "🎟 Book", ActionConfirm: "✅ Confirm", ActionCancel: "❌ Cancel"},
+}
+
+// BookingKeyboard возвращает кнопки подтверждения и отмены брони для уведомления о её создании.
+func BookingKeyboard(eventID, locale string) tgbotapi.InlineKeyboardMarkup {
+	labels, ok := buttonLabels[locale]
+	if !ok {
+		labels = buttonLabels["ru"]
+	}
+
+	return tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(labels[ActionConfirm], callbackData(ActionConfirm, eventID)),
+		tgbotapi.NewInlineKeyboardButtonData(labels[ActionCancel], callbackData(ActionCancel, eventID)),
+	))
+}
+
+// InvitationKeyboard возвращает кнопку брони для приглашения на закрытое мероприятие.
+func InvitationKeyboard(eventID, locale string) tgbotapi.InlineKeyboardMarkup {
+	labels, ok := buttonLabels[locale]
+	if !ok {
+		labels = buttonLabels["ru"]
+	}
+
+	return tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(labels[ActionBook], callbackData(ActionBook, eventID)),
+	))
+}
+
+func callbackData(action, eventID string) string {
+	return action + ":" + eventID
+}
+
+func parseCallbackData(data string) (action, eventID string, ok bool) {
+	action, eventID, ok = strings.Cut(data, ":")
+	if !ok || eventID == "" {
+		return "", "", false
+	}
+
+	switch action {
+	case ActionBook, ActionConfirm, ActionCancel:
+		return action, eventID, true
+	default:
+		return "", "", false
+	}
+}
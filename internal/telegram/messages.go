@@ -0,0 +1,196 @@
+package telegram
+
+import (
+	"fmt"
+	"strings"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stpnv0/EventBooker/internal/domain"
+)
+
+// messageKey — идентификатор текста ответа бота.
+type messageKey string
+
+const (
+	msgHelp            messageKey = "help"
+	msgUnknownCommand  messageKey = "unknown_command"
+	msgUnknownAction   messageKey = "unknown_action"
+	msgMissingEventID  messageKey = "missing_event_id"
+	msgLinkInvalid     messageKey = "link_invalid"
+	msgLinked          messageKey = "linked"
+	msgNotLinked       messageKey = "not_linked"
+	msgBooked          messageKey = "booked"
+	msgBookedConfirmed messageKey = "booked_confirmed"
+	msgConfirmed       messageKey = "confirmed"
+	msgCancelled       messageKey = "cancelled"
+	msgNoEvents        messageKey = "no_events"
+	msgNoBookings      messageKey = "no_bookings"
+	msgBookButton      messageKey = "book_button"
+	msgEventNotFound   messageKey = "event_not_found"
+	msgEventArchived   messageKey = "event_archived"
+	msgEventPrivate    messageKey = "event_private"
+	msgBookingNotFound messageKey = "booking_not_found"
+	msgEventCancelled  messageKey = "event_cancelled"
+	msgEventStarted    messageKey = "event_started"
+	msgSalesNotOpen    messageKey = "sales_not_open"
+	msgSalesClosed     messageKey = "sales_closed"
+	msgTooManyPending  messageKey = "too_many_pending"
+	msgDailyLimit      messageKey = "daily_limit"
+	msgCooldown        messageKey = "cooldown"
+	msgNoSpots         messageKey = "no_spots"
+	msgAlreadyBooked   messageKey = "already_booked"
+	msgNotPending      messageKey = "not_pending"
+	msgBookingExpired  messageKey = "booking_expired"
+	msgNoConfirmation  messageKey = "no_confirmation"
+	msgInternalError   messageKey = "internal_error"
+	msgStatusPending   messageKey = "status_pending"
+	msgStatusConfirmed messageKey = "status_confirmed"
+	msgStatusCancelled messageKey = "status_cancelled"
+	msgDateLayout      messageKey = "date_layout"
+)
+
+// messages — тексты ответов бота на каждом языке из domain.SupportedLocales (HTML-разметка Telegram).
+var messages = map[string]map[messageKey]string{
+	"ru": {
+		msgHelp: `Команды:
+/events — ближайшие мероприятия
+/book &lt;id&gt; — забронировать место
+/confirm &lt;id&gt; — подтвердить бронь
+/mybookings — мои брони
+/cancel &lt;id&gt; — отменить бронь`,
+		msgUnknownCommand:  "Неизвестная команда.\n\n",
+		msgUnknownAction:   "Неизвестное действие",
+		msgMissingEventID:  "Укажите ID мероприятия: /%s &lt;id&gt;",
+		msgLinkInvalid:     "Ссылка недействительна или устарела. Получите новую в веб-интерфейсе EventBooker.",
+		msgLinked:          "Чат привязан к аккаунту <b>%s</b>.\n\n",
+		msgNotLinked:       "Этот чат не привязан к аккаунту EventBooker.\nНажмите «Привязать Telegram» в веб-интерфейсе и перейдите по ссылке.",
+		msgBooked:          "Место забронировано. Подтвердите бронь командой /confirm %s",
+		msgBookedConfirmed: "Место забронировано и подтверждено.",
+		msgConfirmed:       "Бронь подтверждена.",
+		msgCancelled:       "Бронь отменена.",
+		msgNoEvents:        "Предстоящих мероприятий нет.",
+		msgNoBookings:      "У вас нет броней. Список мероприятий: /events",
+		msgBookButton:      "Забронировать: %s",
+		msgEventNotFound:   "Мероприятие не найдено.",
+		msgEventArchived:   "Мероприятие снято с публикации.",
+		msgEventPrivate:    "Это закрытое мероприятие: бронь только по приглашению или с кодом доступа на сайте.",
+		msgBookingNotFound: "Активная бронь на это мероприятие не найдена.",
+		msgEventCancelled:  "Мероприятие отменено.",
+		msgEventStarted:    "Мероприятие уже началось.",
+		msgSalesNotOpen:    "Продажи на это мероприятие ещё не открыты.",
+		msgSalesClosed:     "Продажи на это мероприятие закрыты.",
+		msgTooManyPending:  "У вас слишком много неоплаченных броней — оплатите или отмените одну из них.",
+		msgDailyLimit:      "Достигнут лимит броней за сутки, попробуйте позже.",
+		msgCooldown:        "После нескольких неоплаченных броней бронирование временно приостановлено, попробуйте позже.",
+		msgNoSpots:         "Свободных мест нет.",
+		msgAlreadyBooked:   "У вас уже есть бронь на это мероприятие.",
+		msgNotPending:      "Бронь уже подтверждена или отменена.",
+		msgBookingExpired:  "Время на подтверждение брони истекло.",
+		msgNoConfirmation:  "Это мероприятие не требует подтверждения брони.",
+		msgInternalError:   "Что-то пошло не так, попробуйте позже.",
+		msgStatusPending:   "ожидает подтверждения",
+		msgStatusConfirmed: "подтверждена",
+		msgStatusCancelled: "отменена",
+		msgDateLayout:      "02.01.2006 15:04",
+	},
+	"en": {
+		msgHelp: `Commands:
+/events — upcoming events
+/book &lt;id&gt; — book a spot
+/confirm &lt;id&gt; — confirm a booking
+/mybookings — my bookings
+/cancel &lt;id&gt; — cancel a booking`,
+		msgUnknownCommand:  "Unknown command.\n\n",
+		msgUnknownAction:   "Unknown action",
+		msgMissingEventID:  "Specify the event ID: /%s &lt;id&gt;",
+		msgLinkInvalid:     "The link is invalid or has expired. Get a new one in the EventBooker web app.",
+		msgLinked:          "The chat is linked to account <b>%s</b>.\n\n",
+		msgNotLinked:       "This chat is not linked to an EventBooker account.\nClick “Link Telegram” in the web app and follow the link.",
+		msgBooked:          "Spot booked. Confirm the booking with /confirm %s",
+		msgBookedConfirmed: "Spot booked and confirmed.",
+		msgConfirmed:       "Booking confirmed.",
+		msgCancelled:       "Booking cancelled.",
+		msgNoEvents:        "There are no upcoming events.",
+		msgNoBookings:      "You have no bookings. Events: /events",
+		msgBookButton:      "Book: %s",
+		msgEventNotFound:   "Event not found.",
+		msgEventArchived:   "The event has been unpublished.",
+		msgEventPrivate:    "This is a private event: booking is by invitation or with an access code on the website.",
+		msgBookingNotFound: "No active booking for this event.",
+		msgEventCancelled:  "The event has been cancelled.",
+		msgEventStarted:    "The event has already started.",
+		msgSalesNotOpen:    "Sales for this event have not opened yet.",
+		msgSalesClosed:     "Sales for this event are closed.",
+		msgTooManyPending:  "You have too many unpaid bookings — pay for or cancel one of them.",
+		msgDailyLimit:      "Daily booking limit reached, try again later.",
+		msgCooldown:        "Booking is paused after several unpaid bookings, try again later.",
+		msgNoSpots:         "No spots left.",
+		msgAlreadyBooked:   "You already have a booking for this event.",
+		msgNotPending:      "The booking is already confirmed or cancelled.",
+		msgBookingExpired:  "The time to confirm the booking has run out.",
+		msgNoConfirmation:  "This event does not require booking confirmation.",
+		msgInternalError:   "Something went wrong, try again later.",
+		msgStatusPending:   "awaiting confirmation",
+		msgStatusConfirmed: "confirmed",
+		msgStatusCancelled: "cancelled",
+		msgDateLayout:      "Jan 2, 2006 3:04 PM",
+	},
+}
+
+// commandLists — меню команд бота для каждого языка.
+var commandLists = map[string][]tgbotapi.BotCommand{
+	"ru": {
+		{Command: "events", Description: "Ближайшие мероприятия"},
+		{Command: "book", Description: "Забронировать место: /book <id>"},
+		{Command: "confirm", Description: "Подтвердить бронь: /confirm <id>"},
+		{Command: "mybookings", Description: "Мои брони"},
+		{Command: "cancel", Description: "Отменить бронь: /cancel <id>"},
+	},
+	"en": {
+		{Command: "events", Description: "Upcoming events"},
+		{Command: "book", Description: "Book a spot: /book <id>"},
+		{Command: "confirm", Description: "Confirm a booking: /confirm <id>"},
+		{Command: "mybookings", Description: "My bookings"},
+		{Command: "cancel", Description: "Cancel a booking: /cancel <id>"},
+	},
+}
+
+// text возвращает текст ответа на языке locale, подставляя args, если они есть.
+func text(locale string, key messageKey, args ...any) string {
+	m, ok := messages[locale]
+	if !ok {
+		m = messages[domain.DefaultLocale]
+	}
+	if len(args) == 0 {
+		return m[key]
+	}
+	return fmt.Sprintf(m[key], args...)
+}
+
+// chatLocale выбирает язык ответов: язык аккаунта, а для непривязанного чата —
+// язык клиента Telegram, если он поддерживается.
+func chatLocale(user *domain.User, from *tgbotapi.User) string {
+	if user != nil && domain.IsSupportedLocale(user.Locale) {
+		return user.Locale
+	}
+	if from != nil {
+		lang, _, _ := strings.Cut(from.LanguageCode, "-")
+		if domain.IsSupportedLocale(lang) {
+			return lang
+		}
+	}
+	return domain.DefaultLocale
+}
+
+func statusText(locale string, status domain.BookingStatus) string {
+	switch status {
+	case domain.BookingStatusPending:
+		return text(locale, msgStatusPending)
+	case domain.BookingStatusConfirmed:
+		return text(locale, msgStatusConfirmed)
+	case domain.BookingStatusCancelled:
+		return text(locale, msgStatusCancelled)
+	default:
+		return string(status)
+	}
+}
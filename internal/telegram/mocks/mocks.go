@@ -0,0 +1,816 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stpnv0/EventBooker/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockSender creates a new instance of MockSender. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSender(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSender {
+	mock := &MockSender{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSender is an autogenerated mock type for the Sender type
+type MockSender struct {
+	mock.Mock
+}
+
+type MockSender_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSender) EXPECT() *MockSender_Expecter {
+	return &MockSender_Expecter{mock: &_m.Mock}
+}
+
+// Request provides a mock function for the type MockSender
+func (_mock *MockSender) Request(c tgbotapi.Chattable) (*tgbotapi.APIResponse, error) {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Request")
+	}
+
+	var r0 *tgbotapi.APIResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(tgbotapi.Chattable) (*tgbotapi.APIResponse, error)); ok {
+		return returnFunc(c)
+	}
+	if returnFunc, ok := ret.Get(0).(func(tgbotapi.Chattable) *tgbotapi.APIResponse); ok {
+		r0 = returnFunc(c)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tgbotapi.APIResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(tgbotapi.Chattable) error); ok {
+		r1 = returnFunc(c)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSender_Request_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Request'
+type MockSender_Request_Call struct {
+	*mock.Call
+}
+
+// Request is a helper method to define mock.On call
+//   - c tgbotapi.Chattable
+func (_e *MockSender_Expecter) Request(c interface{}) *MockSender_Request_Call {
+	return &MockSender_Request_Call{Call: _e.mock.On("Request", c)}
+}
+
+func (_c *MockSender_Request_Call) Run(run func(c tgbotapi.Chattable)) *MockSender_Request_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 tgbotapi.Chattable
+		if args[0] != nil {
+			arg0 = args[0].(tgbotapi.Chattable)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSender_Request_Call) Return(aPIResponse *tgbotapi.APIResponse, err error) *MockSender_Request_Call {
+	_c.Call.Return(aPIResponse, err)
+	return _c
+}
+
+func (_c *MockSender_Request_Call) RunAndReturn(run func(c tgbotapi.Chattable) (*tgbotapi.APIResponse, error)) *MockSender_Request_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Send provides a mock function for the type MockSender
+func (_mock *MockSender) Send(c tgbotapi.Chattable) (tgbotapi.Message, error) {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 tgbotapi.Message
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(tgbotapi.Chattable) (tgbotapi.Message, error)); ok {
+		return returnFunc(c)
+	}
+	if returnFunc, ok := ret.Get(0).(func(tgbotapi.Chattable) tgbotapi.Message); ok {
+		r0 = returnFunc(c)
+	} else {
+		r0 = ret.Get(0).(tgbotapi.Message)
+	}
+	if returnFunc, ok := ret.Get(1).(func(tgbotapi.Chattable) error); ok {
+		r1 = returnFunc(c)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSender_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type MockSender_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - c tgbotapi.Chattable
+func (_e *MockSender_Expecter) Send(c interface{}) *MockSender_Send_Call {
+	return &MockSender_Send_Call{Call: _e.mock.On("Send", c)}
+}
+
+func (_c *MockSender_Send_Call) Run(run func(c tgbotapi.Chattable)) *MockSender_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 tgbotapi.Chattable
+		if args[0] != nil {
+			arg0 = args[0].(tgbotapi.Chattable)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSender_Send_Call) Return(message tgbotapi.Message, err error) *MockSender_Send_Call {
+	_c.Call.Return(message, err)
+	return _c
+}
+
+func (_c *MockSender_Send_Call) RunAndReturn(run func(c tgbotapi.Chattable) (tgbotapi.Message, error)) *MockSender_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEventSvc creates a new instance of MockEventSvc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEventSvc(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEventSvc {
+	mock := &MockEventSvc{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockEventSvc is an autogenerated mock type for the EventSvc type
+type MockEventSvc struct {
+	mock.Mock
+}
+
+type MockEventSvc_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEventSvc) EXPECT() *MockEventSvc_Expecter {
+	return &MockEventSvc_Expecter{mock: &_m.Mock}
+}
+
+// GetByID provides a mock function for the type MockEventSvc
+func (_mock *MockEventSvc) GetByID(ctx context.Context, id string) (*domain.Event, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.Event
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Event, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Event); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Event)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventSvc_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockEventSvc_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockEventSvc_Expecter) GetByID(ctx interface{}, id interface{}) *MockEventSvc_GetByID_Call {
+	return &MockEventSvc_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockEventSvc_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockEventSvc_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventSvc_GetByID_Call) Return(event *domain.Event, err error) *MockEventSvc_GetByID_Call {
+	_c.Call.Return(event, err)
+	return _c
+}
+
+func (_c *MockEventSvc_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.Event, error)) *MockEventSvc_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockEventSvc
+func (_mock *MockEventSvc) List(ctx context.Context) ([]*domain.Event, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*domain.Event
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.Event, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.Event); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Event)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventSvc_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockEventSvc_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockEventSvc_Expecter) List(ctx interface{}) *MockEventSvc_List_Call {
+	return &MockEventSvc_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockEventSvc_List_Call) Run(run func(ctx context.Context)) *MockEventSvc_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventSvc_List_Call) Return(events []*domain.Event, err error) *MockEventSvc_List_Call {
+	_c.Call.Return(events, err)
+	return _c
+}
+
+func (_c *MockEventSvc_List_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.Event, error)) *MockEventSvc_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBookingSvc creates a new instance of MockBookingSvc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBookingSvc(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBookingSvc {
+	mock := &MockBookingSvc{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBookingSvc is an autogenerated mock type for the BookingSvc type
+type MockBookingSvc struct {
+	mock.Mock
+}
+
+type MockBookingSvc_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBookingSvc) EXPECT() *MockBookingSvc_Expecter {
+	return &MockBookingSvc_Expecter{mock: &_m.Mock}
+}
+
+// Book provides a mock function for the type MockBookingSvc
+func (_mock *MockBookingSvc) Book(ctx context.Context, eventID string, userID string, accessCode string) (*domain.Booking, error) {
+	ret := _mock.Called(ctx, eventID, userID, accessCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Book")
+	}
+
+	var r0 *domain.Booking
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (*domain.Booking, error)); ok {
+		return returnFunc(ctx, eventID, userID, accessCode)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) *domain.Booking); ok {
+		r0 = returnFunc(ctx, eventID, userID, accessCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Booking)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, eventID, userID, accessCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBookingSvc_Book_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Book'
+type MockBookingSvc_Book_Call struct {
+	*mock.Call
+}
+
+// Book is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+//   - userID string
+//   - accessCode string
+func (_e *MockBookingSvc_Expecter) Book(ctx interface{}, eventID interface{}, userID interface{}, accessCode interface{}) *MockBookingSvc_Book_Call {
+	return &MockBookingSvc_Book_Call{Call: _e.mock.On("Book", ctx, eventID, userID, accessCode)}
+}
+
+func (_c *MockBookingSvc_Book_Call) Run(run func(ctx context.Context, eventID string, userID string, accessCode string)) *MockBookingSvc_Book_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookingSvc_Book_Call) Return(booking *domain.Booking, err error) *MockBookingSvc_Book_Call {
+	_c.Call.Return(booking, err)
+	return _c
+}
+
+func (_c *MockBookingSvc_Book_Call) RunAndReturn(run func(ctx context.Context, eventID string, userID string, accessCode string) (*domain.Booking, error)) *MockBookingSvc_Book_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Cancel provides a mock function for the type MockBookingSvc
+func (_mock *MockBookingSvc) Cancel(ctx context.Context, eventID string, userID string) error {
+	ret := _mock.Called(ctx, eventID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cancel")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, eventID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBookingSvc_Cancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cancel'
+type MockBookingSvc_Cancel_Call struct {
+	*mock.Call
+}
+
+// Cancel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+//   - userID string
+func (_e *MockBookingSvc_Expecter) Cancel(ctx interface{}, eventID interface{}, userID interface{}) *MockBookingSvc_Cancel_Call {
+	return &MockBookingSvc_Cancel_Call{Call: _e.mock.On("Cancel", ctx, eventID, userID)}
+}
+
+func (_c *MockBookingSvc_Cancel_Call) Run(run func(ctx context.Context, eventID string, userID string)) *MockBookingSvc_Cancel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookingSvc_Cancel_Call) Return(err error) *MockBookingSvc_Cancel_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBookingSvc_Cancel_Call) RunAndReturn(run func(ctx context.Context, eventID string, userID string) error) *MockBookingSvc_Cancel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Confirm provides a mock function for the type MockBookingSvc
+func (_mock *MockBookingSvc) Confirm(ctx context.Context, eventID string, userID string) error {
+	ret := _mock.Called(ctx, eventID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Confirm")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, eventID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBookingSvc_Confirm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Confirm'
+type MockBookingSvc_Confirm_Call struct {
+	*mock.Call
+}
+
+// Confirm is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+//   - userID string
+func (_e *MockBookingSvc_Expecter) Confirm(ctx interface{}, eventID interface{}, userID interface{}) *MockBookingSvc_Confirm_Call {
+	return &MockBookingSvc_Confirm_Call{Call: _e.mock.On("Confirm", ctx, eventID, userID)}
+}
+
+func (_c *MockBookingSvc_Confirm_Call) Run(run func(ctx context.Context, eventID string, userID string)) *MockBookingSvc_Confirm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookingSvc_Confirm_Call) Return(err error) *MockBookingSvc_Confirm_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBookingSvc_Confirm_Call) RunAndReturn(run func(ctx context.Context, eventID string, userID string) error) *MockBookingSvc_Confirm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUser provides a mock function for the type MockBookingSvc
+func (_mock *MockBookingSvc) ListByUser(ctx context.Context, userID string) ([]*domain.Booking, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUser")
+	}
+
+	var r0 []*domain.Booking
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*domain.Booking, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*domain.Booking); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Booking)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBookingSvc_ListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUser'
+type MockBookingSvc_ListByUser_Call struct {
+	*mock.Call
+}
+
+// ListByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockBookingSvc_Expecter) ListByUser(ctx interface{}, userID interface{}) *MockBookingSvc_ListByUser_Call {
+	return &MockBookingSvc_ListByUser_Call{Call: _e.mock.On("ListByUser", ctx, userID)}
+}
+
+func (_c *MockBookingSvc_ListByUser_Call) Run(run func(ctx context.Context, userID string)) *MockBookingSvc_ListByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookingSvc_ListByUser_Call) Return(bookings []*domain.Booking, err error) *MockBookingSvc_ListByUser_Call {
+	_c.Call.Return(bookings, err)
+	return _c
+}
+
+func (_c *MockBookingSvc_ListByUser_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]*domain.Booking, error)) *MockBookingSvc_ListByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserSvc creates a new instance of MockUserSvc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserSvc(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserSvc {
+	mock := &MockUserSvc{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUserSvc is an autogenerated mock type for the UserSvc type
+type MockUserSvc struct {
+	mock.Mock
+}
+
+type MockUserSvc_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserSvc) EXPECT() *MockUserSvc_Expecter {
+	return &MockUserSvc_Expecter{mock: &_m.Mock}
+}
+
+// GetByTelegramChatID provides a mock function for the type MockUserSvc
+func (_mock *MockUserSvc) GetByTelegramChatID(ctx context.Context, chatID int64) (*domain.User, error) {
+	ret := _mock.Called(ctx, chatID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTelegramChatID")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.User, error)); ok {
+		return returnFunc(ctx, chatID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.User); ok {
+		r0 = returnFunc(ctx, chatID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, chatID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserSvc_GetByTelegramChatID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTelegramChatID'
+type MockUserSvc_GetByTelegramChatID_Call struct {
+	*mock.Call
+}
+
+// GetByTelegramChatID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatID int64
+func (_e *MockUserSvc_Expecter) GetByTelegramChatID(ctx interface{}, chatID interface{}) *MockUserSvc_GetByTelegramChatID_Call {
+	return &MockUserSvc_GetByTelegramChatID_Call{Call: _e.mock.On("GetByTelegramChatID", ctx, chatID)}
+}
+
+func (_c *MockUserSvc_GetByTelegramChatID_Call) Run(run func(ctx context.Context, chatID int64)) *MockUserSvc_GetByTelegramChatID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserSvc_GetByTelegramChatID_Call) Return(user *domain.User, err error) *MockUserSvc_GetByTelegramChatID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserSvc_GetByTelegramChatID_Call) RunAndReturn(run func(ctx context.Context, chatID int64) (*domain.User, error)) *MockUserSvc_GetByTelegramChatID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLinkSvc creates a new instance of MockLinkSvc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLinkSvc(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLinkSvc {
+	mock := &MockLinkSvc{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLinkSvc is an autogenerated mock type for the LinkSvc type
+type MockLinkSvc struct {
+	mock.Mock
+}
+
+type MockLinkSvc_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLinkSvc) EXPECT() *MockLinkSvc_Expecter {
+	return &MockLinkSvc_Expecter{mock: &_m.Mock}
+}
+
+// Link provides a mock function for the type MockLinkSvc
+func (_mock *MockLinkSvc) Link(ctx context.Context, token string, chatID int64) (*domain.User, error) {
+	ret := _mock.Called(ctx, token, chatID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Link")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64) (*domain.User, error)); ok {
+		return returnFunc(ctx, token, chatID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64) *domain.User); ok {
+		r0 = returnFunc(ctx, token, chatID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = returnFunc(ctx, token, chatID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLinkSvc_Link_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Link'
+type MockLinkSvc_Link_Call struct {
+	*mock.Call
+}
+
+// Link is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - chatID int64
+func (_e *MockLinkSvc_Expecter) Link(ctx interface{}, token interface{}, chatID interface{}) *MockLinkSvc_Link_Call {
+	return &MockLinkSvc_Link_Call{Call: _e.mock.On("Link", ctx, token, chatID)}
+}
+
+func (_c *MockLinkSvc_Link_Call) Run(run func(ctx context.Context, token string, chatID int64)) *MockLinkSvc_Link_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLinkSvc_Link_Call) Return(user *domain.User, err error) *MockLinkSvc_Link_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockLinkSvc_Link_Call) RunAndReturn(run func(ctx context.Context, token string, chatID int64) (*domain.User, error)) *MockLinkSvc_Link_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,78 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stpnv0/EventBooker/internal/netguard"
+)
+
+// Заголовки запроса. Подпись считается как HMAC-SHA256(secret, "<timestamp>.<body>"),
+// чтобы получатель мог отвергать повторно отправленные старые запросы.
+const (
+	HeaderEvent     = "X-EventBooker-Event"
+	HeaderDelivery  = "X-EventBooker-Delivery"
+	HeaderTimestamp = "X-EventBooker-Timestamp"
+	HeaderSignature = "X-EventBooker-Signature"
+)
+
+// maxResponseBody — сколько байт ответа читается, чтобы переиспользовать соединение.
+const maxResponseBody = 64 << 10
+
+// Client доставляет события подписчикам по HTTP. Запросы на непубличные адреса
+// блокируются, редиректы не выполняются.
+type Client struct {
+	http *http.Client
+}
+
+func NewClient(timeout time.Duration) *Client {
+	return &Client{http: netguard.NewHTTPClient(timeout)}
+}
+
+// Send отправляет payload доставки и возвращает статус ответа.
+// Ответ не из диапазона 2xx считается ошибкой.
+func (c *Client) Send(ctx context.Context, sub *domain.WebhookSubscription, d *domain.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("build request: %w", err)
+	}
+
+	ts := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "EventBooker-Webhooks/1.0")
+	req.Header.Set(HeaderEvent, string(d.EventType))
+	req.Header.Set(HeaderDelivery, d.ID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(ts, 10))
+	req.Header.Set(HeaderSignature, "sha256="+Sign(sub.Secret, ts, d.Payload))
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBody))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Sign возвращает hex-подпись тела запроса.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,59 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stpnv0/EventBooker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Send_SignsPayload(t *testing.T) {
+	var got *http.Request
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	sub := &domain.WebhookSubscription{URL: srv.URL, Secret: "s3cret"}
+	d := &domain.WebhookDelivery{ID: "d1", EventType: domain.WebhookBookingCreated, Payload: []byte(`{"type":"booking.created"}`)}
+
+	status, err := (&Client{http: srv.Client()}).Send(context.Background(), sub, d)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, status)
+	assert.Equal(t, `{"type":"booking.created"}`, string(body))
+	assert.Equal(t, "booking.created", got.Header.Get(HeaderEvent))
+	assert.Equal(t, "d1", got.Header.Get(HeaderDelivery))
+
+	ts, err := strconv.ParseInt(got.Header.Get(HeaderTimestamp), 10, 64)
+	require.NoError(t, err)
+	assert.Equal(t, "sha256="+Sign("s3cret", ts, body), got.Header.Get(HeaderSignature))
+}
+
+func TestClient_Send_Non2xxIsError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	sub := &domain.WebhookSubscription{URL: srv.URL, Secret: "s"}
+	status, err := (&Client{http: srv.Client()}).Send(context.Background(), sub, &domain.WebhookDelivery{Payload: []byte(`{}`)})
+
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusBadGateway, status)
+}
+
+func TestSign_DependsOnTimestamp(t *testing.T) {
+	body := []byte(`{}`)
+	assert.NotEqual(t, Sign("s", 1, body), Sign("s", 2, body))
+	assert.Equal(t, Sign("s", 1, body), Sign("s", 1, body))
+}
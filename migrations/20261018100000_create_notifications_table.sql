@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS notifications (
+    id           UUID PRIMARY KEY,
+    kind         VARCHAR(50) NOT NULL,
+    user_id      UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    event_id     UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    attempts     INT NOT NULL DEFAULT 0,
+    locked_until TIMESTAMPTZ,
+    sent_at      TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_notifications_unsent
+    ON notifications (created_at)
+    WHERE sent_at IS NULL;
+
+-- +goose Down
+DROP TABLE IF EXISTS notifications;
@@ -0,0 +1,16 @@
+-- +goose Up
+ALTER TABLE users ADD COLUMN IF NOT EXISTS webhook_url TEXT;
+
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id    UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    channel    VARCHAR(20) NOT NULL
+        CHECK (channel IN ('telegram', 'email', 'webhook')),
+    kind       VARCHAR(50) NOT NULL,
+    enabled    BOOLEAN NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, channel, kind)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS notification_preferences;
+ALTER TABLE users DROP COLUMN IF EXISTS webhook_url;
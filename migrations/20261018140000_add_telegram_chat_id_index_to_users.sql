@@ -0,0 +1,6 @@
+-- +goose Up
+CREATE INDEX IF NOT EXISTS idx_users_telegram_chat_id ON users (telegram_chat_id)
+    WHERE telegram_chat_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_users_telegram_chat_id;
@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS telegram_link_tokens (
+    token_hash  CHAR(64) PRIMARY KEY,
+    user_id     UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expires_at  TIMESTAMPTZ NOT NULL,
+    used_at     TIMESTAMPTZ,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_telegram_link_tokens_user_id ON telegram_link_tokens (user_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS telegram_link_tokens;
@@ -0,0 +1,47 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS event_series (
+    id                 UUID PRIMARY KEY,
+    title              TEXT NOT NULL,
+    description        TEXT NOT NULL,
+    total_spots        INT NOT NULL CHECK ( total_spots > 0 ),
+    requires_payment   BOOLEAN NOT NULL DEFAULT true,
+    booking_ttl        INTERVAL NOT NULL DEFAULT '20 minutes',
+    start_at           TIMESTAMPTZ NOT NULL,
+    timezone           TEXT NOT NULL DEFAULT 'UTC',
+    freq               VARCHAR(16) NOT NULL CHECK ( freq IN ('daily', 'weekly', 'monthly') ),
+    repeat_interval    INT NOT NULL DEFAULT 1 CHECK ( repeat_interval > 0 ),
+    by_weekday         INT[] NOT NULL DEFAULT '{}',
+    by_month_day       INT[] NOT NULL DEFAULT '{}',
+    repeat_count       INT NOT NULL DEFAULT 0,
+    until_at           TIMESTAMPTZ,
+    exceptions         TIMESTAMPTZ[] NOT NULL DEFAULT '{}',
+    materialized_until TIMESTAMPTZ,
+    cancelled_at       TIMESTAMPTZ,
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at         TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_event_series_active
+    ON event_series (materialized_until)
+    WHERE cancelled_at IS NULL;
+
+ALTER TABLE events
+    ADD COLUMN series_id       UUID REFERENCES event_series(id) ON DELETE SET NULL,
+    ADD COLUMN occurrence_date TIMESTAMPTZ,
+    ADD COLUMN detached        BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN cancelled_at    TIMESTAMPTZ;
+
+-- occurrence_date — исходное время вхождения по правилу; не меняется при переносе,
+-- поэтому повторная материализация не создаёт дубликатов.
+CREATE UNIQUE INDEX idx_events_series_occurrence
+    ON events (series_id, occurrence_date)
+    WHERE series_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_events_series_occurrence;
+ALTER TABLE events
+    DROP COLUMN IF EXISTS cancelled_at,
+    DROP COLUMN IF EXISTS detached,
+    DROP COLUMN IF EXISTS occurrence_date,
+    DROP COLUMN IF EXISTS series_id;
+DROP TABLE IF EXISTS event_series;
@@ -0,0 +1,28 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS venues (
+    id         UUID PRIMARY KEY,
+    name       TEXT NOT NULL,
+    address    TEXT NOT NULL,
+    latitude   DOUBLE PRECISION CHECK ( latitude BETWEEN -90 AND 90 ),
+    longitude  DOUBLE PRECISION CHECK ( longitude BETWEEN -180 AND 180 ),
+    capacity   INT NOT NULL CHECK ( capacity > 0 ),
+    timezone   TEXT NOT NULL DEFAULT 'UTC',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Удалить площадку, на которой есть мероприятия, нельзя.
+ALTER TABLE events
+    ADD COLUMN venue_id UUID REFERENCES venues(id) ON DELETE RESTRICT,
+    ADD COLUMN duration INTERVAL NOT NULL DEFAULT '2 hours' CHECK ( duration > INTERVAL '0' );
+
+CREATE INDEX idx_events_venue_date
+    ON events (venue_id, event_date)
+    WHERE venue_id IS NOT NULL AND cancelled_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_events_venue_date;
+ALTER TABLE events
+    DROP COLUMN IF EXISTS duration,
+    DROP COLUMN IF EXISTS venue_id;
+DROP TABLE IF EXISTS venues;
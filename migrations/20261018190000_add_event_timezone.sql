@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE events ADD COLUMN timezone TEXT NOT NULL DEFAULT 'UTC';
+
+-- Существующие мероприятия получают пояс серии или площадки.
+UPDATE events e SET timezone = s.timezone FROM event_series s WHERE e.series_id = s.id;
+UPDATE events e SET timezone = v.timezone FROM venues v WHERE e.venue_id = v.id AND e.series_id IS NULL;
+
+-- +goose Down
+ALTER TABLE events DROP COLUMN IF EXISTS timezone;
@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE events
+    ADD COLUMN sales_open_at  TIMESTAMPTZ,
+    ADD COLUMN sales_close_at TIMESTAMPTZ,
+    ADD CONSTRAINT events_sales_window_check CHECK ( sales_close_at > sales_open_at );
+
+-- +goose Down
+ALTER TABLE events
+    DROP CONSTRAINT IF EXISTS events_sales_window_check,
+    DROP COLUMN IF EXISTS sales_close_at,
+    DROP COLUMN IF EXISTS sales_open_at;
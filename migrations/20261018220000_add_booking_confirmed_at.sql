@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE bookings
+    ADD COLUMN confirmed_at TIMESTAMPTZ;
+
+-- Точный момент подтверждения старых броней неизвестен; последнее изменение — ближайшая оценка.
+UPDATE bookings SET confirmed_at = updated_at WHERE status = 'confirmed';
+
+-- +goose Down
+ALTER TABLE bookings
+    DROP COLUMN IF EXISTS confirmed_at;
@@ -0,0 +1,25 @@
+-- +goose Up
+ALTER TABLE bookings
+    ADD COLUMN expired_at TIMESTAMPTZ;
+
+-- Раньше истечение не отличалось от отмены: считаем истёкшими неподтверждённые брони,
+-- отменённые уже после окончания TTL, — так их отменял только планировщик.
+UPDATE bookings b
+SET expired_at = b.updated_at
+FROM events e
+WHERE b.event_id = e.id
+  AND b.status = 'cancelled'
+  AND b.confirmed_at IS NULL
+  AND b.updated_at >= b.created_at + e.booking_ttl;
+
+-- Для дневной статистики созданных, подтверждённых и истёкших броней.
+CREATE INDEX idx_bookings_created_at ON bookings (created_at);
+CREATE INDEX idx_bookings_confirmed_at ON bookings (confirmed_at) WHERE confirmed_at IS NOT NULL;
+CREATE INDEX idx_bookings_expired_at ON bookings (expired_at) WHERE expired_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_bookings_expired_at;
+DROP INDEX IF EXISTS idx_bookings_confirmed_at;
+DROP INDEX IF EXISTS idx_bookings_created_at;
+ALTER TABLE bookings
+    DROP COLUMN IF EXISTS expired_at;
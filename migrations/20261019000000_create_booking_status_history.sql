@@ -0,0 +1,39 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS booking_status_history (
+    id          BIGSERIAL PRIMARY KEY,
+    booking_id  UUID NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    from_status VARCHAR(35),
+    to_status   VARCHAR(35) NOT NULL,
+    actor       VARCHAR(16) NOT NULL
+        CHECK (actor IN ('user', 'admin', 'system')),
+    actor_id    UUID,
+    reason      VARCHAR(64) NOT NULL,
+    request_id  TEXT,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_booking_status_history_booking
+    ON booking_status_history (booking_id, id);
+
+-- История существующих броней восстанавливается по меткам времени; кто и почему менял статус,
+-- уже не узнать, поэтому такие записи помечены reason = 'backfill'.
+INSERT INTO booking_status_history (booking_id, from_status, to_status, actor, reason, created_at)
+SELECT id, NULL,
+       CASE WHEN confirmed_at = created_at THEN 'confirmed' ELSE 'pending' END,
+       'system', 'backfill', created_at
+FROM bookings;
+
+INSERT INTO booking_status_history (booking_id, from_status, to_status, actor, reason, created_at)
+SELECT id, 'pending', 'confirmed', 'system', 'backfill', confirmed_at
+FROM bookings
+WHERE confirmed_at > created_at;
+
+INSERT INTO booking_status_history (booking_id, from_status, to_status, actor, reason, created_at)
+SELECT id,
+       CASE WHEN confirmed_at IS NOT NULL THEN 'confirmed' ELSE 'pending' END,
+       'cancelled', 'system', 'backfill', COALESCE(expired_at, updated_at)
+FROM bookings
+WHERE status = 'cancelled';
+
+-- +goose Down
+DROP TABLE IF EXISTS booking_status_history;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- Название весит больше описания. Каждый текст разбирается и русским, и английским словарём:
+-- язык мероприятия не хранится, а запрос на любом из двух языков должен находить свои словоформы.
+ALTER TABLE events
+    ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian'::regconfig, coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('english'::regconfig, coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('russian'::regconfig, coalesce(description, '')), 'B') ||
+        setweight(to_tsvector('english'::regconfig, coalesce(description, '')), 'B')
+    ) STORED;
+
+CREATE INDEX idx_events_search ON events USING GIN (search_vector);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_events_search;
+ALTER TABLE events
+    DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,47 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS categories (
+    id          UUID PRIMARY KEY,
+    slug        VARCHAR(64) NOT NULL UNIQUE,
+    name        TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS event_categories (
+    event_id    UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    category_id UUID NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+    PRIMARY KEY (event_id, category_id)
+);
+
+CREATE INDEX idx_event_categories_category ON event_categories (category_id);
+
+-- Теги свободные: создаются при первом использовании и хранятся в нижнем регистре.
+CREATE TABLE IF NOT EXISTS tags (
+    id   BIGSERIAL PRIMARY KEY,
+    name VARCHAR(50) NOT NULL UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS event_tags (
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    tag_id   BIGINT NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (event_id, tag_id)
+);
+
+CREATE INDEX idx_event_tags_tag ON event_tags (tag_id);
+
+CREATE TABLE IF NOT EXISTS category_follows (
+    user_id     UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    category_id UUID NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, category_id)
+);
+
+CREATE INDEX idx_category_follows_category ON category_follows (category_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS category_follows;
+DROP TABLE IF EXISTS event_tags;
+DROP TABLE IF EXISTS tags;
+DROP TABLE IF EXISTS event_categories;
+DROP TABLE IF EXISTS categories;
@@ -0,0 +1,23 @@
+-- +goose Up
+-- Уже созданные мероприятия видны пользователям, поэтому считаются опубликованными;
+-- новые по умолчанию создаются черновиками.
+ALTER TABLE events
+    ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'published'
+        CHECK (status IN ('draft', 'published', 'archived')),
+    ADD COLUMN publish_at TIMESTAMPTZ,
+    ADD COLUMN published_at TIMESTAMPTZ;
+
+UPDATE events SET published_at = created_at;
+
+ALTER TABLE events
+    ALTER COLUMN status SET DEFAULT 'draft';
+
+-- Планировщик ищет черновики, время публикации которых наступило.
+CREATE INDEX idx_events_publish_at ON events (publish_at) WHERE status = 'draft';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_events_publish_at;
+ALTER TABLE events
+    DROP COLUMN IF EXISTS published_at,
+    DROP COLUMN IF EXISTS publish_at,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- Закрытое мероприятие бронируется по коду доступа или по приглашению.
+ALTER TABLE events
+    ADD COLUMN visibility VARCHAR(16) NOT NULL DEFAULT 'public'
+        CHECK (visibility IN ('public', 'private')),
+    ADD COLUMN access_code VARCHAR(64);
+
+CREATE TABLE event_invitations (
+    event_id   UUID        NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    user_id    UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (event_id, user_id)
+);
+
+CREATE INDEX idx_event_invitations_user_id ON event_invitations (user_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS event_invitations;
+ALTER TABLE events
+    DROP COLUMN IF EXISTS access_code,
+    DROP COLUMN IF EXISTS visibility;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- Индивидуальные ограничения броней. NULL в лимите — действует общее правило из конфига.
+CREATE TABLE IF NOT EXISTS booking_limit_overrides (
+    user_id             UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    max_pending         INT CHECK (max_pending >= 0),
+    max_per_day         INT CHECK (max_per_day >= 0),
+    max_expired         INT CHECK (max_expired >= 0),
+    exempt              BOOLEAN NOT NULL DEFAULT FALSE,
+    cooldown_cleared_at TIMESTAMPTZ,
+    updated_at          TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Для подсчёта броней пользователя за последние сутки.
+CREATE INDEX idx_bookings_user_created ON bookings (user_id, created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_bookings_user_created;
+DROP TABLE IF EXISTS booking_limit_overrides;
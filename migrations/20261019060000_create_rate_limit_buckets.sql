@@ -0,0 +1,14 @@
+-- +goose Up
+-- Вёдра ограничителя частоты запросов. UNLOGGED: после сбоя вёдра просто начинаются заново полными.
+CREATE UNLOGGED TABLE IF NOT EXISTS rate_limit_buckets (
+    key        TEXT PRIMARY KEY,
+    tokens     DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    -- После expires_at ведро полное и ничем не отличается от отсутствующего.
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_rate_limit_buckets_expires_at ON rate_limit_buckets (expires_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS rate_limit_buckets;
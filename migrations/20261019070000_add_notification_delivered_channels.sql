@@ -0,0 +1,9 @@
+-- +goose Up
+-- Каналы, которые уже доставили уведомление: при повторе после сбоя другого канала
+-- они пропускаются.
+ALTER TABLE notifications
+    ADD COLUMN delivered_channels TEXT[] NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE notifications
+    DROP COLUMN IF EXISTS delivered_channels;
@@ -0,0 +1,7 @@
+// Package web содержит HTML-шаблоны и статику веб-интерфейса, встроенные в бинарник.
+package web
+
+import "embed"
+
+//go:embed templates static
+var FS embed.FS
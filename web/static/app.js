@@ -66,7 +66,10 @@ function statusBadge(status) {
     const labels = {
         pending: '⏳ Ожидает оплаты',
         confirmed: '✅ Подтверждено',
-        cancelled: '❌ Отменено'
+        cancelled: '❌ Отменено',
+        draft: '📝 Черновик',
+        published: '📢 Опубликовано',
+        archived: '🗄 В архиве'
     };
     return `<span class="badge badge-${status}">${labels[status] || status}</span>`;
 }
@@ -97,6 +100,12 @@ async function handleRegisterUser() {
     const chatIdStr = document.getElementById('telegram-chat-id').value.trim();
     const body = { username };
     if (chatIdStr) body.telegram_chat_id = parseInt(chatIdStr, 10);
+    const email = document.getElementById('email').value.trim();
+    if (email) body.email = email;
+    body.locale = document.getElementById('locale').value;
+    const timezone = document.getElementById('timezone').value.trim()
+        || Intl.DateTimeFormat().resolvedOptions().timeZone;
+    if (timezone) body.timezone = timezone;
 
     try {
         const user = await api('POST', '/users', body);
@@ -126,6 +135,8 @@ function setCurrentUser(user) {
         <strong>👤 ${esc(user.username)}</strong>
         <span class="user-id">ID: ${esc(user.id.slice(0, 8))}...</span>
         ${user.telegram_chat_id ? `<span class="user-tg">📱 ${esc(String(user.telegram_chat_id))}</span>` : ''}
+        ${user.email ? `<span class="user-tg">✉️ ${esc(user.email)}</span>` : ''}
+        <button class="btn-secondary" onclick="handleLinkTelegram()">Привязать Telegram</button>
     `;
 
     document.getElementById('my-bookings-card').style.display = 'block';
@@ -134,10 +145,23 @@ function setCurrentUser(user) {
     loadMyBookings();
 }
 
+async function handleLinkTelegram() {
+    if (!currentUser) return;
+    try {
+        const link = await api('POST', `/users/${currentUser.id}/telegram-link`);
+        window.open(link.url, '_blank');
+        showToast('Откройте бота и нажмите «Start»');
+    } catch (e) {
+        showToast(e.message, 'error');
+    }
+}
+
 // ── User Panel: Events ──
 async function loadEvents() {
     try {
-        const events = await api('GET', '/events');
+        // Закрытые мероприятия видны только приглашённым.
+        const query = currentUser ? `?user_id=${currentUser.id}` : '';
+        const events = await api('GET', `/events${query}`);
         const list = document.getElementById('events-list');
 
         if (!events.length) {
@@ -315,6 +339,7 @@ async function handleCreateEvent() {
     const spots = parseInt(document.getElementById('event-spots').value, 10);
     const ttl = parseInt(document.getElementById('event-ttl').value, 10) || 0;
     const requiresPayment = document.getElementById('event-requires-payment').checked;
+    const publish = document.getElementById('event-publish').checked;
 
     if (!title || !description || !dateStr || !spots) {
         showToast('Заполните все обязательные поля', 'error');
@@ -328,7 +353,8 @@ async function handleCreateEvent() {
             event_date: new Date(dateStr).toISOString(),
             total_spots: spots,
             booking_ttl_minutes: ttl,
-            requires_payment: requiresPayment
+            requires_payment: requiresPayment,
+            publish
         });
         showToast(`Мероприятие "${event.title}" создано`);
 
@@ -338,6 +364,7 @@ async function handleCreateEvent() {
         document.getElementById('event-spots').value = '50';
         document.getElementById('event-ttl').value = '20';
         document.getElementById('event-requires-payment').checked = true;
+        document.getElementById('event-publish').checked = true;
 
         handleLoadAdminEvents();
     } catch (e) {
@@ -345,9 +372,19 @@ async function handleCreateEvent() {
     }
 }
 
+async function handlePublishEvent(eventId) {
+    try {
+        const event = await api('POST', `/events/${eventId}/publish`);
+        showToast(`Мероприятие "${event.title}" опубликовано`);
+        handleLoadAdminEvents();
+    } catch (e) {
+        showToast(e.message, 'error');
+    }
+}
+
 async function loadAdminEvents() {
     try {
-        const events = await api('GET', '/events');
+        const events = await api('GET', '/events?status=all&visibility=all');
         const list = document.getElementById('admin-events-list');
 
         if (!events.length) {
@@ -377,6 +414,10 @@ async function loadAdminEvents() {
                 ? `<span>⏰ TTL: ${esc(d.event.booking_ttl)}</span>`
                 : '<span class="badge badge-confirmed">Без подтверждения</span>';
 
+            const publishBtn = d.event.status === 'draft'
+                ? `<button class="btn-small btn-confirm" onclick="handlePublishEvent('${d.event.id}')">Опубликовать</button>`
+                : '';
+
             return `
                 <div class="list-item">
                     <h3>${esc(d.event.title)}</h3>
@@ -386,6 +427,9 @@ async function loadAdminEvents() {
                             🪑 ${d.available_spots} / ${d.event.total_spots}
                         </span>
                         ${confirmInfo}
+                        ${statusBadge(d.event.status)}
+                        ${d.event.visibility === 'private' ? '<span class="badge badge-private">🔒 Закрытое</span>' : ''}
+                        ${publishBtn}
                     </div>
                     <p style="margin-top:0.5rem;font-size:0.9rem;color:#555">
                         ${esc(d.event.description)}
@@ -421,6 +465,7 @@ async function handleLoadUsers() {
             ? `<span>📱 ${esc(String(u.telegram_chat_id))}</span>`
             : '<span style="color:#999">Telegram не привязан</span>'
         }
+                    ${u.email ? `<span>✉️ ${esc(u.email)}</span>` : ''}
                     <span>📅 ${formatDate(u.created_at)}</span>
                 </div>
             </div>